@@ -139,6 +139,8 @@ func startDomainEventMonitoring(
 	qemuAgentUserInterval time.Duration,
 	qemuAgentVersionInterval time.Duration,
 	qemuAgentFSFreezeStatusInterval time.Duration,
+	qemuAgentUsageInterval time.Duration,
+	qemuAgentCustomCommands []agentpoller.AgentCommand,
 	metadataCache *metadata.Cache,
 ) {
 	go func() {
@@ -150,12 +152,20 @@ func startDomainEventMonitoring(
 		}
 	}()
 
-	err := notifier.StartDomainNotifier(domainConn, deleteNotificationSent, vmi, domainName, agentStore, qemuAgentSysInterval, qemuAgentFileInterval, qemuAgentUserInterval, qemuAgentVersionInterval, qemuAgentFSFreezeStatusInterval, metadataCache)
+	err := notifier.StartDomainNotifier(domainConn, deleteNotificationSent, vmi, domainName, agentStore, qemuAgentSysInterval, qemuAgentFileInterval, qemuAgentUserInterval, qemuAgentVersionInterval, qemuAgentFSFreezeStatusInterval, qemuAgentUsageInterval, qemuAgentCustomCommands, metadataCache)
 	if err != nil {
 		panic(err)
 	}
 }
 
+func customAgentCommands(commands []string) []agentpoller.AgentCommand {
+	agentCommands := make([]agentpoller.AgentCommand, 0, len(commands))
+	for _, command := range commands {
+		agentCommands = append(agentCommands, agentpoller.AgentCommand(command))
+	}
+	return agentCommands
+}
+
 func initializeDirs(ephemeralDiskDir string,
 	containerDiskDir string,
 	hotplugDiskDir string,
@@ -348,6 +358,8 @@ func main() {
 	qemuAgentUserInterval := pflag.Duration("qemu-agent-user-interval", 10*time.Second, "Interval between consecutive qemu agent calls for user command")
 	qemuAgentVersionInterval := pflag.Duration("qemu-agent-version-interval", 300*time.Second, "Interval between consecutive qemu agent calls for version command")
 	qemuAgentFSFreezeStatusInterval := pflag.Duration("qemu-fsfreeze-status-interval", 5*time.Second, "Interval between consecutive qemu agent calls for fsfreeze status command")
+	qemuAgentUsageInterval := pflag.Duration("qemu-agent-usage-interval", 30*time.Second, "Interval between consecutive qemu agent calls for cpu stats and load commands")
+	qemuAgentCustomCommands := pflag.StringSlice("qemu-agent-custom-commands", nil, "Additional qemu agent commands to call with the sys commands interval")
 	simulateCrash := pflag.Bool("simulate-crash", false, "Causes virt-launcher to immediately crash. This is used by functional tests to simulate crash loop scenarios.")
 	libvirtLogFilters := pflag.String("libvirt-log-filters", "", "Set custom log filters for libvirt")
 
@@ -445,7 +457,7 @@ func main() {
 
 	events := make(chan watch.Event, 2)
 	// Send domain notifications to virt-handler
-	startDomainEventMonitoring(notifier, *virtShareDir, domainConn, events, vmi, domainName, &agentStore, *qemuAgentSysInterval, *qemuAgentFileInterval, *qemuAgentUserInterval, *qemuAgentVersionInterval, *qemuAgentFSFreezeStatusInterval, metadataCache)
+	startDomainEventMonitoring(notifier, *virtShareDir, domainConn, events, vmi, domainName, &agentStore, *qemuAgentSysInterval, *qemuAgentFileInterval, *qemuAgentUserInterval, *qemuAgentVersionInterval, *qemuAgentFSFreezeStatusInterval, *qemuAgentUsageInterval, customAgentCommands(*qemuAgentCustomCommands), metadataCache)
 
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt,
@@ -13,3 +13,4 @@ protoc --go_out=plugins=grpc:. pkg/handler-launcher-com/notify/info/info.proto
 protoc --go_out=plugins=grpc:. pkg/handler-launcher-com/cmd/v1/cmd.proto
 protoc --go_out=plugins=grpc:. pkg/handler-launcher-com/cmd/info/info.proto
 protoc --go_out=plugins=grpc:. pkg/vsock/system/v1/system.proto
+protoc --proto_path=pkg/virt-handler/device-manager/podresources/v1 --proto_path=vendor --gogo_out=plugins=grpc:pkg/virt-handler/device-manager/podresources/v1 pkg/virt-handler/device-manager/podresources/v1/api.proto
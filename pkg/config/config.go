@@ -65,6 +65,8 @@ var (
 	SecretSourceDir = filepath.Join(mountBaseDir, "secret")
 	// DownwardAPISourceDir represents a location where downwardapi is attached to the pod
 	DownwardAPISourceDir = filepath.Join(mountBaseDir, "downwardapi")
+	// NVRAMTemplateSourceDir represents a location where a custom NVRAM template is attached to the pod
+	NVRAMTemplateSourceDir = filepath.Join(mountBaseDir, "nvram-template")
 	// ServiceAccountSourceDir represents the location where the ServiceAccount token is attached to the pod
 	ServiceAccountSourceDir = "/var/run/secrets/kubernetes.io/serviceaccount/"
 
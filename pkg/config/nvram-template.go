@@ -0,0 +1,42 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package config
+
+import (
+	"path/filepath"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// DefaultNVRAMTemplateKey is the file looked up in a NVRAM template source when no key is provided
+const DefaultNVRAMTemplateKey = "OVMF_VARS.fd"
+
+// GetNVRAMTemplateKey returns the name of the variable store file in a NVRAM template source
+func GetNVRAMTemplateKey(source *v1.NVRAMTemplateSource) string {
+	if source.Key == "" {
+		return DefaultNVRAMTemplateKey
+	}
+	return source.Key
+}
+
+// GetNVRAMTemplatePath returns a path to the custom NVRAM template mounted on a pod
+func GetNVRAMTemplatePath(source *v1.NVRAMTemplateSource) string {
+	return filepath.Join(NVRAMTemplateSourceDir, GetNVRAMTemplateKey(source))
+}
@@ -0,0 +1,49 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package config
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("NVRAM template", func() {
+
+	It("Should default to the OVMF vars file name", func() {
+		source := &v1.NVRAMTemplateSource{
+			ConfigMap: &k8sv1.LocalObjectReference{Name: "secureboot-vars"},
+		}
+		Expect(GetNVRAMTemplatePath(source)).To(Equal(filepath.Join(NVRAMTemplateSourceDir, DefaultNVRAMTemplateKey)))
+	})
+
+	It("Should use the provided key", func() {
+		source := &v1.NVRAMTemplateSource{
+			Secret: &k8sv1.LocalObjectReference{Name: "secureboot-vars"},
+			Key:    "company_VARS.fd",
+		}
+		Expect(GetNVRAMTemplatePath(source)).To(Equal(filepath.Join(NVRAMTemplateSourceDir, "company_VARS.fd")))
+	})
+})
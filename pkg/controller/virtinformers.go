@@ -548,6 +548,17 @@ func GetVirtualMachineInformerIndexers() cache.Indexers {
 			}
 			return pvcs, nil
 		},
+		"dependsOn": func(obj interface{}) ([]string, error) {
+			vm, ok := obj.(*kubev1.VirtualMachine)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+			var dependencies []string
+			for _, dependency := range vm.Spec.DependsOn {
+				dependencies = append(dependencies, fmt.Sprintf("%s/%s", vm.Namespace, dependency.Name))
+			}
+			return dependencies, nil
+		},
 	}
 }
 
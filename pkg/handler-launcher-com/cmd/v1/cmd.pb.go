@@ -40,6 +40,7 @@ It has these top-level messages:
 	SEVInfoResponse
 	LaunchMeasurementResponse
 	InjectLaunchSecretRequest
+	GuestAgentCommandResultsResponse
 */
 package v1
 
@@ -891,6 +892,32 @@ func (m *InjectLaunchSecretRequest) GetOptions() []byte {
 	return nil
 }
 
+type GuestAgentCommandResultsResponse struct {
+	Response                         *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	GuestAgentCommandResultsResponse string    `protobuf:"bytes,2,opt,name=guestAgentCommandResultsResponse" json:"guestAgentCommandResultsResponse,omitempty"`
+}
+
+func (m *GuestAgentCommandResultsResponse) Reset()         { *m = GuestAgentCommandResultsResponse{} }
+func (m *GuestAgentCommandResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*GuestAgentCommandResultsResponse) ProtoMessage()    {}
+func (*GuestAgentCommandResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{30}
+}
+
+func (m *GuestAgentCommandResultsResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *GuestAgentCommandResultsResponse) GetGuestAgentCommandResultsResponse() string {
+	if m != nil {
+		return m.GuestAgentCommandResultsResponse
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QemuVersionResponse)(nil), "kubevirt.cmd.v1.QemuVersionResponse")
 	proto.RegisterType((*VMI)(nil), "kubevirt.cmd.v1.VMI")
@@ -922,6 +949,7 @@ func init() {
 	proto.RegisterType((*SEVInfoResponse)(nil), "kubevirt.cmd.v1.SEVInfoResponse")
 	proto.RegisterType((*LaunchMeasurementResponse)(nil), "kubevirt.cmd.v1.LaunchMeasurementResponse")
 	proto.RegisterType((*InjectLaunchSecretRequest)(nil), "kubevirt.cmd.v1.InjectLaunchSecretRequest")
+	proto.RegisterType((*GuestAgentCommandResultsResponse)(nil), "kubevirt.cmd.v1.GuestAgentCommandResultsResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -964,6 +992,7 @@ type CmdClient interface {
 	GetSEVInfo(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*SEVInfoResponse, error)
 	GetLaunchMeasurement(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*LaunchMeasurementResponse, error)
 	InjectLaunchSecret(ctx context.Context, in *InjectLaunchSecretRequest, opts ...grpc.CallOption) (*Response, error)
+	GetGuestAgentCommandResults(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GuestAgentCommandResultsResponse, error)
 }
 
 type cmdClient struct {
@@ -1235,6 +1264,15 @@ func (c *cmdClient) InjectLaunchSecret(ctx context.Context, in *InjectLaunchSecr
 	return out, nil
 }
 
+func (c *cmdClient) GetGuestAgentCommandResults(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GuestAgentCommandResultsResponse, error) {
+	out := new(GuestAgentCommandResultsResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GetGuestAgentCommandResults", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Cmd service
 
 type CmdServer interface {
@@ -1267,6 +1305,7 @@ type CmdServer interface {
 	GetSEVInfo(context.Context, *EmptyRequest) (*SEVInfoResponse, error)
 	GetLaunchMeasurement(context.Context, *VMIRequest) (*LaunchMeasurementResponse, error)
 	InjectLaunchSecret(context.Context, *InjectLaunchSecretRequest) (*Response, error)
+	GetGuestAgentCommandResults(context.Context, *EmptyRequest) (*GuestAgentCommandResultsResponse, error)
 }
 
 func RegisterCmdServer(s *grpc.Server, srv CmdServer) {
@@ -1795,6 +1834,24 @@ func _Cmd_InjectLaunchSecret_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cmd_GetGuestAgentCommandResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GetGuestAgentCommandResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GetGuestAgentCommandResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GetGuestAgentCommandResults(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Cmd_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kubevirt.cmd.v1.Cmd",
 	HandlerType: (*CmdServer)(nil),
@@ -1915,6 +1972,10 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InjectLaunchSecret",
 			Handler:    _Cmd_InjectLaunchSecret_Handler,
 		},
+		{
+			MethodName: "GetGuestAgentCommandResults",
+			Handler:    _Cmd_GetGuestAgentCommandResults_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/handler-launcher-com/cmd/v1/cmd.proto",
@@ -1923,109 +1984,112 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("pkg/handler-launcher-com/cmd/v1/cmd.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1662 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xef, 0x6e, 0x1b, 0xc7,
-	0x11, 0x17, 0x45, 0x4a, 0xa6, 0x46, 0x7f, 0x62, 0xaf, 0xfe, 0xf4, 0xac, 0xd6, 0xb6, 0xba, 0x28,
-	0x0c, 0xa5, 0x48, 0xa4, 0xda, 0x75, 0x82, 0xc2, 0x28, 0x8a, 0x54, 0x14, 0xa5, 0x28, 0x09, 0x6d,
-	0xe6, 0x28, 0xc9, 0x68, 0xda, 0x20, 0x58, 0xdd, 0xad, 0x4e, 0x5b, 0xdd, 0xed, 0x5e, 0x6f, 0xf7,
-	0x58, 0x33, 0x9f, 0x0a, 0xb4, 0xe8, 0x87, 0x02, 0x7d, 0x89, 0x7e, 0xe9, 0x33, 0xf4, 0x49, 0xfa,
-	0x3a, 0xc1, 0xee, 0xed, 0x51, 0x47, 0xde, 0xd1, 0x8c, 0x41, 0x7e, 0xe2, 0xcd, 0xce, 0xcc, 0x6f,
-	0x67, 0x67, 0x67, 0xe6, 0x7e, 0x3c, 0xf8, 0x30, 0xbe, 0x0d, 0x0e, 0x6f, 0x08, 0xf7, 0x43, 0x9a,
-	0x7c, 0x1c, 0x92, 0x94, 0x7b, 0x37, 0x34, 0xf9, 0xd8, 0x13, 0xd1, 0xa1, 0x17, 0xf9, 0x87, 0xfd,
-	0x67, 0xfa, 0xe7, 0x20, 0x4e, 0x84, 0x12, 0xe8, 0x83, 0xdb, 0xf4, 0x8a, 0xf6, 0x59, 0xa2, 0x0e,
-	0xf4, 0x5a, 0xff, 0x19, 0xbe, 0x86, 0xcd, 0xaf, 0x69, 0x94, 0x5e, 0xd2, 0x44, 0x32, 0xc1, 0x5d,
-	0x2a, 0x63, 0xc1, 0x25, 0x45, 0x9f, 0x40, 0x33, 0xb1, 0xcf, 0x4e, 0x6d, 0xaf, 0xb6, 0xbf, 0xfa,
-	0xfc, 0xe1, 0xc1, 0x98, 0xeb, 0x41, 0x6e, 0xec, 0x0e, 0x4d, 0x91, 0x03, 0xf7, 0xfa, 0x19, 0x92,
-	0xb3, 0xb8, 0x57, 0xdb, 0x5f, 0x71, 0x73, 0x11, 0x3f, 0x81, 0xfa, 0x65, 0xe7, 0xcc, 0x18, 0x44,
-	0xec, 0x0b, 0x29, 0xb8, 0x81, 0x5d, 0x73, 0x73, 0x11, 0x3f, 0x83, 0x7a, 0xab, 0x7b, 0x81, 0x36,
-	0x60, 0x91, 0xf9, 0x46, 0xb7, 0xee, 0x2e, 0x32, 0x1f, 0xed, 0x42, 0x53, 0xb2, 0xab, 0x90, 0xf1,
-	0x40, 0x3a, 0x8b, 0x7b, 0xf5, 0xfd, 0x75, 0x77, 0x28, 0xe3, 0x43, 0xb8, 0xd7, 0xcb, 0x9e, 0x4b,
-	0x6e, 0x5b, 0xb0, 0xd4, 0x27, 0x61, 0x4a, 0x4d, 0x18, 0x0d, 0x37, 0x13, 0x70, 0x1b, 0x96, 0xba,
-	0x24, 0xa0, 0x52, 0xab, 0x3d, 0x91, 0x72, 0x65, 0x3c, 0x1a, 0x6e, 0x26, 0x20, 0x04, 0x8d, 0x94,
-	0x33, 0x65, 0x43, 0x37, 0xcf, 0x7a, 0x4d, 0xb2, 0xef, 0xa9, 0x53, 0x37, 0xd0, 0xe6, 0x19, 0xbf,
-	0x80, 0xe5, 0x0e, 0x8d, 0x44, 0x32, 0x40, 0x3b, 0xb0, 0x4c, 0xa2, 0x02, 0x90, 0x95, 0xaa, 0x90,
-	0xf0, 0xff, 0x6b, 0xd0, 0x68, 0xd1, 0x30, 0x2c, 0xc5, 0x7a, 0x08, 0xcb, 0x91, 0x81, 0x33, 0xe6,
-	0xab, 0xcf, 0x7f, 0x52, 0xca, 0x74, 0xb6, 0x9b, 0x6b, 0xcd, 0xd0, 0x47, 0xb0, 0x14, 0xeb, 0x63,
-	0x38, 0xf5, 0xbd, 0xfa, 0xfe, 0xea, 0xf3, 0x9d, 0x92, 0xbd, 0x39, 0xa4, 0x9b, 0x19, 0xa1, 0x4f,
-	0x61, 0xc5, 0x67, 0x52, 0x11, 0xee, 0x51, 0xe9, 0x34, 0x8c, 0x87, 0x53, 0xf2, 0xb0, 0x79, 0x74,
-	0xef, 0x4c, 0xd1, 0x3e, 0x34, 0xbc, 0x38, 0x95, 0xce, 0x92, 0x71, 0xd9, 0x2a, 0xb9, 0xb4, 0xba,
-	0x17, 0xae, 0xb1, 0xc0, 0x9f, 0x41, 0xf3, 0x5c, 0xc4, 0x22, 0x14, 0xc1, 0x00, 0xbd, 0x00, 0xe0,
-	0x69, 0x44, 0xbe, 0xf3, 0x68, 0x18, 0x4a, 0xa7, 0x66, 0x7c, 0xb7, 0xcb, 0xbe, 0x34, 0x0c, 0xdd,
-	0x15, 0x6d, 0xa8, 0x9f, 0x24, 0xfe, 0x57, 0x0d, 0x96, 0x7b, 0x9d, 0x23, 0x26, 0x24, 0xc2, 0xb0,
-	0x16, 0x11, 0x9e, 0x5e, 0x13, 0x4f, 0xa5, 0x09, 0x4d, 0x4c, 0x9e, 0x56, 0xdc, 0x91, 0x35, 0x5d,
-	0x45, 0x71, 0x22, 0xfc, 0xd4, 0xcb, 0x33, 0x9c, 0x8b, 0xc5, 0x02, 0xac, 0x8f, 0x14, 0x20, 0xba,
-	0x0f, 0x75, 0x79, 0x9b, 0x3a, 0x0d, 0xb3, 0xaa, 0x1f, 0xf5, 0xe5, 0x5d, 0x93, 0x88, 0x85, 0x03,
-	0x67, 0xc9, 0x2c, 0x5a, 0x09, 0xff, 0xb3, 0x06, 0xcd, 0x63, 0x26, 0x6f, 0xcf, 0xf8, 0xb5, 0x30,
-	0x46, 0x22, 0x89, 0x88, 0xb2, 0x81, 0x58, 0x09, 0xed, 0xc1, 0xea, 0x15, 0xf1, 0x6e, 0x19, 0x0f,
-	0x4e, 0x58, 0x48, 0x6d, 0x18, 0xc5, 0x25, 0xf4, 0x18, 0x40, 0xc7, 0x4b, 0xc2, 0x5e, 0x5e, 0x3f,
-	0x0d, 0xb7, 0xb0, 0xa2, 0x11, 0x74, 0x4a, 0x72, 0x83, 0x86, 0x31, 0x28, 0x2e, 0xe1, 0xff, 0xd5,
-	0x60, 0xbd, 0x15, 0xa6, 0x52, 0xd1, 0xa4, 0x25, 0xf8, 0x35, 0x0b, 0xd0, 0x01, 0xa0, 0xf6, 0xdb,
-	0x98, 0x70, 0x5f, 0xc7, 0x27, 0xdb, 0x9c, 0x5c, 0x85, 0x34, 0x2b, 0xa5, 0xa6, 0x5b, 0xa1, 0x41,
-	0xbf, 0x85, 0x87, 0x27, 0x09, 0xa5, 0xba, 0x1e, 0x5c, 0x1a, 0x8b, 0x44, 0x31, 0x1e, 0x1c, 0x33,
-	0x99, 0xb9, 0x2d, 0x1a, 0xb7, 0xc9, 0x06, 0xe8, 0x25, 0x38, 0x47, 0xc2, 0xbb, 0x91, 0xc7, 0x4c,
-	0xc6, 0x21, 0x19, 0x9c, 0x88, 0xa4, 0x7d, 0x72, 0x76, 0x9a, 0x52, 0xa9, 0xa4, 0x39, 0x4f, 0xd3,
-	0x9d, 0xa8, 0xc7, 0xff, 0x6d, 0xc0, 0xf6, 0x65, 0x76, 0x96, 0x0e, 0xf1, 0x6e, 0x18, 0xa7, 0xaf,
-	0x63, 0xc5, 0x04, 0x97, 0xe8, 0x4b, 0xd8, 0x1a, 0x55, 0x64, 0x17, 0x6f, 0xc7, 0x4c, 0xb9, 0xf8,
-	0x33, 0xb5, 0x5b, 0xe9, 0x84, 0x5e, 0xc0, 0x76, 0x87, 0x46, 0x47, 0x24, 0x0c, 0x85, 0xe0, 0x3d,
-	0x45, 0x94, 0xec, 0xd2, 0x84, 0x89, 0xec, 0x70, 0xeb, 0x6e, 0xb5, 0x12, 0xfd, 0x0a, 0x36, 0xbb,
-	0x09, 0xd5, 0xeb, 0x1e, 0x51, 0xd4, 0xbf, 0x14, 0x61, 0x1a, 0xd9, 0x76, 0x5a, 0x71, 0xab, 0x54,
-	0x7a, 0x1e, 0x2a, 0x5b, 0xe2, 0xe6, 0xa6, 0xaa, 0xe6, 0x61, 0xde, 0x03, 0xee, 0xd0, 0x14, 0xf5,
-	0x60, 0xc5, 0xdc, 0x87, 0x2e, 0x25, 0xdb, 0x48, 0x9f, 0x94, 0xfc, 0x2a, 0xd3, 0x74, 0x30, 0xf4,
-	0x6b, 0x73, 0x95, 0x0c, 0xdc, 0x3b, 0x9c, 0x09, 0x45, 0xb0, 0x3c, 0xb1, 0x08, 0x8e, 0x61, 0xdd,
-	0x2b, 0x56, 0x91, 0x73, 0xcf, 0x1c, 0xe0, 0x71, 0xb9, 0x2b, 0x8b, 0x56, 0xee, 0xa8, 0xd3, 0xee,
-	0x1b, 0xd8, 0x18, 0x0d, 0x49, 0x77, 0xd4, 0x2d, 0x1d, 0xd8, 0xbe, 0xd0, 0x8f, 0xe8, 0xb0, 0x38,
-	0x75, 0xab, 0x52, 0x94, 0xb7, 0x95, 0x1d, 0xc8, 0x2f, 0x17, 0x7f, 0x53, 0xc3, 0x7d, 0x80, 0xcb,
-	0xce, 0x99, 0x4b, 0xff, 0xa2, 0x0b, 0x07, 0x3d, 0x85, 0x7a, 0x3f, 0x62, 0xb6, 0x18, 0xca, 0x43,
-	0x47, 0x5b, 0x6a, 0x03, 0xf4, 0x19, 0xdc, 0x13, 0x59, 0xa6, 0xec, 0x66, 0x4f, 0x7f, 0x5c, 0x5e,
-	0xdd, 0xdc, 0x0d, 0x9f, 0xc3, 0xfd, 0x0e, 0x0b, 0x12, 0xa2, 0xcc, 0x7b, 0xef, 0xfd, 0x76, 0x77,
-	0x46, 0x77, 0x5f, 0xbb, 0x43, 0xfd, 0x7b, 0x0d, 0x56, 0xdb, 0x6f, 0xa9, 0x97, 0x23, 0x3e, 0x06,
-	0xf0, 0x45, 0x44, 0x18, 0x7f, 0x45, 0x22, 0x6a, 0x73, 0x55, 0x58, 0xd1, 0x48, 0x2d, 0x11, 0x45,
-	0x84, 0xfb, 0xf9, 0x28, 0xb3, 0xa2, 0x7e, 0x87, 0xfc, 0x3e, 0x09, 0xf2, 0xaa, 0x34, 0xcf, 0xe8,
-	0x29, 0x6c, 0x28, 0x16, 0x51, 0x91, 0xaa, 0x1e, 0xf5, 0x04, 0xf7, 0xa5, 0x29, 0xc6, 0x25, 0x77,
-	0x6c, 0x15, 0x6f, 0xc0, 0x5a, 0x3b, 0x8a, 0xd5, 0xc0, 0x46, 0x81, 0x7f, 0x07, 0x4d, 0xb7, 0xf0,
-	0x8e, 0x96, 0xa9, 0xe7, 0x51, 0x29, 0xed, 0xe0, 0xc8, 0x45, 0xad, 0x89, 0xa8, 0x94, 0x24, 0xc8,
-	0xe7, 0x59, 0x2e, 0xe2, 0xef, 0x60, 0xe3, 0xd8, 0xc4, 0x3c, 0x2b, 0x41, 0xd8, 0x81, 0xe5, 0xec,
-	0xf0, 0x76, 0x07, 0x2b, 0x61, 0x0e, 0x9b, 0xd9, 0x06, 0xa6, 0x4d, 0x67, 0xdd, 0x65, 0x0f, 0x56,
-	0xfd, 0x3b, 0xb4, 0x7c, 0x38, 0x17, 0x96, 0xf0, 0x5b, 0x78, 0x60, 0x06, 0x95, 0x29, 0xc6, 0x19,
-	0x77, 0xfb, 0x08, 0x1e, 0x04, 0xe3, 0x58, 0x76, 0xcf, 0xb2, 0x02, 0xff, 0xa3, 0x06, 0xdb, 0x66,
-	0xeb, 0x0b, 0x49, 0x93, 0xaf, 0x98, 0x54, 0xb3, 0x6e, 0xff, 0x02, 0xb6, 0x83, 0x2a, 0x3c, 0x1b,
-	0x42, 0xb5, 0x12, 0xff, 0xbb, 0x06, 0x8e, 0x09, 0x43, 0xbf, 0xab, 0xe4, 0x40, 0x2a, 0x1a, 0xcd,
-	0x9c, 0xf6, 0x97, 0xe0, 0x04, 0x13, 0x20, 0x6d, 0x30, 0x13, 0xf5, 0x78, 0x00, 0x6b, 0x59, 0xdb,
-	0xcc, 0x16, 0xc2, 0x2e, 0x34, 0xe9, 0x5b, 0xa6, 0x5a, 0xc2, 0xcf, 0xb6, 0x5c, 0x72, 0x87, 0xb2,
-	0xae, 0x3d, 0xa9, 0xfc, 0xd7, 0xa9, 0xb2, 0xd4, 0xc0, 0x4a, 0xf8, 0x1b, 0xb8, 0x6f, 0x32, 0xd1,
-	0xd5, 0x04, 0xe8, 0x47, 0xb6, 0x6d, 0xb9, 0x11, 0x17, 0x2b, 0x1b, 0xf1, 0x0b, 0x5b, 0x67, 0x19,
-	0xf6, 0x4c, 0x67, 0xc3, 0x02, 0xd6, 0xf5, 0xbb, 0xfa, 0x7b, 0xfa, 0xbe, 0xd3, 0xea, 0x53, 0xd8,
-	0x49, 0xf9, 0xb5, 0x71, 0x3d, 0xaf, 0x0a, 0x7a, 0x82, 0x16, 0xbf, 0x81, 0x07, 0x19, 0xf3, 0x3c,
-	0x4e, 0xa3, 0xf8, 0x7d, 0x37, 0xdd, 0x85, 0xa6, 0x9f, 0x46, 0x71, 0x97, 0xa8, 0x1b, 0x7b, 0xf9,
-	0x43, 0x19, 0x5f, 0xc1, 0x07, 0xbd, 0xf6, 0xe5, 0x3c, 0x7a, 0x4f, 0x0f, 0x33, 0xda, 0x37, 0xaf,
-	0x57, 0x3b, 0x88, 0xad, 0x88, 0xff, 0x56, 0x83, 0x87, 0x5f, 0x99, 0xff, 0x42, 0x1d, 0x4a, 0x64,
-	0x9a, 0xd0, 0x88, 0x72, 0x35, 0x87, 0x56, 0x0f, 0xc7, 0x31, 0xed, 0xc6, 0x65, 0x05, 0xfe, 0x16,
-	0x1e, 0x9e, 0xf1, 0x3f, 0x53, 0x4f, 0x65, 0x71, 0xf4, 0xa8, 0x97, 0x50, 0x35, 0xb7, 0x57, 0xcd,
-	0xf3, 0xff, 0x6c, 0x42, 0xbd, 0x15, 0xf9, 0xe8, 0x15, 0xa0, 0xde, 0x80, 0x7b, 0xa3, 0xaf, 0x3b,
-	0xf4, 0xd3, 0x4a, 0xc8, 0x6c, 0xf3, 0xdd, 0xc9, 0x87, 0xc5, 0x0b, 0xe8, 0x35, 0x6c, 0x76, 0x49,
-	0x2a, 0xe9, 0xdc, 0x00, 0xbf, 0x86, 0xed, 0x0b, 0x1e, 0xcf, 0x15, 0xb2, 0x07, 0x5b, 0x59, 0x2f,
-	0x8c, 0x21, 0x96, 0x49, 0xcd, 0x48, 0xcb, 0xbc, 0x1b, 0xd4, 0x85, 0x9d, 0x0b, 0xdb, 0x09, 0x73,
-	0x0b, 0xf4, 0x1c, 0x9c, 0x9e, 0xb8, 0x56, 0x2e, 0xbd, 0x12, 0x42, 0xcd, 0x0d, 0xd5, 0x85, 0x9d,
-	0xde, 0x4d, 0xaa, 0x7c, 0xf1, 0x57, 0x3e, 0x37, 0xcc, 0x57, 0x80, 0xbe, 0x64, 0x61, 0x38, 0x37,
-	0xbc, 0x2e, 0x6c, 0x1d, 0xd3, 0x90, 0xaa, 0xf9, 0xe5, 0xf2, 0x0d, 0x6c, 0x67, 0x8c, 0x6d, 0x1c,
-	0xf2, 0xe7, 0xe5, 0x7f, 0xcc, 0x63, 0xcc, 0x6e, 0x6a, 0xc5, 0xeb, 0x0e, 0x1a, 0x3a, 0x9d, 0x93,
-	0x24, 0xa0, 0x6a, 0x86, 0x48, 0xff, 0x00, 0x8f, 0x5a, 0xfa, 0x5f, 0xf4, 0x58, 0x36, 0x87, 0x1b,
-	0xcc, 0x78, 0xf5, 0x2c, 0xe0, 0x24, 0xcc, 0x82, 0xec, 0x0a, 0xbf, 0x15, 0x52, 0xc2, 0xd3, 0x78,
-	0x06, 0xcc, 0x3f, 0xc2, 0x93, 0x13, 0xc6, 0x49, 0xc8, 0xc6, 0x0b, 0x7f, 0x1e, 0x01, 0xbf, 0x02,
-	0xf4, 0xb9, 0x50, 0x71, 0x98, 0x06, 0x9f, 0x0b, 0xa9, 0x8e, 0x69, 0x9f, 0x79, 0x54, 0xce, 0x80,
-	0xd7, 0x81, 0x95, 0x53, 0xaa, 0x32, 0xb6, 0x88, 0x1e, 0x95, 0x2c, 0x8b, 0xbc, 0x77, 0xf7, 0x49,
-	0xf9, 0x1f, 0xc8, 0x08, 0x8d, 0x35, 0x45, 0xb5, 0x31, 0x84, 0x33, 0xdc, 0x70, 0x1a, 0xe6, 0x2f,
-	0x26, 0x60, 0x8e, 0x30, 0x57, 0x33, 0xa2, 0xd6, 0x4e, 0xa9, 0x1a, 0xb2, 0xcc, 0x69, 0xb0, 0xb8,
-	0xa4, 0x2e, 0x11, 0x54, 0x03, 0xda, 0x3c, 0xa5, 0x86, 0xcd, 0x4d, 0x8d, 0xf3, 0x69, 0x35, 0x60,
-	0x89, 0x09, 0x2e, 0xa0, 0x3f, 0x99, 0x14, 0x14, 0x58, 0xd9, 0x34, 0xe8, 0x0f, 0xab, 0xa1, 0xab,
-	0x78, 0xdd, 0x02, 0x3a, 0x82, 0x86, 0x66, 0x3f, 0xd3, 0x30, 0xdf, 0x79, 0xe7, 0x6d, 0x68, 0x68,
-	0x76, 0x88, 0x7e, 0x56, 0xc6, 0xb8, 0xfb, 0xaf, 0xb5, 0xfb, 0x68, 0x82, 0xb6, 0x30, 0x8c, 0x57,
-	0x86, 0x6c, 0xac, 0x62, 0x68, 0x8c, 0xb3, 0xc0, 0x49, 0x77, 0x52, 0x24, 0x73, 0xa6, 0x7b, 0x9c,
-	0xb1, 0xae, 0x19, 0x92, 0x26, 0x84, 0x27, 0x7c, 0xcb, 0x2b, 0x30, 0xaa, 0x69, 0x33, 0x4f, 0xdf,
-	0x4d, 0xe1, 0x13, 0xed, 0xfb, 0x97, 0x67, 0xc5, 0xf7, 0x5d, 0x3b, 0x47, 0x4a, 0xac, 0xa1, 0xd5,
-	0xbd, 0x90, 0x33, 0x31, 0x07, 0x38, 0xa5, 0xca, 0x52, 0xbb, 0x69, 0x81, 0xee, 0x95, 0xbf, 0xf4,
-	0x8c, 0x72, 0x42, 0xbc, 0x80, 0x08, 0x6c, 0x9d, 0x52, 0x55, 0xa2, 0x71, 0xef, 0x0e, 0xf1, 0x97,
-	0x25, 0xe5, 0x44, 0x1e, 0x88, 0x17, 0xd0, 0xb7, 0x80, 0xca, 0x24, 0x0d, 0x95, 0x31, 0x26, 0x32,
-	0xb9, 0x77, 0xa6, 0xe4, 0xa8, 0xf1, 0xcd, 0x62, 0xff, 0xd9, 0xd5, 0xb2, 0xf9, 0xfa, 0xfe, 0xeb,
-	0x1f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe2, 0x60, 0xa1, 0xf2, 0xaa, 0x17, 0x00, 0x00,
+	// 1705 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xef, 0x6e, 0xe3, 0xc6,
+	0x11, 0xb7, 0x2c, 0xd9, 0x27, 0x8f, 0xff, 0xe4, 0x6e, 0xcf, 0x76, 0x79, 0x4e, 0xef, 0x4e, 0x5d,
+	0x14, 0x07, 0xa7, 0x48, 0xec, 0xfa, 0x7a, 0x09, 0x8a, 0x43, 0x51, 0x24, 0x96, 0x65, 0xc7, 0x97,
+	0xe8, 0x4e, 0xa1, 0x6c, 0x1f, 0x9a, 0x36, 0x08, 0x68, 0x72, 0x45, 0x6f, 0x4d, 0xee, 0x32, 0xdc,
+	0xa5, 0x7a, 0xba, 0x4f, 0x05, 0x5a, 0xf4, 0x43, 0x81, 0x7e, 0xec, 0x23, 0x14, 0x7d, 0x86, 0x3e,
+	0x49, 0x5f, 0xa7, 0xd8, 0xe5, 0x52, 0xa6, 0x44, 0xca, 0x8a, 0x2b, 0x7d, 0x32, 0x67, 0x67, 0xe6,
+	0xb7, 0xb3, 0xc3, 0x99, 0xd9, 0x1f, 0x65, 0xf8, 0x28, 0xba, 0xf6, 0xf7, 0xaf, 0x1c, 0xe6, 0x05,
+	0x24, 0xfe, 0x24, 0x70, 0x12, 0xe6, 0x5e, 0x91, 0xf8, 0x13, 0x97, 0x87, 0xfb, 0x6e, 0xe8, 0xed,
+	0xf7, 0x0f, 0xd4, 0x9f, 0xbd, 0x28, 0xe6, 0x92, 0xa3, 0x0f, 0xae, 0x93, 0x4b, 0xd2, 0xa7, 0xb1,
+	0xdc, 0x53, 0x6b, 0xfd, 0x03, 0xdc, 0x83, 0x87, 0xdf, 0x90, 0x30, 0xb9, 0x20, 0xb1, 0xa0, 0x9c,
+	0xd9, 0x44, 0x44, 0x9c, 0x09, 0x82, 0x3e, 0x85, 0x7a, 0x6c, 0x9e, 0xad, 0x4a, 0xa3, 0xb2, 0xbb,
+	0xfa, 0xfc, 0xd1, 0xde, 0x98, 0xeb, 0x5e, 0x66, 0x6c, 0x0f, 0x4d, 0x91, 0x05, 0xf7, 0xfa, 0x29,
+	0x92, 0xb5, 0xd8, 0xa8, 0xec, 0xae, 0xd8, 0x99, 0x88, 0x9f, 0x42, 0xf5, 0xa2, 0x7d, 0xaa, 0x0d,
+	0x42, 0xfa, 0x4a, 0x70, 0xa6, 0x61, 0xd7, 0xec, 0x4c, 0xc4, 0x07, 0x50, 0x6d, 0x76, 0xce, 0xd1,
+	0x06, 0x2c, 0x52, 0x4f, 0xeb, 0xd6, 0xed, 0x45, 0xea, 0xa1, 0x1d, 0xa8, 0x0b, 0x7a, 0x19, 0x50,
+	0xe6, 0x0b, 0x6b, 0xb1, 0x51, 0xdd, 0x5d, 0xb7, 0x87, 0x32, 0xde, 0x87, 0x7b, 0xdd, 0xf4, 0xb9,
+	0xe0, 0xb6, 0x09, 0x4b, 0x7d, 0x27, 0x48, 0x88, 0x0e, 0xa3, 0x66, 0xa7, 0x02, 0x6e, 0xc1, 0x52,
+	0xc7, 0xf1, 0x89, 0x50, 0x6a, 0x97, 0x27, 0x4c, 0x6a, 0x8f, 0x9a, 0x9d, 0x0a, 0x08, 0x41, 0x2d,
+	0x61, 0x54, 0x9a, 0xd0, 0xf5, 0xb3, 0x5a, 0x13, 0xf4, 0x3d, 0xb1, 0xaa, 0x1a, 0x5a, 0x3f, 0xe3,
+	0x17, 0xb0, 0xdc, 0x26, 0x21, 0x8f, 0x07, 0x68, 0x1b, 0x96, 0x9d, 0x30, 0x07, 0x64, 0xa4, 0x32,
+	0x24, 0xfc, 0xdf, 0x0a, 0xd4, 0x9a, 0x24, 0x08, 0x0a, 0xb1, 0xee, 0xc3, 0x72, 0xa8, 0xe1, 0xb4,
+	0xf9, 0xea, 0xf3, 0x9f, 0x14, 0x32, 0x9d, 0xee, 0x66, 0x1b, 0x33, 0xf4, 0x31, 0x2c, 0x45, 0xea,
+	0x18, 0x56, 0xb5, 0x51, 0xdd, 0x5d, 0x7d, 0xbe, 0x5d, 0xb0, 0xd7, 0x87, 0xb4, 0x53, 0x23, 0xf4,
+	0x19, 0xac, 0x78, 0x54, 0x48, 0x87, 0xb9, 0x44, 0x58, 0x35, 0xed, 0x61, 0x15, 0x3c, 0x4c, 0x1e,
+	0xed, 0x1b, 0x53, 0xb4, 0x0b, 0x35, 0x37, 0x4a, 0x84, 0xb5, 0xa4, 0x5d, 0x36, 0x0b, 0x2e, 0xcd,
+	0xce, 0xb9, 0xad, 0x2d, 0xf0, 0xe7, 0x50, 0x3f, 0xe3, 0x11, 0x0f, 0xb8, 0x3f, 0x40, 0x2f, 0x00,
+	0x58, 0x12, 0x3a, 0xdf, 0xbb, 0x24, 0x08, 0x84, 0x55, 0xd1, 0xbe, 0x5b, 0x45, 0x5f, 0x12, 0x04,
+	0xf6, 0x8a, 0x32, 0x54, 0x4f, 0x02, 0xff, 0xbd, 0x02, 0xcb, 0xdd, 0xf6, 0x21, 0xe5, 0x02, 0x61,
+	0x58, 0x0b, 0x1d, 0x96, 0xf4, 0x1c, 0x57, 0x26, 0x31, 0x89, 0x75, 0x9e, 0x56, 0xec, 0x91, 0x35,
+	0x55, 0x45, 0x51, 0xcc, 0xbd, 0xc4, 0xcd, 0x32, 0x9c, 0x89, 0xf9, 0x02, 0xac, 0x8e, 0x14, 0x20,
+	0xba, 0x0f, 0x55, 0x71, 0x9d, 0x58, 0x35, 0xbd, 0xaa, 0x1e, 0xd5, 0xcb, 0xeb, 0x39, 0x21, 0x0d,
+	0x06, 0xd6, 0x92, 0x5e, 0x34, 0x12, 0xfe, 0x5b, 0x05, 0xea, 0x47, 0x54, 0x5c, 0x9f, 0xb2, 0x1e,
+	0xd7, 0x46, 0x3c, 0x0e, 0x1d, 0x69, 0x02, 0x31, 0x12, 0x6a, 0xc0, 0xea, 0xa5, 0xe3, 0x5e, 0x53,
+	0xe6, 0x1f, 0xd3, 0x80, 0x98, 0x30, 0xf2, 0x4b, 0xe8, 0x09, 0x80, 0x8a, 0xd7, 0x09, 0xba, 0x59,
+	0xfd, 0xd4, 0xec, 0xdc, 0x8a, 0x42, 0x50, 0x29, 0xc9, 0x0c, 0x6a, 0xda, 0x20, 0xbf, 0x84, 0xff,
+	0x53, 0x81, 0xf5, 0x66, 0x90, 0x08, 0x49, 0xe2, 0x26, 0x67, 0x3d, 0xea, 0xa3, 0x3d, 0x40, 0xad,
+	0x77, 0x91, 0xc3, 0x3c, 0x15, 0x9f, 0x68, 0x31, 0xe7, 0x32, 0x20, 0x69, 0x29, 0xd5, 0xed, 0x12,
+	0x0d, 0xfa, 0x0d, 0x3c, 0x3a, 0x8e, 0x09, 0x51, 0xf5, 0x60, 0x93, 0x88, 0xc7, 0x92, 0x32, 0xff,
+	0x88, 0x8a, 0xd4, 0x6d, 0x51, 0xbb, 0x4d, 0x36, 0x40, 0x2f, 0xc1, 0x3a, 0xe4, 0xee, 0x95, 0x38,
+	0xa2, 0x22, 0x0a, 0x9c, 0xc1, 0x31, 0x8f, 0x5b, 0xc7, 0xa7, 0x27, 0x09, 0x11, 0x52, 0xe8, 0xf3,
+	0xd4, 0xed, 0x89, 0x7a, 0xfc, 0xef, 0x1a, 0x6c, 0x5d, 0xa4, 0x67, 0x69, 0x3b, 0xee, 0x15, 0x65,
+	0xe4, 0x4d, 0x24, 0x29, 0x67, 0x02, 0x7d, 0x05, 0x9b, 0xa3, 0x8a, 0xf4, 0xc5, 0x5b, 0x95, 0x09,
+	0xc5, 0x9f, 0xaa, 0xed, 0x52, 0x27, 0xf4, 0x02, 0xb6, 0xda, 0x24, 0x3c, 0x74, 0x82, 0x80, 0x73,
+	0xd6, 0x95, 0x8e, 0x14, 0x1d, 0x12, 0x53, 0x9e, 0x1e, 0x6e, 0xdd, 0x2e, 0x57, 0xa2, 0x5f, 0xc2,
+	0xc3, 0x4e, 0x4c, 0xd4, 0xba, 0xeb, 0x48, 0xe2, 0x5d, 0xf0, 0x20, 0x09, 0x4d, 0x3b, 0xad, 0xd8,
+	0x65, 0x2a, 0x35, 0x0f, 0xa5, 0x29, 0x71, 0xab, 0x36, 0x61, 0x1e, 0x66, 0x3d, 0x60, 0x0f, 0x4d,
+	0x51, 0x17, 0x56, 0xf4, 0xfb, 0x50, 0xa5, 0x64, 0x1a, 0xe9, 0xd3, 0x82, 0x5f, 0x69, 0x9a, 0xf6,
+	0x86, 0x7e, 0x2d, 0x26, 0xe3, 0x81, 0x7d, 0x83, 0x33, 0xa1, 0x08, 0x96, 0x27, 0x16, 0xc1, 0x11,
+	0xac, 0xbb, 0xf9, 0x2a, 0xb2, 0xee, 0xe9, 0x03, 0x3c, 0x29, 0x76, 0x65, 0xde, 0xca, 0x1e, 0x75,
+	0xda, 0x79, 0x0b, 0x1b, 0xa3, 0x21, 0xa9, 0x8e, 0xba, 0x26, 0x03, 0xd3, 0x17, 0xea, 0x11, 0xed,
+	0xe7, 0xa7, 0x6e, 0x59, 0x8a, 0xb2, 0xb6, 0x32, 0x03, 0xf9, 0xe5, 0xe2, 0xaf, 0x2b, 0xb8, 0x0f,
+	0x70, 0xd1, 0x3e, 0xb5, 0xc9, 0x0f, 0xaa, 0x70, 0xd0, 0x33, 0xa8, 0xf6, 0x43, 0x6a, 0x8a, 0xa1,
+	0x38, 0x74, 0x94, 0xa5, 0x32, 0x40, 0x9f, 0xc3, 0x3d, 0x9e, 0x66, 0xca, 0x6c, 0xf6, 0xec, 0xc7,
+	0xe5, 0xd5, 0xce, 0xdc, 0xf0, 0x19, 0xdc, 0x6f, 0x53, 0x3f, 0x76, 0xa4, 0xbe, 0xf7, 0xee, 0xb6,
+	0xbb, 0x35, 0xba, 0xfb, 0xda, 0x0d, 0xea, 0x5f, 0x2a, 0xb0, 0xda, 0x7a, 0x47, 0xdc, 0x0c, 0xf1,
+	0x09, 0x80, 0xc7, 0x43, 0x87, 0xb2, 0xd7, 0x4e, 0x48, 0x4c, 0xae, 0x72, 0x2b, 0x0a, 0xa9, 0xc9,
+	0xc3, 0xd0, 0x61, 0x5e, 0x36, 0xca, 0x8c, 0xa8, 0xee, 0x90, 0x2f, 0x62, 0x3f, 0xab, 0x4a, 0xfd,
+	0x8c, 0x9e, 0xc1, 0x86, 0xa4, 0x21, 0xe1, 0x89, 0xec, 0x12, 0x97, 0x33, 0x4f, 0xe8, 0x62, 0x5c,
+	0xb2, 0xc7, 0x56, 0xf1, 0x06, 0xac, 0xb5, 0xc2, 0x48, 0x0e, 0x4c, 0x14, 0xf8, 0xb7, 0x50, 0xb7,
+	0x73, 0x77, 0xb4, 0x48, 0x5c, 0x97, 0x08, 0x61, 0x06, 0x47, 0x26, 0x2a, 0x4d, 0x48, 0x84, 0x70,
+	0xfc, 0x6c, 0x9e, 0x65, 0x22, 0xfe, 0x1e, 0x36, 0x8e, 0x74, 0xcc, 0xb3, 0x12, 0x84, 0x6d, 0x58,
+	0x4e, 0x0f, 0x6f, 0x76, 0x30, 0x12, 0x66, 0xf0, 0x30, 0xdd, 0x40, 0xb7, 0xe9, 0xac, 0xbb, 0x34,
+	0x60, 0xd5, 0xbb, 0x41, 0xcb, 0x86, 0x73, 0x6e, 0x09, 0xbf, 0x83, 0x07, 0x7a, 0x50, 0xe9, 0x62,
+	0x9c, 0x71, 0xb7, 0x8f, 0xe1, 0x81, 0x3f, 0x8e, 0x65, 0xf6, 0x2c, 0x2a, 0xf0, 0x5f, 0x2b, 0xb0,
+	0xa5, 0xb7, 0x3e, 0x17, 0x24, 0xfe, 0x9a, 0x0a, 0x39, 0xeb, 0xf6, 0x2f, 0x60, 0xcb, 0x2f, 0xc3,
+	0x33, 0x21, 0x94, 0x2b, 0xf1, 0x3f, 0x2a, 0x60, 0xe9, 0x30, 0xd4, 0x5d, 0x25, 0x06, 0x42, 0x92,
+	0x70, 0xe6, 0xb4, 0xbf, 0x04, 0xcb, 0x9f, 0x00, 0x69, 0x82, 0x99, 0xa8, 0xc7, 0x03, 0x58, 0x4b,
+	0xdb, 0x66, 0xb6, 0x10, 0x76, 0xa0, 0x4e, 0xde, 0x51, 0xd9, 0xe4, 0x5e, 0xba, 0xe5, 0x92, 0x3d,
+	0x94, 0x55, 0xed, 0x09, 0xe9, 0xbd, 0x49, 0xa4, 0xa1, 0x06, 0x46, 0xc2, 0xdf, 0xc2, 0x7d, 0x9d,
+	0x89, 0x8e, 0x22, 0x40, 0x3f, 0xb2, 0x6d, 0x8b, 0x8d, 0xb8, 0x58, 0xda, 0x88, 0xaf, 0xe0, 0x41,
+	0x0e, 0x7b, 0xa6, 0xb3, 0x61, 0x0e, 0xeb, 0xea, 0xae, 0x7e, 0x4f, 0xee, 0x3a, 0xad, 0x3e, 0x83,
+	0xed, 0x84, 0xf5, 0xb4, 0xeb, 0x59, 0x59, 0xd0, 0x13, 0xb4, 0xf8, 0x2d, 0x3c, 0x48, 0x99, 0xe7,
+	0x51, 0x12, 0x46, 0x77, 0xdd, 0x74, 0x07, 0xea, 0x5e, 0x12, 0x46, 0x1d, 0x47, 0x5e, 0x99, 0x97,
+	0x3f, 0x94, 0xf1, 0x25, 0x7c, 0xd0, 0x6d, 0x5d, 0xcc, 0xa3, 0xf7, 0xd4, 0x30, 0x23, 0x7d, 0x7d,
+	0xbd, 0x9a, 0x41, 0x6c, 0x44, 0xfc, 0xe7, 0x0a, 0x3c, 0xfa, 0x5a, 0x7f, 0x0b, 0xb5, 0x89, 0x23,
+	0x92, 0x98, 0x84, 0x84, 0xc9, 0x39, 0xb4, 0x7a, 0x30, 0x8e, 0x69, 0x36, 0x2e, 0x2a, 0xf0, 0x77,
+	0xf0, 0xe8, 0x94, 0xfd, 0x91, 0xb8, 0x32, 0x8d, 0xa3, 0x4b, 0xdc, 0x98, 0xc8, 0xf9, 0x5d, 0x35,
+	0xff, 0xaa, 0x40, 0x43, 0x17, 0xd7, 0x17, 0x3e, 0x61, 0xd2, 0x5c, 0x1b, 0x36, 0x11, 0x49, 0x30,
+	0xfb, 0x04, 0x7d, 0x05, 0x0d, 0x7f, 0x0a, 0xb4, 0x79, 0xab, 0x53, 0xed, 0x9e, 0xff, 0x73, 0x13,
+	0xaa, 0xcd, 0xd0, 0x43, 0xaf, 0x01, 0x75, 0x07, 0xcc, 0x1d, 0xbd, 0x96, 0xd1, 0x87, 0xa5, 0x47,
+	0x4f, 0x93, 0xb4, 0x33, 0x39, 0x56, 0xbc, 0x80, 0xde, 0xc0, 0xc3, 0x8e, 0x93, 0x08, 0x32, 0x37,
+	0xc0, 0x6f, 0x60, 0xeb, 0x9c, 0x45, 0x73, 0x85, 0xec, 0xc2, 0x66, 0xda, 0xb3, 0x63, 0x88, 0x45,
+	0xf2, 0x35, 0xd2, 0xda, 0xb7, 0x83, 0xda, 0xb0, 0x7d, 0xce, 0x7a, 0x65, 0xb0, 0xff, 0x7f, 0xa0,
+	0x67, 0x60, 0x75, 0x79, 0x4f, 0xda, 0xe4, 0x92, 0x73, 0x39, 0x37, 0x54, 0x1b, 0xb6, 0xbb, 0x57,
+	0x89, 0xf4, 0xf8, 0x9f, 0xd8, 0xdc, 0x30, 0x5f, 0x03, 0xfa, 0x8a, 0x06, 0xc1, 0xdc, 0xf0, 0x3a,
+	0xb0, 0x79, 0x44, 0x02, 0x22, 0xe7, 0x97, 0xcb, 0xb7, 0xb0, 0x95, 0x32, 0xcb, 0x71, 0xc8, 0x9f,
+	0x15, 0xbf, 0xec, 0xc7, 0x18, 0xe8, 0xd4, 0x8a, 0x57, 0x1d, 0x34, 0x74, 0x3a, 0x73, 0x62, 0x9f,
+	0xc8, 0x19, 0x22, 0xfd, 0x1d, 0x3c, 0x6e, 0xaa, 0xaf, 0xfd, 0xb1, 0x6c, 0x0e, 0x37, 0x98, 0xf1,
+	0xd5, 0x53, 0x9f, 0x39, 0x41, 0x1a, 0x64, 0x87, 0x7b, 0xcd, 0x80, 0x38, 0x2c, 0x89, 0x66, 0xc0,
+	0xfc, 0x3d, 0x3c, 0x3d, 0xa6, 0xcc, 0x09, 0xe8, 0x7b, 0x32, 0xff, 0x80, 0x5f, 0x03, 0xfa, 0x92,
+	0xcb, 0x28, 0x48, 0xfc, 0x2f, 0xb9, 0x90, 0x47, 0xa4, 0x4f, 0x5d, 0x22, 0x66, 0xc0, 0x6b, 0xc3,
+	0xca, 0x09, 0x91, 0x29, 0xab, 0x45, 0x8f, 0x0b, 0x96, 0x79, 0x7e, 0xbe, 0xf3, 0xb4, 0xf8, 0xa5,
+	0x34, 0x42, 0xb7, 0x75, 0x51, 0x6d, 0x0c, 0xe1, 0x34, 0x87, 0x9d, 0x86, 0xf9, 0xf3, 0x09, 0x98,
+	0x23, 0x0c, 0x5b, 0x8f, 0xa8, 0xb5, 0x13, 0x22, 0x87, 0x6c, 0x78, 0x1a, 0x2c, 0x2e, 0xa8, 0x0b,
+	0x44, 0x5a, 0x83, 0xd6, 0x4f, 0x88, 0x66, 0x9d, 0x53, 0xe3, 0x7c, 0x56, 0x0e, 0x58, 0x60, 0xac,
+	0x0b, 0xe8, 0x0f, 0x3a, 0x05, 0x39, 0xf6, 0x38, 0x0d, 0xfa, 0xa3, 0x72, 0xe8, 0x32, 0xfe, 0xb9,
+	0x80, 0x0e, 0xa1, 0xa6, 0x58, 0xda, 0x34, 0xcc, 0x5b, 0xdf, 0x79, 0x0b, 0x6a, 0x8a, 0xc5, 0xa2,
+	0x9f, 0x16, 0x31, 0x6e, 0xbe, 0x09, 0x77, 0x1e, 0x4f, 0xd0, 0xe6, 0x86, 0xf1, 0xca, 0x90, 0x35,
+	0x96, 0x0c, 0x8d, 0x71, 0xb6, 0xba, 0x83, 0x6f, 0x33, 0xc9, 0x75, 0x8f, 0x35, 0xd6, 0x35, 0x43,
+	0x72, 0x87, 0xf0, 0x84, 0xdf, 0x1c, 0x73, 0xcc, 0x6f, 0xda, 0xcc, 0x53, 0xef, 0x26, 0xf7, 0x53,
+	0xf2, 0xdd, 0xcb, 0xb3, 0xe4, 0x77, 0x68, 0x33, 0x47, 0x0a, 0xac, 0xa1, 0xd9, 0x39, 0x17, 0x33,
+	0x31, 0x07, 0x38, 0x21, 0xd2, 0x50, 0xd0, 0x69, 0x81, 0x36, 0x0a, 0xea, 0x31, 0xee, 0x8a, 0x17,
+	0x90, 0x03, 0x9b, 0x27, 0x44, 0x16, 0xe8, 0xe6, 0xed, 0x21, 0xfe, 0xa2, 0xa0, 0x9c, 0xc8, 0x57,
+	0xf1, 0x02, 0xfa, 0x0e, 0x50, 0x91, 0x4c, 0xa2, 0x22, 0xc6, 0x44, 0xc6, 0x79, 0x7b, 0x4a, 0x7e,
+	0x80, 0x0f, 0xb3, 0x29, 0x50, 0xc2, 0xe5, 0xa6, 0xe5, 0xe8, 0xa0, 0xbc, 0x00, 0x6f, 0x61, 0x85,
+	0x78, 0xe1, 0xb0, 0xf6, 0xed, 0x62, 0xff, 0xe0, 0x72, 0x59, 0xff, 0x63, 0xe2, 0x57, 0xff, 0x1b,
+	0x00, 0xcd, 0x19, 0x83, 0x2a, 0xc5, 0x18, 0x00, 0x00,
 }
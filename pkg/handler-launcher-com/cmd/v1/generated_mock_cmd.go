@@ -494,6 +494,22 @@ func (_mr *_MockCmdClientRecorder) InjectLaunchSecret(arg0, arg1 interface{}, ar
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "InjectLaunchSecret", _s...)
 }
 
+func (_m *MockCmdClient) GetGuestAgentCommandResults(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*GuestAgentCommandResultsResponse, error) {
+	_s := []interface{}{ctx, in}
+	for _, _x := range opts {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "GetGuestAgentCommandResults", _s...)
+	ret0, _ := ret[0].(*GuestAgentCommandResultsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockCmdClientRecorder) GetGuestAgentCommandResults(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetGuestAgentCommandResults", _s...)
+}
+
 // Mock of CmdServer interface
 type MockCmdServer struct {
 	ctrl     *gomock.Controller
@@ -833,3 +849,14 @@ func (_m *MockCmdServer) InjectLaunchSecret(_param0 context.Context, _param1 *In
 func (_mr *_MockCmdServerRecorder) InjectLaunchSecret(arg0, arg1 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "InjectLaunchSecret", arg0, arg1)
 }
+
+func (_m *MockCmdServer) GetGuestAgentCommandResults(_param0 context.Context, _param1 *EmptyRequest) (*GuestAgentCommandResultsResponse, error) {
+	ret := _m.ctrl.Call(_m, "GetGuestAgentCommandResults", _param0, _param1)
+	ret0, _ := ret[0].(*GuestAgentCommandResultsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockCmdServerRecorder) GetGuestAgentCommandResults(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetGuestAgentCommandResults", arg0, arg1)
+}
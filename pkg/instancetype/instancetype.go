@@ -46,6 +46,8 @@ type Methods interface {
 	InferDefaultInstancetype(vm *virtv1.VirtualMachine) (*virtv1.InstancetypeMatcher, error)
 	InferDefaultPreference(vm *virtv1.VirtualMachine) (*virtv1.PreferenceMatcher, error)
 	CheckPreferenceRequirements(instancetypeSpec *instancetypev1beta1.VirtualMachineInstancetypeSpec, preferenceSpec *instancetypev1beta1.VirtualMachinePreferenceSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) (*k8sfield.Path, error)
+	Upgrade(vm *virtv1.VirtualMachine, dryRun bool) (*virtv1.InstancetypeUpgrade, error)
+	Resize(vm *virtv1.VirtualMachine, instancetypeName string) error
 }
 
 type Conflicts []*k8sfield.Path
@@ -1178,6 +1180,7 @@ func ApplyDevicePreferences(preferenceSpec *instancetypev1beta1.VirtualMachinePr
 	}
 
 	applyDiskPreferences(preferenceSpec, vmiSpec)
+	applyDefaultPodInterfacePreferences(preferenceSpec, vmiSpec)
 	applyInterfacePreferences(preferenceSpec, vmiSpec)
 	applyInputPreferences(preferenceSpec, vmiSpec)
 }
@@ -1235,6 +1238,25 @@ func isInterfaceOnPodNetwork(interfaceName string, vmiSpec *virtv1.VirtualMachin
 	return false
 }
 
+// applyDefaultPodInterfacePreferences attaches the default pod interface without a binding when a binding plugin is
+// preferred, the preferred binding is then applied to it instead of the default binding of the cluster.
+func applyDefaultPodInterfacePreferences(preferenceSpec *instancetypev1beta1.VirtualMachinePreferenceSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) {
+	if preferenceSpec.Devices.PreferredInterfaceBinding == nil {
+		return
+	}
+
+	autoAttach := vmiSpec.Domain.Devices.AutoattachPodInterface
+	if autoAttach != nil && !*autoAttach {
+		return
+	}
+
+	if len(vmiSpec.Networks) == 0 && len(vmiSpec.Domain.Devices.Interfaces) == 0 {
+		defaultPodNetwork := virtv1.DefaultPodNetwork()
+		vmiSpec.Domain.Devices.Interfaces = []virtv1.Interface{{Name: defaultPodNetwork.Name}}
+		vmiSpec.Networks = []virtv1.Network{*defaultPodNetwork}
+	}
+}
+
 func applyInterfacePreferences(preferenceSpec *instancetypev1beta1.VirtualMachinePreferenceSpec, vmiSpec *virtv1.VirtualMachineInstanceSpec) {
 	for ifaceIndex := range vmiSpec.Domain.Devices.Interfaces {
 		vmiIface := &vmiSpec.Domain.Devices.Interfaces[ifaceIndex]
@@ -1244,6 +1266,9 @@ func applyInterfacePreferences(preferenceSpec *instancetypev1beta1.VirtualMachin
 		if preferenceSpec.Devices.PreferredInterfaceMasquerade != nil && isInterfaceBindingUnset(vmiIface) && isInterfaceOnPodNetwork(vmiIface.Name, vmiSpec) {
 			vmiIface.Masquerade = preferenceSpec.Devices.PreferredInterfaceMasquerade.DeepCopy()
 		}
+		if preferenceSpec.Devices.PreferredInterfaceBinding != nil && isInterfaceBindingUnset(vmiIface) && isInterfaceOnPodNetwork(vmiIface.Name, vmiSpec) {
+			vmiIface.Binding = preferenceSpec.Devices.PreferredInterfaceBinding.DeepCopy()
+		}
 	}
 }
 
@@ -1432,3 +1457,28 @@ func applyTerminationGracePeriodSeconds(preferenceSpec *instancetypev1beta1.Virt
 		vmiSpec.TerminationGracePeriodSeconds = pointer.Int64(*preferenceSpec.PreferredTerminationGracePeriodSeconds)
 	}
 }
+
+// ApplyDataVolumePreferences applies the preferred storage class and access mode to a DataVolumeSpec not requesting them
+func ApplyDataVolumePreferences(preferenceSpec *instancetypev1beta1.VirtualMachinePreferenceSpec, dataVolumeSpec *v1beta1.DataVolumeSpec) {
+	if preferenceSpec == nil || preferenceSpec.Volumes == nil {
+		return
+	}
+
+	if dataVolumeSpec.PVC != nil {
+		if preferenceSpec.Volumes.PreferredStorageClassName != "" && dataVolumeSpec.PVC.StorageClassName == nil {
+			dataVolumeSpec.PVC.StorageClassName = pointer.String(preferenceSpec.Volumes.PreferredStorageClassName)
+		}
+		if preferenceSpec.Volumes.PreferredAccessMode != "" && len(dataVolumeSpec.PVC.AccessModes) == 0 {
+			dataVolumeSpec.PVC.AccessModes = []k8sv1.PersistentVolumeAccessMode{preferenceSpec.Volumes.PreferredAccessMode}
+		}
+	}
+
+	if dataVolumeSpec.Storage != nil {
+		if preferenceSpec.Volumes.PreferredStorageClassName != "" && dataVolumeSpec.Storage.StorageClassName == nil {
+			dataVolumeSpec.Storage.StorageClassName = pointer.String(preferenceSpec.Volumes.PreferredStorageClassName)
+		}
+		if preferenceSpec.Volumes.PreferredAccessMode != "" && len(dataVolumeSpec.Storage.AccessModes) == 0 {
+			dataVolumeSpec.Storage.AccessModes = []k8sv1.PersistentVolumeAccessMode{preferenceSpec.Volumes.PreferredAccessMode}
+		}
+	}
+}
@@ -35,6 +35,7 @@ import (
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 	fakeclientset "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
 	instancetypeclientv1beta1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/instancetype/v1beta1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 )
 
 const resourceUID types.UID = "9160e5de-2540-476a-86d9-af0081aee68a"
@@ -1514,6 +1515,71 @@ var _ = Describe("Instancetype and Preferences", func() {
 				})
 			})
 
+			Context("PreferredInterfaceBinding", func() {
+				BeforeEach(func() {
+					preferenceSpec.Devices.PreferredInterfaceMasquerade = nil
+					preferenceSpec.Devices.PreferredInterfaceBinding = &v1.PluginBinding{Name: "passt"}
+				})
+
+				It("should be applied to interface on Pod network", func() {
+					vmi.Spec.Networks = []v1.Network{{
+						Name: vmi.Spec.Domain.Devices.Interfaces[0].Name,
+						NetworkSource: v1.NetworkSource{
+							Pod: &v1.PodNetwork{},
+						},
+					}}
+					Expect(instancetypeMethods.ApplyToVmi(field, instancetypeSpec, preferenceSpec, &vmi.Spec)).To(BeNil())
+					Expect(vmi.Spec.Domain.Devices.Interfaces[0].Binding).To(Equal(preferenceSpec.Devices.PreferredInterfaceBinding))
+					Expect(vmi.Spec.Domain.Devices.Interfaces[1].Binding).To(BeNil())
+				})
+
+				It("should not be applied on interface that has another binding set", func() {
+					vmi.Spec.Domain.Devices.Interfaces[0].Bridge = &v1.InterfaceBridge{}
+					Expect(instancetypeMethods.ApplyToVmi(field, instancetypeSpec, preferenceSpec, &vmi.Spec)).To(BeNil())
+					Expect(vmi.Spec.Domain.Devices.Interfaces[0].Binding).To(BeNil())
+				})
+			})
+
+			Context("default pod interface", func() {
+				BeforeEach(func() {
+					vmi.Spec.Domain.Devices.Interfaces = nil
+					vmi.Spec.Networks = nil
+					preferenceSpec.Devices.PreferredAutoattachPodInterface = nil
+				})
+
+				It("should be attached with the preferred binding plugin and model", func() {
+					preferenceSpec.Devices.PreferredInterfaceMasquerade = nil
+					preferenceSpec.Devices.PreferredInterfaceBinding = &v1.PluginBinding{Name: "passt"}
+					Expect(instancetypeMethods.ApplyToVmi(field, instancetypeSpec, preferenceSpec, &vmi.Spec)).To(BeNil())
+					Expect(vmi.Spec.Networks).To(ConsistOf(*v1.DefaultPodNetwork()))
+					Expect(vmi.Spec.Domain.Devices.Interfaces).To(HaveLen(1))
+					Expect(vmi.Spec.Domain.Devices.Interfaces[0].Name).To(Equal(v1.DefaultPodNetwork().Name))
+					Expect(vmi.Spec.Domain.Devices.Interfaces[0].Binding).To(Equal(preferenceSpec.Devices.PreferredInterfaceBinding))
+					Expect(vmi.Spec.Domain.Devices.Interfaces[0].Model).To(Equal(preferenceSpec.Devices.PreferredInterfaceModel))
+				})
+
+				It("should not be attached for a preferred masquerade binding", func() {
+					Expect(instancetypeMethods.ApplyToVmi(field, instancetypeSpec, preferenceSpec, &vmi.Spec)).To(BeNil())
+					Expect(vmi.Spec.Domain.Devices.Interfaces).To(BeEmpty())
+					Expect(vmi.Spec.Networks).To(BeEmpty())
+				})
+
+				It("should not be attached without a preferred binding", func() {
+					preferenceSpec.Devices.PreferredInterfaceMasquerade = nil
+					Expect(instancetypeMethods.ApplyToVmi(field, instancetypeSpec, preferenceSpec, &vmi.Spec)).To(BeNil())
+					Expect(vmi.Spec.Domain.Devices.Interfaces).To(BeEmpty())
+					Expect(vmi.Spec.Networks).To(BeEmpty())
+				})
+
+				It("should not be attached when the pod interface is not auto attached", func() {
+					preferenceSpec.Devices.PreferredInterfaceBinding = &v1.PluginBinding{Name: "passt"}
+					vmi.Spec.Domain.Devices.AutoattachPodInterface = pointer.Bool(false)
+					Expect(instancetypeMethods.ApplyToVmi(field, instancetypeSpec, preferenceSpec, &vmi.Spec)).To(BeNil())
+					Expect(vmi.Spec.Domain.Devices.Interfaces).To(BeEmpty())
+					Expect(vmi.Spec.Networks).To(BeEmpty())
+				})
+			})
+
 			It("should apply to VMI", func() {
 				conflicts := instancetypeMethods.ApplyToVmi(field, instancetypeSpec, preferenceSpec, &vmi.Spec)
 				Expect(conflicts).To(BeEmpty())
@@ -1752,6 +1818,51 @@ var _ = Describe("Instancetype and Preferences", func() {
 		})
 	})
 
+	Context("DataVolume preferences", func() {
+		var dataVolumeSpec *cdiv1beta1.DataVolumeSpec
+
+		BeforeEach(func() {
+			dataVolumeSpec = &cdiv1beta1.DataVolumeSpec{
+				PVC:     &k8sv1.PersistentVolumeClaimSpec{},
+				Storage: &cdiv1beta1.StorageSpec{},
+			}
+		})
+
+		It("should apply the preferred storage class and access mode", func() {
+			preferenceSpec := &instancetypev1beta1.VirtualMachinePreferenceSpec{
+				Volumes: &instancetypev1beta1.VolumePreferences{
+					PreferredStorageClassName: "ssd",
+					PreferredAccessMode:       k8sv1.ReadWriteMany,
+				},
+			}
+			instancetype.ApplyDataVolumePreferences(preferenceSpec, dataVolumeSpec)
+			Expect(*dataVolumeSpec.PVC.StorageClassName).To(Equal("ssd"))
+			Expect(dataVolumeSpec.PVC.AccessModes).To(ConsistOf(k8sv1.ReadWriteMany))
+			Expect(*dataVolumeSpec.Storage.StorageClassName).To(Equal("ssd"))
+			Expect(dataVolumeSpec.Storage.AccessModes).To(ConsistOf(k8sv1.ReadWriteMany))
+		})
+
+		It("should not overwrite a requested storage class and access mode", func() {
+			dataVolumeSpec.Storage.StorageClassName = pointer.String("local")
+			dataVolumeSpec.Storage.AccessModes = []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce}
+			preferenceSpec := &instancetypev1beta1.VirtualMachinePreferenceSpec{
+				Volumes: &instancetypev1beta1.VolumePreferences{
+					PreferredStorageClassName: "ssd",
+					PreferredAccessMode:       k8sv1.ReadWriteMany,
+				},
+			}
+			instancetype.ApplyDataVolumePreferences(preferenceSpec, dataVolumeSpec)
+			Expect(*dataVolumeSpec.Storage.StorageClassName).To(Equal("local"))
+			Expect(dataVolumeSpec.Storage.AccessModes).To(ConsistOf(k8sv1.ReadWriteOnce))
+		})
+
+		It("should ignore a missing preference", func() {
+			instancetype.ApplyDataVolumePreferences(nil, dataVolumeSpec)
+			Expect(dataVolumeSpec.PVC.StorageClassName).To(BeNil())
+			Expect(dataVolumeSpec.Storage.AccessModes).To(BeEmpty())
+		})
+	})
+
 	Context("preference requirements check", func() {
 		var (
 			preferCores   = instancetypev1beta1.PreferCores
@@ -0,0 +1,118 @@
+package instancetype
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	apiinstancetype "kubevirt.io/api/instancetype"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+)
+
+const (
+	instancetypeNamePath = "/spec/instancetype/name"
+)
+
+// GetFamily returns the family of an instancetype. It is taken from the family label or, if the label is absent,
+// from the series of names following the <series>.<size> convention, e.g. u1 for u1.small.
+func GetFamily(obj metav1.Object) string {
+	if family, ok := obj.GetLabels()[apiinstancetype.InstancetypeFamilyLabel]; ok {
+		return family
+	}
+	if series, _, found := strings.Cut(obj.GetName(), "."); found {
+		return series
+	}
+	return ""
+}
+
+// Resize switches a VirtualMachine to another instancetype of the same kind and family. The VirtualMachine is patched
+// to reference the new instancetype and the ControllerRevision of the old one is removed, the VirtualMachine controller
+// then stores a ControllerRevision of the new instancetype and applies the change to a running VirtualMachineInstance.
+func (m *InstancetypeMethods) Resize(vm *virtv1.VirtualMachine, instancetypeName string) error {
+	if vm.Spec.Instancetype == nil {
+		return errors.NewBadRequest(fmt.Sprintf("VirtualMachine %s/%s does not reference an instancetype", vm.Namespace, vm.Name))
+	}
+	if vm.Spec.Instancetype.Name == instancetypeName {
+		return nil
+	}
+
+	current, _, err := m.findInstancetypeByKind(vm.Namespace, vm.Spec.Instancetype.Kind, vm.Spec.Instancetype.Name)
+	if err != nil {
+		return err
+	}
+	target, targetSpec, err := m.findInstancetypeByKind(vm.Namespace, vm.Spec.Instancetype.Kind, instancetypeName)
+	if err != nil {
+		return err
+	}
+
+	family := GetFamily(current)
+	if family == "" {
+		return errors.NewBadRequest(fmt.Sprintf("instancetype %s is not part of a family", current.GetName()))
+	}
+	if targetFamily := GetFamily(target); targetFamily != family {
+		return errors.NewBadRequest(fmt.Sprintf("instancetype %s of family %q can not be resized to %s of family %q", current.GetName(), family, instancetypeName, targetFamily))
+	}
+
+	if err := m.checkForInstancetypeConflicts(targetSpec, &vm.Spec.Template.Spec); err != nil {
+		return errors.NewBadRequest(err.Error())
+	}
+	preferenceSpec, err := m.FindPreferenceSpec(vm)
+	if err != nil {
+		return err
+	}
+	if path, err := m.CheckPreferenceRequirements(targetSpec, preferenceSpec, &vm.Spec.Template.Spec); err != nil {
+		return errors.NewBadRequest(fmt.Sprintf("failure checking preference requirements at %s: %v", path.String(), err))
+	}
+
+	patches := []patch.PatchOperation{
+		{Op: patch.PatchTestOp, Path: instancetypeNamePath, Value: vm.Spec.Instancetype.Name},
+		{Op: patch.PatchReplaceOp, Path: instancetypeNamePath, Value: instancetypeName},
+	}
+	if vm.Spec.Instancetype.RevisionName != "" {
+		patches = append(patches,
+			patch.PatchOperation{Op: patch.PatchTestOp, Path: instancetypeRevisionNamePath, Value: vm.Spec.Instancetype.RevisionName},
+			patch.PatchOperation{Op: patch.PatchRemoveOp, Path: instancetypeRevisionNamePath},
+		)
+	}
+	payload, err := patch.GeneratePatchPayload(patches...)
+	if err != nil {
+		// This is a programmer's error and should not happen
+		return fmt.Errorf("failed to generate patch payload: %w", err)
+	}
+	if _, err := m.Clientset.VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, types.JSONPatchType, payload, &metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("failed to update VirtualMachine with the resized instancetype: %w", err)
+	}
+
+	if vm.Spec.Instancetype.RevisionName != "" {
+		m.deleteUnreferencedRevision(vm, vm.Spec.Instancetype.RevisionName)
+	}
+	return nil
+}
+
+func (m *InstancetypeMethods) findInstancetypeByKind(namespace, kind, name string) (metav1.Object, *instancetypev1beta1.VirtualMachineInstancetypeSpec, error) {
+	switch strings.ToLower(kind) {
+	case apiinstancetype.SingularResourceName, apiinstancetype.PluralResourceName:
+		instancetype, err := m.findInstancetypeByClient(types.NamespacedName{Namespace: namespace, Name: name})
+		if err != nil {
+			return nil, nil, err
+		}
+		return instancetype, &instancetype.Spec, nil
+
+	case apiinstancetype.ClusterSingularResourceName, apiinstancetype.ClusterPluralResourceName, "":
+		clusterInstancetype, err := m.findClusterInstancetypeByClient(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		return clusterInstancetype, &clusterInstancetype.Spec, nil
+
+	default:
+		return nil, nil, fmt.Errorf("got unexpected kind in InstancetypeMatcher: %s", kind)
+	}
+}
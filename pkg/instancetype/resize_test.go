@@ -0,0 +1,181 @@
+//nolint:lll
+package instancetype_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+	apiinstancetype "kubevirt.io/api/instancetype"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	fakeclientset "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/instancetype"
+)
+
+var _ = Describe("Instancetype resize", func() {
+	var (
+		vm                  *v1.VirtualMachine
+		vmInterface         *kubecli.MockVirtualMachineInterface
+		k8sClient           *k8sfake.Clientset
+		virtClient          *kubecli.MockKubevirtClient
+		instancetypeMethods *instancetype.InstancetypeMethods
+		currentRevision     *appsv1.ControllerRevision
+		listedVMs           []v1.VirtualMachine
+	)
+
+	newClusterInstancetype := func(name string, labels map[string]string, guestCPUs uint32) *instancetypev1beta1.VirtualMachineClusterInstancetype {
+		return &instancetypev1beta1.VirtualMachineClusterInstancetype{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "VirtualMachineClusterInstancetype",
+				APIVersion: instancetypev1beta1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Labels:     labels,
+				UID:        resourceUID,
+				Generation: resourceGeneration,
+			},
+			Spec: instancetypev1beta1.VirtualMachineInstancetypeSpec{
+				CPU:    instancetypev1beta1.CPUInstancetype{Guest: guestCPUs},
+				Memory: instancetypev1beta1.MemoryInstancetype{Guest: resource.MustParse("128Mi")},
+			},
+		}
+	}
+
+	createClusterInstancetype := func(name string, labels map[string]string, guestCPUs uint32) *instancetypev1beta1.VirtualMachineClusterInstancetype {
+		clusterInstancetype, err := virtClient.VirtualMachineClusterInstancetype().Create(context.Background(), newClusterInstancetype(name, labels, guestCPUs), metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return clusterInstancetype
+	}
+
+	expectBadRequest := func(err error) {
+		Expect(err).To(HaveOccurred())
+		Expect(errors.IsBadRequest(err)).To(BeTrue(), err.Error())
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		k8sClient = k8sfake.NewSimpleClientset()
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmInterface).AnyTimes()
+		listedVMs = nil
+		vmInterface.EXPECT().List(context.Background(), &metav1.ListOptions{}).DoAndReturn(func(_ context.Context, _ *metav1.ListOptions) (*v1.VirtualMachineList, error) {
+			return &v1.VirtualMachineList{Items: listedVMs}, nil
+		}).AnyTimes()
+		virtClient.EXPECT().AppsV1().Return(k8sClient.AppsV1()).AnyTimes()
+		virtClient.EXPECT().VirtualMachineClusterInstancetype().Return(fakeclientset.NewSimpleClientset().InstancetypeV1beta1().VirtualMachineClusterInstancetypes()).AnyTimes()
+		instancetypeMethods = &instancetype.InstancetypeMethods{Clientset: virtClient}
+
+		small := createClusterInstancetype("u1.small", nil, 1)
+
+		vm = kubecli.NewMinimalVM("testvm")
+		vm.Namespace = k8sv1.NamespaceDefault
+		vm.UID = "testvm-uid"
+		vm.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{}
+		vm.Spec.Instancetype = &v1.InstancetypeMatcher{
+			Name: small.Name,
+			Kind: apiinstancetype.ClusterSingularResourceName,
+		}
+
+		var err error
+		currentRevision, err = instancetype.CreateControllerRevision(vm, small)
+		Expect(err).ToNot(HaveOccurred())
+		currentRevision, err = k8sClient.AppsV1().ControllerRevisions(vm.Namespace).Create(context.Background(), currentRevision, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		vm.Spec.Instancetype.RevisionName = currentRevision.Name
+	})
+
+	DescribeTable("should determine the family of an instancetype", func(name string, labels map[string]string, expectedFamily string) {
+		Expect(instancetype.GetFamily(newClusterInstancetype(name, labels, 1))).To(Equal(expectedFamily))
+	},
+		Entry("from the family label", "small", map[string]string{apiinstancetype.InstancetypeFamilyLabel: "general"}, "general"),
+		Entry("from the family label over the name", "u1.small", map[string]string{apiinstancetype.InstancetypeFamilyLabel: "general"}, "general"),
+		Entry("from the series of the name", "cx1.2xlarge", nil, "cx1"),
+		Entry("not without label or series", "small", nil, ""),
+	)
+
+	It("should switch the VM to another size of the family", func() {
+		createClusterInstancetype("u1.large", nil, 4)
+
+		vmInterface.EXPECT().Patch(context.Background(), vm.Name, types.JSONPatchType, gomock.Any(), &metav1.PatchOptions{}).DoAndReturn(
+			func(_ context.Context, _ string, _ types.PatchType, data []byte, _ *metav1.PatchOptions, _ ...string) (*v1.VirtualMachine, error) {
+				Expect(string(data)).To(Equal(`[{"op":"test","path":"/spec/instancetype/name","value":"u1.small"},{"op":"replace","path":"/spec/instancetype/name","value":"u1.large"},{"op":"test","path":"/spec/instancetype/revisionName","value":"` + currentRevision.Name + `"},{"op":"remove","path":"/spec/instancetype/revisionName","value":null}]`))
+				return vm, nil
+			})
+
+		Expect(instancetypeMethods.Resize(vm, "u1.large")).To(Succeed())
+
+		_, err := k8sClient.AppsV1().ControllerRevisions(vm.Namespace).Get(context.Background(), currentRevision.Name, metav1.GetOptions{})
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should switch the VM to another size even if the old ControllerRevision can not be deleted", func() {
+		createClusterInstancetype("u1.large", nil, 4)
+		k8sClient.Fake.PrependReactor("delete", "controllerrevisions", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			return true, nil, errors.NewForbidden(schema.GroupResource{Group: "apps", Resource: "controllerrevisions"}, currentRevision.Name, fmt.Errorf("not allowed"))
+		})
+		vmInterface.EXPECT().Patch(context.Background(), vm.Name, types.JSONPatchType, gomock.Any(), &metav1.PatchOptions{}).Return(vm, nil)
+
+		Expect(instancetypeMethods.Resize(vm, "u1.large")).To(Succeed())
+	})
+
+	It("should not delete a ControllerRevision of another VirtualMachine", func() {
+		createClusterInstancetype("u1.large", nil, 4)
+		currentRevision.OwnerReferences[0].UID = "other-uid"
+		_, err := k8sClient.AppsV1().ControllerRevisions(vm.Namespace).Update(context.Background(), currentRevision, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		vmInterface.EXPECT().Patch(context.Background(), vm.Name, types.JSONPatchType, gomock.Any(), &metav1.PatchOptions{}).Return(vm, nil)
+
+		Expect(instancetypeMethods.Resize(vm, "u1.large")).To(Succeed())
+
+		_, err = k8sClient.AppsV1().ControllerRevisions(vm.Namespace).Get(context.Background(), currentRevision.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should leave a VM already referencing the size untouched", func() {
+		Expect(instancetypeMethods.Resize(vm, "u1.small")).To(Succeed())
+	})
+
+	It("should reject an instancetype of another family", func() {
+		createClusterInstancetype("cx1.large", nil, 4)
+		expectBadRequest(instancetypeMethods.Resize(vm, "cx1.large"))
+	})
+
+	It("should reject an instancetype which is not part of a family", func() {
+		createClusterInstancetype("large", nil, 4)
+		expectBadRequest(instancetypeMethods.Resize(vm, "large"))
+	})
+
+	It("should reject an instancetype conflicting with the VM", func() {
+		createClusterInstancetype("u1.large", nil, 4)
+		vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{Sockets: 2}
+		expectBadRequest(instancetypeMethods.Resize(vm, "u1.large"))
+	})
+
+	It("should fail if the instancetype does not exist", func() {
+		err := instancetypeMethods.Resize(vm, "u1.huge")
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should reject a VM without an instancetype", func() {
+		vm.Spec.Instancetype = nil
+		expectBadRequest(instancetypeMethods.Resize(vm, "u1.large"))
+	})
+})
@@ -0,0 +1,267 @@
+package instancetype
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+)
+
+const (
+	instancetypeRevisionNamePath = "/spec/instancetype/revisionName"
+	preferenceRevisionNamePath   = "/spec/preference/revisionName"
+)
+
+// Upgrade re-resolves the instancetype and preference of a VirtualMachine to the latest generation of the referenced
+// objects. ControllerRevisions holding an object of an older API version are rewritten as v1beta1 even if the object
+// itself did not change. Unless dryRun is set, the new ControllerRevisions are stored, the VirtualMachine is patched to
+// reference them and the ControllerRevisions which are no longer referenced are removed.
+func (m *InstancetypeMethods) Upgrade(vm *virtv1.VirtualMachine, dryRun bool) (*virtv1.InstancetypeUpgrade, error) {
+	upgrade := &virtv1.InstancetypeUpgrade{}
+	var instancetypeRevision, preferenceRevision *appsv1.ControllerRevision
+
+	if vm.Spec.Instancetype != nil && vm.Spec.Instancetype.RevisionName != "" {
+		latestRevision, err := m.createInstancetypeRevision(vm)
+		if err != nil {
+			return nil, err
+		}
+		upgrade.Instancetype, instancetypeRevision, err = m.upgradeRevision(vm.Namespace, vm.Spec.Instancetype.RevisionName, latestRevision, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if vm.Spec.Preference != nil && vm.Spec.Preference.RevisionName != "" {
+		latestRevision, err := m.createPreferenceRevision(vm)
+		if err != nil {
+			return nil, err
+		}
+		upgrade.Preference, preferenceRevision, err = m.upgradeRevision(vm.Namespace, vm.Spec.Preference.RevisionName, latestRevision, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if instancetypeRevision == nil && preferenceRevision == nil {
+		return upgrade, nil
+	}
+	if err := m.checkUpgradedPreferenceRequirements(vm, instancetypeRevision, preferenceRevision); err != nil {
+		return nil, err
+	}
+	if dryRun {
+		return upgrade, nil
+	}
+
+	if err := m.applyUpgrade(vm, upgrade, instancetypeRevision, preferenceRevision); err != nil {
+		return nil, err
+	}
+	return upgrade, nil
+}
+
+// upgradeRevision compares the ControllerRevision referenced by a VirtualMachine with one created from the latest
+// generation of the object. The latest revision is only returned if the VirtualMachine has to be moved to it.
+func (m *InstancetypeMethods) upgradeRevision(namespace, currentRevisionName string, latestRevision *appsv1.ControllerRevision, isPreference bool) (*virtv1.RevisionUpgrade, *appsv1.ControllerRevision, error) {
+	currentRevision, err := m.getControllerRevisionByClient(types.NamespacedName{Namespace: namespace, Name: currentRevisionName})
+	if err != nil {
+		return nil, nil, err
+	}
+	currentVersion, err := storedVersion(currentRevision, isPreference)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	upgrade := &virtv1.RevisionUpgrade{
+		CurrentRevisionName: currentRevisionName,
+		CurrentVersion:      currentVersion,
+		RevisionName:        currentRevisionName,
+	}
+	equal, err := CompareRevisions(currentRevision, latestRevision, isPreference)
+	if err != nil {
+		return nil, nil, err
+	}
+	if equal && currentVersion == instancetypev1beta1.SchemeGroupVersion.Version {
+		return upgrade, nil, nil
+	}
+
+	if !equal {
+		if upgrade.Diff, err = diffRevisions(currentRevision, latestRevision); err != nil {
+			return nil, nil, err
+		}
+	}
+	// ControllerRevisions are immutable, a revision of the same generation stored with an older API version
+	// has to be rewritten under a new name
+	if latestRevision.Name == currentRevisionName {
+		latestRevision.Name = fmt.Sprintf("%s-%s", latestRevision.Name, instancetypev1beta1.SchemeGroupVersion.Version)
+	}
+	upgrade.RevisionName = latestRevision.Name
+	return upgrade, latestRevision, nil
+}
+
+func (m *InstancetypeMethods) checkUpgradedPreferenceRequirements(vm *virtv1.VirtualMachine, instancetypeRevision, preferenceRevision *appsv1.ControllerRevision) error {
+	instancetypeSpec, err := m.FindInstancetypeSpec(vm)
+	if err != nil {
+		return err
+	}
+	if instancetypeRevision != nil {
+		if instancetypeSpec, err = getInstancetypeSpecFromControllerRevision(instancetypeRevision); err != nil {
+			return err
+		}
+	}
+	preferenceSpec, err := m.FindPreferenceSpec(vm)
+	if err != nil {
+		return err
+	}
+	if preferenceRevision != nil {
+		if preferenceSpec, err = getPreferenceSpecFromControllerRevision(preferenceRevision); err != nil {
+			return err
+		}
+	}
+
+	if path, err := m.CheckPreferenceRequirements(instancetypeSpec, preferenceSpec, &vm.Spec.Template.Spec); err != nil {
+		return fmt.Errorf("failure checking preference requirements at %s: %w", path.String(), err)
+	}
+	return nil
+}
+
+func (m *InstancetypeMethods) applyUpgrade(vm *virtv1.VirtualMachine, upgrade *virtv1.InstancetypeUpgrade, instancetypeRevision, preferenceRevision *appsv1.ControllerRevision) error {
+	var patches []patch.PatchOperation
+	var oldRevisionNames []string
+	if instancetypeRevision != nil {
+		if _, err := storeRevision(instancetypeRevision, m.Clientset, false); err != nil {
+			return err
+		}
+		patches = append(patches, revisionNamePatch(instancetypeRevisionNamePath, upgrade.Instancetype)...)
+		oldRevisionNames = append(oldRevisionNames, upgrade.Instancetype.CurrentRevisionName)
+	}
+	if preferenceRevision != nil {
+		if _, err := storeRevision(preferenceRevision, m.Clientset, true); err != nil {
+			return err
+		}
+		patches = append(patches, revisionNamePatch(preferenceRevisionNamePath, upgrade.Preference)...)
+		oldRevisionNames = append(oldRevisionNames, upgrade.Preference.CurrentRevisionName)
+	}
+
+	payload, err := patch.GeneratePatchPayload(patches...)
+	if err != nil {
+		// This is a programmer's error and should not happen
+		return fmt.Errorf("failed to generate patch payload: %w", err)
+	}
+	if _, err := m.Clientset.VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, types.JSONPatchType, payload, &metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("failed to update VirtualMachine with the upgraded ControllerRevision references: %w", err)
+	}
+
+	for _, name := range oldRevisionNames {
+		m.deleteUnreferencedRevision(vm, name)
+	}
+	return nil
+}
+
+// deleteUnreferencedRevision removes a ControllerRevision the VirtualMachine no longer references. As the name comes
+// from the spec of the VirtualMachine, only revisions controlled by the VirtualMachine and not referenced by any
+// VirtualMachine are deleted. Failing to do so is only logged, the revision is garbage collected with its owner.
+func (m *InstancetypeMethods) deleteUnreferencedRevision(vm *virtv1.VirtualMachine, name string) {
+	revision, err := m.Clientset.AppsV1().ControllerRevisions(vm.Namespace).Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			log.Log.Object(vm).Reason(err).Warningf("Failed to get the unreferenced ControllerRevision %s", name)
+		}
+		return
+	}
+	if !metav1.IsControlledBy(revision, vm) {
+		log.Log.Object(vm).Warningf("Not deleting the ControllerRevision %s, it is not controlled by the VirtualMachine", name)
+		return
+	}
+
+	vms, err := m.Clientset.VirtualMachine(vm.Namespace).List(context.Background(), &metav1.ListOptions{})
+	if err != nil {
+		log.Log.Object(vm).Reason(err).Warningf("Failed to check whether the ControllerRevision %s is still referenced", name)
+		return
+	}
+	for i := range vms.Items {
+		if referencesRevision(&vms.Items[i], name) {
+			log.Log.Object(vm).V(3).Infof("Not deleting the ControllerRevision %s, it is still referenced by VirtualMachine %s", name, vms.Items[i].Name)
+			return
+		}
+	}
+
+	err = m.Clientset.AppsV1().ControllerRevisions(vm.Namespace).Delete(context.Background(), name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &revision.UID},
+	})
+	if err != nil && !errors.IsNotFound(err) {
+		log.Log.Object(vm).Reason(err).Warningf("Failed to delete the unreferenced ControllerRevision %s", name)
+	}
+}
+
+func referencesRevision(vm *virtv1.VirtualMachine, name string) bool {
+	return (vm.Spec.Instancetype != nil && vm.Spec.Instancetype.RevisionName == name) ||
+		(vm.Spec.Preference != nil && vm.Spec.Preference.RevisionName == name)
+}
+
+func revisionNamePatch(path string, upgrade *virtv1.RevisionUpgrade) []patch.PatchOperation {
+	return []patch.PatchOperation{
+		{Op: patch.PatchTestOp, Path: path, Value: upgrade.CurrentRevisionName},
+		{Op: patch.PatchReplaceOp, Path: path, Value: upgrade.RevisionName},
+	}
+}
+
+// storedVersion returns the API version of the object stored in a ControllerRevision
+func storedVersion(revision *appsv1.ControllerRevision, isPreference bool) (string, error) {
+	if len(revision.Data.Raw) == 0 {
+		if revision.Data.Object == nil {
+			return "", fmt.Errorf("ControllerRevision %s holds no object", revision.Name)
+		}
+		return revision.Data.Object.GetObjectKind().GroupVersionKind().Version, nil
+	}
+
+	// The first revisions stored a VirtualMachineInstancetypeSpecRevision or VirtualMachinePreferenceSpecRevision
+	oldObject, err := decodeSpecRevision(revision.Data.Raw, isPreference)
+	if err != nil {
+		return "", err
+	}
+	if oldObject != nil {
+		return "v1alpha1", nil
+	}
+
+	typeMeta := &metav1.TypeMeta{}
+	if err := json.Unmarshal(revision.Data.Raw, typeMeta); err != nil {
+		return "", fmt.Errorf("failed to decode the type of the object in ControllerRevision %s: %w", revision.Name, err)
+	}
+	return typeMeta.GroupVersionKind().Version, nil
+}
+
+// diffRevisions returns the JSON merge patch turning the spec of the current into the spec of the latest
+// ControllerRevision. Both revisions have to be decoded already.
+func diffRevisions(currentRevision, latestRevision *appsv1.ControllerRevision) (string, error) {
+	currentSpec, err := getInstancetypeAPISpec(currentRevision.Data.Object)
+	if err != nil {
+		return "", err
+	}
+	latestSpec, err := getInstancetypeAPISpec(latestRevision.Data.Object)
+	if err != nil {
+		return "", err
+	}
+	currentJSON, err := json.Marshal(currentSpec)
+	if err != nil {
+		return "", err
+	}
+	latestJSON, err := json.Marshal(latestSpec)
+	if err != nil {
+		return "", err
+	}
+	diff, err := jsonpatch.CreateMergePatch(currentJSON, latestJSON)
+	if err != nil {
+		return "", fmt.Errorf("failed to diff ControllerRevision %s and %s: %w", currentRevision.Name, latestRevision.Name, err)
+	}
+	return string(diff), nil
+}
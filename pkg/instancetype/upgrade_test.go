@@ -0,0 +1,214 @@
+//nolint:lll
+package instancetype_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	apiinstancetype "kubevirt.io/api/instancetype"
+	instancetypev1alpha2 "kubevirt.io/api/instancetype/v1alpha2"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	fakeclientset "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/instancetype"
+)
+
+var _ = Describe("Instancetype upgrade", func() {
+	var (
+		vm                  *v1.VirtualMachine
+		vmInterface         *kubecli.MockVirtualMachineInterface
+		k8sClient           *k8sfake.Clientset
+		virtClient          *kubecli.MockKubevirtClient
+		instancetypeMethods *instancetype.InstancetypeMethods
+		clusterInstancetype *instancetypev1beta1.VirtualMachineClusterInstancetype
+		currentRevision     *appsv1.ControllerRevision
+		listedVMs           []v1.VirtualMachine
+	)
+
+	getRevision := func(name string) (*appsv1.ControllerRevision, error) {
+		return k8sClient.AppsV1().ControllerRevisions(vm.Namespace).Get(context.Background(), name, metav1.GetOptions{})
+	}
+
+	updateInstancetype := func(guestCPUs uint32) {
+		clusterInstancetype.Spec.CPU.Guest = guestCPUs
+		clusterInstancetype.Generation++
+		_, err := virtClient.VirtualMachineClusterInstancetype().Update(context.Background(), clusterInstancetype, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	expectRevisionNamePatch := func(oldName, newName string) {
+		vmInterface.EXPECT().Patch(context.Background(), vm.Name, types.JSONPatchType, gomock.Any(), &metav1.PatchOptions{}).DoAndReturn(
+			func(_ context.Context, _ string, _ types.PatchType, data []byte, _ *metav1.PatchOptions, _ ...string) (*v1.VirtualMachine, error) {
+				Expect(string(data)).To(Equal(`[{"op":"test","path":"/spec/instancetype/revisionName","value":"` + oldName + `"},{"op":"replace","path":"/spec/instancetype/revisionName","value":"` + newName + `"}]`))
+				return vm, nil
+			})
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		k8sClient = k8sfake.NewSimpleClientset()
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmInterface).AnyTimes()
+		listedVMs = nil
+		vmInterface.EXPECT().List(context.Background(), &metav1.ListOptions{}).DoAndReturn(func(_ context.Context, _ *metav1.ListOptions) (*v1.VirtualMachineList, error) {
+			return &v1.VirtualMachineList{Items: listedVMs}, nil
+		}).AnyTimes()
+		virtClient.EXPECT().AppsV1().Return(k8sClient.AppsV1()).AnyTimes()
+		virtClient.EXPECT().VirtualMachineClusterInstancetype().Return(fakeclientset.NewSimpleClientset().InstancetypeV1beta1().VirtualMachineClusterInstancetypes()).AnyTimes()
+		instancetypeMethods = &instancetype.InstancetypeMethods{Clientset: virtClient}
+
+		clusterInstancetype = &instancetypev1beta1.VirtualMachineClusterInstancetype{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "VirtualMachineClusterInstancetype",
+				APIVersion: instancetypev1beta1.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "test-cluster-instancetype",
+				UID:        resourceUID,
+				Generation: resourceGeneration,
+			},
+			Spec: instancetypev1beta1.VirtualMachineInstancetypeSpec{
+				CPU:    instancetypev1beta1.CPUInstancetype{Guest: uint32(2)},
+				Memory: instancetypev1beta1.MemoryInstancetype{Guest: resource.MustParse("128Mi")},
+			},
+		}
+		_, err := virtClient.VirtualMachineClusterInstancetype().Create(context.Background(), clusterInstancetype, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		vm = kubecli.NewMinimalVM("testvm")
+		vm.Namespace = k8sv1.NamespaceDefault
+		vm.UID = "testvm-uid"
+		vm.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{}
+		vm.Spec.Instancetype = &v1.InstancetypeMatcher{
+			Name: clusterInstancetype.Name,
+			Kind: apiinstancetype.ClusterSingularResourceName,
+		}
+
+		currentRevision, err = instancetype.CreateControllerRevision(vm, clusterInstancetype)
+		Expect(err).ToNot(HaveOccurred())
+		currentRevision, err = k8sClient.AppsV1().ControllerRevisions(vm.Namespace).Create(context.Background(), currentRevision, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		vm.Spec.Instancetype.RevisionName = currentRevision.Name
+	})
+
+	It("should leave a VM referencing the latest generation untouched", func() {
+		upgrade, err := instancetypeMethods.Upgrade(vm, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(upgrade.Instancetype.RevisionName).To(Equal(currentRevision.Name))
+		Expect(upgrade.Instancetype.CurrentVersion).To(Equal(instancetypev1beta1.SchemeGroupVersion.Version))
+		Expect(upgrade.Instancetype.Diff).To(BeEmpty())
+		Expect(upgrade.Preference).To(BeNil())
+	})
+
+	It("should only report the changes of a new generation with dry run", func() {
+		updateInstancetype(4)
+
+		upgrade, err := instancetypeMethods.Upgrade(vm, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(upgrade.Instancetype.CurrentRevisionName).To(Equal(currentRevision.Name))
+		Expect(upgrade.Instancetype.RevisionName).To(Equal(instancetype.GetRevisionName(vm.Name, clusterInstancetype.Name, resourceUID, resourceGeneration+1)))
+		Expect(upgrade.Instancetype.Diff).To(MatchJSON(`{"cpu":{"guest":4}}`))
+
+		_, err = getRevision(upgrade.Instancetype.RevisionName)
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should move the VM to a new generation", func() {
+		updateInstancetype(4)
+		newRevisionName := instancetype.GetRevisionName(vm.Name, clusterInstancetype.Name, resourceUID, resourceGeneration+1)
+		expectRevisionNamePatch(currentRevision.Name, newRevisionName)
+
+		upgrade, err := instancetypeMethods.Upgrade(vm, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(upgrade.Instancetype.RevisionName).To(Equal(newRevisionName))
+
+		_, err = getRevision(newRevisionName)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = getRevision(currentRevision.Name)
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should not delete a revision controlled by another VirtualMachine", func() {
+		currentRevision.OwnerReferences[0].UID = "other-uid"
+		_, err := k8sClient.AppsV1().ControllerRevisions(vm.Namespace).Update(context.Background(), currentRevision, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		updateInstancetype(4)
+		expectRevisionNamePatch(currentRevision.Name, instancetype.GetRevisionName(vm.Name, clusterInstancetype.Name, resourceUID, resourceGeneration+1))
+
+		_, err = instancetypeMethods.Upgrade(vm, false)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = getRevision(currentRevision.Name)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should not delete a revision still referenced by another VirtualMachine", func() {
+		otherVM := kubecli.NewMinimalVM("othervm")
+		otherVM.Spec.Instancetype = &v1.InstancetypeMatcher{Name: clusterInstancetype.Name, RevisionName: currentRevision.Name}
+		listedVMs = []v1.VirtualMachine{*otherVM}
+		updateInstancetype(4)
+		expectRevisionNamePatch(currentRevision.Name, instancetype.GetRevisionName(vm.Name, clusterInstancetype.Name, resourceUID, resourceGeneration+1))
+
+		_, err := instancetypeMethods.Upgrade(vm, false)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = getRevision(currentRevision.Name)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should rewrite a revision stored with an older API version", func() {
+		oldInstancetype := &instancetypev1alpha2.VirtualMachineClusterInstancetype{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "VirtualMachineClusterInstancetype",
+				APIVersion: instancetypev1alpha2.SchemeGroupVersion.String(),
+			},
+			ObjectMeta: clusterInstancetype.ObjectMeta,
+			Spec: instancetypev1alpha2.VirtualMachineInstancetypeSpec{
+				CPU:    instancetypev1alpha2.CPUInstancetype{Guest: uint32(2)},
+				Memory: instancetypev1alpha2.MemoryInstancetype{Guest: resource.MustParse("128Mi")},
+			},
+		}
+		raw, err := json.Marshal(oldInstancetype)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(k8sClient.AppsV1().ControllerRevisions(vm.Namespace).Delete(context.Background(), currentRevision.Name, metav1.DeleteOptions{})).To(Succeed())
+		currentRevision.Data = runtime.RawExtension{Raw: raw}
+		_, err = k8sClient.AppsV1().ControllerRevisions(vm.Namespace).Create(context.Background(), currentRevision, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		newRevisionName := currentRevision.Name + "-v1beta1"
+		expectRevisionNamePatch(currentRevision.Name, newRevisionName)
+
+		upgrade, err := instancetypeMethods.Upgrade(vm, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(upgrade.Instancetype.CurrentVersion).To(Equal(instancetypev1alpha2.SchemeGroupVersion.Version))
+		Expect(upgrade.Instancetype.RevisionName).To(Equal(newRevisionName))
+		Expect(upgrade.Instancetype.Diff).ToNot(ContainSubstring("guest"))
+
+		newRevision, err := getRevision(newRevisionName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(newRevision.Data.Object).To(BeAssignableToTypeOf(&instancetypev1beta1.VirtualMachineClusterInstancetype{}))
+	})
+
+	It("should fail if the new generation conflicts with the VM", func() {
+		vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{Sockets: 2}
+		updateInstancetype(4)
+
+		_, err := instancetypeMethods.Upgrade(vm, false)
+		Expect(err).To(MatchError(ContainSubstring("VM field conflicts with selected Instancetype")))
+	})
+})
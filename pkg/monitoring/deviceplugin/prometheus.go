@@ -0,0 +1,94 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package deviceplugin
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	HealthyMetricName     = "kubevirt_node_device_plugin_healthy"
+	RestartsMetricName    = "kubevirt_node_device_plugin_restarts_total"
+	AllocatableMetricName = "kubevirt_node_devices_allocatable"
+	AllocatedMetricName   = "kubevirt_node_devices_allocated"
+)
+
+var (
+	healthy = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: HealthyMetricName,
+			Help: "Indication for a device plugin of virt-handler which is registered with the kubelet and advertises healthy devices, broken down by node and resource name",
+		},
+		[]string{"node", "resource_name"},
+	)
+	restarts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: RestartsMetricName,
+			Help: "Amount of restarts of a device plugin of virt-handler, broken down by node and resource name",
+		},
+		[]string{"node", "resource_name"},
+	)
+	allocatable = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: AllocatableMetricName,
+			Help: "Amount of devices of a device plugin of virt-handler which the kubelet reports as allocatable, broken down by node and resource name",
+		},
+		[]string{"node", "resource_name"},
+	)
+	allocated = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: AllocatedMetricName,
+			Help: "Amount of devices of a device plugin of virt-handler which are allocated to pods, broken down by node and resource name",
+		},
+		[]string{"node", "resource_name"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(healthy)
+	prometheus.MustRegister(restarts)
+	prometheus.MustRegister(allocatable)
+	prometheus.MustRegister(allocated)
+}
+
+// IncRestarts counts a restart of the device plugin of the resource
+func IncRestarts(node, resourceName string) {
+	restarts.WithLabelValues(node, resourceName).Inc()
+}
+
+// SetDevices records the health and the allocatable and allocated devices of the device plugin of the resource
+func SetDevices(node, resourceName string, isHealthy bool, allocatableDevices, allocatedDevices int64) {
+	healthyValue := 0.0
+	if isHealthy {
+		healthyValue = 1.0
+	}
+	healthy.WithLabelValues(node, resourceName).Set(healthyValue)
+	allocatable.WithLabelValues(node, resourceName).Set(float64(allocatableDevices))
+	allocated.WithLabelValues(node, resourceName).Set(float64(allocatedDevices))
+}
+
+// DeleteMetrics drops the series of a device plugin which is not running anymore
+func DeleteMetrics(node, resourceName string) {
+	labels := prometheus.Labels{"node": node, "resource_name": resourceName}
+	healthy.Delete(labels)
+	restarts.Delete(labels)
+	allocatable.Delete(labels)
+	allocated.Delete(labels)
+}
@@ -22,6 +22,7 @@ package prometheus
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -565,6 +566,68 @@ func (metrics *vmiMetrics) updateFilesystem(vmFSStats k6tv1.VirtualMachineInstan
 	}
 }
 
+func (metrics *vmiMetrics) updateGuestAgentStats(guestInfo *k6tv1.VirtualMachineInstanceGuestAgentInfo) {
+	if guestInfo == nil {
+		return
+	}
+
+	if load := guestInfo.Load; load != nil {
+		metrics.pushCommonMetric(
+			"kubevirt_vmi_guest_load_1m",
+			"Guest system load average over 1 minute as reported by the guest agent.",
+			prometheus.GaugeValue,
+			load.Load1m,
+		)
+		metrics.pushCommonMetric(
+			"kubevirt_vmi_guest_load_5m",
+			"Guest system load average over 5 minutes as reported by the guest agent.",
+			prometheus.GaugeValue,
+			load.Load5m,
+		)
+		metrics.pushCommonMetric(
+			"kubevirt_vmi_guest_load_15m",
+			"Guest system load average over 15 minutes as reported by the guest agent.",
+			prometheus.GaugeValue,
+			load.Load15m,
+		)
+	}
+
+	for _, cpuStats := range guestInfo.CPUStats {
+		modes := []struct {
+			mode string
+			ms   int64
+		}{
+			{"user", cpuStats.User},
+			{"nice", cpuStats.Nice},
+			{"system", cpuStats.System},
+			{"idle", cpuStats.Idle},
+			{"iowait", cpuStats.IOWait},
+			{"irq", cpuStats.IRQ},
+			{"softirq", cpuStats.SoftIRQ},
+			{"steal", cpuStats.Steal},
+		}
+		for _, m := range modes {
+			metrics.pushCustomMetric(
+				"kubevirt_vmi_guest_vcpu_seconds_total",
+				"Total time spent by the guest vCPU in each mode as reported by the guest agent.",
+				prometheus.CounterValue,
+				float64(m.ms)/1000,
+				[]string{"id", "mode"},
+				[]string{strconv.Itoa(cpuStats.CPU), m.mode},
+			)
+		}
+	}
+
+	if blockInfo := guestInfo.MemoryBlockInfo; blockInfo != nil {
+		metrics.pushCommonMetric(
+			"kubevirt_vmi_guest_memory_block_size_bytes",
+			"Size of the guest memory blocks in bytes as reported by the guest agent.",
+			prometheus.GaugeValue,
+			float64(blockInfo.Size),
+		)
+	}
+}
+
 func updateVersion(ch chan<- prometheus.Metric) {
 	verinfo := version.Get()
 	ch <- prometheus.MustNewConstMetric(
@@ -631,6 +694,7 @@ type prometheusScraper struct {
 type VirtualMachineInstanceStats struct {
 	DomainStats *stats.DomainStats
 	FsStats     k6tv1.VirtualMachineInstanceFileSystemList
+	GuestInfo   *k6tv1.VirtualMachineInstanceGuestAgentInfo
 }
 
 func (ps *prometheusScraper) Scrape(socketFile string, vmi *k6tv1.VirtualMachineInstance) {
@@ -665,6 +729,13 @@ func (ps *prometheusScraper) Scrape(socketFile string, vmi *k6tv1.VirtualMachine
 		return
 	}
 
+	// the guest agent metrics are optional, a failure must not drop the domain metrics
+	vmStats.GuestInfo, err = cli.GetGuestInfo()
+	if err != nil {
+		log.Log.Reason(err).Warningf("failed to update guest agent stats from socket %s", socketFile)
+		vmStats.GuestInfo = nil
+	}
+
 	// GetDomainStats() may hang for a long time.
 	// If it wakes up past the timeout, there is no point in send back any metric.
 	// In the best case the information is stale, in the worst case the information is stale *and*
@@ -727,6 +798,7 @@ func (metrics *vmiMetrics) updateMetrics(vmi *k6tv1.VirtualMachineInstance, vmSt
 	}
 	metrics.updateMigrateInfo(vmStats.DomainStats.MigrateDomainJobInfo)
 	metrics.updateFilesystem(vmStats.FsStats)
+	metrics.updateGuestAgentStats(vmStats.GuestInfo)
 }
 
 func (metrics *vmiMetrics) newPrometheusDesc(name string, help string, customLabels []string) *prometheus.Desc {
@@ -1241,6 +1241,48 @@ var _ = Describe("Prometheus", func() {
 			Expect(ch).To(BeEmpty())
 		})
 
+		It("should expose guest agent load and vCPU metrics", func() {
+			ch := make(chan prometheus.Metric, 12)
+			defer close(ch)
+
+			ps := prometheusScraper{ch: ch}
+
+			domainStats := &stats.DomainStats{
+				Cpu:                  &stats.DomainStatsCPU{},
+				Memory:               &stats.DomainStatsMemory{},
+				Net:                  []stats.DomainStatsNet{},
+				MigrateDomainJobInfo: &stats.DomainJobInfo{},
+			}
+
+			vmStats := newVmStats(domainStats, nil)
+			vmStats.GuestInfo = &k6tv1.VirtualMachineInstanceGuestAgentInfo{
+				Load: &k6tv1.VirtualMachineInstanceGuestOSLoad{
+					Load1m:  0.5,
+					Load5m:  0.25,
+					Load15m: 0.125,
+				},
+				CPUStats: []k6tv1.VirtualMachineInstanceGuestOSCPUStats{
+					{CPU: 0, User: 1500, System: 500},
+				},
+			}
+
+			vmi := k6tv1.VirtualMachineInstance{}
+			ps.Report("test", &vmi, vmStats)
+
+			for _, name := range []string{"kubevirt_vmi_guest_load_1m", "kubevirt_vmi_guest_load_5m", "kubevirt_vmi_guest_load_15m"} {
+				result := <-ch
+				Expect(result).ToNot(BeNil())
+				Expect(result.Desc().String()).To(ContainSubstring(name))
+			}
+
+			result := <-ch
+			dto := &io_prometheus_client.Metric{}
+			Expect(result.Write(dto)).To(Succeed())
+			Expect(result.Desc().String()).To(ContainSubstring("kubevirt_vmi_guest_vcpu_seconds_total"))
+			Expect(dto.GetCounter().GetValue()).To(Equal(1.5))
+			Expect(ch).To(HaveLen(7))
+		})
+
 		DescribeTable("CPU metrics", func(metricName string, MetricValue int, cpuStats *stats.DomainStatsCPU) {
 			ch := make(chan prometheus.Metric, 1)
 			defer close(ch)
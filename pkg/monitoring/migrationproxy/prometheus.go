@@ -0,0 +1,79 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package migrationproxy
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	TransferredBytesMetricName  = "kubevirt_migration_proxy_transferred_bytes_total"
+	ActiveConnectionsMetricName = "kubevirt_migration_proxy_active_connections"
+
+	// DirectionOutbound is data read from the accepted connection and written to the dialed one
+	DirectionOutbound = "outbound"
+	// DirectionInbound is data read from the dialed connection and written back to the accepted one
+	DirectionInbound = "inbound"
+)
+
+var (
+	transferredBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: TransferredBytesMetricName,
+			Help: "Amount of bytes piped through the migration proxy, broken down by vmi uid, role, channel, connection and direction",
+		},
+		[]string{"uid", "role", "channel", "connection", "direction"},
+	)
+	activeConnections = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: ActiveConnectionsMetricName,
+			Help: "Amount of open migration proxy connections, broken down by vmi uid, role and channel",
+		},
+		[]string{"uid", "role", "channel"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(transferredBytes)
+	prometheus.MustRegister(activeConnections)
+}
+
+type Decrementer interface {
+	Dec()
+}
+
+// NewTransferredBytesCounter returns the counter tracking the bytes copied in one direction of a single proxied connection
+func NewTransferredBytesCounter(uid, role, channel, connection, direction string) prometheus.Counter {
+	return transferredBytes.WithLabelValues(uid, role, channel, connection, direction)
+}
+
+// NewActiveConnection increments the metric for active proxy connections by one for the vmi uid, role and channel
+// and returns a recorder for decrementing it once the connection is closed
+func NewActiveConnection(uid, role, channel string) Decrementer {
+	recorder := activeConnections.WithLabelValues(uid, role, channel)
+	recorder.Inc()
+	return recorder
+}
+
+// DeleteMetrics drops all the proxy series of a vmi once its proxies are torn down
+func DeleteMetrics(uid string) {
+	transferredBytes.DeletePartialMatch(prometheus.Labels{"uid": uid})
+	activeConnections.DeletePartialMatch(prometheus.Labels{"uid": uid})
+}
@@ -17,6 +17,8 @@ type MockInstancetypeMethods struct {
 	InferDefaultInstancetypeFunc    func(vm *v1.VirtualMachine) (*v1.InstancetypeMatcher, error)
 	InferDefaultPreferenceFunc      func(vm *v1.VirtualMachine) (*v1.PreferenceMatcher, error)
 	CheckPreferenceRequirementsFunc func(instancetypeSpec *instancetypev1beta1.VirtualMachineInstancetypeSpec, preferenceSpec *instancetypev1beta1.VirtualMachinePreferenceSpec, vmiSpec *v1.VirtualMachineInstanceSpec) (*k8sfield.Path, error)
+	UpgradeFunc                     func(vm *v1.VirtualMachine, dryRun bool) (*v1.InstancetypeUpgrade, error)
+	ResizeFunc                      func(vm *v1.VirtualMachine, instancetypeName string) error
 }
 
 var _ instancetype.Methods = &MockInstancetypeMethods{}
@@ -49,6 +51,14 @@ func (m *MockInstancetypeMethods) CheckPreferenceRequirements(instancetypeSpec *
 	return m.CheckPreferenceRequirementsFunc(instancetypeSpec, preferenceSpec, vmiSpec)
 }
 
+func (m *MockInstancetypeMethods) Upgrade(vm *v1.VirtualMachine, dryRun bool) (*v1.InstancetypeUpgrade, error) {
+	return m.UpgradeFunc(vm, dryRun)
+}
+
+func (m *MockInstancetypeMethods) Resize(vm *v1.VirtualMachine, instancetypeName string) error {
+	return m.ResizeFunc(vm, instancetypeName)
+}
+
 func NewMockInstancetypeMethods() *MockInstancetypeMethods {
 	return &MockInstancetypeMethods{
 		FindInstancetypeSpecFunc: func(_ *v1.VirtualMachine) (*instancetypev1beta1.VirtualMachineInstancetypeSpec, error) {
@@ -72,5 +82,11 @@ func NewMockInstancetypeMethods() *MockInstancetypeMethods {
 		CheckPreferenceRequirementsFunc: func(_ *instancetypev1beta1.VirtualMachineInstancetypeSpec, _ *instancetypev1beta1.VirtualMachinePreferenceSpec, _ *v1.VirtualMachineInstanceSpec) (*k8sfield.Path, error) {
 			return nil, nil
 		},
+		UpgradeFunc: func(_ *v1.VirtualMachine, _ bool) (*v1.InstancetypeUpgrade, error) {
+			return &v1.InstancetypeUpgrade{}, nil
+		},
+		ResizeFunc: func(_ *v1.VirtualMachine, _ string) error {
+			return nil
+		},
 	}
 }
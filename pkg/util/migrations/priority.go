@@ -0,0 +1,76 @@
+package migrations
+
+import (
+	"sort"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var priorityRanks = map[v1.MigrationPriority]int{
+	v1.MigrationPriorityHigh:   2,
+	v1.MigrationPriorityNormal: 1,
+	v1.MigrationPriorityLow:    0,
+}
+
+// Priority returns the priority of a migration. If none is set explicitly, evacuations are
+// prioritized over user requested migrations, which in turn come before workload updates.
+func Priority(migration *v1.VirtualMachineInstanceMigration) v1.MigrationPriority {
+	if migration.Spec.Priority != nil {
+		return *migration.Spec.Priority
+	}
+	if _, exists := migration.Annotations[v1.EvacuationMigrationAnnotation]; exists {
+		return v1.MigrationPriorityHigh
+	}
+	if _, exists := migration.Annotations[v1.WorkloadUpdateMigrationAnnotation]; exists {
+		return v1.MigrationPriorityLow
+	}
+	return v1.MigrationPriorityNormal
+}
+
+func priorityRank(migration *v1.VirtualMachineInstanceMigration) int {
+	if rank, exists := priorityRanks[Priority(migration)]; exists {
+		return rank
+	}
+	return priorityRanks[v1.MigrationPriorityNormal]
+}
+
+func createdBefore(a, b *v1.VirtualMachineInstanceMigration) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	if a.Namespace != b.Namespace {
+		return a.Namespace < b.Namespace
+	}
+	return a.Name < b.Name
+}
+
+// SortQueuedMigrations orders the queued migrations in the order they should be started.
+// Migrations with a higher priority always go first. Within the same priority, a namespace
+// gets its next migration started only after all namespaces with less running or queued
+// migrations had their turn, so that a bulk of migrations in one namespace can't starve the
+// others. Migrations which are otherwise equal are started in creation order.
+func SortQueuedMigrations(queued []*v1.VirtualMachineInstanceMigration, running []*v1.VirtualMachineInstanceMigration) {
+	sort.SliceStable(queued, func(i, j int) bool {
+		if rankI, rankJ := priorityRank(queued[i]), priorityRank(queued[j]); rankI != rankJ {
+			return rankI > rankJ
+		}
+		return createdBefore(queued[i], queued[j])
+	})
+
+	namespaceLoad := map[string]int{}
+	for _, migration := range running {
+		namespaceLoad[migration.Namespace]++
+	}
+	shares := make(map[*v1.VirtualMachineInstanceMigration]int, len(queued))
+	for _, migration := range queued {
+		shares[migration] = namespaceLoad[migration.Namespace]
+		namespaceLoad[migration.Namespace]++
+	}
+
+	sort.SliceStable(queued, func(i, j int) bool {
+		if rankI, rankJ := priorityRank(queued[i]), priorityRank(queued[j]); rankI != rankJ {
+			return rankI > rankJ
+		}
+		return shares[queued[i]] < shares[queued[j]]
+	})
+}
@@ -75,6 +75,16 @@ func IsVMIVirtiofsEnabled(vmi *v1.VirtualMachineInstance) bool {
 	return false
 }
 
+// Check if the VMI has a volume backed by a vhost-user-blk socket
+func IsVhostUserBlkVMI(vmi *v1.VirtualMachineInstance) bool {
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.VhostUserBlk != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // Check if a VMI spec requests a HostDevice
 func IsHostDevVMI(vmi *v1.VirtualMachineInstance) bool {
 	if vmi.Spec.Domain.Devices.HostDevices != nil && len(vmi.Spec.Domain.Devices.HostDevices) != 0 {
@@ -83,6 +93,16 @@ func IsHostDevVMI(vmi *v1.VirtualMachineInstance) bool {
 	return false
 }
 
+// Check if a VMI spec passes through host input devices
+func IsInputPassthroughVMI(vmi *v1.VirtualMachineInstance) bool {
+	for _, input := range vmi.Spec.Domain.Devices.Inputs {
+		if input.Type == v1.InputTypePassthrough {
+			return true
+		}
+	}
+	return false
+}
+
 // Check if a VMI spec requests a VFIO device
 func IsVFIOVMI(vmi *v1.VirtualMachineInstance) bool {
 
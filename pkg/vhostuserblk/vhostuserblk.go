@@ -0,0 +1,47 @@
+package vhostuserblk
+
+import (
+	"fmt"
+	"path/filepath"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+// SocketPathEnv holds the path where a storage plugin sidecar has to create its socket
+const SocketPathEnv = "VHOST_USER_BLK_SOCKET"
+
+// This is empty dir shared between the compute container and the storage plugin sidecars
+var VhostUserBlkSockets = "vhost-user-blk-sockets"
+var VhostUserBlkSocketsMountBaseDir = filepath.Join(util.VirtShareDir, VhostUserBlkSockets)
+
+// This is the host directory below which node agents create their sockets
+var NodeSockets = "vhost-user-blk-node-sockets"
+var NodeSocketsHostDir = "/var/run/kubevirt-vhost-user-blk"
+var NodeSocketsMountBaseDir = filepath.Join(util.VirtShareDir, NodeSockets)
+
+// NodeSocketsHostPath returns the host directory holding the node agent sockets of a VMI.
+// Only this directory is mounted into the pod of the VMI, so a VMI can never reach the
+// backends created for VMIs of other namespaces.
+func NodeSocketsHostPath(namespace string, vmiName string) string {
+	return filepath.Join(NodeSocketsHostDir, namespace, vmiName)
+}
+
+// IsServedBySidecar returns true if the socket of the volume is provided
+// by a storage plugin sidecar, false if it is provided by a node agent
+func IsServedBySidecar(source *v1.VhostUserBlkSource) bool {
+	return source.Image != ""
+}
+
+func SocketPath(volume *v1.Volume) string {
+	source := volume.VhostUserBlk
+	socketName := source.SocketName
+	if socketName == "" {
+		socketName = fmt.Sprintf("%s.sock", volume.Name)
+	}
+	if IsServedBySidecar(source) {
+		return filepath.Join(VhostUserBlkSocketsMountBaseDir, socketName)
+	}
+	return filepath.Join(NodeSocketsMountBaseDir, socketName)
+}
@@ -280,6 +280,29 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
 			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("upgrade-instancetype")).
+			To(subresourceApp.VMInstancetypeUpgradeRequestHandler).
+			Reads(v1.InstancetypeUpgradeOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vm-UpgradeInstancetype").
+			Produces(restful.MIME_JSON).
+			Doc("Move a VirtualMachine to the latest generation of its instancetype and preference.").
+			Returns(http.StatusOK, "OK", v1.InstancetypeUpgrade{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("resize")).
+			To(subresourceApp.VMResizeRequestHandler).
+			Reads(v1.VirtualMachineResizeOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vm-Resize").
+			Doc("Switch a VirtualMachine to another instancetype of the same family.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("freeze")).
 			To(subresourceApp.FreezeVMIRequestHandler).
 			Reads(v1.FreezeUnfreezeTimeout{}).
@@ -333,7 +356,7 @@ func (app *virtAPIApp) composeSubresources() {
 
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("vnc")).
 			To(subresourceApp.VNCRequestHandler).
-			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).Param(definitions.SharedVNCParam(subws)).
 			Operation(version.Version + "VNC").
 			Doc("Open a websocket connection to connect to VNC on the specified VirtualMachineInstance."))
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("vnc/screenshot")).
@@ -393,6 +416,26 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
 			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
 
+		subws.Route(subws.GET(definitions.SubResourcePath("nodes/{name}/evacuationplan")).
+			To(subresourceApp.NodeEvacuationPlanHandler).
+			Param(definitions.NameParam(subws)).
+			Operation(version.Version+"NodeEvacuationPlan").
+			Produces(restful.MIME_JSON).
+			Doc("Get the evacuation plan of the specified Node.").
+			Returns(http.StatusOK, "OK", v1.NodeEvacuationPlan{}).
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
+		subws.Route(subws.PUT(definitions.SubResourcePath("nodes/{name}/evacuate")).
+			To(subresourceApp.EvacuateNodeHandler).
+			Param(definitions.NameParam(subws)).
+			Operation(version.Version+"EvacuateNode").
+			Produces(restful.MIME_JSON).
+			Doc("Mark all VirtualMachineInstances on the specified Node which live migrate on eviction for evacuation.").
+			Returns(http.StatusOK, "OK", v1.NodeEvacuationPlan{}).
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		subws.Route(subws.GET(definitions.SubResourcePath("version")).Produces(restful.MIME_JSON).
 			To(func(request *restful.Request, response *restful.Response) {
 				response.WriteAsJson(virtversion.Get())
@@ -451,6 +494,33 @@ func (app *virtAPIApp) composeSubresources() {
 			Writes(v1.VirtualMachineInstanceFileSystemList{}).
 			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
 
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestinfo")).
+			To(subresourceApp.GuestAgentCommandResults).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Operation(version.Version+"Guestinfo").
+			Doc("Get the results of the custom guest agent commands configured in the KubeVirt CR").
+			Writes(v1.VirtualMachineInstanceGuestAgentCommandResultList{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceGuestAgentCommandResultList{}))
+
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("stats")).
+			To(subresourceApp.Stats).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Operation(version.Version+"Stats").
+			Doc("Get the latest CPU, memory, disk and network usage of a VirtualMachineInstance").
+			Writes(v1.VirtualMachineInstanceStats{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceStats{}))
+
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("domainxml")).
+			To(subresourceApp.DomainXML).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Operation(version.Version+"DomainXML").
+			Doc("Get the libvirt domain XML of a VirtualMachineInstance, with credentials and launch secrets redacted").
+			Writes(v1.VirtualMachineInstanceDomainXML{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceDomainXML{}))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("addvolume")).
 			To(subresourceApp.VMIAddVolumeRequestHandler).
 			Reads(v1.AddVolumeOptions{}).
@@ -469,6 +539,15 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("iotune")).
+			To(subresourceApp.VMIIOTuneRequestHandler).
+			Reads(v1.DiskIOTuneOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vmi-iotune").
+			Doc("Change the I/O limits of a disk of a running Virtual Machine Instance").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("addvolume")).
 			To(subresourceApp.VMAddVolumeRequestHandler).
 			Reads(v1.AddVolumeOptions{}).
@@ -487,6 +566,15 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("iotune")).
+			To(subresourceApp.VMIOTuneRequestHandler).
+			Reads(v1.DiskIOTuneOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vm-iotune").
+			Doc("Change the I/O limits of a disk of a Virtual Machine and of its running Virtual Machine Instance.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("memorydump")).
 			To(subresourceApp.MemoryDumpVMRequestHandler).
 			Reads(v1.VirtualMachineMemoryDumpRequest{}).
@@ -561,6 +649,14 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "expand-vm-spec",
 						Namespaced: true,
 					},
+					{
+						Name:       "nodes/evacuationplan",
+						Namespaced: false,
+					},
+					{
+						Name:       "nodes/evacuate",
+						Namespaced: false,
+					},
 					{
 						Name:       "virtualmachineinstances/vnc",
 						Namespaced: true,
@@ -613,6 +709,14 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachines/expand-spec",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachines/upgrade-instancetype",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachines/resize",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/guestosinfo",
 						Namespaced: true,
@@ -625,6 +729,18 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/filesystemlist",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/guestinfo",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/stats",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/domainxml",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/addvolume",
 						Namespaced: true,
@@ -633,6 +749,14 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/removevolume",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/iotune",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachines/iotune",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/sev/fetchcertchain",
 						Namespaced: true,
@@ -860,7 +984,7 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 		validating_webhook.ServeMigrationCreate(w, r, app.clusterConfig, app.virtCli)
 	})
 	http.HandleFunc(components.MigrationUpdateValidatePath, func(w http.ResponseWriter, r *http.Request) {
-		validating_webhook.ServeMigrationUpdate(w, r)
+		validating_webhook.ServeMigrationUpdate(w, r, app.virtCli)
 	})
 	http.HandleFunc(components.VMSnapshotValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMSnapshots(w, r, app.clusterConfig, app.virtCli)
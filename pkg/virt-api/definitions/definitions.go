@@ -568,6 +568,7 @@ const (
 	NamespaceParamName  = "namespace"
 	NameParamName       = "name"
 	MoveCursorParamName = "moveCursor"
+	SharedParamName     = "shared"
 )
 
 func NameParam(ws *restful.WebService) *restful.Parameter {
@@ -582,6 +583,10 @@ func MoveCursorParam(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter(MoveCursorParamName, "Move the cursor on the VNC display to wake up the screen").DataType("boolean").DefaultValue("false")
 }
 
+func SharedVNCParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(SharedParamName, "Share the VNC display with the other shared connections instead of closing them").DataType("boolean").DefaultValue("false")
+}
+
 func labelSelectorParam(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter("labelSelector", "A selector to restrict the list of returned objects by their labels. Defaults to everything")
 }
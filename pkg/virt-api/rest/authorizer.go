@@ -188,11 +188,16 @@ func (a *authorizor) generateAccessReview(req *restful.Request) (*authorization.
 	// URL examples
 	// /apis/subresources.kubevirt.io/v1alpha3/namespaces/default/virtualmachineinstances/testvmi/console
 	// /apis/subresources.kubevirt.io/v1alpha3/namespaces/default/expand-vm-spec
+	// /apis/subresources.kubevirt.io/v1alpha3/nodes/node01/evacuationplan
 	pathSplit := strings.Split(url.Path, "/")
 	if len(pathSplit) >= 9 {
 		if err := addNamespacedResourceAttributes(pathSplit, httpRequest, r); err != nil {
 			return nil, err
 		}
+	} else if len(pathSplit) == 7 && pathSplit[4] == "nodes" {
+		if err := addNodeResourceAttributes(pathSplit, httpRequest, r); err != nil {
+			return nil, err
+		}
 	} else if len(pathSplit) == 7 {
 		if err := addNamespacedResourceBaseAttributes(pathSplit, httpRequest, r); err != nil {
 			return nil, err
@@ -264,6 +269,36 @@ func addNamespacedResourceBaseAttributes(pathSplit []string, httpRequest *http.R
 	return nil
 }
 
+func addNodeResourceAttributes(pathSplit []string, httpRequest *http.Request, r *authorization.SubjectAccessReview) error {
+	// URL example
+	// /apis/subresources.kubevirt.io/v1alpha3/nodes/node01/evacuationplan
+	group := pathSplit[2]
+	version := pathSplit[3]
+	resource := pathSplit[4]
+	resourceName := pathSplit[5]
+	subresource := pathSplit[6]
+
+	if subresource != "evacuationplan" && subresource != "evacuate" {
+		return fmt.Errorf("unknown subresource %s", subresource)
+	}
+
+	verb, err := mapHttpVerbToRbacVerb(httpRequest.Method, resourceName)
+	if err != nil {
+		return err
+	}
+
+	r.Spec.ResourceAttributes = &authorization.ResourceAttributes{
+		Verb:        verb,
+		Group:       group,
+		Version:     version,
+		Resource:    resource,
+		Subresource: subresource,
+		Name:        resourceName,
+	}
+
+	return nil
+}
+
 func mapHttpVerbToRbacVerb(httpVerb string, name string) (string, error) {
 	// see https://kubernetes.io/docs/reference/access-authn-authz/authorization/#determine-the-request-verb
 	// if name is empty, we assume plural verbs
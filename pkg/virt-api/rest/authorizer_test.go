@@ -29,6 +29,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/ghttp"
+	authorization "k8s.io/api/authorization/v1"
 	authorizationclient "k8s.io/client-go/kubernetes/typed/authorization/v1"
 	"k8s.io/client-go/tools/clientcmd"
 )
@@ -215,6 +216,26 @@ var _ = Describe("Authorizer", func() {
 				})
 			})
 
+			Context("with node resource", func() {
+				BeforeEach(func() {
+					req.Request.Method = http.MethodPut
+					req.Request.URL.Path = "/apis/subresources.kubevirt.io/v1/nodes/node01/evacuate"
+				})
+
+				It("should review access to the node subresource", func() {
+					result, err := app.generateAccessReview(req)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result.Spec.ResourceAttributes).To(Equal(&authorization.ResourceAttributes{
+						Verb:        "update",
+						Group:       "subresources.kubevirt.io",
+						Version:     "v1",
+						Resource:    "nodes",
+						Subresource: "evacuate",
+						Name:        "node01",
+					}))
+				})
+			})
+
 			DescribeTable("should allow all users for info endpoints", func(path string) {
 				req.Request.URL.Path = path
 				allowed, _, err := app.Authorize(req)
@@ -262,6 +283,7 @@ var _ = Describe("Authorizer", func() {
 				Entry("invalid resource type", "/apis/subresources.kubevirt.io/v1alpha3/namespaces/default/madeupresource/testvmi/console"),
 				Entry("unknown namespaced resource endpoint", "/apis/subresources.kubevirt.io/v1/namespaces/default/madethisup/testvmi/console"),
 				Entry("unknown namespaced base resource endpoint", "/apis/subresources.kubevirt.io/v1/namespaces/default/madethisup"),
+				Entry("unknown node subresource endpoint", "/apis/subresources.kubevirt.io/v1/nodes/node01/madethisup"),
 			)
 		})
 
@@ -0,0 +1,300 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/emicklei/go-restful/v3"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/util/migrations"
+)
+
+const vmiNotMigratableReason = "VMI is not live-migratable"
+
+// NodeEvacuationPlanHandler returns what happens to every VMI on a node when the node is drained,
+// together with the progress of an evacuation which is already underway.
+func (app *SubresourceAPIApp) NodeEvacuationPlanHandler(request *restful.Request, response *restful.Response) {
+	plan, statusErr := app.nodeEvacuationPlan(request.PathParameter("name"))
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	if err := response.WriteEntity(plan); err != nil {
+		log.Log.Reason(err).Error("Failed to write http response.")
+	}
+}
+
+// EvacuateNodeHandler marks all VMIs on a node which live migrate on eviction for evacuation, the same
+// way the pod eviction webhook does during a drain. VMIs which are shut down on eviction are left alone
+// until their pods are actually evicted.
+func (app *SubresourceAPIApp) EvacuateNodeHandler(request *restful.Request, response *restful.Response) {
+	nodeName := request.PathParameter("name")
+
+	plan, statusErr := app.nodeEvacuationPlan(nodeName)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	data := []byte(fmt.Sprintf(`[{ "op": "add", "path": "/status/evacuationNodeName", "value": "%s" }]`, nodeName))
+	for i := range plan.VMIs {
+		entry := &plan.VMIs[i]
+		if entry.Phase != v1.NodeEvacuationPending {
+			continue
+		}
+		if entry.Action != v1.NodeEvacuationActionLiveMigrate && entry.Action != v1.NodeEvacuationActionExternal {
+			continue
+		}
+
+		_, err := app.virtCli.VirtualMachineInstance(entry.Namespace).Patch(context.Background(), entry.Name, types.JSONPatchType, data, &k8smetav1.PatchOptions{})
+		if err != nil {
+			writeError(errors.NewInternalError(fmt.Errorf("failed to mark vmi %s/%s for evacuation: %v", entry.Namespace, entry.Name, err)), response)
+			return
+		}
+		entry.Phase = v1.NodeEvacuationMarked
+	}
+	plan.Status = evacuationPlanStatus(plan.VMIs)
+
+	if err := response.WriteEntity(plan); err != nil {
+		log.Log.Reason(err).Error("Failed to write http response.")
+	}
+}
+
+func (app *SubresourceAPIApp) nodeEvacuationPlan(nodeName string) (*v1.NodeEvacuationPlan, *errors.StatusError) {
+	if _, err := app.virtCli.CoreV1().Nodes().Get(context.Background(), nodeName, k8smetav1.GetOptions{}); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.NewNotFound(k8sv1.Resource("node"), nodeName)
+		}
+		return nil, errors.NewInternalError(fmt.Errorf("unable to retrieve node [%s]: %v", nodeName, err))
+	}
+
+	vmis, err := app.virtCli.VirtualMachineInstance(k8smetav1.NamespaceAll).List(context.Background(), &k8smetav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", v1.NodeNameLabel, nodeName),
+	})
+	if err != nil {
+		return nil, errors.NewInternalError(fmt.Errorf("unable to list vmis on node [%s]: %v", nodeName, err))
+	}
+
+	plan := &v1.NodeEvacuationPlan{
+		TypeMeta: k8smetav1.TypeMeta{
+			Kind:       "NodeEvacuationPlan",
+			APIVersion: v1.SubresourceStorageGroupVersion.String(),
+		},
+		NodeName: nodeName,
+	}
+	for i := range vmis.Items {
+		vmi := &vmis.Items[i]
+		if vmi.IsFinal() || vmi.Status.NodeName != nodeName {
+			continue
+		}
+		plan.VMIs = append(plan.VMIs, app.newEvacuationPlanEntry(vmi, evacuationPhase(vmi)))
+	}
+
+	migrated, statusErr := app.migratedOffNode(nodeName)
+	if statusErr != nil {
+		return nil, statusErr
+	}
+	plan.VMIs = append(plan.VMIs, migrated...)
+
+	sort.Slice(plan.VMIs, func(i, j int) bool {
+		if plan.VMIs[i].Namespace != plan.VMIs[j].Namespace {
+			return plan.VMIs[i].Namespace < plan.VMIs[j].Namespace
+		}
+		return plan.VMIs[i].Name < plan.VMIs[j].Name
+	})
+
+	total := resource.NewQuantity(0, resource.BinarySI)
+	for _, entry := range plan.VMIs {
+		if entry.EstimatedDataTransfer != nil {
+			total.Add(*entry.EstimatedDataTransfer)
+		}
+	}
+	plan.EstimatedDataTransfer = total
+	plan.Status = evacuationPlanStatus(plan.VMIs)
+
+	return plan, nil
+}
+
+// migratedOffNode returns the VMIs which already left the node through a successful evacuation migration.
+// Only the last migration of a VMI counts, so VMIs which moved on since an earlier maintenance are not reported.
+func (app *SubresourceAPIApp) migratedOffNode(nodeName string) ([]v1.NodeEvacuationPlanEntry, *errors.StatusError) {
+	migrationList, err := app.virtCli.VirtualMachineInstanceMigration(k8smetav1.NamespaceAll).List(&k8smetav1.ListOptions{})
+	if err != nil {
+		return nil, errors.NewInternalError(fmt.Errorf("unable to list migrations: %v", err))
+	}
+
+	var entries []v1.NodeEvacuationPlanEntry
+	for _, migration := range migrationList.Items {
+		if migration.Annotations[v1.EvacuationMigrationAnnotation] != nodeName || migration.Status.Phase != v1.MigrationSucceeded {
+			continue
+		}
+
+		vmi, err := app.virtCli.VirtualMachineInstance(migration.Namespace).Get(context.Background(), migration.Spec.VMIName, &k8smetav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			return nil, errors.NewInternalError(fmt.Errorf("unable to retrieve vmi [%s]: %v", migration.Spec.VMIName, err))
+		}
+
+		state := vmi.Status.MigrationState
+		if vmi.Status.NodeName == nodeName || state == nil || state.MigrationUID != migration.UID || state.SourceNode != nodeName {
+			continue
+		}
+		entries = append(entries, app.newEvacuationPlanEntry(vmi, v1.NodeEvacuationMigrated))
+	}
+	return entries, nil
+}
+
+func (app *SubresourceAPIApp) newEvacuationPlanEntry(vmi *v1.VirtualMachineInstance, phase v1.NodeEvacuationPhase) v1.NodeEvacuationPlanEntry {
+	entry := v1.NodeEvacuationPlanEntry{
+		Namespace:        vmi.Namespace,
+		Name:             vmi.Name,
+		EvictionStrategy: migrations.VMIEvictionStrategy(app.clusterConfig, vmi),
+		Phase:            phase,
+	}
+
+	if phase == v1.NodeEvacuationMigrated {
+		entry.Action = v1.NodeEvacuationActionLiveMigrate
+		entry.EstimatedDataTransfer = estimatedDataTransfer(vmi)
+		return entry
+	}
+
+	if entry.EvictionStrategy == nil {
+		entry.Action = v1.NodeEvacuationActionShutdown
+		entry.Reason = "VMI has no eviction strategy"
+		entry.Phase = v1.NodeEvacuationPending
+		return entry
+	}
+
+	switch *entry.EvictionStrategy {
+	case v1.EvictionStrategyLiveMigrate:
+		if vmi.IsMigratable() {
+			entry.Action = v1.NodeEvacuationActionLiveMigrate
+		} else {
+			entry.Action = v1.NodeEvacuationActionBlocked
+			entry.Reason = notMigratableReason(vmi)
+		}
+	case v1.EvictionStrategyLiveMigrateIfPossible:
+		if vmi.IsMigratable() {
+			entry.Action = v1.NodeEvacuationActionLiveMigrate
+		} else {
+			entry.Action = v1.NodeEvacuationActionShutdown
+			entry.Reason = notMigratableReason(vmi)
+		}
+	case v1.EvictionStrategyExternal:
+		entry.Action = v1.NodeEvacuationActionExternal
+	default:
+		entry.Action = v1.NodeEvacuationActionShutdown
+	}
+
+	if entry.Action == v1.NodeEvacuationActionLiveMigrate {
+		entry.EstimatedDataTransfer = estimatedDataTransfer(vmi)
+	} else if entry.Action != v1.NodeEvacuationActionExternal {
+		entry.Phase = v1.NodeEvacuationPending
+	}
+	return entry
+}
+
+func evacuationPhase(vmi *v1.VirtualMachineInstance) v1.NodeEvacuationPhase {
+	switch {
+	case !vmi.IsMarkedForEviction():
+		return v1.NodeEvacuationPending
+	case migrations.IsMigrating(vmi):
+		return v1.NodeEvacuationMigrating
+	case vmi.Status.MigrationState != nil && vmi.Status.MigrationState.Failed:
+		return v1.NodeEvacuationFailed
+	default:
+		return v1.NodeEvacuationMarked
+	}
+}
+
+func notMigratableReason(vmi *v1.VirtualMachineInstance) string {
+	for _, cond := range vmi.Status.Conditions {
+		if cond.Type == v1.VirtualMachineInstanceIsMigratable && cond.Status == k8sv1.ConditionFalse {
+			return fmt.Sprintf("%s: %s", cond.Reason, cond.Message)
+		}
+	}
+	return vmiNotMigratableReason
+}
+
+// estimatedDataTransfer approximates the data a live migration of the VMI copies: its guest memory and,
+// for block migrations, the size of all volumes which are not shared with the target node.
+func estimatedDataTransfer(vmi *v1.VirtualMachineInstance) *resource.Quantity {
+	estimate := resource.NewQuantity(0, resource.BinarySI)
+	if vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Guest != nil {
+		estimate.Add(*vmi.Spec.Domain.Memory.Guest)
+	} else if memory, exists := vmi.Spec.Domain.Resources.Requests[k8sv1.ResourceMemory]; exists {
+		estimate.Add(memory)
+	}
+
+	if vmi.Status.MigrationMethod != v1.BlockMigration {
+		return estimate
+	}
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		pvcInfo := volumeStatus.PersistentVolumeClaimInfo
+		if pvcInfo == nil || hasAccessMode(pvcInfo.AccessModes, k8sv1.ReadWriteMany) {
+			continue
+		}
+		if capacity, exists := pvcInfo.Capacity[k8sv1.ResourceStorage]; exists {
+			estimate.Add(capacity)
+		}
+	}
+	return estimate
+}
+
+func hasAccessMode(accessModes []k8sv1.PersistentVolumeAccessMode, mode k8sv1.PersistentVolumeAccessMode) bool {
+	for _, accessMode := range accessModes {
+		if accessMode == mode {
+			return true
+		}
+	}
+	return false
+}
+
+func evacuationPlanStatus(entries []v1.NodeEvacuationPlanEntry) *v1.NodeEvacuationPlanStatus {
+	status := &v1.NodeEvacuationPlanStatus{}
+	for _, entry := range entries {
+		switch entry.Phase {
+		case v1.NodeEvacuationPending:
+			status.Pending++
+		case v1.NodeEvacuationMarked:
+			status.Marked++
+		case v1.NodeEvacuationMigrating:
+			status.Migrating++
+		case v1.NodeEvacuationMigrated:
+			status.Migrated++
+		case v1.NodeEvacuationFailed:
+			status.Failed++
+		}
+	}
+	return status
+}
@@ -0,0 +1,274 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Node evacuation plan subresources", func() {
+	const nodeName = "node01"
+
+	var (
+		virtClient    *kubecli.MockKubevirtClient
+		vmiClient     *kubecli.MockVirtualMachineInstanceInterface
+		migrateClient *kubecli.MockVirtualMachineInstanceMigrationInterface
+		app           *SubresourceAPIApp
+
+		request  *restful.Request
+		recorder *httptest.ResponseRecorder
+		response *restful.Response
+	)
+
+	newVMI := func(name string, evictionStrategy *v1.EvictionStrategy, migratable bool) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: k8smetav1.ObjectMeta{
+				Name:      name,
+				Namespace: k8smetav1.NamespaceDefault,
+				Labels:    map[string]string{v1.NodeNameLabel: nodeName},
+			},
+			Spec: v1.VirtualMachineInstanceSpec{
+				EvictionStrategy: evictionStrategy,
+				Domain: v1.DomainSpec{
+					Resources: v1.ResourceRequirements{
+						Requests: k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("1Gi")},
+					},
+				},
+			},
+			Status: v1.VirtualMachineInstanceStatus{
+				Phase:    v1.Running,
+				NodeName: nodeName,
+			},
+		}
+		migratableCondition := v1.VirtualMachineInstanceCondition{
+			Type:   v1.VirtualMachineInstanceIsMigratable,
+			Status: k8sv1.ConditionTrue,
+		}
+		if !migratable {
+			migratableCondition.Status = k8sv1.ConditionFalse
+			migratableCondition.Reason = v1.VirtualMachineInstanceReasonDisksNotMigratable
+			migratableCondition.Message = "cannot migrate VMI: PVC is not shared"
+		}
+		vmi.Status.Conditions = append(vmi.Status.Conditions, migratableCondition)
+		return vmi
+	}
+
+	expectVMIs := func(vmis ...*v1.VirtualMachineInstance) {
+		list := &v1.VirtualMachineInstanceList{}
+		for _, vmi := range vmis {
+			list.Items = append(list.Items, *vmi)
+		}
+		vmiClient.EXPECT().List(context.Background(), &k8smetav1.ListOptions{LabelSelector: v1.NodeNameLabel + "=" + nodeName}).Return(list, nil)
+	}
+
+	expectMigrations := func(migrations ...v1.VirtualMachineInstanceMigration) {
+		migrateClient.EXPECT().List(&k8smetav1.ListOptions{}).Return(&v1.VirtualMachineInstanceMigrationList{Items: migrations}, nil)
+	}
+
+	decodePlan := func() *v1.NodeEvacuationPlan {
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		plan := &v1.NodeEvacuationPlan{}
+		Expect(json.NewDecoder(recorder.Body).Decode(plan)).To(Succeed())
+		return plan
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		vmiClient = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		migrateClient = kubecli.NewMockVirtualMachineInstanceMigrationInterface(ctrl)
+
+		kubeClient := fake.NewSimpleClientset(&k8sv1.Node{ObjectMeta: k8smetav1.ObjectMeta{Name: nodeName}})
+		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(gomock.Any()).Return(vmiClient).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstanceMigration(k8smetav1.NamespaceAll).Return(migrateClient).AnyTimes()
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		app = NewSubresourceAPIApp(virtClient, 0, nil, config)
+
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = nodeName
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+		response.SetRequestAccepts(restful.MIME_JSON)
+	})
+
+	It("should fail if the node does not exist", func() {
+		request.PathParameters()["name"] = "unknown"
+		app.NodeEvacuationPlanHandler(request, response)
+		Expect(recorder.Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("should plan the action of every VMI according to its eviction strategy", func() {
+		liveMigrate := v1.EvictionStrategyLiveMigrate
+		liveMigrateIfPossible := v1.EvictionStrategyLiveMigrateIfPossible
+		external := v1.EvictionStrategyExternal
+
+		expectVMIs(
+			newVMI("migratable", &liveMigrate, true),
+			newVMI("blocked", &liveMigrate, false),
+			newVMI("shutdown-if-not-possible", &liveMigrateIfPossible, false),
+			newVMI("external", &external, true),
+			newVMI("no-strategy", nil, true),
+		)
+		expectMigrations()
+
+		app.NodeEvacuationPlanHandler(request, response)
+		plan := decodePlan()
+
+		Expect(plan.NodeName).To(Equal(nodeName))
+		Expect(plan.VMIs).To(HaveLen(5))
+		actions := map[string]v1.NodeEvacuationAction{}
+		for _, entry := range plan.VMIs {
+			actions[entry.Name] = entry.Action
+		}
+		Expect(actions).To(Equal(map[string]v1.NodeEvacuationAction{
+			"migratable":               v1.NodeEvacuationActionLiveMigrate,
+			"blocked":                  v1.NodeEvacuationActionBlocked,
+			"shutdown-if-not-possible": v1.NodeEvacuationActionShutdown,
+			"external":                 v1.NodeEvacuationActionExternal,
+			"no-strategy":              v1.NodeEvacuationActionShutdown,
+		}))
+		Expect(plan.VMIs[0].Name).To(Equal("blocked"))
+		Expect(plan.VMIs[0].Reason).To(Equal(v1.VirtualMachineInstanceReasonDisksNotMigratable + ": cannot migrate VMI: PVC is not shared"))
+		Expect(plan.EstimatedDataTransfer.Cmp(resource.MustParse("1Gi"))).To(BeZero())
+		Expect(plan.Status).To(Equal(&v1.NodeEvacuationPlanStatus{Pending: 5}))
+	})
+
+	It("should include the non-shared volumes of block migrations in the estimated data transfer", func() {
+		liveMigrate := v1.EvictionStrategyLiveMigrate
+		vmi := newVMI("block", &liveMigrate, true)
+		vmi.Status.MigrationMethod = v1.BlockMigration
+		vmi.Status.VolumeStatus = []v1.VolumeStatus{
+			{
+				Name: "local",
+				PersistentVolumeClaimInfo: &v1.PersistentVolumeClaimInfo{
+					AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce},
+					Capacity:    k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("10Gi")},
+				},
+			},
+			{
+				Name: "shared",
+				PersistentVolumeClaimInfo: &v1.PersistentVolumeClaimInfo{
+					AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteMany},
+					Capacity:    k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("20Gi")},
+				},
+			},
+		}
+		expectVMIs(vmi)
+		expectMigrations()
+
+		app.NodeEvacuationPlanHandler(request, response)
+		plan := decodePlan()
+
+		Expect(plan.VMIs).To(HaveLen(1))
+		Expect(plan.VMIs[0].EstimatedDataTransfer.Cmp(resource.MustParse("11Gi"))).To(BeZero())
+	})
+
+	It("should report the progress of an evacuation", func() {
+		liveMigrate := v1.EvictionStrategyLiveMigrate
+		marked := newVMI("marked", &liveMigrate, true)
+		marked.Status.EvacuationNodeName = nodeName
+		failed := newVMI("failed", &liveMigrate, true)
+		failed.Status.EvacuationNodeName = nodeName
+		failed.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{Failed: true}
+		expectVMIs(marked, failed)
+
+		migrated := newVMI("migrated", &liveMigrate, true)
+		migrated.Status.NodeName = "node02"
+		migrated.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+			MigrationUID: "migration-uid",
+			SourceNode:   nodeName,
+			Completed:    true,
+		}
+		expectMigrations(
+			v1.VirtualMachineInstanceMigration{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Namespace:   k8smetav1.NamespaceDefault,
+					UID:         "migration-uid",
+					Annotations: map[string]string{v1.EvacuationMigrationAnnotation: nodeName},
+				},
+				Spec:   v1.VirtualMachineInstanceMigrationSpec{VMIName: "migrated"},
+				Status: v1.VirtualMachineInstanceMigrationStatus{Phase: v1.MigrationSucceeded},
+			},
+			v1.VirtualMachineInstanceMigration{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Namespace:   k8smetav1.NamespaceDefault,
+					UID:         "other-node-uid",
+					Annotations: map[string]string{v1.EvacuationMigrationAnnotation: "node02"},
+				},
+				Spec:   v1.VirtualMachineInstanceMigrationSpec{VMIName: "elsewhere"},
+				Status: v1.VirtualMachineInstanceMigrationStatus{Phase: v1.MigrationSucceeded},
+			},
+		)
+		vmiClient.EXPECT().Get(context.Background(), "migrated", gomock.Any()).Return(migrated, nil)
+
+		app.NodeEvacuationPlanHandler(request, response)
+		plan := decodePlan()
+
+		Expect(plan.VMIs).To(HaveLen(3))
+		Expect(plan.VMIs[0].Name).To(Equal("failed"))
+		Expect(plan.VMIs[0].Phase).To(Equal(v1.NodeEvacuationFailed))
+		Expect(plan.VMIs[1].Name).To(Equal("marked"))
+		Expect(plan.VMIs[1].Phase).To(Equal(v1.NodeEvacuationMarked))
+		Expect(plan.VMIs[2].Name).To(Equal("migrated"))
+		Expect(plan.VMIs[2].Phase).To(Equal(v1.NodeEvacuationMigrated))
+		Expect(plan.Status).To(Equal(&v1.NodeEvacuationPlanStatus{Marked: 1, Migrated: 1, Failed: 1}))
+	})
+
+	It("should only mark VMIs for evacuation which live migrate on eviction", func() {
+		liveMigrate := v1.EvictionStrategyLiveMigrate
+		external := v1.EvictionStrategyExternal
+		expectVMIs(
+			newVMI("migratable", &liveMigrate, true),
+			newVMI("blocked", &liveMigrate, false),
+			newVMI("external", &external, true),
+			newVMI("no-strategy", nil, true),
+		)
+		expectMigrations()
+
+		patch := []byte(`[{ "op": "add", "path": "/status/evacuationNodeName", "value": "node01" }]`)
+		vmiClient.EXPECT().Patch(context.Background(), "migratable", types.JSONPatchType, patch, &k8smetav1.PatchOptions{}).Return(nil, nil)
+		vmiClient.EXPECT().Patch(context.Background(), "external", types.JSONPatchType, patch, &k8smetav1.PatchOptions{}).Return(nil, nil)
+
+		app.EvacuateNodeHandler(request, response)
+		plan := decodePlan()
+
+		Expect(plan.Status).To(Equal(&v1.NodeEvacuationPlanStatus{Pending: 2, Marked: 2}))
+	})
+})
@@ -0,0 +1,82 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+// VMInstancetypeUpgradeRequestHandler moves a VM to ControllerRevisions of the latest generation of its instancetype
+// and preference and returns the changes. With dryRun only the changes are returned.
+func (app *SubresourceAPIApp) VMInstancetypeUpgradeRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	opts := &v1.InstancetypeUpgradeOptions{}
+	if request.Request.Body != nil {
+		defer request.Request.Body.Close()
+		err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+		switch err {
+		case io.EOF, nil:
+			break
+		default:
+			writeError(errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err)), response)
+			return
+		}
+	}
+	if len(opts.DryRun) > 0 && opts.DryRun[0] != k8smetav1.DryRunAll {
+		writeError(errors.NewBadRequest(fmt.Sprintf("invalid dryRun option %s", opts.DryRun[0])), response)
+		return
+	}
+
+	vm, statusErr := app.fetchVirtualMachine(name, namespace)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	if vm.Spec.Instancetype == nil && vm.Spec.Preference == nil {
+		writeError(errors.NewBadRequest(fmt.Sprintf("VirtualMachine %s/%s references neither an instancetype nor a preference", namespace, name)), response)
+		return
+	}
+
+	upgrade, err := app.instancetypeMethods.Upgrade(vm, len(opts.DryRun) > 0)
+	if err != nil {
+		log.Log.Object(vm).Reason(err).Error("Failed to upgrade the instancetype and preference of the VirtualMachine")
+		if statusErr, ok := err.(*errors.StatusError); ok {
+			writeError(statusErr, response)
+			return
+		}
+		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, err), response)
+		return
+	}
+
+	if err := response.WriteEntity(upgrade); err != nil {
+		log.Log.Reason(err).Error("Failed to write http response.")
+	}
+}
@@ -0,0 +1,129 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Instancetype upgrade subresource", func() {
+	const vmName = "testvm"
+
+	var (
+		vmClient            *kubecli.MockVirtualMachineInterface
+		instancetypeMethods *testutils.MockInstancetypeMethods
+		app                 *SubresourceAPIApp
+		vm                  *v1.VirtualMachine
+
+		request  *restful.Request
+		recorder *httptest.ResponseRecorder
+		response *restful.Response
+	)
+
+	setBody := func(opts *v1.InstancetypeUpgradeOptions) {
+		body, err := json.Marshal(opts)
+		Expect(err).ToNot(HaveOccurred())
+		request.Request.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		vmClient = kubecli.NewMockVirtualMachineInterface(ctrl)
+		virtClient.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmClient).AnyTimes()
+
+		instancetypeMethods = testutils.NewMockInstancetypeMethods()
+		app = NewSubresourceAPIApp(virtClient, 0, nil, nil)
+		app.instancetypeMethods = instancetypeMethods
+
+		vm = kubecli.NewMinimalVM(vmName)
+		vm.Spec.Instancetype = &v1.InstancetypeMatcher{Name: "u1.small", RevisionName: "testvm-u1.small-1"}
+		vmClient.EXPECT().Get(context.Background(), vmName, &k8smetav1.GetOptions{}).Return(vm, nil).AnyTimes()
+
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = vmName
+		request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+		response.SetRequestAccepts(restful.MIME_JSON)
+	})
+
+	DescribeTable("should upgrade the VM and return the changes", func(opts *v1.InstancetypeUpgradeOptions, expectedDryRun bool) {
+		if opts != nil {
+			setBody(opts)
+		}
+		expectedUpgrade := &v1.InstancetypeUpgrade{Instancetype: &v1.RevisionUpgrade{
+			CurrentRevisionName: "testvm-u1.small-1",
+			RevisionName:        "testvm-u1.small-2",
+			Diff:                "spec.cpu.guest: 1 -> 2",
+		}}
+		instancetypeMethods.UpgradeFunc = func(_ *v1.VirtualMachine, dryRun bool) (*v1.InstancetypeUpgrade, error) {
+			Expect(dryRun).To(Equal(expectedDryRun))
+			return expectedUpgrade, nil
+		}
+
+		app.VMInstancetypeUpgradeRequestHandler(request, response)
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		upgrade := &v1.InstancetypeUpgrade{}
+		Expect(json.NewDecoder(recorder.Body).Decode(upgrade)).To(Succeed())
+		Expect(upgrade).To(Equal(expectedUpgrade))
+	},
+		Entry("without a body", nil, false),
+		Entry("with dry run", &v1.InstancetypeUpgradeOptions{DryRun: []string{k8smetav1.DryRunAll}}, true),
+	)
+
+	It("should reject an invalid dry run option", func() {
+		setBody(&v1.InstancetypeUpgradeOptions{DryRun: []string{"foo"}})
+		app.VMInstancetypeUpgradeRequestHandler(request, response)
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should reject a VM without instancetype and preference", func() {
+		vm.Spec.Instancetype = nil
+		app.VMInstancetypeUpgradeRequestHandler(request, response)
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should return a conflict if the upgrade fails", func() {
+		instancetypeMethods.UpgradeFunc = func(_ *v1.VirtualMachine, _ bool) (*v1.InstancetypeUpgrade, error) {
+			return nil, fmt.Errorf("VM field conflicts with selected Instancetype")
+		}
+		app.VMInstancetypeUpgradeRequestHandler(request, response)
+		Expect(recorder.Code).To(Equal(http.StatusConflict))
+	})
+})
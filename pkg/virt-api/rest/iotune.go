@@ -0,0 +1,197 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+)
+
+// VMIIOTuneRequestHandler changes the I/O limits of a disk of a running VMI.
+// virt-handler applies the new limits when it syncs the VMI.
+func (app *SubresourceAPIApp) VMIIOTuneRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	opts, statusErr := decodeDiskIOTuneOptions(request)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	vmi, statusErr := app.FetchVirtualMachineInstance(namespace, name)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	if !vmi.IsRunning() {
+		writeError(errors.NewConflict(v1.Resource("virtualmachineinstance"), name, fmt.Errorf(vmiNotRunning)), response)
+		return
+	}
+
+	if statusErr := app.patchVMIDiskIOTune(vmi, opts); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+// VMIOTuneRequestHandler changes the I/O limits of a disk of a VM, and of its VMI if it is running
+func (app *SubresourceAPIApp) VMIOTuneRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	opts, statusErr := decodeDiskIOTuneOptions(request)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	vm, statusErr := app.fetchVirtualMachine(name, namespace)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	patchBytes, err := generateDiskIOTunePatch("/spec/template/spec/domain/devices/disks", vm.Spec.Template.Spec.Domain.Devices.Disks, opts)
+	if err != nil {
+		writeError(errors.NewBadRequest(err.Error()), response)
+		return
+	}
+
+	log.Log.Object(vm).V(4).Infof(patchingVMFmt, string(patchBytes))
+	if _, err := app.virtCli.VirtualMachine(namespace).Patch(context.Background(), name, types.JSONPatchType, patchBytes, &k8smetav1.PatchOptions{DryRun: opts.DryRun}); err != nil {
+		log.Log.Object(vm).Reason(err).Error("unable to patch vm")
+		writeError(toPatchStatusError("vm", err), response)
+		return
+	}
+
+	vmi, err := app.virtCli.VirtualMachineInstance(namespace).Get(context.Background(), name, &k8smetav1.GetOptions{})
+	if errors.IsNotFound(err) || err == nil && !vmi.IsRunning() {
+		response.WriteHeader(http.StatusAccepted)
+		return
+	} else if err != nil {
+		writeError(errors.NewInternalError(fmt.Errorf("unable to retrieve vmi [%s]: %v", name, err)), response)
+		return
+	}
+
+	if statusErr := app.patchVMIDiskIOTune(vmi, opts); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (app *SubresourceAPIApp) patchVMIDiskIOTune(vmi *v1.VirtualMachineInstance, opts *v1.DiskIOTuneOptions) *errors.StatusError {
+	patchBytes, err := generateDiskIOTunePatch("/spec/domain/devices/disks", vmi.Spec.Domain.Devices.Disks, opts)
+	if err != nil {
+		return errors.NewBadRequest(err.Error())
+	}
+
+	log.Log.Object(vmi).V(4).Infof("Patching VMI: %s", string(patchBytes))
+	if _, err := app.virtCli.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, &k8smetav1.PatchOptions{DryRun: opts.DryRun}); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("unable to patch vmi")
+		return toPatchStatusError("vmi", err)
+	}
+	return nil
+}
+
+func decodeDiskIOTuneOptions(request *restful.Request) (*v1.DiskIOTuneOptions, *errors.StatusError) {
+	if request.Request.Body == nil {
+		return nil, errors.NewBadRequest("Request with no body, a disk name is expected as the request body")
+	}
+	defer request.Request.Body.Close()
+
+	opts := &v1.DiskIOTuneOptions{}
+	err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+	switch err {
+	case io.EOF, nil:
+		break
+	default:
+		return nil, errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err))
+	}
+
+	if opts.Name == "" {
+		return nil, errors.NewBadRequest("DiskIOTuneOptions requires name to be set")
+	}
+	if len(opts.DryRun) > 0 && opts.DryRun[0] != k8smetav1.DryRunAll {
+		return nil, errors.NewBadRequest(fmt.Sprintf("invalid dryRun option %s", opts.DryRun[0]))
+	}
+	return opts, nil
+}
+
+// generateDiskIOTunePatch replaces the I/O limits of the disk with the given name.
+// The disk name and the old limits are tested, so that concurrent changes to the disks are not overwritten.
+func generateDiskIOTunePatch(disksPath string, disks []v1.Disk, opts *v1.DiskIOTuneOptions) ([]byte, error) {
+	index := -1
+	for i := range disks {
+		if disks[i].Name == opts.Name {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("disk %s not found", opts.Name)
+	}
+
+	diskPath := fmt.Sprintf("%s/%d", disksPath, index)
+	ioTunePath := diskPath + "/ioTune"
+	oldIOTune := disks[index].IOTune
+	patches := []patch.PatchOperation{{Op: patch.PatchTestOp, Path: diskPath + "/name", Value: opts.Name}}
+	switch {
+	case oldIOTune == nil && opts.IOTune == nil:
+		return nil, fmt.Errorf("disk %s has no I/O limits", opts.Name)
+	case oldIOTune == nil:
+		patches = append(patches, patch.PatchOperation{Op: patch.PatchAddOp, Path: ioTunePath, Value: opts.IOTune})
+	case opts.IOTune == nil:
+		patches = append(patches,
+			patch.PatchOperation{Op: patch.PatchTestOp, Path: ioTunePath, Value: oldIOTune},
+			patch.PatchOperation{Op: patch.PatchRemoveOp, Path: ioTunePath},
+		)
+	default:
+		patches = append(patches,
+			patch.PatchOperation{Op: patch.PatchTestOp, Path: ioTunePath, Value: oldIOTune},
+			patch.PatchOperation{Op: patch.PatchReplaceOp, Path: ioTunePath, Value: opts.IOTune},
+		)
+	}
+	return patch.GeneratePatchPayload(patches...)
+}
+
+func toPatchStatusError(resource string, err error) *errors.StatusError {
+	if statusErr, ok := err.(*errors.StatusError); ok && (errors.IsInvalid(err) || errors.IsBadRequest(err) || errors.IsConflict(err)) {
+		return statusErr
+	}
+	return errors.NewInternalError(fmt.Errorf("unable to patch %s: %v", resource, err))
+}
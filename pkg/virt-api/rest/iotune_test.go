@@ -0,0 +1,195 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/api"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Disk I/O limits subresources", func() {
+	const vmName = "testvm"
+
+	var (
+		vmClient  *kubecli.MockVirtualMachineInterface
+		vmiClient *kubecli.MockVirtualMachineInstanceInterface
+		app       *SubresourceAPIApp
+
+		request  *restful.Request
+		recorder *httptest.ResponseRecorder
+		response *restful.Response
+	)
+
+	newVMI := func(phase v1.VirtualMachineInstancePhase, ioTune *v1.DiskIOTune) *v1.VirtualMachineInstance {
+		vmi := api.NewMinimalVMI(vmName)
+		vmi.Spec.Domain.Devices.Disks = []v1.Disk{{Name: "rootdisk"}, {Name: "datadisk", IOTune: ioTune}}
+		vmi.Status.Phase = phase
+		return vmi
+	}
+
+	setBody := func(opts *v1.DiskIOTuneOptions) {
+		body, err := json.Marshal(opts)
+		Expect(err).ToNot(HaveOccurred())
+		request.Request.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		vmClient = kubecli.NewMockVirtualMachineInterface(ctrl)
+		vmiClient = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		virtClient.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmClient).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiClient).AnyTimes()
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		app = NewSubresourceAPIApp(virtClient, 0, nil, config)
+
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = vmName
+		request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+	})
+
+	Context("on a VMI", func() {
+
+		It("should add I/O limits to a disk", func() {
+			ioTune := &v1.DiskIOTune{TotalIOPSSec: 100}
+			setBody(&v1.DiskIOTuneOptions{Name: "datadisk", IOTune: ioTune})
+
+			vmiClient.EXPECT().Get(context.Background(), vmName, &k8smetav1.GetOptions{}).Return(newVMI(v1.Running, nil), nil)
+			vmiClient.EXPECT().Patch(context.Background(), vmName, types.JSONPatchType, gomock.Any(), &k8smetav1.PatchOptions{}).DoAndReturn(
+				func(_ context.Context, _ string, _ types.PatchType, patch []byte, _ *k8smetav1.PatchOptions, _ ...string) (*v1.VirtualMachineInstance, error) {
+					Expect(string(patch)).To(Equal(`[{"op":"test","path":"/spec/domain/devices/disks/1/name","value":"datadisk"},{"op":"add","path":"/spec/domain/devices/disks/1/ioTune","value":{"totalIOPSSec":100}}]`))
+					return nil, nil
+				})
+
+			app.VMIIOTuneRequestHandler(request, response)
+			Expect(recorder.Code).To(Equal(http.StatusAccepted))
+		})
+
+		It("should remove the I/O limits of a disk", func() {
+			setBody(&v1.DiskIOTuneOptions{Name: "datadisk"})
+
+			vmiClient.EXPECT().Get(context.Background(), vmName, &k8smetav1.GetOptions{}).Return(newVMI(v1.Running, &v1.DiskIOTune{TotalIOPSSec: 100}), nil)
+			vmiClient.EXPECT().Patch(context.Background(), vmName, types.JSONPatchType, gomock.Any(), &k8smetav1.PatchOptions{}).DoAndReturn(
+				func(_ context.Context, _ string, _ types.PatchType, patch []byte, _ *k8smetav1.PatchOptions, _ ...string) (*v1.VirtualMachineInstance, error) {
+					Expect(string(patch)).To(ContainSubstring(`{"op":"test","path":"/spec/domain/devices/disks/1/ioTune","value":{"totalIOPSSec":100}}`))
+					Expect(string(patch)).To(ContainSubstring(`{"op":"remove","path":"/spec/domain/devices/disks/1/ioTune"`))
+					return nil, nil
+				})
+
+			app.VMIIOTuneRequestHandler(request, response)
+			Expect(recorder.Code).To(Equal(http.StatusAccepted))
+		})
+
+		It("should pass on invalid I/O limits rejected by the admission webhook", func() {
+			setBody(&v1.DiskIOTuneOptions{Name: "datadisk", IOTune: &v1.DiskIOTune{MaxLengthSeconds: 10}})
+
+			vmiClient.EXPECT().Get(context.Background(), vmName, &k8smetav1.GetOptions{}).Return(newVMI(v1.Running, nil), nil)
+			vmiClient.EXPECT().Patch(context.Background(), vmName, types.JSONPatchType, gomock.Any(), &k8smetav1.PatchOptions{}).
+				Return(nil, errors.NewBadRequest("admission webhook denied the request"))
+
+			app.VMIIOTuneRequestHandler(request, response)
+			Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		DescribeTable("should reject the request", func(opts *v1.DiskIOTuneOptions, vmi *v1.VirtualMachineInstance, expectedCode int) {
+			setBody(opts)
+			if vmi != nil {
+				vmiClient.EXPECT().Get(context.Background(), vmName, &k8smetav1.GetOptions{}).Return(vmi, nil)
+			}
+
+			app.VMIIOTuneRequestHandler(request, response)
+			Expect(recorder.Code).To(Equal(expectedCode))
+		},
+			Entry("without a disk name", &v1.DiskIOTuneOptions{}, nil, http.StatusBadRequest),
+			Entry("with an invalid dry run option", &v1.DiskIOTuneOptions{Name: "datadisk", DryRun: []string{"Some"}}, nil, http.StatusBadRequest),
+			Entry("if the VMI is not running", &v1.DiskIOTuneOptions{Name: "datadisk"}, newVMI(v1.Scheduled, nil), http.StatusConflict),
+			Entry("if the disk does not exist", &v1.DiskIOTuneOptions{Name: "unknown"}, newVMI(v1.Running, nil), http.StatusBadRequest),
+			Entry("if the disk has no I/O limits to remove", &v1.DiskIOTuneOptions{Name: "datadisk"}, newVMI(v1.Running, nil), http.StatusBadRequest),
+		)
+	})
+
+	Context("on a VM", func() {
+
+		var vm *v1.VirtualMachine
+
+		BeforeEach(func() {
+			vmi := newVMI(v1.Running, &v1.DiskIOTune{TotalIOPSSec: 100})
+			vm = &v1.VirtualMachine{
+				ObjectMeta: vmi.ObjectMeta,
+				Spec: v1.VirtualMachineSpec{
+					Template: &v1.VirtualMachineInstanceTemplateSpec{Spec: vmi.Spec},
+				},
+			}
+		})
+
+		It("should change the I/O limits of the VM and of its running VMI", func() {
+			setBody(&v1.DiskIOTuneOptions{Name: "datadisk", IOTune: &v1.DiskIOTune{TotalIOPSSec: 200}, DryRun: []string{k8smetav1.DryRunAll}})
+			patchOptions := &k8smetav1.PatchOptions{DryRun: []string{k8smetav1.DryRunAll}}
+
+			vmClient.EXPECT().Get(context.Background(), vmName, &k8smetav1.GetOptions{}).Return(vm, nil)
+			vmClient.EXPECT().Patch(context.Background(), vmName, types.JSONPatchType, gomock.Any(), patchOptions).DoAndReturn(
+				func(_ context.Context, _ string, _ types.PatchType, patch []byte, _ *k8smetav1.PatchOptions, _ ...string) (*v1.VirtualMachine, error) {
+					Expect(string(patch)).To(ContainSubstring(`{"op":"replace","path":"/spec/template/spec/domain/devices/disks/1/ioTune","value":{"totalIOPSSec":200}}`))
+					return vm, nil
+				})
+			vmiClient.EXPECT().Get(context.Background(), vmName, &k8smetav1.GetOptions{}).Return(newVMI(v1.Running, &v1.DiskIOTune{TotalIOPSSec: 100}), nil)
+			vmiClient.EXPECT().Patch(context.Background(), vmName, types.JSONPatchType, gomock.Any(), patchOptions).DoAndReturn(
+				func(_ context.Context, _ string, _ types.PatchType, patch []byte, _ *k8smetav1.PatchOptions, _ ...string) (*v1.VirtualMachineInstance, error) {
+					Expect(string(patch)).To(ContainSubstring(`{"op":"replace","path":"/spec/domain/devices/disks/1/ioTune","value":{"totalIOPSSec":200}}`))
+					return nil, nil
+				})
+
+			app.VMIOTuneRequestHandler(request, response)
+			Expect(recorder.Code).To(Equal(http.StatusAccepted))
+		})
+
+		It("should only change the I/O limits of the VM if it is not running", func() {
+			setBody(&v1.DiskIOTuneOptions{Name: "datadisk", IOTune: &v1.DiskIOTune{TotalIOPSSec: 200}})
+
+			vmClient.EXPECT().Get(context.Background(), vmName, &k8smetav1.GetOptions{}).Return(vm, nil)
+			vmClient.EXPECT().Patch(context.Background(), vmName, types.JSONPatchType, gomock.Any(), &k8smetav1.PatchOptions{}).Return(vm, nil)
+			vmiClient.EXPECT().Get(context.Background(), vmName, &k8smetav1.GetOptions{}).Return(nil, errors.NewNotFound(v1.Resource("virtualmachineinstance"), vmName))
+
+			app.VMIOTuneRequestHandler(request, response)
+			Expect(recorder.Code).To(Equal(http.StatusAccepted))
+		})
+	})
+})
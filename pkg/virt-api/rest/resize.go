@@ -0,0 +1,72 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+// VMResizeRequestHandler switches a VM to another instancetype of the same family. The VM controller applies the new
+// size to a running VMI if possible and marks the VM as RestartRequired otherwise.
+func (app *SubresourceAPIApp) VMResizeRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body, an instancetype is expected as the request body"), response)
+		return
+	}
+	opts := &v1.VirtualMachineResizeOptions{}
+	defer request.Request.Body.Close()
+	if err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts); err != nil {
+		writeError(errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err)), response)
+		return
+	}
+	if opts.Instancetype == "" {
+		writeError(errors.NewBadRequest("an instancetype has to be provided"), response)
+		return
+	}
+
+	vm, statusErr := app.fetchVirtualMachine(name, namespace)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	if err := app.instancetypeMethods.Resize(vm, opts.Instancetype); err != nil {
+		log.Log.Object(vm).Reason(err).Errorf("Failed to resize the VirtualMachine to instancetype %s", opts.Instancetype)
+		if statusErr, ok := err.(*errors.StatusError); ok {
+			writeError(statusErr, response)
+			return
+		}
+		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, err), response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
@@ -0,0 +1,118 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Resize subresource", func() {
+	const vmName = "testvm"
+
+	var (
+		instancetypeMethods *testutils.MockInstancetypeMethods
+		app                 *SubresourceAPIApp
+
+		request  *restful.Request
+		recorder *httptest.ResponseRecorder
+		response *restful.Response
+	)
+
+	setBody := func(opts *v1.VirtualMachineResizeOptions) {
+		body, err := json.Marshal(opts)
+		Expect(err).ToNot(HaveOccurred())
+		request.Request.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		vmClient := kubecli.NewMockVirtualMachineInterface(ctrl)
+		virtClient.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmClient).AnyTimes()
+
+		instancetypeMethods = testutils.NewMockInstancetypeMethods()
+		app = NewSubresourceAPIApp(virtClient, 0, nil, nil)
+		app.instancetypeMethods = instancetypeMethods
+
+		vm := kubecli.NewMinimalVM(vmName)
+		vm.Spec.Instancetype = &v1.InstancetypeMatcher{Name: "u1.small"}
+		vmClient.EXPECT().Get(context.Background(), vmName, &k8smetav1.GetOptions{}).Return(vm, nil).AnyTimes()
+
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = vmName
+		request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+	})
+
+	It("should resize the VM to the requested instancetype", func() {
+		setBody(&v1.VirtualMachineResizeOptions{Instancetype: "u1.large"})
+		instancetypeMethods.ResizeFunc = func(vm *v1.VirtualMachine, instancetypeName string) error {
+			Expect(vm.Name).To(Equal(vmName))
+			Expect(instancetypeName).To(Equal("u1.large"))
+			return nil
+		}
+
+		app.VMResizeRequestHandler(request, response)
+		Expect(recorder.Code).To(Equal(http.StatusAccepted))
+	})
+
+	It("should reject a request without body", func() {
+		app.VMResizeRequestHandler(request, response)
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should reject a request without instancetype", func() {
+		setBody(&v1.VirtualMachineResizeOptions{})
+		app.VMResizeRequestHandler(request, response)
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+	})
+
+	DescribeTable("should fail if the resize fails", func(err error, expectedCode int) {
+		setBody(&v1.VirtualMachineResizeOptions{Instancetype: "cx1.large"})
+		instancetypeMethods.ResizeFunc = func(_ *v1.VirtualMachine, _ string) error {
+			return err
+		}
+
+		app.VMResizeRequestHandler(request, response)
+		Expect(recorder.Code).To(Equal(expectedCode))
+	},
+		Entry("with the status of the error", errors.NewBadRequest("instancetype u1.small of family \"u1\" can not be resized to cx1.large of family \"cx1\""), http.StatusBadRequest),
+		Entry("with a conflict otherwise", fmt.Errorf("failed to update VirtualMachine with the resized instancetype"), http.StatusConflict),
+	)
+})
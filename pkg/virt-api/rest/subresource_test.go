@@ -1787,6 +1787,9 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			Entry("for GuestOSInfo", app.GuestOSInfo),
 			Entry("for UserList", app.UserList),
 			Entry("for Filesystem", app.FilesystemList),
+			Entry("for GuestAgentCommandResults", app.GuestAgentCommandResults),
+			Entry("for Stats", app.Stats),
+			Entry("for DomainXML", app.DomainXML),
 		)
 
 		DescribeTable("should fail when the VMI is not running", func(fn subRes) {
@@ -1806,6 +1809,9 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			Entry("for GuestOSInfo", app.GuestOSInfo),
 			Entry("for UserList", app.UserList),
 			Entry("for FilesystemList", app.FilesystemList),
+			Entry("for GuestAgentCommandResults", app.GuestAgentCommandResults),
+			Entry("for Stats", app.Stats),
+			Entry("for DomainXML", app.DomainXML),
 		)
 
 		DescribeTable("should fail when VMI does not have agent connected", func(fn subRes) {
@@ -1830,6 +1836,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			Entry("for GuestOSInfo", app.GuestOSInfo),
 			Entry("for UserList", app.UserList),
 			Entry("for FilesystemList", app.FilesystemList),
+			Entry("for GuestAgentCommandResults", app.GuestAgentCommandResults),
 		)
 	})
 
@@ -30,6 +30,9 @@ func (app *SubresourceAPIApp) VNCRequestHandler(request *restful.Request, respon
 		app.FetchVirtualMachineInstance,
 		validateVMIForVNC,
 		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+			if request.QueryParameter(definitions.SharedParamName) == "true" {
+				return conn.SharedVNCURI(vmi)
+			}
 			return conn.VNCURI(vmi)
 		}),
 	)
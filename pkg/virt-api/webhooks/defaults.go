@@ -49,9 +49,40 @@ func SetDefaultVirtualMachineInstance(clusterConfig *virtconfig.ClusterConfig, v
 	v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 	setDefaultHypervFeatureDependencies(&vmi.Spec)
 	setDefaultCPUArch(clusterConfig, &vmi.Spec)
+	setClusterBaselineCPUModel(clusterConfig, &vmi.Spec)
 	return nil
 }
 
+// setClusterBaselineCPUModel replaces the cluster-baseline CPU model with the CPU baseline the VMI
+// can run with. If no baseline is known yet, the model is left alone and the VMI is rejected.
+func setClusterBaselineCPUModel(clusterConfig *virtconfig.ClusterConfig, spec *v1.VirtualMachineInstanceSpec) {
+	if spec.Domain.CPU == nil || spec.Domain.CPU.Model != v1.CPUModeClusterBaseline {
+		return
+	}
+
+	baseline := clusterConfig.GetCPUBaseline(spec.NodeSelector)
+	if baseline == nil || baseline.Model == "" {
+		return
+	}
+
+	spec.Domain.CPU.Model = baseline.Model
+	for _, feature := range baseline.Features {
+		if hasCPUFeature(spec.Domain.CPU.Features, feature) {
+			continue
+		}
+		spec.Domain.CPU.Features = append(spec.Domain.CPU.Features, v1.CPUFeature{Name: feature, Policy: "require"})
+	}
+}
+
+func hasCPUFeature(features []v1.CPUFeature, name string) bool {
+	for _, feature := range features {
+		if feature.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
 func setDefaultCPUArch(clusterConfig *virtconfig.ClusterConfig, spec *v1.VirtualMachineInstanceSpec) {
 	// Do some CPU arch specific setting.
 	if IsARM64(spec) {
@@ -122,7 +122,7 @@ func (mutator *VMsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1.
 	preferenceSpec := mutator.getPreferenceSpec(&vm)
 	mutator.setDefaultArchitecture(&vm)
 	mutator.setDefaultMachineType(&vm, preferenceSpec)
-	mutator.setPreferenceStorageClassName(&vm, preferenceSpec)
+	mutator.setPreferenceDataVolumeTemplates(&vm, preferenceSpec)
 
 	patchBytes, err := patch.GeneratePatchPayload(
 		patch.PatchOperation{
@@ -190,21 +190,14 @@ func (mutator *VMsMutator) setDefaultMachineType(vm *v1.VirtualMachine, preferen
 	}
 }
 
-func (mutator *VMsMutator) setPreferenceStorageClassName(vm *v1.VirtualMachine, preferenceSpec *instancetypev1beta1.VirtualMachinePreferenceSpec) {
+func (mutator *VMsMutator) setPreferenceDataVolumeTemplates(vm *v1.VirtualMachine, preferenceSpec *instancetypev1beta1.VirtualMachinePreferenceSpec) {
 	// Nothing to do, let's the validating webhook fail later
 	if vm.Spec.Template == nil {
 		return
 	}
 
-	if preferenceSpec != nil && preferenceSpec.Volumes != nil && preferenceSpec.Volumes.PreferredStorageClassName != "" {
-		for _, dv := range vm.Spec.DataVolumeTemplates {
-			if dv.Spec.PVC != nil && dv.Spec.PVC.StorageClassName == nil {
-				dv.Spec.PVC.StorageClassName = &preferenceSpec.Volumes.PreferredStorageClassName
-			}
-			if dv.Spec.Storage != nil && dv.Spec.Storage.StorageClassName == nil {
-				dv.Spec.Storage.StorageClassName = &preferenceSpec.Volumes.PreferredStorageClassName
-			}
-		}
+	for i := range vm.Spec.DataVolumeTemplates {
+		instancetype.ApplyDataVolumePreferences(preferenceSpec, &vm.Spec.DataVolumeTemplates[i].Spec)
 	}
 }
 
@@ -356,7 +356,7 @@ var _ = Describe("VirtualMachine Mutator", func() {
 		Expect(vmSpec.Template.Spec.Domain.Machine.Type).To(Equal(preference.Spec.Machine.PreferredMachineType))
 	})
 
-	Context("setPreferenceStorageClassName", func() {
+	Context("setPreferenceDataVolumeTemplates", func() {
 
 		var preference *instancetypev1beta1.VirtualMachineClusterPreference
 
@@ -372,6 +372,7 @@ var _ = Describe("VirtualMachine Mutator", func() {
 				Spec: instancetypev1beta1.VirtualMachinePreferenceSpec{
 					Volumes: &instancetypev1beta1.VolumePreferences{
 						PreferredStorageClassName: "ceph",
+						PreferredAccessMode:       k8sv1.ReadWriteMany,
 					},
 				},
 			}
@@ -442,6 +443,30 @@ var _ = Describe("VirtualMachine Mutator", func() {
 			vmSpec, _ := getVMSpecMetaFromResponse(rt.GOARCH)
 			assertStorageStorageClassName(vmSpec.DataVolumeTemplates, storageClass)
 		})
+
+		It("should apply PreferredAccessMode to PVC and Storage", func() {
+			vm.Spec.DataVolumeTemplates = []v1.DataVolumeTemplateSpec{{
+				Spec: v1beta1.DataVolumeSpec{
+					PVC:     &k8sv1.PersistentVolumeClaimSpec{},
+					Storage: &v1beta1.StorageSpec{},
+				},
+			}}
+			vmSpec, _ := getVMSpecMetaFromResponse(rt.GOARCH)
+			Expect(vmSpec.DataVolumeTemplates[0].Spec.PVC.AccessModes).To(ConsistOf(k8sv1.ReadWriteMany))
+			Expect(vmSpec.DataVolumeTemplates[0].Spec.Storage.AccessModes).To(ConsistOf(k8sv1.ReadWriteMany))
+		})
+
+		It("should not overwrite access modes already defined in DataVolumeTemplate", func() {
+			vm.Spec.DataVolumeTemplates = []v1.DataVolumeTemplateSpec{{
+				Spec: v1beta1.DataVolumeSpec{
+					Storage: &v1beta1.StorageSpec{
+						AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce},
+					},
+				},
+			}}
+			vmSpec, _ := getVMSpecMetaFromResponse(rt.GOARCH)
+			Expect(vmSpec.DataVolumeTemplates[0].Spec.Storage.AccessModes).To(ConsistOf(k8sv1.ReadWriteOnce))
+		})
 	})
 
 	Context("on update", func() {
@@ -245,6 +245,61 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 		Entry("on arm64", "arm64", v1.CPUModeHostPassthrough),
 	)
 
+	Context("with the cluster-baseline CPU model", func() {
+
+		BeforeEach(func() {
+			kv := testutils.GetFakeKubeVirtClusterConfig(kvInformer)
+			kv.Spec.Configuration.CPUBaselineNodePools = []v1.CPUBaselineNodePool{
+				{Name: "new", NodeSelector: map[string]string{"pool": "new"}},
+			}
+			kv.Status.CPUBaselines = []v1.CPUBaseline{
+				{Model: "Haswell", Features: []string{"vmx"}, Nodes: 3},
+				{NodePool: "new", Model: "Skylake-Server", Features: []string{"avx512f", "vmx"}, Nodes: 2},
+			}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, kv)
+		})
+
+		It("should resolve the CPU baseline of the cluster", func() {
+			vmi.Spec.Domain.CPU = &v1.CPU{Model: v1.CPUModeClusterBaseline}
+			_, vmiSpec, _ := getMetaSpecStatusFromAdmit(rt.GOARCH)
+			Expect(vmiSpec.Domain.CPU.Model).To(Equal("Haswell"))
+			Expect(vmiSpec.Domain.CPU.Features).To(ConsistOf(v1.CPUFeature{Name: "vmx", Policy: "require"}))
+		})
+
+		It("should resolve the CPU baseline of the node pool the VMI is restricted to", func() {
+			vmi.Spec.NodeSelector = map[string]string{"pool": "new", "zone": "a"}
+			vmi.Spec.Domain.CPU = &v1.CPU{
+				Model:    v1.CPUModeClusterBaseline,
+				Features: []v1.CPUFeature{{Name: "vmx", Policy: "disable"}},
+			}
+			_, vmiSpec, _ := getMetaSpecStatusFromAdmit(rt.GOARCH)
+			Expect(vmiSpec.Domain.CPU.Model).To(Equal("Skylake-Server"))
+			Expect(vmiSpec.Domain.CPU.Features).To(ConsistOf(
+				v1.CPUFeature{Name: "vmx", Policy: "disable"},
+				v1.CPUFeature{Name: "avx512f", Policy: "require"},
+			))
+		})
+
+		It("should resolve the cluster-baseline CPU model configured as default", func() {
+			kv := testutils.GetFakeKubeVirtClusterConfig(kvInformer)
+			kv.Spec.Configuration.CPUModel = v1.CPUModeClusterBaseline
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, kv)
+
+			_, vmiSpec, _ := getMetaSpecStatusFromAdmit("amd64")
+			Expect(vmiSpec.Domain.CPU.Model).To(Equal("Haswell"))
+		})
+
+		It("should keep the cluster-baseline CPU model if no CPU baseline is known", func() {
+			kv := testutils.GetFakeKubeVirtClusterConfig(kvInformer)
+			kv.Status.CPUBaselines = nil
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, kv)
+
+			vmi.Spec.Domain.CPU = &v1.CPU{Model: v1.CPUModeClusterBaseline}
+			_, vmiSpec, _ := getMetaSpecStatusFromAdmit(rt.GOARCH)
+			Expect(vmiSpec.Domain.CPU.Model).To(Equal(v1.CPUModeClusterBaseline))
+		})
+	})
+
 	DescribeTable("it should", func(given []v1.Volume, expected []v1.Volume) {
 		vmi.Spec.Volumes = given
 		_, vmiSpec, _ := getMetaSpecStatusFromAdmit(rt.GOARCH)
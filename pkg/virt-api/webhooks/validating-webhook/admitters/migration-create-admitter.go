@@ -25,6 +25,8 @@ import (
 	"fmt"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -92,6 +94,14 @@ func (admitter *MigrationCreateAdmitter) Admit(ar *admissionv1.AdmissionReview)
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	causes, err = authorizeReservedMigrationFields(admitter.VirtClient, migration, nil, &ar.Request.UserInfo)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
 	vmi, err := admitter.VirtClient.VirtualMachineInstance(migration.Namespace).Get(context.Background(), migration.Spec.VMIName, &metav1.GetOptions{})
 	if errors.IsNotFound(err) {
 		// ensure VMI exists for the migration
@@ -123,6 +133,76 @@ func (admitter *MigrationCreateAdmitter) Admit(ar *admissionv1.AdmissionReview)
 	return &reviewResponse
 }
 
+// reservedMigrationAnnotations mark the migrations of evacuations and workload updates. They change
+// the priority of a migration and are counted by virt-controller.
+var reservedMigrationAnnotations = []string{
+	v1.EvacuationMigrationAnnotation,
+	v1.WorkloadUpdateMigrationAnnotation,
+}
+
+// authorizeReservedMigrationFields reserves the High priority, which lets a migration overtake the queued
+// node drain evacuations, and the annotations of evacuations and workload updates for KubeVirt itself
+// and for users allowed to migrate in all namespaces. oldMigration is nil on create.
+func authorizeReservedMigrationFields(virtClient kubecli.KubevirtClient, migration, oldMigration *v1.VirtualMachineInstanceMigration, userInfo *authenticationv1.UserInfo) ([]metav1.StatusCause, error) {
+	if webhooks.IsKubeVirtServiceAccount(userInfo.Username) {
+		return nil, nil
+	}
+
+	var causes []metav1.StatusCause
+	if oldMigration == nil && migration.Spec.Priority != nil && *migration.Spec.Priority == v1.MigrationPriorityHigh {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("priority %s is reserved for evacuations and users allowed to migrate in all namespaces", v1.MigrationPriorityHigh),
+			Field:   k8sfield.NewPath("spec", "priority").String(),
+		})
+	}
+	for _, annotation := range reservedMigrationAnnotations {
+		value, exists := migration.Annotations[annotation]
+		if !exists {
+			continue
+		}
+		if oldMigration != nil {
+			if oldValue, oldExists := oldMigration.Annotations[annotation]; oldExists && oldValue == value {
+				continue
+			}
+		}
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("annotation %s is reserved for KubeVirt and users allowed to migrate in all namespaces", annotation),
+			Field:   k8sfield.NewPath("metadata", "annotations").Key(annotation).String(),
+		})
+	}
+	if len(causes) == 0 {
+		return nil, nil
+	}
+
+	extra := map[string]authv1.ExtraValue{}
+	for key, value := range userInfo.Extra {
+		extra[key] = authv1.ExtraValue(value)
+	}
+	sar := &authv1.SubjectAccessReview{
+		Spec: authv1.SubjectAccessReviewSpec{
+			User:   userInfo.Username,
+			Groups: userInfo.Groups,
+			UID:    userInfo.UID,
+			Extra:  extra,
+			ResourceAttributes: &authv1.ResourceAttributes{
+				Verb:     "create",
+				Group:    webhooks.MigrationGroupVersionResource.Group,
+				Resource: webhooks.MigrationGroupVersionResource.Resource,
+			},
+		},
+	}
+	sar, err := virtClient.AuthorizationV1().SubjectAccessReviews().Create(context.Background(), sar, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if sar.Status.Allowed {
+		return nil, nil
+	}
+	return causes, nil
+}
+
 func getAdmissionReviewMigration(ar *admissionv1.AdmissionReview) (new *v1.VirtualMachineInstanceMigration, old *v1.VirtualMachineInstanceMigration, err error) {
 
 	if !webhookutils.ValidateRequestResource(ar.Request.Resource, webhooks.MigrationGroupVersionResource.Group, webhooks.MigrationGroupVersionResource.Resource) {
@@ -161,5 +241,18 @@ func ValidateVirtualMachineInstanceMigrationSpec(field *k8sfield.Path, spec *v1.
 		})
 	}
 
+	if spec.Priority != nil {
+		switch *spec.Priority {
+		case v1.MigrationPriorityHigh, v1.MigrationPriorityNormal, v1.MigrationPriorityLow:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("priority %s is not supported, supported values are %s, %s and %s", *spec.Priority,
+					v1.MigrationPriorityHigh, v1.MigrationPriorityNormal, v1.MigrationPriorityLow),
+				Field: field.Child("priority").String(),
+			})
+		}
+	}
+
 	return causes
 }
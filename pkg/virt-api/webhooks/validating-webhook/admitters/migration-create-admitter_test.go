@@ -22,14 +22,19 @@ package admitters
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
 
 	"kubevirt.io/client-go/api"
 
@@ -161,6 +166,138 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.vmiName"))
 		})
 
+		It("should reject Migration spec on create with an unknown priority", func() {
+			priority := v1.MigrationPriority("Urgent")
+			migration := v1.VirtualMachineInstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+				},
+				Spec: v1.VirtualMachineInstanceMigrationSpec{
+					VMIName:  "testvmimigrate1",
+					Priority: &priority,
+				},
+			}
+			migrationBytes, _ := json.Marshal(&migration)
+
+			enableFeatureGate(virtconfig.LiveMigrationGate)
+
+			ar := &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource: webhooks.MigrationGroupVersionResource,
+					Object: runtime.RawExtension{
+						Raw: migrationBytes,
+					},
+				},
+			}
+
+			resp := migrationCreateAdmitter.Admit(ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.priority"))
+		})
+
+		Context("with High priority", func() {
+			var k8sClient *k8sfake.Clientset
+
+			admitHighPriority := func(username string) *admissionv1.AdmissionResponse {
+				priority := v1.MigrationPriorityHigh
+				migration := v1.VirtualMachineInstanceMigration{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+					},
+					Spec: v1.VirtualMachineInstanceMigrationSpec{
+						VMIName:  "testvmimigrate1",
+						Priority: &priority,
+					},
+				}
+				migrationBytes, _ := json.Marshal(&migration)
+
+				enableFeatureGate(virtconfig.LiveMigrationGate)
+
+				return migrationCreateAdmitter.Admit(&admissionv1.AdmissionReview{
+					Request: &admissionv1.AdmissionRequest{
+						Resource: webhooks.MigrationGroupVersionResource,
+						Object: runtime.RawExtension{
+							Raw: migrationBytes,
+						},
+						UserInfo: authenticationv1.UserInfo{Username: username},
+					},
+				})
+			}
+
+			expectAccessReview := func(allowed bool) {
+				k8sClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (bool, runtime.Object, error) {
+					sar := action.(testing.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+					Expect(sar.Spec.User).To(Equal("user"))
+					Expect(sar.Spec.ResourceAttributes.Namespace).To(BeEmpty())
+					Expect(sar.Spec.ResourceAttributes.Verb).To(Equal("create"))
+					Expect(sar.Spec.ResourceAttributes.Resource).To(Equal("virtualmachineinstancemigrations"))
+					return true, &authorizationv1.SubjectAccessReview{Status: authorizationv1.SubjectAccessReviewStatus{Allowed: allowed}}, nil
+				})
+			}
+
+			BeforeEach(func() {
+				k8sClient = k8sfake.NewSimpleClientset()
+				virtClient.EXPECT().AuthorizationV1().Return(k8sClient.AuthorizationV1()).AnyTimes()
+			})
+
+			It("should reject users not allowed to migrate in all namespaces", func() {
+				expectAccessReview(false)
+
+				resp := admitHighPriority("user")
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.priority"))
+			})
+
+			It("should accept users allowed to migrate in all namespaces", func() {
+				expectAccessReview(true)
+				mockVMIClient.EXPECT().Get(context.Background(), "testvmimigrate1", gomock.Any()).Return(api.NewMinimalVMI("testvmimigrate1"), nil)
+
+				Expect(admitHighPriority("user").Allowed).To(BeTrue())
+			})
+
+			It("should accept KubeVirt service accounts without an access review", func() {
+				mockVMIClient.EXPECT().Get(context.Background(), "testvmimigrate1", gomock.Any()).Return(api.NewMinimalVMI("testvmimigrate1"), nil)
+
+				Expect(admitHighPriority("system:serviceaccount:kubevirt:kubevirt-controller").Allowed).To(BeTrue())
+				Expect(k8sClient.Actions()).To(BeEmpty())
+			})
+
+			DescribeTable("should reject reserved annotations of users not allowed to migrate in all namespaces", func(annotation string) {
+				expectAccessReview(false)
+
+				migration := v1.VirtualMachineInstanceMigration{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:   "default",
+						Annotations: map[string]string{annotation: "value"},
+					},
+					Spec: v1.VirtualMachineInstanceMigrationSpec{
+						VMIName: "testvmimigrate1",
+					},
+				}
+				migrationBytes, _ := json.Marshal(&migration)
+
+				enableFeatureGate(virtconfig.LiveMigrationGate)
+
+				resp := migrationCreateAdmitter.Admit(&admissionv1.AdmissionReview{
+					Request: &admissionv1.AdmissionRequest{
+						Resource: webhooks.MigrationGroupVersionResource,
+						Object: runtime.RawExtension{
+							Raw: migrationBytes,
+						},
+						UserInfo: authenticationv1.UserInfo{Username: "user"},
+					},
+				})
+				Expect(resp.Allowed).To(BeFalse())
+				Expect(resp.Result.Details.Causes).To(HaveLen(1))
+				Expect(resp.Result.Details.Causes[0].Field).To(Equal(fmt.Sprintf("metadata.annotations[%s]", annotation)))
+			},
+				Entry("of evacuations", v1.EvacuationMigrationAnnotation),
+				Entry("of workload updates", v1.WorkloadUpdateMigrationAnnotation),
+			)
+		})
+
 		It("should accept valid Migration spec on create", func() {
 			vmi := api.NewMinimalVMI("testvmimigrate1")
 
@@ -25,11 +25,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
 
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 )
 
 type MigrationUpdateAdmitter struct {
+	VirtClient kubecli.KubevirtClient
 }
 
 func ensureSelectorLabelSafe(newMigration *v1.VirtualMachineInstanceMigration, oldMigration *v1.VirtualMachineInstanceMigration) []metav1.StatusCause {
@@ -87,6 +89,14 @@ func (admitter *MigrationUpdateAdmitter) Admit(ar *admissionv1.AdmissionReview)
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	causes, err = authorizeReservedMigrationFields(admitter.VirtClient, newMigration, oldMigration, &ar.Request.UserInfo)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
 	reviewResponse := admissionv1.AdmissionResponse{}
 	reviewResponse.Allowed = true
 	return &reviewResponse
@@ -22,13 +22,19 @@ package admitters
 import (
 	"encoding/json"
 
+	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
 
 	"kubevirt.io/client-go/api"
+	"kubevirt.io/client-go/kubecli"
 
 	v1 "kubevirt.io/api/core/v1"
 
@@ -137,6 +143,69 @@ var _ = Describe("Validating MigrationUpdate Admitter", func() {
 		Expect(resp.Allowed).To(BeTrue())
 	})
 
+	Context("with reserved annotations", func() {
+		var k8sClient *k8sfake.Clientset
+
+		admitAnnotationUpdate := func(username string, oldAnnotations, newAnnotations map[string]string) *admissionv1.AdmissionResponse {
+			migration := v1.VirtualMachineInstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "somemigration",
+					Namespace:   "default",
+					Annotations: oldAnnotations,
+				},
+				Spec: v1.VirtualMachineInstanceMigrationSpec{
+					VMIName: "testmigratevmiupdate",
+				},
+			}
+			oldMigrationBytes, _ := json.Marshal(&migration)
+			migration.Annotations = newAnnotations
+			newMigrationBytes, _ := json.Marshal(&migration)
+
+			return migrationUpdateAdmitter.Admit(&admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource:  webhooks.MigrationGroupVersionResource,
+					Object:    runtime.RawExtension{Raw: newMigrationBytes},
+					OldObject: runtime.RawExtension{Raw: oldMigrationBytes},
+					Operation: admissionv1.Update,
+					UserInfo:  authenticationv1.UserInfo{Username: username},
+				},
+			})
+		}
+
+		BeforeEach(func() {
+			k8sClient = k8sfake.NewSimpleClientset()
+			virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+			virtClient.EXPECT().AuthorizationV1().Return(k8sClient.AuthorizationV1()).AnyTimes()
+			migrationUpdateAdmitter = &MigrationUpdateAdmitter{VirtClient: virtClient}
+			DeferCleanup(func() {
+				migrationUpdateAdmitter = &MigrationUpdateAdmitter{}
+			})
+		})
+
+		It("should reject users not allowed to migrate in all namespaces adding them", func() {
+			k8sClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (bool, runtime.Object, error) {
+				return true, &authorizationv1.SubjectAccessReview{Status: authorizationv1.SubjectAccessReviewStatus{Allowed: false}}, nil
+			})
+
+			resp := admitAnnotationUpdate("user", nil, map[string]string{v1.EvacuationMigrationAnnotation: "node01"})
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("metadata.annotations[kubevirt.io/evacuationMigration]"))
+		})
+
+		It("should accept unchanged annotations without an access review", func() {
+			annotations := map[string]string{v1.EvacuationMigrationAnnotation: "node01"}
+			Expect(admitAnnotationUpdate("user", annotations, annotations).Allowed).To(BeTrue())
+			Expect(k8sClient.Actions()).To(BeEmpty())
+		})
+
+		It("should accept KubeVirt service accounts without an access review", func() {
+			resp := admitAnnotationUpdate("system:serviceaccount:kubevirt:kubevirt-controller", nil, map[string]string{v1.WorkloadUpdateMigrationAnnotation: "image"})
+			Expect(resp.Allowed).To(BeTrue())
+			Expect(k8sClient.Actions()).To(BeEmpty())
+		})
+	})
+
 	It("should reject Migration on update if labels include our selector and are removed", func() {
 		vmi := api.NewMinimalVMI("testmigratevmiupdate-labelsremoved")
 
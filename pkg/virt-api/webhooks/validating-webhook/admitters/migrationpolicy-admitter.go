@@ -27,6 +27,7 @@ import (
 
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/api/migrations"
 
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
@@ -95,6 +96,37 @@ func (admitter *MigrationPolicyAdmitter) Admit(ar *admissionv1.AdmissionReview)
 		}
 	}
 
+	if spec.ParallelMigrationStreams != nil && *spec.ParallelMigrationStreams == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "must be greater than zero",
+			Field:   sourceField.Child("parallelMigrationStreams").String(),
+		})
+	}
+
+	if spec.Compression != nil {
+		switch *spec.Compression {
+		case v1.MigrationCompressionNone, v1.MigrationCompressionZstd, v1.MigrationCompressionXBZRLE:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("compression %s is not supported, supported values are %s, %s and %s", *spec.Compression,
+					v1.MigrationCompressionNone, v1.MigrationCompressionZstd, v1.MigrationCompressionXBZRLE),
+				Field: sourceField.Child("compression").String(),
+			})
+		}
+	}
+
+	// libvirt refuses to migrate with xbzrle over multiple connections
+	if spec.Compression != nil && *spec.Compression == v1.MigrationCompressionXBZRLE &&
+		spec.ParallelMigrationStreams != nil && *spec.ParallelMigrationStreams > 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("compression %s can not be used with more than one parallel migration stream", v1.MigrationCompressionXBZRLE),
+			Field:   sourceField.Child("compression").String(),
+		})
+	}
+
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
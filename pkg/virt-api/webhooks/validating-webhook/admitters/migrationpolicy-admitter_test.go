@@ -78,6 +78,18 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 		Entry("negative CompletionTimeoutPerGiB",
 			migrationsv1.MigrationPolicySpec{CompletionTimeoutPerGiB: pointer.Int64Ptr(-1)},
 		),
+
+		Entry("zero ParallelMigrationStreams",
+			migrationsv1.MigrationPolicySpec{ParallelMigrationStreams: pointer.Uint32(0)},
+		),
+
+		Entry("unknown Compression",
+			migrationsv1.MigrationPolicySpec{Compression: compressionPtr("lz4")},
+		),
+
+		Entry("xbzrle Compression with multiple ParallelMigrationStreams",
+			migrationsv1.MigrationPolicySpec{Compression: compressionPtr(v1.MigrationCompressionXBZRLE), ParallelMigrationStreams: pointer.Uint32(4)},
+		),
 	)
 
 	DescribeTable("should accept migration policy with", func(policySpec migrationsv1.MigrationPolicySpec) {
@@ -104,12 +116,32 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 			migrationsv1.MigrationPolicySpec{BandwidthPerMigration: resource.NewScaledQuantity(0, 1)},
 		),
 
+		Entry("multiple ParallelMigrationStreams",
+			migrationsv1.MigrationPolicySpec{ParallelMigrationStreams: pointer.Uint32(4)},
+		),
+
+		Entry("zstd Compression",
+			migrationsv1.MigrationPolicySpec{Compression: compressionPtr(v1.MigrationCompressionZstd)},
+		),
+
+		Entry("xbzrle Compression",
+			migrationsv1.MigrationPolicySpec{Compression: compressionPtr(v1.MigrationCompressionXBZRLE)},
+		),
+
+		Entry("zstd Compression with multiple ParallelMigrationStreams",
+			migrationsv1.MigrationPolicySpec{Compression: compressionPtr(v1.MigrationCompressionZstd), ParallelMigrationStreams: pointer.Uint32(4)},
+		),
+
 		Entry("empty spec",
 			migrationsv1.MigrationPolicySpec{},
 		),
 	)
 })
 
+func compressionPtr(compression v1.MigrationCompression) *v1.MigrationCompression {
+	return &compression
+}
+
 func createPolicyAdmissionReview(policy *migrationsv1.MigrationPolicy, namespace string) *admissionv1.AdmissionReview {
 	policyBytes, _ := json.Marshal(policy)
 
@@ -1,8 +1,12 @@
 package admitters
 
 import (
+	"fmt"
+
 	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	"kubevirt.io/api/instancetype"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
@@ -27,6 +31,26 @@ func (f *ClusterPreferenceAdmitter) Admit(ar *admissionv1.AdmissionReview) *admi
 	return admitPreference(ar.Request, instancetype.ClusterPluralPreferenceResourceName)
 }
 
+func ValidatePreferenceSpec(field *k8sfield.Path, spec *instancetypev1beta1.VirtualMachinePreferenceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	causes = append(causes, validatePreferredInterfaceBinding(field, spec)...)
+	return causes
+}
+
+func validatePreferredInterfaceBinding(field *k8sfield.Path, spec *instancetypev1beta1.VirtualMachinePreferenceSpec) (causes []metav1.StatusCause) {
+	if spec.Devices != nil && spec.Devices.PreferredInterfaceMasquerade != nil && spec.Devices.PreferredInterfaceBinding != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s and %s should not be requested together.",
+				field.Child("devices", "preferredInterfaceMasquerade").String(),
+				field.Child("devices", "preferredInterfaceBinding").String()),
+			Field: field.Child("devices", "preferredInterfaceBinding").String(),
+		})
+	}
+	return causes
+}
+
 func admitPreference(request *admissionv1.AdmissionRequest, resource string) *admissionv1.AdmissionResponse {
 	// Only handle create and update
 	if request.Operation != admissionv1.Create && request.Operation != admissionv1.Update {
@@ -48,6 +72,15 @@ func admitPreference(request *admissionv1.AdmissionRequest, resource string) *ad
 		return resp
 	}
 
+	preferenceSpecObj, _, err := webhookutils.GetPreferenceSpecFromAdmissionRequest(request)
+	if err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+	causes := ValidatePreferenceSpec(k8sfield.NewPath("spec"), preferenceSpecObj)
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
 	return &admissionv1.AdmissionResponse{
 		Allowed: true,
 	}
@@ -11,6 +11,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	v1 "kubevirt.io/api/core/v1"
 	apiinstancetype "kubevirt.io/api/instancetype"
 	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
 )
@@ -50,6 +51,19 @@ var _ = Describe("Validating Preference Admitter", func() {
 		Expect(response.Allowed).To(BeFalse(), "Expected preference to not be allowed")
 		Expect(response.Result.Code).To(Equal(int32(http.StatusBadRequest)), "Expected error 400: BadRequest")
 	})
+
+	It("should reject a preferred masquerade and binding plugin requested together", func() {
+		preferenceObj.Spec.Devices = &instancetypev1beta1.DevicePreferences{
+			PreferredInterfaceMasquerade: &v1.InterfaceMasquerade{},
+			PreferredInterfaceBinding:    &v1.PluginBinding{Name: "passt"},
+		}
+		ar := createPreferenceAdmissionReview(preferenceObj, instancetypev1beta1.SchemeGroupVersion.Version)
+		response := admitter.Admit(ar)
+
+		Expect(response.Allowed).To(BeFalse(), "Expected preference to not be allowed")
+		Expect(response.Result.Details.Causes).To(HaveLen(1))
+		Expect(response.Result.Details.Causes[0].Field).To(Equal("spec.devices.preferredInterfaceBinding"))
+	})
 })
 
 var _ = Describe("Validating ClusterPreference Admitter", func() {
@@ -110,6 +110,7 @@ func (admitter *VMICreateAdmitter) Admit(ar *admissionv1.AdmissionReview) *admis
 	// We only want to validate that volumes are mapped to disks or filesystems during VMI admittance, thus this logic is seperated from the above call that is shared with the VM admitter.
 	causes = append(causes, validateVirtualMachineInstanceSpecVolumeDisks(k8sfield.NewPath("spec"), &vmi.Spec)...)
 	causes = append(causes, ValidateVirtualMachineInstanceMandatoryFields(k8sfield.NewPath("spec"), &vmi.Spec)...)
+	causes = append(causes, validateClusterBaselineCPUModelResolved(k8sfield.NewPath("spec"), &vmi.Spec)...)
 	causes = append(causes, ValidateVirtualMachineInstanceMetadata(k8sfield.NewPath("metadata"), &vmi.ObjectMeta, admitter.ClusterConfig, accountName)...)
 	// In a future, yet undecided, release either libvirt or QEMU are going to check the hyperv dependencies, so we can get rid of this code.
 	causes = append(causes, webhooks.ValidateVirtualMachineInstanceHypervFeatureDependencies(k8sfield.NewPath("spec"), &vmi.Spec)...)
@@ -155,9 +156,11 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateCpuPinning(field, spec, config)...)
 	causes = append(causes, validateNUMA(field, spec, config)...)
 	causes = append(causes, validateCPUIsolatorThread(field, spec)...)
+	causes = append(causes, validateThreadPlacement(field, spec)...)
 	causes = append(causes, validateCPUFeaturePolicies(field, spec)...)
 	causes = append(causes, validateCPUHotplug(field, spec)...)
 	causes = append(causes, validateStartStrategy(field, spec)...)
+	causes = append(causes, validateGuestTimeSync(field, spec)...)
 	causes = append(causes, validateRealtime(field, spec, !root)...)
 	causes = append(causes, validateSpecAffinity(field, spec)...)
 	causes = append(causes, validateSpecTopologySpreadConstraints(field, spec)...)
@@ -223,6 +226,7 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateFilesystemsWithVirtIOFSEnabled(field, spec, config)...)
 	causes = append(causes, validateHostDevicesWithPassthroughEnabled(field, spec, config)...)
 	causes = append(causes, validateSoundDevices(field, spec)...)
+	causes = append(causes, validateVhostUserBlkDisks(field, spec)...)
 	causes = append(causes, validateLaunchSecurity(field, spec, config)...)
 	causes = append(causes, validateVSOCK(field, spec, config)...)
 	causes = append(causes, validatePersistentReservation(field, spec, config)...)
@@ -688,10 +692,27 @@ func validateInputDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceS
 			})
 		}
 
-		if input.Type != v1.InputTypeTablet {
+		switch input.Type {
+		case v1.InputTypeTablet:
+		case v1.InputTypePassthrough:
+			if input.Bus == v1.InputBusUSB {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "Passthrough input device can have only virtio bus.",
+					Field:   field.Child("domain", "devices", "inputs").Index(idx).Child("bus").String(),
+				})
+			}
+			if input.DeviceName == "" {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueRequired,
+					Message: "Passthrough input device requires a deviceName.",
+					Field:   field.Child("domain", "devices", "inputs").Index(idx).Child("deviceName").String(),
+				})
+			}
+		default:
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
-				Message: "Input device can have only tablet type.",
+				Message: "Input device can have only tablet or passthrough type.",
 				Field:   field.Child("domain", "devices", "inputs").Index(idx).Child("type").String(),
 			})
 		}
@@ -870,13 +891,46 @@ func validateHostDevicesWithPassthroughEnabled(field *k8sfield.Path, spec *v1.Vi
 	return causes
 }
 
+func validateVhostUserBlkDisks(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
+	vhostUserBlkVolumes := make(map[string]struct{})
+	for _, volume := range spec.Volumes {
+		if volume.VhostUserBlk != nil {
+			vhostUserBlkVolumes[volume.Name] = struct{}{}
+		}
+	}
+	if len(vhostUserBlkVolumes) == 0 {
+		return causes
+	}
+
+	for idx, disk := range spec.Domain.Devices.Disks {
+		if _, exists := vhostUserBlkVolumes[disk.Name]; !exists {
+			continue
+		}
+		diskField := field.Child("domain", "devices", "disks").Index(idx)
+		if disk.Disk == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be of type disk to use a vhost-user-blk volume", diskField.String()),
+				Field:   diskField.String(),
+			})
+		} else if disk.Disk.Bus != "" && disk.Disk.Bus != v1.DiskBusVirtio {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s must use the virtio bus to use a vhost-user-blk volume", diskField.Child("disk", "bus").String()),
+				Field:   diskField.Child("disk", "bus").String(),
+			})
+		}
+	}
+	return causes
+}
+
 func validateSoundDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
 	if spec.Domain.Devices.Sound != nil {
 		model := spec.Domain.Devices.Sound.Model
-		if model != "" && model != "ich9" && model != "ac97" {
+		if model != "" && model != "ich9" && model != "ac97" && model != "virtio" {
 			causes = append(causes, metav1.StatusCause{
 				Type:    metav1.CauseTypeFieldValueInvalid,
-				Message: "Sound device type is not supported. Options: 'ich9' or 'ac97'",
+				Message: "Sound device type is not supported. Options: 'ich9', 'ac97' or 'virtio'",
 				Field:   field.Child("Sound").String(),
 			})
 		}
@@ -1119,6 +1173,19 @@ func validateCPUFeaturePolicies(field *k8sfield.Path, spec *v1.VirtualMachineIns
 	return causes
 }
 
+// validateClusterBaselineCPUModelResolved rejects VMIs whose cluster-baseline CPU model could not be
+// resolved by the mutating webhook, since no node would ever match it
+func validateClusterBaselineCPUModelResolved(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
+	if spec.Domain.CPU != nil && spec.Domain.CPU.Model == v1.CPUModeClusterBaseline {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s is set to %s, but no CPU baseline was computed for the nodes of the VMI yet", field.Child("domain", "cpu", "model").String(), v1.CPUModeClusterBaseline),
+			Field:   field.Child("domain", "cpu", "model").String(),
+		})
+	}
+	return causes
+}
+
 func validateCPUIsolatorThread(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
 	if spec.Domain.CPU != nil && spec.Domain.CPU.IsolateEmulatorThread && !spec.Domain.CPU.DedicatedCPUPlacement {
 		causes = append(causes, metav1.StatusCause{
@@ -1130,6 +1197,36 @@ func validateCPUIsolatorThread(field *k8sfield.Path, spec *v1.VirtualMachineInst
 	return causes
 }
 
+func validateThreadPlacement(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
+	if spec.Domain.CPU == nil || spec.Domain.CPU.ThreadPlacement == nil {
+		return causes
+	}
+	cpu := spec.Domain.CPU
+	threadPlacementField := field.Child("domain", "cpu", "threadPlacement")
+	if !cpu.DedicatedCPUPlacement {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s should be only set in combination with DedicatedCPUPlacement", threadPlacementField.String()),
+			Field:   threadPlacementField.String(),
+		})
+	}
+	if cpu.IsolateEmulatorThread {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s and %s are mutually exclusive", threadPlacementField.String(), field.Child("domain", "cpu", "isolateEmulatorThread").String()),
+			Field:   threadPlacementField.String(),
+		})
+	}
+	if cpu.ThreadPlacement.GetReservedCPUs() == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be greater than 0", threadPlacementField.Child("reservedCPUs").String()),
+			Field:   threadPlacementField.Child("reservedCPUs").String(),
+		})
+	}
+	return causes
+}
+
 func validateCpuPinning(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
 	if spec.Domain.CPU != nil && spec.Domain.CPU.DedicatedCPUPlacement {
 		causes = append(causes, validateMemoryLimitAndRequestProvided(field, spec)...)
@@ -1281,6 +1378,25 @@ func validateStartStrategy(field *k8sfield.Path, spec *v1.VirtualMachineInstance
 	return causes
 }
 
+func validateGuestTimeSync(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
+	clock := spec.Domain.Clock
+	if clock == nil || clock.GuestTimeSync == nil {
+		return causes
+	}
+
+	switch clock.GuestTimeSync.Policy {
+	case "", v1.GuestTimeSyncAuto, v1.GuestTimeSyncDisabled:
+	default:
+		policyField := field.Child("domain", "clock", "guestTimeSync", "policy")
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s is set with an unrecognized option: %s", policyField.String(), clock.GuestTimeSync.Policy),
+			Field:   policyField.String(),
+		})
+	}
+	return causes
+}
+
 func validateMemoryRequestsAndLimits(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
 	if spec.Domain.Resources.Requests.Memory().Value() > 0 && spec.Domain.Resources.Limits.Memory().Value() > 0 && spec.Domain.Resources.Requests.Memory().Value() != spec.Domain.Resources.Limits.Memory().Value() {
 		causes = append(causes, metav1.StatusCause{
@@ -1862,6 +1978,42 @@ func validateBootloader(field *k8sfield.Path, bootloader *v1.Bootloader) []metav
 		})
 	}
 
+	if bootloader != nil && bootloader.EFI != nil && bootloader.EFI.NVRAMTemplate != nil {
+		causes = append(causes, validateNVRAMTemplate(field.Child("efi", "nvramTemplate"), bootloader.EFI.NVRAMTemplate)...)
+	}
+
+	return causes
+}
+
+func validateNVRAMTemplate(field *k8sfield.Path, source *v1.NVRAMTemplateSource) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	sources := 0
+	if source.Secret != nil {
+		sources++
+	}
+	if source.ConfigMap != nil {
+		sources++
+	}
+	if source.PersistentVolumeClaim != nil {
+		sources++
+	}
+	if sources != 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must have exactly one of secret, configMap or persistentVolumeClaim set.", field.String()),
+			Field:   field.String(),
+		})
+	}
+
+	if source.Key != "" && (source.Key != filepath.Base(source.Key) || source.Key == "." || source.Key == "..") {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be a file name, not a path.", field.Child("key").String()),
+			Field:   field.Child("key").String(),
+		})
+	}
+
 	return causes
 }
 
@@ -2098,6 +2250,9 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 			memoryDumpVolumeCount++
 			volumeSourceSetCount++
 		}
+		if volume.VhostUserBlk != nil {
+			volumeSourceSetCount++
+		}
 
 		if volumeSourceSetCount != 1 {
 			causes = append(causes, metav1.StatusCause{
@@ -2223,6 +2378,37 @@ func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconf
 			})
 		}
 
+		if vhostUserBlk := volume.VhostUserBlk; vhostUserBlk != nil {
+			if !config.VhostUserBlkEnabled() {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: "VhostUserBlk feature gate is not enabled",
+					Field:   field.Index(idx).String(),
+				})
+			}
+			if vhostUserBlk.Image == "" && vhostUserBlk.SocketName == "" {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueRequired,
+					Message: fmt.Sprintf("%s is required when the socket is provided by a node agent", field.Index(idx).Child("vhostUserBlk", "socketName").String()),
+					Field:   field.Index(idx).Child("vhostUserBlk", "socketName").String(),
+				})
+			}
+			if strings.Contains(vhostUserBlk.SocketName, "/") || strings.Contains(vhostUserBlk.SocketName, "..") {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s must be a file name, not a path", field.Index(idx).Child("vhostUserBlk", "socketName").String()),
+					Field:   field.Index(idx).Child("vhostUserBlk", "socketName").String(),
+				})
+			}
+			if vhostUserBlk.Queues != nil && *vhostUserBlk.Queues == 0 {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s must be greater than 0", field.Index(idx).Child("vhostUserBlk", "queues").String()),
+					Field:   field.Index(idx).Child("vhostUserBlk", "queues").String(),
+				})
+			}
+		}
+
 		// validate HostDisk data
 		if hostDisk := volume.HostDisk; hostDisk != nil {
 			if !config.HostDiskEnabled() {
@@ -2571,8 +2757,75 @@ func validateDisks(field *k8sfield.Path, disks []v1.Disk) []metav1.StatusCause {
 				}
 			}
 		}
+
+		if disk.IOTune != nil {
+			causes = append(causes, validateDiskIOTune(field.Index(idx).Child("ioTune"), disk.IOTune)...)
+		}
+	}
+
+	return causes
+}
+
+// validateDiskIOTune rejects the limit combinations libvirt refuses
+func validateDiskIOTune(field *k8sfield.Path, ioTune *v1.DiskIOTune) (causes []metav1.StatusCause) {
+	type ioLimit struct {
+		name  string
+		value uint64
+		max   uint64
+	}
+	exclusiveLimits := []struct {
+		total      ioLimit
+		readWrites []ioLimit
+	}{
+		{
+			total: ioLimit{"totalBytesSec", ioTune.TotalBytesSec, ioTune.TotalBytesSecMax},
+			readWrites: []ioLimit{
+				{"readBytesSec", ioTune.ReadBytesSec, ioTune.ReadBytesSecMax},
+				{"writeBytesSec", ioTune.WriteBytesSec, ioTune.WriteBytesSecMax},
+			},
+		},
+		{
+			total: ioLimit{"totalIOPSSec", ioTune.TotalIOPSSec, ioTune.TotalIOPSSecMax},
+			readWrites: []ioLimit{
+				{"readIOPSSec", ioTune.ReadIOPSSec, ioTune.ReadIOPSSecMax},
+				{"writeIOPSSec", ioTune.WriteIOPSSec, ioTune.WriteIOPSSecMax},
+			},
+		},
+	}
+
+	hasBurst := false
+	for _, limits := range exclusiveLimits {
+		for _, readWrite := range limits.readWrites {
+			if limits.total.value != 0 && readWrite.value != 0 || limits.total.max != 0 && readWrite.max != 0 {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s can't be combined with %s", field.Child(limits.total.name).String(), field.Child(readWrite.name).String()),
+					Field:   field.Child(readWrite.name).String(),
+				})
+			}
+		}
+		for _, limit := range append(limits.readWrites, limits.total) {
+			if limit.max == 0 {
+				continue
+			}
+			hasBurst = true
+			if limit.max < limit.value || limit.value == 0 {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s must be set and can't be greater than %s", field.Child(limit.name).String(), field.Child(limit.name+"Max").String()),
+					Field:   field.Child(limit.name + "Max").String(),
+				})
+			}
+		}
 	}
 
+	if ioTune.MaxLengthSeconds != 0 && !hasBurst {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires at least one burst limit", field.Child("maxLengthSeconds").String()),
+			Field:   field.Child("maxLengthSeconds").String(),
+		})
+	}
 	return causes
 }
 
@@ -149,6 +149,26 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		Expect(resp.Result.Message).To(ContainSubstring("no memory requested"))
 	})
 
+	It("should reject VMIs with an unresolved cluster-baseline CPU model", func() {
+		vmi := api.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.CPU = &v1.CPU{Model: v1.CPUModeClusterBaseline}
+		vmiBytes, _ := json.Marshal(&vmi)
+
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Resource: webhooks.VirtualMachineInstanceGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: vmiBytes,
+				},
+			},
+		}
+		resp := vmiCreateAdmitter.Admit(ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.domain.cpu.model"))
+		Expect(resp.Result.Message).To(ContainSubstring("no CPU baseline was computed"))
+	})
+
 	It("should allow Clock without Timer", func() {
 		vmi := api.NewMinimalVMI("testvmi")
 		vmi.Spec.Domain.Clock = &v1.Clock{
@@ -625,7 +645,7 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Field).To(Equal("fake.domain.devices.disks[0].name"))
 		})
 		It("should allow supported audio devices", func() {
-			supportedDevices := [...]string{"", "ich9", "ac97"}
+			supportedDevices := [...]string{"", "ich9", "ac97", "virtio"}
 			vmi := api.NewMinimalVMI("testvmi")
 
 			for _, deviceName := range supportedDevices {
@@ -766,6 +786,31 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 					Name: "tablet0",
 					Bus:  v1.InputBus("ps2"),
 				}, 2, []string{"fake.domain.devices.inputs[0].bus", "fake.domain.devices.inputs[0].type"}, "Expect type error"),
+			Entry("and accept passthrough input with virtio bus",
+				v1.Input{
+					Type:       v1.InputTypePassthrough,
+					Name:       "keyboard0",
+					Bus:        v1.InputBusVirtio,
+					DeviceName: "example.org/keyboard",
+				}, 0, []string{}, "Expect no errors"),
+			Entry("and accept passthrough input without bus",
+				v1.Input{
+					Type:       v1.InputTypePassthrough,
+					Name:       "keyboard0",
+					DeviceName: "example.org/keyboard",
+				}, 0, []string{}, "Expect no errors"),
+			Entry("and reject passthrough input with usb bus",
+				v1.Input{
+					Type:       v1.InputTypePassthrough,
+					Name:       "keyboard0",
+					Bus:        v1.InputBusUSB,
+					DeviceName: "example.org/keyboard",
+				}, 1, []string{"fake.domain.devices.inputs[0].bus"}, "Expect bus error"),
+			Entry("and reject passthrough input without device name",
+				v1.Input{
+					Type: v1.InputTypePassthrough,
+					Name: "keyboard0",
+				}, 1, []string{"fake.domain.devices.inputs[0].deviceName"}, "Expect device name error"),
 		)
 
 		It("should reject negative requests.cpu value", func() {
@@ -2337,6 +2382,30 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Field).To(Equal("fake.startStrategy"))
 			Expect(causes[0].Message).To(Equal("fake.startStrategy is set with an unrecognized option: invalid"))
 		})
+		DescribeTable("should accept valid guest time sync policy", func(policy v1.GuestTimeSyncPolicy) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Clock = &v1.Clock{
+				GuestTimeSync: &v1.GuestTimeSync{Policy: policy},
+			}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		},
+			Entry("with no policy", v1.GuestTimeSyncPolicy("")),
+			Entry("with Auto", v1.GuestTimeSyncAuto),
+			Entry("with Disabled", v1.GuestTimeSyncDisabled),
+		)
+		It("should reject invalid guest time sync policy", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Clock = &v1.Clock{
+				GuestTimeSync: &v1.GuestTimeSync{Policy: "invalid"},
+			}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Type).To(Equal(metav1.CauseTypeFieldValueInvalid))
+			Expect(causes[0].Field).To(Equal("fake.domain.clock.guestTimeSync.policy"))
+		})
 		It("should reject spec with paused start strategy and LivenessProbe", func() {
 			vmi := api.NewMinimalVMI("testvmi")
 			strategy := v1.StartStrategyPaused
@@ -2554,6 +2623,29 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(HaveLen(1))
 			Expect(causes[0].Field).To(Equal("fake.domain.cpu.isolateEmulatorThread"))
 		})
+		DescribeTable("should validate the thread placement", func(cpu *v1.CPU, expectedField string) {
+			vmi.Spec.Domain.CPU = cpu
+			vmi.Spec.Domain.CPU.Cores = 4
+			vmi.Spec.Domain.Resources.Limits = k8sv1.ResourceList{
+				k8sv1.ResourceCPU: resource.MustParse("4"),
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			if expectedField == "" {
+				Expect(causes).To(BeEmpty())
+			} else {
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+			}
+		},
+			Entry("and accept it with DedicatedCPUPlacement",
+				&v1.CPU{DedicatedCPUPlacement: true, ThreadPlacement: &v1.ThreadPlacement{ReservedCPUs: pointer.Uint32(2)}}, ""),
+			Entry("and reject it without DedicatedCPUPlacement",
+				&v1.CPU{ThreadPlacement: &v1.ThreadPlacement{}}, "fake.domain.cpu.threadPlacement"),
+			Entry("and reject it in combination with IsolateEmulatorThread",
+				&v1.CPU{DedicatedCPUPlacement: true, IsolateEmulatorThread: true, ThreadPlacement: &v1.ThreadPlacement{}}, "fake.domain.cpu.threadPlacement"),
+			Entry("and reject it without reserved cpus",
+				&v1.CPU{DedicatedCPUPlacement: true, ThreadPlacement: &v1.ThreadPlacement{ReservedCPUs: pointer.Uint32(0)}}, "fake.domain.cpu.threadPlacement.reservedCPUs"),
+		)
 		It("should reject specs without inconsistent cpu reqirements", func() {
 			vmi.Spec.Domain.CPU.Cores = 4
 			vmi.Spec.Domain.Resources.Limits = k8sv1.ResourceList{
@@ -2940,6 +3032,26 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Field).To(Equal("disks[0].disk.bus"))
 		})
 
+		DescribeTable("should validate the I/O limits of disks", func(ioTune *v1.DiskIOTune, expectedFields ...string) {
+			disks := []v1.Disk{{Name: "testdisk", IOTune: ioTune}}
+			causes := validateDisks(k8sfield.NewPath("disks"), disks)
+			fields := []string{}
+			for _, cause := range causes {
+				fields = append(fields, cause.Field)
+			}
+			Expect(fields).To(ConsistOf(expectedFields))
+		},
+			Entry("accept total limits with bursts", &v1.DiskIOTune{TotalBytesSec: 1024, TotalBytesSecMax: 2048, TotalIOPSSec: 100, TotalIOPSSecMax: 200, MaxLengthSeconds: 10}),
+			Entry("accept read and write limits", &v1.DiskIOTune{ReadBytesSec: 1024, WriteBytesSec: 2048, ReadIOPSSec: 100, WriteIOPSSec: 200}),
+			Entry("accept a total bandwidth with read and write operation limits", &v1.DiskIOTune{TotalBytesSec: 1024, ReadIOPSSec: 100, WriteIOPSSec: 200}),
+			Entry("reject total and read bandwidth limits", &v1.DiskIOTune{TotalBytesSec: 1024, ReadBytesSec: 1024}, "disks[0].ioTune.readBytesSec"),
+			Entry("reject total and write operation limits", &v1.DiskIOTune{TotalIOPSSec: 100, WriteIOPSSec: 100}, "disks[0].ioTune.writeIOPSSec"),
+			Entry("reject total and read burst limits", &v1.DiskIOTune{TotalIOPSSec: 100, TotalIOPSSecMax: 200, ReadIOPSSecMax: 200}, "disks[0].ioTune.readIOPSSec", "disks[0].ioTune.readIOPSSecMax"),
+			Entry("reject a burst limit without a limit", &v1.DiskIOTune{WriteBytesSecMax: 2048}, "disks[0].ioTune.writeBytesSecMax"),
+			Entry("reject a burst limit below the limit", &v1.DiskIOTune{ReadIOPSSec: 200, ReadIOPSSecMax: 100}, "disks[0].ioTune.readIOPSSecMax"),
+			Entry("reject a burst length without burst limits", &v1.DiskIOTune{TotalIOPSSec: 100, MaxLengthSeconds: 10}, "disks[0].ioTune.maxLengthSeconds"),
+		)
+
 		It("should reject disks with PCI address on a non-virtio bus ", func() {
 			vmi := api.NewMinimalVMI("testvmi")
 
@@ -3497,6 +3609,71 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(BeEmpty())
 		})
 
+		It("should reject vhostUserBlk volumes if the feature gate is not enabled", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "spdk",
+				VolumeSource: v1.VolumeSource{
+					VhostUserBlk: &v1.VhostUserBlkSource{Image: "spdk:latest"},
+				},
+			})
+
+			causes := validateVolumes(k8sfield.NewPath("fake"), vmi.Spec.Volumes, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(Equal("VhostUserBlk feature gate is not enabled"))
+		})
+
+		DescribeTable("should validate vhostUserBlk volumes", func(source *v1.VhostUserBlkSource, expectedMessages ...string) {
+			enableFeatureGate(virtconfig.VhostUserBlkGate)
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: "spdk",
+				VolumeSource: v1.VolumeSource{
+					VhostUserBlk: source,
+				},
+			})
+
+			causes := validateVolumes(k8sfield.NewPath("fake"), vmi.Spec.Volumes, config)
+			Expect(causes).To(HaveLen(len(expectedMessages)))
+			for i, message := range expectedMessages {
+				Expect(causes[i].Message).To(Equal(message))
+			}
+		},
+			Entry("with a storage plugin sidecar", &v1.VhostUserBlkSource{Image: "spdk:latest"}),
+			Entry("with a node agent socket", &v1.VhostUserBlkSource{SocketName: "nvme0.sock"}),
+			Entry("without image and socket name", &v1.VhostUserBlkSource{},
+				"fake[0].vhostUserBlk.socketName is required when the socket is provided by a node agent"),
+			Entry("with a socket path", &v1.VhostUserBlkSource{SocketName: "../nvme0.sock"},
+				"fake[0].vhostUserBlk.socketName must be a file name, not a path"),
+			Entry("with a parent directory as socket name", &v1.VhostUserBlkSource{SocketName: ".."},
+				"fake[0].vhostUserBlk.socketName must be a file name, not a path"),
+			Entry("with zero queues", &v1.VhostUserBlkSource{Image: "spdk:latest", Queues: pointer.Uint32(0)},
+				"fake[0].vhostUserBlk.queues must be greater than 0"),
+		)
+
+		DescribeTable("should validate disks of vhostUserBlk volumes", func(disk v1.DiskDevice, expectedField string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{{Name: "spdk", DiskDevice: disk}}
+			vmi.Spec.Volumes = []v1.Volume{{
+				Name: "spdk",
+				VolumeSource: v1.VolumeSource{
+					VhostUserBlk: &v1.VhostUserBlkSource{Image: "spdk:latest"},
+				},
+			}}
+
+			causes := validateVhostUserBlkDisks(k8sfield.NewPath("fake"), &vmi.Spec)
+			if expectedField == "" {
+				Expect(causes).To(BeEmpty())
+			} else {
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+			}
+		},
+			Entry("accept a virtio disk", v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio}}, ""),
+			Entry("reject a sata disk", v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSATA}}, "fake.domain.devices.disks[0].disk.bus"),
+			Entry("reject a cdrom", v1.DiskDevice{CDRom: &v1.CDRomTarget{}}, "fake.domain.devices.disks[0]"),
+		)
+
 		It("should accept sysprep volumes", func() {
 			vmi := api.NewMinimalVMI("fake-vmi")
 			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
@@ -3710,6 +3887,43 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(HaveLen(1))
 		})
 
+		DescribeTable("should validate the EFI NVRAM template", func(source *v1.NVRAMTemplateSource, expectedField string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Firmware = &v1.Firmware{
+				Bootloader: &v1.Bootloader{
+					EFI: &v1.EFI{
+						SecureBoot:    pointer.Bool(false),
+						NVRAMTemplate: source,
+					},
+				},
+			}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			if expectedField == "" {
+				Expect(causes).To(BeEmpty())
+			} else {
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+			}
+		},
+			Entry("accept a Secret source",
+				&v1.NVRAMTemplateSource{Secret: &k8sv1.LocalObjectReference{Name: "vars"}}, ""),
+			Entry("accept a ConfigMap source with a key",
+				&v1.NVRAMTemplateSource{ConfigMap: &k8sv1.LocalObjectReference{Name: "vars"}, Key: "company_VARS.fd"}, ""),
+			Entry("accept a PersistentVolumeClaim source",
+				&v1.NVRAMTemplateSource{PersistentVolumeClaim: &k8sv1.LocalObjectReference{Name: "vars"}}, ""),
+			Entry("reject a template without source",
+				&v1.NVRAMTemplateSource{}, "fake.domain.firmware.bootloader.efi.nvramTemplate"),
+			Entry("reject a template with multiple sources",
+				&v1.NVRAMTemplateSource{
+					Secret:    &k8sv1.LocalObjectReference{Name: "vars"},
+					ConfigMap: &k8sv1.LocalObjectReference{Name: "vars"},
+				}, "fake.domain.firmware.bootloader.efi.nvramTemplate"),
+			Entry("reject a key which is a path",
+				&v1.NVRAMTemplateSource{PersistentVolumeClaim: &k8sv1.LocalObjectReference{Name: "vars"}, Key: "../OVMF_VARS.fd"},
+				"fake.domain.firmware.bootloader.efi.nvramTemplate.key"),
+		)
+
 		It("should reject disk without a valid DNS-1123 name", func() {
 			vmi := api.NewMinimalVMI("testvmi")
 
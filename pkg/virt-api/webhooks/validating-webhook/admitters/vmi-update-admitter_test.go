@@ -379,6 +379,14 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 		return res
 	}
 
+	makeDisksWithIOTune := func(ioTune *v1.DiskIOTune, indexes ...int) []v1.Disk {
+		res := makeDisks(indexes...)
+		for i := range res {
+			res[i].IOTune = ioTune
+		}
+		return res
+	}
+
 	makeDisksNoVolume := func(indexes ...int) []v1.Disk {
 		res := make([]v1.Disk, 0)
 		for _, index := range indexes {
@@ -502,6 +510,20 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 			makeDisks(0, 1),
 			makeStatus(2, 1),
 			nil),
+		Entry("Should accept if the I/O limits of permanent and hotplug disks changed",
+			makeVolumes(0, 1),
+			makeVolumes(0, 1),
+			makeDisksWithIOTune(&v1.DiskIOTune{TotalIOPSSec: 100}, 0, 1),
+			makeDisks(0, 1),
+			makeStatus(2, 1),
+			nil),
+		Entry("Should reject invalid I/O limits",
+			makeVolumes(0),
+			makeVolumes(0),
+			makeDisksWithIOTune(&v1.DiskIOTune{MaxLengthSeconds: 10}, 0),
+			makeDisks(0),
+			makeStatus(1, 0),
+			makeExpected("spec.domain.devices.disks[0].ioTune.maxLengthSeconds requires at least one burst limit", "spec.domain.devices.disks[0].ioTune.maxLengthSeconds")),
 		Entry("Should reject if we add disk with invalid bus",
 			makeVolumes(0, 1),
 			makeVolumes(0),
@@ -166,6 +166,11 @@ func (admitter *VMsAdmitter) Admit(ar *admissionv1.AdmissionReview) *admissionv1
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	causes = validateDependencies(k8sfield.NewPath("spec", "dependsOn"), &vm)
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
 	causes, err = admitter.authorizeVirtualMachineSpec(ar.Request, &vm)
 	if err != nil {
 		return webhookutils.ToAdmissionResponseError(err)
@@ -457,6 +462,48 @@ func ValidateVirtualMachineSpec(field *k8sfield.Path, spec *v1.VirtualMachineSpe
 	return causes
 }
 
+// validateDependencies checks the VirtualMachines of the same namespace a VirtualMachine depends on
+func validateDependencies(field *k8sfield.Path, vm *v1.VirtualMachine) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	names := map[string]bool{}
+	for idx, dependency := range vm.Spec.DependsOn {
+		switch {
+		case dependency.Name == "":
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("'name' field must not be empty for dependency entry %s.", field.Index(idx).String()),
+				Field:   field.Index(idx).Child("name").String(),
+			})
+		case dependency.Name == vm.Name:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "A VirtualMachine can not depend on itself",
+				Field:   field.Index(idx).Child("name").String(),
+			})
+		case names[dependency.Name]:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("Duplicate dependency on VirtualMachine %s", dependency.Name),
+				Field:   field.Index(idx).Child("name").String(),
+			})
+		}
+		names[dependency.Name] = true
+
+		switch dependency.Condition {
+		case "", v1.DependencyReady, v1.DependencyAgentConnected:
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("Invalid dependency condition (%s), must be %s or %s", dependency.Condition, v1.DependencyReady, v1.DependencyAgentConnected),
+				Field:   field.Index(idx).Child("condition").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
 func (admitter *VMsAdmitter) validateVolumeRequests(vm *v1.VirtualMachine) ([]metav1.StatusCause, error) {
 	if len(vm.Status.VolumeRequests) == 0 {
 		return nil, nil
@@ -244,6 +244,37 @@ var _ = Describe("Validating VM Admitter", func() {
 		Expect(resp.Allowed).To(BeTrue())
 	})
 
+	DescribeTable("should validate the dependencies of a VM", func(dependencies []v1.VirtualMachineDependency, expectedField string) {
+		vmi := api.NewMinimalVMI("testvmi")
+		vm := &v1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "app",
+			},
+			Spec: v1.VirtualMachineSpec{
+				Running: &notRunning,
+				Template: &v1.VirtualMachineInstanceTemplateSpec{
+					Spec: vmi.Spec,
+				},
+				DependsOn: dependencies,
+			},
+		}
+
+		resp := admitVm(vmsAdmitter, vm)
+		if expectedField == "" {
+			Expect(resp.Allowed).To(BeTrue())
+			return
+		}
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal(expectedField))
+	},
+		Entry("accept dependencies on other VMs", []v1.VirtualMachineDependency{{Name: "db"}, {Name: "cache", Condition: v1.DependencyAgentConnected}}, ""),
+		Entry("reject a dependency without a name", []v1.VirtualMachineDependency{{Name: ""}}, "spec.dependsOn[0].name"),
+		Entry("reject a dependency on the VM itself", []v1.VirtualMachineDependency{{Name: "db"}, {Name: "app"}}, "spec.dependsOn[1].name"),
+		Entry("reject duplicate dependencies", []v1.VirtualMachineDependency{{Name: "db"}, {Name: "db", Condition: v1.DependencyReady}}, "spec.dependsOn[1].name"),
+		Entry("reject an unknown condition", []v1.VirtualMachineDependency{{Name: "db", Condition: "Started"}}, "spec.dependsOn[0].condition"),
+	)
+
 	DescribeTable("should reject VolumeRequests on a migrating vm", func(requests []v1.VirtualMachineVolumeRequest) {
 		now := metav1.Now()
 		vmi := api.NewMinimalVMI("testvmi")
@@ -59,8 +59,8 @@ func ServeMigrationCreate(resp http.ResponseWriter, req *http.Request, clusterCo
 	validating_webhooks.Serve(resp, req, &admitters.MigrationCreateAdmitter{ClusterConfig: clusterConfig, VirtClient: virtCli})
 }
 
-func ServeMigrationUpdate(resp http.ResponseWriter, req *http.Request) {
-	validating_webhooks.Serve(resp, req, &admitters.MigrationUpdateAdmitter{})
+func ServeMigrationUpdate(resp http.ResponseWriter, req *http.Request, virtCli kubecli.KubevirtClient) {
+	validating_webhooks.Serve(resp, req, &admitters.MigrationUpdateAdmitter{VirtClient: virtCli})
 }
 
 func ServeVMSnapshots(resp http.ResponseWriter, req *http.Request, clusterConfig *virtconfig.ClusterConfig, virtCli kubecli.KubevirtClient) {
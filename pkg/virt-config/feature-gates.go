@@ -41,6 +41,7 @@ const (
 	HotplugVolumesGate         = "HotplugVolumes"
 	HostDiskGate               = "HostDisk"
 	VirtIOFSGate               = "ExperimentalVirtiofsSupport"
+	VhostUserBlkGate           = "VhostUserBlk"
 	MacvtapGate                = "Macvtap"
 	PasstGate                  = "Passt"
 	DownwardMetricsFeatureGate = "DownwardMetrics"
@@ -174,6 +175,10 @@ func (config *ClusterConfig) VirtiofsEnabled() bool {
 	return config.isFeatureGateEnabled(VirtIOFSGate)
 }
 
+func (config *ClusterConfig) VhostUserBlkEnabled() bool {
+	return config.isFeatureGateEnabled(VhostUserBlkGate)
+}
+
 func (config *ClusterConfig) MacvtapEnabled() bool {
 	return config.isFeatureGateEnabled(MacvtapGate)
 }
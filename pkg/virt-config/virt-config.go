@@ -25,11 +25,13 @@ package virtconfig
 
 import (
 	"fmt"
+	"time"
 
 	"kubevirt.io/client-go/log"
 
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
 
 	v1 "kubevirt.io/api/core/v1"
 )
@@ -84,6 +86,8 @@ const (
 	DefaultVirtAPIBurst                   = 10
 	DefaultVirtWebhookClientQPS           = 200
 	DefaultVirtWebhookClientBurst         = 400
+
+	DefaultNodeFailoverNotReadyTimeout = 1 * time.Minute
 )
 
 func IsAMD64(arch string) bool {
@@ -436,6 +440,13 @@ func (c *ClusterConfig) GetKSMConfiguration() *v1.KSMConfiguration {
 	return c.GetConfig().KSMConfiguration
 }
 
+func (c *ClusterConfig) GetGuestAgentCustomCommands() []string {
+	if agentConfig := c.GetConfig().GuestAgentConfiguration; agentConfig != nil {
+		return agentConfig.CustomCommands
+	}
+	return nil
+}
+
 func (c *ClusterConfig) GetMaximumCpuSockets() (numOfSockets uint32) {
 	liveConfig := c.GetConfig().LiveUpdateConfiguration
 	if liveConfig != nil && liveConfig.MaxCpuSockets != nil {
@@ -444,3 +455,49 @@ func (c *ClusterConfig) GetMaximumCpuSockets() (numOfSockets uint32) {
 
 	return
 }
+
+// NodeFailoverEnabled returns whether VMIs on NotReady nodes are failed over
+func (c *ClusterConfig) NodeFailoverEnabled() bool {
+	return c.GetConfig().NodeFailover != nil
+}
+
+func (c *ClusterConfig) GetNodeFailoverNotReadyTimeout() time.Duration {
+	failover := c.GetConfig().NodeFailover
+	if failover == nil || failover.NotReadyTimeout == nil {
+		return DefaultNodeFailoverNotReadyTimeout
+	}
+	return failover.NotReadyTimeout.Duration
+}
+
+func (c *ClusterConfig) NodeFailoverRequiresFencing() bool {
+	failover := c.GetConfig().NodeFailover
+	return failover == nil || failover.RequireFencing == nil || *failover.RequireFencing
+}
+
+func (c *ClusterConfig) GetCPUBaselineNodePools() []v1.CPUBaselineNodePool {
+	return c.GetConfig().CPUBaselineNodePools
+}
+
+// GetCPUBaseline returns the CPU baseline of the node pool whose node selector is part of the given
+// node selector, or the CPU baseline of the whole cluster if there is no such pool
+func (c *ClusterConfig) GetCPUBaseline(nodeSelector map[string]string) *v1.CPUBaseline {
+	kv := c.GetConfigFromKubeVirtCR()
+	if kv == nil {
+		return nil
+	}
+
+	nodePool := ""
+	for _, pool := range c.GetCPUBaselineNodePools() {
+		if len(pool.NodeSelector) > 0 && labels.SelectorFromSet(pool.NodeSelector).Matches(labels.Set(nodeSelector)) {
+			nodePool = pool.Name
+			break
+		}
+	}
+
+	for i := range kv.Status.CPUBaselines {
+		if kv.Status.CPUBaselines[i].NodePool == nodePool {
+			return &kv.Status.CPUBaselines[i]
+		}
+	}
+	return nil
+}
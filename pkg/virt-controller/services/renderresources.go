@@ -203,20 +203,29 @@ func WithCPUPinning(cpu *v1.CPU) ResourceRendererOption {
 
 		// allocate 1 more pcpu if IsolateEmulatorThread request
 		if cpu.IsolateEmulatorThread {
-			emulatorThreadCPU := resource.NewQuantity(1, resource.BinarySI)
-			limits := renderer.calculatedLimits[k8sv1.ResourceCPU]
-			limits.Add(*emulatorThreadCPU)
-			renderer.vmLimits[k8sv1.ResourceCPU] = limits
-			if cpuRequest, ok := renderer.vmRequests[k8sv1.ResourceCPU]; ok {
-				cpuRequest.Add(*emulatorThreadCPU)
-				renderer.vmRequests[k8sv1.ResourceCPU] = cpuRequest
-			}
+			addDedicatedCPUs(renderer, 1)
+		}
+
+		// allocate the pcpus reserved for the emulator, I/O and vhost-net threads
+		if reservedCPUs := cpu.ThreadPlacement.GetReservedCPUs(); reservedCPUs > 0 {
+			addDedicatedCPUs(renderer, int64(reservedCPUs))
 		}
 
 		renderer.vmLimits[k8sv1.ResourceMemory] = *renderer.vmRequests.Memory()
 	}
 }
 
+func addDedicatedCPUs(renderer *ResourceRenderer, count int64) {
+	dedicatedCPUs := resource.NewQuantity(count, resource.BinarySI)
+	limits := renderer.calculatedLimits[k8sv1.ResourceCPU]
+	limits.Add(*dedicatedCPUs)
+	renderer.vmLimits[k8sv1.ResourceCPU] = limits
+	if cpuRequest, ok := renderer.vmRequests[k8sv1.ResourceCPU]; ok {
+		cpuRequest.Add(*dedicatedCPUs)
+		renderer.vmRequests[k8sv1.ResourceCPU] = cpuRequest
+	}
+}
+
 func WithNetworkResources(networkToResourceMap map[string]string) ResourceRendererOption {
 	return func(renderer *ResourceRenderer) {
 		resources := renderer.ResourceRequirements()
@@ -252,6 +261,19 @@ func WithHostDevices(hostDevices []v1.HostDevice) ResourceRendererOption {
 	}
 }
 
+func WithInputDevices(inputs []v1.Input) ResourceRendererOption {
+	return func(renderer *ResourceRenderer) {
+		resources := renderer.ResourceRequirements()
+		for _, input := range inputs {
+			if input.Type == v1.InputTypePassthrough {
+				requestResource(&resources, input.DeviceName)
+			}
+		}
+		copyResources(resources.Limits, renderer.calculatedLimits)
+		copyResources(resources.Requests, renderer.calculatedRequests)
+	}
+}
+
 func WithSEV() ResourceRendererOption {
 	return func(renderer *ResourceRenderer) {
 		resources := renderer.ResourceRequirements()
@@ -503,11 +525,27 @@ func validatePermittedHostDevices(spec *v1.VirtualMachineInstanceSpec, config *v
 				errors = append(errors, fmt.Sprintf("GPU %s is not permitted in permittedHostDevices configuration", hostDev.DeviceName))
 			}
 		}
+		supportedUSBDevicesMap := make(map[string]bool)
+		for _, dev := range hostDevs.USB {
+			supportedUSBDevicesMap[dev.ResourceName] = true
+		}
 		for _, hostDev := range spec.Domain.Devices.HostDevices {
-			if _, exist := supportedHostDevicesMap[hostDev.DeviceName]; !exist {
+			if !supportedHostDevicesMap[hostDev.DeviceName] && !supportedUSBDevicesMap[hostDev.DeviceName] {
 				errors = append(errors, fmt.Sprintf("HostDevice %s is not permitted in permittedHostDevices configuration", hostDev.DeviceName))
 			}
 		}
+		supportedInputDevicesMap := make(map[string]bool)
+		for _, dev := range hostDevs.InputDevices {
+			supportedInputDevicesMap[dev.ResourceName] = true
+		}
+		for _, input := range spec.Domain.Devices.Inputs {
+			if input.Type != v1.InputTypePassthrough {
+				continue
+			}
+			if _, exist := supportedInputDevicesMap[input.DeviceName]; !exist {
+				errors = append(errors, fmt.Sprintf("Input %s is not permitted in permittedHostDevices configuration", input.DeviceName))
+			}
+		}
 	}
 
 	if len(errors) != 0 {
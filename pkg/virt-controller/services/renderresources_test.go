@@ -6,6 +6,7 @@ import (
 
 	kubev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/pointer"
 
 	v1 "kubevirt.io/api/core/v1"
 
@@ -181,6 +182,32 @@ var _ = Describe("Resource pod spec renderer", func() {
 				Entry("request and limits set by the user", true),
 			)
 		})
+
+		When("a thread placement is requested", func() {
+			userSpecifiedCPURequest := kubev1.ResourceList{kubev1.ResourceCPU: userCPURequest}
+
+			DescribeTable("adds the reserved CPUs to the requests and limits", func(threadPlacement *v1.ThreadPlacement, expectedReservedCPUs int64) {
+				rr = NewResourceRenderer(
+					nil,
+					userSpecifiedCPURequest,
+					WithCPUPinning(&v1.CPU{
+						Cores:           4,
+						ThreadPlacement: threadPlacement,
+					}),
+				)
+				Expect(rr.Limits()).To(HaveKeyWithValue(
+					kubev1.ResourceCPU,
+					*resource.NewQuantity(4+expectedReservedCPUs, resource.BinarySI),
+				))
+				Expect(rr.Requests()).To(HaveKeyWithValue(
+					kubev1.ResourceCPU,
+					addResources(userCPURequest, *resource.NewQuantity(expectedReservedCPUs, resource.BinarySI)),
+				))
+			},
+				Entry("with the default of one reserved CPU", &v1.ThreadPlacement{}, int64(1)),
+				Entry("with explicitly reserved CPUs", &v1.ThreadPlacement{ReservedCPUs: pointer.Uint32(2)}, int64(2)),
+			)
+		})
 	})
 
 	Context("WithNetworkResources option", func() {
@@ -264,6 +291,25 @@ var _ = Describe("Resource pod spec renderer", func() {
 		})
 	})
 
+	Context("WithInputDevices option", func() {
+		It("only passthrough inputs request resources", func() {
+			rr = NewResourceRenderer(
+				nil,
+				nil,
+				WithInputDevices([]v1.Input{
+					{Name: "tablet", Type: v1.InputTypeTablet, Bus: v1.InputBusUSB},
+					{Name: "keyboard", Type: v1.InputTypePassthrough, Bus: v1.InputBusVirtio, DeviceName: "example.org/keyboard"},
+				}),
+			)
+			Expect(rr.Limits()).To(Equal(kubev1.ResourceList{
+				kubev1.ResourceName("example.org/keyboard"): *resource.NewScaledQuantity(1, 0),
+			}))
+			Expect(rr.Requests()).To(Equal(kubev1.ResourceList{
+				kubev1.ResourceName("example.org/keyboard"): *resource.NewScaledQuantity(1, 0),
+			}))
+		})
+	})
+
 	It("WithSEV option adds ", func() {
 		sevResourceKey := kubev1.ResourceName("devices.kubevirt.io/sev")
 		rr = NewResourceRenderer(nil, nil, WithSEV())
@@ -282,6 +328,29 @@ var _ = Describe("Resource pod spec renderer", func() {
 		}
 	}
 
+	DescribeTable("validate permitted USB host devices", func(deviceName string, shouldSucceed bool) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			PermittedHostDevices: &v1.PermittedHostDevices{
+				USB: []v1.USBHostDevice{{
+					ResourceName: "example.org/dongle",
+					Selectors:    []v1.USBSelector{{Vendor: "046b", Product: "ff10"}},
+				}},
+			},
+		})
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Devices.HostDevices = []v1.HostDevice{{Name: "dongle", DeviceName: deviceName}}
+
+		err := validatePermittedHostDevices(spec, clusterConfig)
+		if shouldSucceed {
+			Expect(err).ToNot(HaveOccurred())
+		} else {
+			Expect(err).To(MatchError(ContainSubstring("HostDevice %s is not permitted", deviceName)))
+		}
+	},
+		Entry("accept a permitted USB resource", "example.org/dongle", true),
+		Entry("reject an unknown resource", "example.org/unknown", false),
+	)
+
 	DescribeTable("Calculate ratios from VMI", func(req, lim, expectedReq, expectedLim kubev1.ResourceList) {
 		kvConfig := &v1.KubeVirtConfiguration{
 			SupportContainerResources: []v1.SupportContainerResources{
@@ -19,6 +19,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/sriov"
 	"kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/vhostuserblk"
 	"kubevirt.io/kubevirt/pkg/virtiofs"
 )
 
@@ -425,6 +426,54 @@ func withBackendStorage(vmi *v1.VirtualMachineInstance) VolumeRendererOption {
 	}
 }
 
+func withNVRAMTemplate(vmi *v1.VirtualMachineInstance) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		if !util.IsEFIVMI(vmi) || vmi.Spec.Domain.Firmware.Bootloader.EFI.NVRAMTemplate == nil {
+			return nil
+		}
+
+		volumeSource, err := nvramTemplateVolumeSource(*vmi.Spec.Domain.Firmware.Bootloader.EFI.NVRAMTemplate)
+		if err != nil {
+			return err
+		}
+		renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+			Name:         nvramTemplate,
+			VolumeSource: volumeSource,
+		})
+		renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+			Name:      nvramTemplate,
+			MountPath: config.NVRAMTemplateSourceDir,
+			ReadOnly:  true,
+		})
+		return nil
+	}
+}
+
+func nvramTemplateVolumeSource(source v1.NVRAMTemplateSource) (k8sv1.VolumeSource, error) {
+	switch {
+	case source.Secret != nil:
+		return k8sv1.VolumeSource{
+			Secret: &k8sv1.SecretVolumeSource{
+				SecretName: source.Secret.Name,
+			},
+		}, nil
+	case source.ConfigMap != nil:
+		return k8sv1.VolumeSource{
+			ConfigMap: &k8sv1.ConfigMapVolumeSource{
+				LocalObjectReference: *source.ConfigMap,
+			},
+		}, nil
+	case source.PersistentVolumeClaim != nil:
+		return k8sv1.VolumeSource{
+			PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+				ClaimName: source.PersistentVolumeClaim.Name,
+				ReadOnly:  true,
+			},
+		}, nil
+	}
+	return k8sv1.VolumeSource{}, fmt.Errorf("NVRAM template must have a Secret, ConfigMap or PersistentVolumeClaim reference set")
+}
+
 func withSidecarVolumes(hookSidecars hooks.HookSidecarList) VolumeRendererOption {
 	return func(renderer *VolumeRenderer) error {
 		if len(hookSidecars) != 0 {
@@ -446,6 +495,41 @@ func withVirioFS() VolumeRendererOption {
 	}
 }
 
+func withVhostUserBlk(vmi *v1.VirtualMachineInstance) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		sidecarSockets, nodeSockets := false, false
+		for _, volume := range vmi.Spec.Volumes {
+			if volume.VhostUserBlk == nil {
+				continue
+			}
+			if vhostuserblk.IsServedBySidecar(volume.VhostUserBlk) {
+				sidecarSockets = true
+			} else {
+				nodeSockets = true
+			}
+		}
+
+		if sidecarSockets {
+			renderer.podVolumeMounts = append(renderer.podVolumeMounts, mountPath(vhostuserblk.VhostUserBlkSockets, vhostuserblk.VhostUserBlkSocketsMountBaseDir))
+			renderer.podVolumes = append(renderer.podVolumes, emptyDirVolume(vhostuserblk.VhostUserBlkSockets))
+		}
+		if nodeSockets {
+			hostPathType := k8sv1.HostPathDirectoryOrCreate
+			renderer.podVolumeMounts = append(renderer.podVolumeMounts, mountPath(vhostuserblk.NodeSockets, vhostuserblk.NodeSocketsMountBaseDir))
+			renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+				Name: vhostuserblk.NodeSockets,
+				VolumeSource: k8sv1.VolumeSource{
+					HostPath: &k8sv1.HostPathVolumeSource{
+						Path: vhostuserblk.NodeSocketsHostPath(vmi.Namespace, vmi.Name),
+						Type: &hostPathType,
+					},
+				},
+			})
+		}
+		return nil
+	}
+}
+
 func withHugepages() VolumeRendererOption {
 	return func(renderer *VolumeRenderer) error {
 		hugepagesBasePath := "/dev/hugepages"
@@ -334,6 +334,69 @@ var _ = Describe("Container spec renderer", func() {
 			Expect(vsr.VolumeDevices()).To(BeEmpty())
 		})
 	})
+
+	Context("with NVRAM template option", func() {
+		newEFIVMI := func(source *v1.NVRAMTemplateSource) *v1.VirtualMachineInstance {
+			return &v1.VirtualMachineInstance{
+				Spec: v1.VirtualMachineInstanceSpec{
+					Domain: v1.DomainSpec{
+						Firmware: &v1.Firmware{
+							Bootloader: &v1.Bootloader{
+								EFI: &v1.EFI{NVRAMTemplate: source},
+							},
+						},
+					},
+				},
+			}
+		}
+
+		It("should not add anything without a NVRAM template", func() {
+			var err error
+			vsr, err = NewVolumeRenderer(namespace, ephemeralDisk, containerDisk, virtShareDir, withNVRAMTemplate(newEFIVMI(nil)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vsr.Volumes()).To(ConsistOf(defaultVolumes()))
+			Expect(vsr.Mounts()).To(ConsistOf(defaultVolumeMounts()))
+		})
+
+		DescribeTable("should mount the NVRAM template read-only", func(source *v1.NVRAMTemplateSource, expectedVolumeSource k8sv1.VolumeSource) {
+			var err error
+			vsr, err = NewVolumeRenderer(namespace, ephemeralDisk, containerDisk, virtShareDir, withNVRAMTemplate(newEFIVMI(source)))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vsr.Volumes()).To(ConsistOf(
+				append(
+					defaultVolumes(),
+					k8sv1.Volume{
+						Name:         "nvram-template",
+						VolumeSource: expectedVolumeSource,
+					})))
+			Expect(vsr.Mounts()).To(ConsistOf(
+				append(
+					defaultVolumeMounts(),
+					k8sv1.VolumeMount{
+						Name:      "nvram-template",
+						ReadOnly:  true,
+						MountPath: "/var/run/kubevirt-private/nvram-template",
+					})))
+		},
+			Entry("from a Secret",
+				&v1.NVRAMTemplateSource{Secret: &k8sv1.LocalObjectReference{Name: "vars"}},
+				k8sv1.VolumeSource{Secret: &k8sv1.SecretVolumeSource{SecretName: "vars"}},
+			),
+			Entry("from a ConfigMap",
+				&v1.NVRAMTemplateSource{ConfigMap: &k8sv1.LocalObjectReference{Name: "vars"}},
+				k8sv1.VolumeSource{ConfigMap: &k8sv1.ConfigMapVolumeSource{LocalObjectReference: k8sv1.LocalObjectReference{Name: "vars"}}},
+			),
+			Entry("from a PersistentVolumeClaim",
+				&v1.NVRAMTemplateSource{PersistentVolumeClaim: &k8sv1.LocalObjectReference{Name: "vars"}},
+				k8sv1.VolumeSource{PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "vars", ReadOnly: true}},
+			),
+		)
+
+		It("should fail without any source", func() {
+			_, err := NewVolumeRenderer(namespace, ephemeralDisk, containerDisk, virtShareDir, withNVRAMTemplate(newEFIVMI(&v1.NVRAMTemplateSource{})))
+			Expect(err).To(HaveOccurred())
+		})
+	})
 })
 
 func vmiDiskPath(volumeName string) string {
@@ -505,6 +505,24 @@ var _ = Describe("Template", func() {
 				Expect(pod.Spec.SecurityContext.SELinuxOptions).ToNot(BeNil())
 				Expect(pod.Spec.SecurityContext.SELinuxOptions.Type).To(Equal("spc_t"))
 			})
+			It("should pass custom guest agent commands to virt-launcher", func() {
+				config, kvInformer, svc = configFactory(defaultArch)
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.GuestAgentConfiguration = &v1.GuestAgentConfiguration{
+					CustomCommands: []string{"guest-get-timezone", "guest-get-hostname"},
+				}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, kvConfig)
+
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "testvmi", Namespace: "default", UID: "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{Volumes: []v1.Volume{}, Domain: v1.DomainSpec{}},
+				}
+				pod, err := svc.RenderLaunchManifest(&vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.Containers[0].Command).To(ContainElements("--qemu-agent-custom-commands", "guest-get-timezone,guest-get-hostname"))
+			})
 			DescribeTable("should have an SELinux level of", func(enableWorkaround bool) {
 				config, kvInformer, svc = configFactory(defaultArch)
 				kvConfig := kv.DeepCopy()
@@ -3448,6 +3466,82 @@ var _ = Describe("Template", func() {
 
 		})
 
+		Context("with vhost-user-blk volumes", func() {
+			var vmi *v1.VirtualMachineInstance
+
+			BeforeEach(func() {
+				config, kvInformer, svc = configFactory(defaultArch)
+				vmi = &v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testvmi",
+						Namespace: "namespace",
+						UID:       "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{
+						Volumes: []v1.Volume{
+							{
+								Name: "spdk",
+								VolumeSource: v1.VolumeSource{
+									VhostUserBlk: &v1.VhostUserBlkSource{
+										Image: "spdk:latest",
+										Args:  []string{"--bdev", "nvme0n1"},
+									},
+								},
+							},
+						},
+					},
+				}
+			})
+
+			It("should add a storage plugin sidecar sharing its socket with the compute container", func() {
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.Containers).To(HaveLen(2))
+
+				sidecar := pod.Spec.Containers[1]
+				Expect(sidecar.Name).To(Equal("vhost-user-blk-spdk"))
+				Expect(sidecar.Image).To(Equal("spdk:latest"))
+				Expect(sidecar.Args).To(Equal([]string{"--bdev", "nvme0n1"}))
+				Expect(sidecar.Env).To(ContainElement(kubev1.EnvVar{Name: "VHOST_USER_BLK_SOCKET", Value: "/var/run/kubevirt/vhost-user-blk-sockets/spdk.sock"}))
+
+				socketsMount := kubev1.VolumeMount{Name: "vhost-user-blk-sockets", MountPath: "/var/run/kubevirt/vhost-user-blk-sockets"}
+				Expect(sidecar.VolumeMounts).To(ContainElement(socketsMount))
+				Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(socketsMount))
+				Expect(pod.Spec.Volumes).To(ContainElement(kubev1.Volume{
+					Name:         "vhost-user-blk-sockets",
+					VolumeSource: kubev1.VolumeSource{EmptyDir: &kubev1.EmptyDirVolumeSource{}},
+				}))
+			})
+
+			It("should mount the node agent sockets into the compute container", func() {
+				vmi.Spec.Volumes[0].VhostUserBlk = &v1.VhostUserBlkSource{SocketName: "nvme0.sock"}
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.Containers).To(HaveLen(1))
+				Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(kubev1.VolumeMount{
+					Name:      "vhost-user-blk-node-sockets",
+					MountPath: "/var/run/kubevirt/vhost-user-blk-node-sockets",
+				}))
+				hostPathType := kubev1.HostPathDirectoryOrCreate
+				Expect(pod.Spec.Volumes).To(ContainElement(kubev1.Volume{
+					Name: "vhost-user-blk-node-sockets",
+					VolumeSource: kubev1.VolumeSource{HostPath: &kubev1.HostPathVolumeSource{
+						Path: "/var/run/kubevirt-vhost-user-blk/namespace/testvmi",
+						Type: &hostPathType,
+					}},
+				}))
+			})
+
+			It("should make the storage plugin sidecar guaranteed for dedicated CPUs", func() {
+				vmi.Spec.Domain.CPU = &v1.CPU{DedicatedCPUPlacement: true}
+
+				containers := generateVhostUserBlkContainers(vmi, config)
+				Expect(containers).To(HaveLen(1))
+				Expect(containers[0].Resources.Requests).To(Equal(containers[0].Resources.Limits))
+			})
+		})
+
 		Context("virtiofs container qos", func() {
 
 			var vmi *v1.VirtualMachineInstance
@@ -0,0 +1,78 @@
+package services
+
+import (
+	"fmt"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/vhostuserblk"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+func generateVhostUserBlkContainers(vmi *v1.VirtualMachineInstance, config *virtconfig.ClusterConfig) []k8sv1.Container {
+	var containers []k8sv1.Container
+	for i := range vmi.Spec.Volumes {
+		volume := &vmi.Spec.Volumes[i]
+		if volume.VhostUserBlk == nil || !vhostuserblk.IsServedBySidecar(volume.VhostUserBlk) {
+			continue
+		}
+		resources := resourcesForVhostUserBlkContainer(vmi.IsCPUDedicated() || vmi.WantsToHaveQOSGuaranteed(), config)
+		containers = append(containers, generateVhostUserBlkContainer(volume, resources))
+	}
+	return containers
+}
+
+func resourcesForVhostUserBlkContainer(guaranteedQOS bool, config *virtconfig.ClusterConfig) k8sv1.ResourceRequirements {
+	resources := k8sv1.ResourceRequirements{Requests: k8sv1.ResourceList{}, Limits: k8sv1.ResourceList{}}
+
+	resources.Requests[k8sv1.ResourceCPU] = resource.MustParse("100m")
+	if reqCpu := config.GetSupportContainerRequest(v1.VhostUserBlk, k8sv1.ResourceCPU); reqCpu != nil {
+		resources.Requests[k8sv1.ResourceCPU] = *reqCpu
+	}
+	resources.Requests[k8sv1.ResourceMemory] = resource.MustParse("64M")
+	if reqMem := config.GetSupportContainerRequest(v1.VhostUserBlk, k8sv1.ResourceMemory); reqMem != nil {
+		resources.Requests[k8sv1.ResourceMemory] = *reqMem
+	}
+
+	if limCpu := config.GetSupportContainerLimit(v1.VhostUserBlk, k8sv1.ResourceCPU); limCpu != nil {
+		resources.Limits[k8sv1.ResourceCPU] = *limCpu
+	}
+	if limMem := config.GetSupportContainerLimit(v1.VhostUserBlk, k8sv1.ResourceMemory); limMem != nil {
+		resources.Limits[k8sv1.ResourceMemory] = *limMem
+	}
+
+	// A guaranteed pod requires limits equal to the requests on every container
+	if guaranteedQOS {
+		for name, request := range resources.Requests {
+			if _, exists := resources.Limits[name]; !exists {
+				resources.Limits[name] = request
+			}
+			resources.Requests[name] = resources.Limits[name]
+		}
+	}
+
+	return resources
+}
+
+func generateVhostUserBlkContainer(volume *v1.Volume, resources k8sv1.ResourceRequirements) k8sv1.Container {
+	return k8sv1.Container{
+		Name:            fmt.Sprintf("vhost-user-blk-%s", volume.Name),
+		Image:           volume.VhostUserBlk.Image,
+		ImagePullPolicy: k8sv1.PullIfNotPresent,
+		Args:            volume.VhostUserBlk.Args,
+		Env: []k8sv1.EnvVar{
+			{
+				Name:  vhostuserblk.SocketPathEnv,
+				Value: vhostuserblk.SocketPath(volume),
+			},
+		},
+		VolumeMounts: []k8sv1.VolumeMount{
+			// This is required to pass socket to compute
+			mountPath(vhostuserblk.VhostUserBlkSockets, vhostuserblk.VhostUserBlkSocketsMountBaseDir),
+		},
+		Resources:       resources,
+		SecurityContext: securityContextVirtioFS(restricted),
+	}
+}
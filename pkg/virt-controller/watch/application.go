@@ -258,6 +258,7 @@ type VirtControllerApp struct {
 	promCertFilePath         string
 	promKeyFilePath          string
 	nodeTopologyUpdater      topology.NodeTopologyUpdater
+	cpuBaselineUpdater       topology.CPUBaselineUpdater
 	nodeTopologyUpdatePeriod time.Duration
 	reloadableRateLimiter    *ratelimiter.ReloadableRateLimiter
 	leaderElector            *leaderelection.LeaderElector
@@ -276,6 +277,7 @@ func init() {
 
 	prometheus.MustRegister(leaderGauge)
 	prometheus.MustRegister(readyGauge)
+	prometheus.MustRegister(nodeFailovers)
 }
 
 func Execute() {
@@ -561,6 +563,7 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 		}()
 		go vca.workloadUpdateController.Run(stop)
 		go vca.nodeTopologyUpdater.Run(vca.nodeTopologyUpdatePeriod, stop)
+		go vca.cpuBaselineUpdater.Run(vca.nodeTopologyUpdatePeriod, stop)
 		go func() {
 			if err := vca.vmCloneController.Run(vca.cloneControllerThreads, stop); err != nil {
 				log.Log.Warningf("error running the clone controller: %v", err)
@@ -625,7 +628,7 @@ func (vca *VirtControllerApp) initCommon() {
 	}
 
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "node-controller")
-	vca.nodeController, err = NewNodeController(vca.clientSet, vca.nodeInformer, vca.vmiInformer, recorder)
+	vca.nodeController, err = NewNodeController(vca.clientSet, vca.nodeInformer, vca.vmiInformer, recorder, vca.clusterConfig)
 	if err != nil {
 		panic(err)
 	}
@@ -648,6 +651,7 @@ func (vca *VirtControllerApp) initCommon() {
 	}
 
 	vca.nodeTopologyUpdater = topology.NewNodeTopologyUpdater(vca.clientSet, topologyHinter, vca.nodeInformer)
+	vca.cpuBaselineUpdater = topology.NewCPUBaselineUpdater(vca.clientSet, vca.nodeInformer, vca.clusterConfig)
 }
 
 func (vca *VirtControllerApp) initReplicaSet() {
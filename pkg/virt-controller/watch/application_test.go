@@ -75,6 +75,8 @@ var _ = Describe("Application", func() {
 		virtClient := kubecli.NewMockKubevirtClient(ctrl)
 		topologyUpdater := topology.NewMockNodeTopologyUpdater(ctrl)
 		topologyUpdater.EXPECT().Run(gomock.Any(), gomock.Any())
+		cpuBaselineUpdater := topology.NewMockCPUBaselineUpdater(ctrl)
+		cpuBaselineUpdater.EXPECT().Run(gomock.Any(), gomock.Any())
 
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
@@ -123,10 +125,11 @@ var _ = Describe("Application", func() {
 
 		app.vmiInformer = vmiInformer
 		app.nodeTopologyUpdater = topologyUpdater
+		app.cpuBaselineUpdater = cpuBaselineUpdater
 		app.informerFactory = controller.NewKubeInformerFactory(nil, nil, nil, "test")
 		app.evacuationController, _ = evacuation.NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, recorder, virtClient, config)
 		app.disruptionBudgetController, _ = disruptionbudget.NewDisruptionBudgetController(vmiInformer, pdbInformer, podInformer, migrationInformer, recorder, virtClient, config)
-		app.nodeController, _ = NewNodeController(virtClient, nodeInformer, vmiInformer, recorder)
+		app.nodeController, _ = NewNodeController(virtClient, nodeInformer, vmiInformer, recorder, config)
 		app.vmiController, _ = NewVMIController(services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", "g", pvcInformer.GetStore(), virtClient, config, qemuGid, "h"),
 			vmiInformer,
 			vmInformer,
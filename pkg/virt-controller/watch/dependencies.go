@@ -0,0 +1,230 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package watch
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	k8score "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const (
+	dependsOnIndex = "dependsOn"
+
+	WaitingForDependenciesReason = "WaitingForDependencies"
+	WaitingForDependentsReason   = "WaitingForDependents"
+	DependencyCycleReason        = "DependencyCycle"
+)
+
+// unreadyDependencies returns the names of the VMs the given VM depends on which did not reach their condition yet
+func (c *VMController) unreadyDependencies(vm *virtv1.VirtualMachine) []string {
+	var unready []string
+	for _, dependency := range vm.Spec.DependsOn {
+		if !c.isDependencyReady(vm.Namespace, dependency) {
+			unready = append(unready, dependency.Name)
+		}
+	}
+	return unready
+}
+
+func (c *VMController) isDependencyReady(namespace string, dependency virtv1.VirtualMachineDependency) bool {
+	obj, exists, err := c.vmiInformer.GetStore().GetByKey(controller.NamespacedKey(namespace, dependency.Name))
+	if err != nil || !exists {
+		return false
+	}
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+	if vmi.DeletionTimestamp != nil || vmi.IsFinal() {
+		return false
+	}
+
+	conditionType := virtv1.VirtualMachineInstanceReady
+	if dependency.Condition == virtv1.DependencyAgentConnected {
+		conditionType = virtv1.VirtualMachineInstanceAgentConnected
+	}
+	return controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi, conditionType, k8score.ConditionTrue)
+}
+
+// stoppingDependents returns the names of the VMs depending on the given VM which are stopped as well
+// but still have a running VMI. Dependents which keep running do not hold back the stop of the VM, and
+// neither do dependents the VM depends on itself, as they would wait for each other forever.
+func (c *VMController) stoppingDependents(vm *virtv1.VirtualMachine) []string {
+	objs, err := c.vmInformer.GetIndexer().ByIndex(dependsOnIndex, controller.NamespacedKey(vm.Namespace, vm.Name))
+	if err != nil {
+		log.Log.Object(vm).Reason(err).Error("Failed to look up the dependents of the VM")
+		return nil
+	}
+
+	var stopping []string
+	for _, obj := range objs {
+		dependent := obj.(*virtv1.VirtualMachine)
+		vmiObj, exists, err := c.vmiInformer.GetStore().GetByKey(controller.NamespacedKey(dependent.Namespace, dependent.Name))
+		if err != nil || !exists {
+			continue
+		}
+		vmi := vmiObj.(*virtv1.VirtualMachineInstance)
+		if vmi.IsFinal() || c.dependsOn(vm, dependent.Name) {
+			continue
+		}
+		if dependent.DeletionTimestamp != nil || !isSetToStart(dependent, vmi) {
+			stopping = append(stopping, dependent.Name)
+		}
+	}
+	sort.Strings(stopping)
+	return stopping
+}
+
+// findDependencyCycle returns the names of the VMs forming a dependency cycle with the given VM,
+// starting and ending with the VM itself, or nil if there is no such cycle
+func (c *VMController) findDependencyCycle(vm *virtv1.VirtualMachine) []string {
+	path := []string{vm.Name}
+	visited := map[string]bool{}
+
+	var visit func(current *virtv1.VirtualMachine) bool
+	visit = func(current *virtv1.VirtualMachine) bool {
+		for _, dependency := range current.Spec.DependsOn {
+			if dependency.Name == vm.Name {
+				path = append(path, dependency.Name)
+				return true
+			}
+			if visited[dependency.Name] {
+				continue
+			}
+			visited[dependency.Name] = true
+
+			obj, exists, err := c.vmInformer.GetStore().GetByKey(controller.NamespacedKey(vm.Namespace, dependency.Name))
+			if err != nil || !exists {
+				continue
+			}
+			path = append(path, dependency.Name)
+			if visit(obj.(*virtv1.VirtualMachine)) {
+				return true
+			}
+			path = path[:len(path)-1]
+		}
+		return false
+	}
+
+	if visit(vm) {
+		return path
+	}
+	return nil
+}
+
+// dependsOn returns whether the given VM depends on the named VM, directly or through other VMs
+func (c *VMController) dependsOn(vm *virtv1.VirtualMachine, name string) bool {
+	visited := map[string]bool{}
+	queue := []*virtv1.VirtualMachine{vm}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, dependency := range current.Spec.DependsOn {
+			if dependency.Name == name {
+				return true
+			}
+			if visited[dependency.Name] {
+				continue
+			}
+			visited[dependency.Name] = true
+
+			obj, exists, err := c.vmInformer.GetStore().GetByKey(controller.NamespacedKey(vm.Namespace, dependency.Name))
+			if err != nil || !exists {
+				continue
+			}
+			queue = append(queue, obj.(*virtv1.VirtualMachine))
+		}
+	}
+	return false
+}
+
+// enqueueDependencyGroup wakes up the VMs depending on the VM of the given VMI, which may wait for it
+// to become ready, and the VMs it depends on, which may wait for it to be stopped
+func (c *VMController) enqueueDependencyGroup(vmi *virtv1.VirtualMachineInstance) {
+	objs, err := c.vmInformer.GetIndexer().ByIndex(dependsOnIndex, controller.NamespacedKey(vmi.Namespace, vmi.Name))
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to look up the dependents of the VMI")
+		return
+	}
+	for _, obj := range objs {
+		c.enqueueVm(obj)
+	}
+
+	obj, exists, err := c.vmInformer.GetStore().GetByKey(controller.NamespacedKey(vmi.Namespace, vmi.Name))
+	if err != nil || !exists {
+		return
+	}
+	for _, dependency := range obj.(*virtv1.VirtualMachine).Spec.DependsOn {
+		c.Queue.Add(controller.NamespacedKey(vmi.Namespace, dependency.Name))
+	}
+}
+
+// syncDependenciesPendingCondition flags a VM whose start waits for its dependencies
+// or whose stop waits for its dependents
+func (c *VMController) syncDependenciesPendingCondition(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	vmConditionManager := controller.NewVirtualMachineConditionManager()
+
+	var reason, message string
+	switch {
+	case vmi == nil && len(vm.Spec.DependsOn) > 0 && vm.DeletionTimestamp == nil && isSetToStart(vm, vmi):
+		if cycle := c.findDependencyCycle(vm); cycle != nil {
+			reason = DependencyCycleReason
+			message = fmt.Sprintf("The VirtualMachines %s depend on each other", strings.Join(cycle, " -> "))
+		} else if unready := c.unreadyDependencies(vm); len(unready) > 0 {
+			reason = WaitingForDependenciesReason
+			message = fmt.Sprintf("Waiting for the VirtualMachines %s before starting", strings.Join(unready, ", "))
+		}
+	case vmi != nil && vmi.DeletionTimestamp == nil && !vmi.IsFinal() && (vm.DeletionTimestamp != nil || !isSetToStart(vm, vmi)):
+		if dependents := c.stoppingDependents(vm); len(dependents) > 0 {
+			reason = WaitingForDependentsReason
+			message = fmt.Sprintf("Waiting for the VirtualMachines %s to stop first", strings.Join(dependents, ", "))
+		} else if cycle := c.findDependencyCycle(vm); cycle != nil {
+			reason = DependencyCycleReason
+			message = fmt.Sprintf("The VirtualMachines %s depend on each other, stopping without waiting for them", strings.Join(cycle, " -> "))
+		}
+	}
+
+	if reason == "" {
+		vmConditionManager.RemoveCondition(vm, virtv1.VirtualMachineDependenciesPending)
+		return
+	}
+
+	vmConditionManager.UpdateCondition(vm, &virtv1.VirtualMachineCondition{
+		Type:               virtv1.VirtualMachineDependenciesPending,
+		Status:             k8score.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: v1.Now(),
+	})
+}
+
+// isVirtualMachineStatusWaitingForDependencies determines whether the VM status field should be set to "WaitingForDependencies".
+func (c *VMController) isVirtualMachineStatusWaitingForDependencies(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
+	if vmi != nil || len(vm.Spec.DependsOn) == 0 || c.isVMIStartExpected(vm) || !isSetToStart(vm, vmi) {
+		return false
+	}
+	return len(c.unreadyDependencies(vm)) > 0
+}
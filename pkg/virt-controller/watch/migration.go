@@ -93,6 +93,17 @@ const defaultCatchAllPendingTimeoutSeconds = int64(60 * 15)
 
 var migrationBackoffError = errors.New(MigrationBackoffReason)
 
+// migrationQueuedError is returned when a migration has to wait for other migrations
+// before its target pod can be created. It is reflected in the migrationQueued condition.
+type migrationQueuedError struct {
+	reason  string
+	message string
+}
+
+func (e *migrationQueuedError) Error() string {
+	return e.message
+}
+
 type MigrationController struct {
 	templateService         services.TemplateService
 	clientset               kubecli.KubevirtClient
@@ -358,7 +369,8 @@ func (c *MigrationController) execute(key string) error {
 		return err
 	}
 
-	if syncErr != nil {
+	var queuedErr *migrationQueuedError
+	if syncErr != nil && !errors.As(syncErr, &queuedErr) {
 		return syncErr
 	}
 
@@ -414,6 +426,7 @@ func (c *MigrationController) updateStatus(migration *virtv1.VirtualMachineInsta
 	conditionManager := controller.NewVirtualMachineInstanceMigrationConditionManager()
 	vmiConditionManager := controller.NewVirtualMachineInstanceConditionManager()
 	migrationCopy := migration.DeepCopy()
+	var queuedErr *migrationQueuedError
 
 	podExists, attachmentPodExists := len(pods) > 0, false
 	if podExists {
@@ -534,11 +547,16 @@ func (c *MigrationController) updateStatus(migration *virtv1.VirtualMachineInsta
 					LastProbeTime: v1.Now(),
 				}
 				migrationCopy.Status.Conditions = append(migrationCopy.Status.Conditions, condition)
+			} else if errors.As(syncError, &queuedErr) {
+				setMigrationQueuedCondition(migrationCopy, queuedErr)
 			}
 		case virtv1.MigrationScheduling:
 			if conditionManager.HasCondition(migrationCopy, virtv1.VirtualMachineInstanceMigrationRejectedByResourceQuota) {
 				conditionManager.RemoveCondition(migrationCopy, virtv1.VirtualMachineInstanceMigrationRejectedByResourceQuota)
 			}
+			if conditionManager.HasCondition(migrationCopy, virtv1.VirtualMachineInstanceMigrationQueued) {
+				conditionManager.RemoveCondition(migrationCopy, virtv1.VirtualMachineInstanceMigrationQueued)
+			}
 			if isPodReady(pod) {
 				if controller.VMIHasHotplugVolumes(vmi) {
 					if attachmentPodExists && isPodReady(attachmentPod) {
@@ -980,11 +998,15 @@ func (c *MigrationController) handleTargetPodCreation(key string, migration *vir
 	}
 
 	// XXX: Make this configurable, think about limit per node, bandwidth per migration, and so on.
-	if len(runningMigrations) >= int(*c.clusterConfig.GetMigrationConfiguration().ParallelMigrationsPerCluster) {
+	clusterLimit := int(*c.clusterConfig.GetMigrationConfiguration().ParallelMigrationsPerCluster)
+	if len(runningMigrations) >= clusterLimit {
 		log.Log.Object(migration).Infof("Waiting to schedule target pod for vmi [%s/%s] migration because total running parallel migration count [%d] is currently at the global cluster limit.", vmi.Namespace, vmi.Name, len(runningMigrations))
 		// Let's wait until some migrations are done
 		c.Queue.AddAfter(key, time.Second*5)
-		return nil
+		return &migrationQueuedError{
+			reason:  virtv1.MigrationQueuedClusterLimitReason,
+			message: fmt.Sprintf("%d migrations are running, which is the limit of parallel migrations per cluster", len(runningMigrations)),
+		}
 	}
 
 	outboundMigrations, err := c.outboundMigrationsOnNode(vmi.Status.NodeName, runningMigrations)
@@ -998,7 +1020,23 @@ func (c *MigrationController) handleTargetPodCreation(key string, migration *vir
 		// XXX: Make this configurable, thinkg about inbound migration limit, bandwidh per migration, and so on.
 		log.Log.Object(migration).Infof("Waiting to schedule target pod for vmi [%s/%s] migration because total running parallel outbound migrations on target node [%d] has hit outbound migrations per node limit.", vmi.Namespace, vmi.Name, outboundMigrations)
 		c.Queue.AddAfter(key, time.Second*5)
-		return nil
+		return &migrationQueuedError{
+			reason:  virtv1.MigrationQueuedNodeLimitReason,
+			message: fmt.Sprintf("%d migrations are running from node %s, which is the limit of parallel outbound migrations per node", outboundMigrations, vmi.Status.NodeName),
+		}
+	}
+
+	queuedAhead, err := c.migrationsQueuedAhead(migration, runningMigrations)
+	if err != nil {
+		return err
+	}
+	if queuedAhead >= clusterLimit-len(runningMigrations) {
+		log.Log.Object(migration).Infof("Waiting to schedule target pod for vmi [%s/%s] migration because [%d] queued migrations have to be started first.", vmi.Namespace, vmi.Name, queuedAhead)
+		c.Queue.AddAfter(key, time.Second*5)
+		return &migrationQueuedError{
+			reason:  virtv1.MigrationQueuedBehindOtherMigrationsReason,
+			message: fmt.Sprintf("%d queued migrations with a higher priority or from namespaces with less running migrations are started first", queuedAhead),
+		}
 	}
 
 	// migration was accepted into the system, now see if we
@@ -1806,6 +1844,74 @@ func (c *MigrationController) findRunningMigrations() ([]*virtv1.VirtualMachineI
 	return runningMigrations, nil
 }
 
+// migrationsQueuedAhead counts the queued migrations which have to be started before the given one.
+// Only migrations which already wait for a free slot are taken into account, and only if the
+// outbound migrations limit of their source node would let them start.
+func (c *MigrationController) migrationsQueuedAhead(migration *virtv1.VirtualMachineInstanceMigration, runningMigrations []*virtv1.VirtualMachineInstanceMigration) (int, error) {
+	conditionManager := controller.NewVirtualMachineInstanceMigrationConditionManager()
+	nodeLimit := int(*c.clusterConfig.GetMigrationConfiguration().ParallelOutboundMigrationsPerNode)
+
+	running := map[types.UID]bool{}
+	for _, runningMigration := range runningMigrations {
+		running[runningMigration.UID] = true
+	}
+
+	queued := []*virtv1.VirtualMachineInstanceMigration{migration}
+	for _, candidate := range migrations.ListUnfinishedMigrations(c.migrationInformer) {
+		if candidate.UID == migration.UID || running[candidate.UID] ||
+			candidate.DeletionTimestamp != nil ||
+			candidate.Status.Phase != virtv1.MigrationPending ||
+			!conditionManager.HasCondition(candidate, virtv1.VirtualMachineInstanceMigrationQueued) {
+			continue
+		}
+		obj, exists, err := c.vmiInformer.GetStore().GetByKey(candidate.Namespace + "/" + candidate.Spec.VMIName)
+		if err != nil {
+			return 0, err
+		}
+		if !exists || !obj.(*virtv1.VirtualMachineInstance).IsRunning() {
+			continue
+		}
+		outboundMigrations, err := c.outboundMigrationsOnNode(obj.(*virtv1.VirtualMachineInstance).Status.NodeName, runningMigrations)
+		if err != nil {
+			return 0, err
+		}
+		if outboundMigrations >= nodeLimit {
+			continue
+		}
+		queued = append(queued, candidate)
+	}
+
+	migrations.SortQueuedMigrations(queued, runningMigrations)
+	for i, queuedMigration := range queued {
+		if queuedMigration.UID == migration.UID {
+			return i, nil
+		}
+	}
+	return 0, nil
+}
+
+func setMigrationQueuedCondition(migration *virtv1.VirtualMachineInstanceMigration, queuedErr *migrationQueuedError) {
+	for i, cond := range migration.Status.Conditions {
+		if cond.Type != virtv1.VirtualMachineInstanceMigrationQueued {
+			continue
+		}
+		if cond.Reason != queuedErr.reason || cond.Message != queuedErr.message {
+			migration.Status.Conditions[i].Reason = queuedErr.reason
+			migration.Status.Conditions[i].Message = queuedErr.message
+			migration.Status.Conditions[i].LastProbeTime = v1.Now()
+		}
+		return
+	}
+	migration.Status.Conditions = append(migration.Status.Conditions, virtv1.VirtualMachineInstanceMigrationCondition{
+		Type:               virtv1.VirtualMachineInstanceMigrationQueued,
+		Status:             k8sv1.ConditionTrue,
+		LastProbeTime:      v1.Now(),
+		LastTransitionTime: v1.Now(),
+		Reason:             queuedErr.reason,
+		Message:            queuedErr.message,
+	})
+}
+
 func (c *MigrationController) getNodeForVMI(vmi *virtv1.VirtualMachineInstance) (*k8sv1.Node, error) {
 	obj, exists, err := c.nodeInformer.GetStore().GetByKey(vmi.Status.NodeName)
 
@@ -667,6 +667,7 @@ var _ = Describe("Migration watcher", func() {
 				addVirtualMachineInstance(vmi)
 			}
 
+			shouldExpectMigrationCondition(migration, virtv1.VirtualMachineInstanceMigrationQueued)
 			controller.Execute()
 		})
 
@@ -700,6 +701,7 @@ var _ = Describe("Migration watcher", func() {
 				Expect(podInformer.GetStore().Add(pod)).To(Succeed())
 			}
 
+			shouldExpectMigrationCondition(migration, virtv1.VirtualMachineInstanceMigrationQueued)
 			controller.Execute()
 		})
 
@@ -742,9 +744,124 @@ var _ = Describe("Migration watcher", func() {
 				addVirtualMachineInstance(vmi)
 			}
 
+			shouldExpectMigrationCondition(migration, virtv1.VirtualMachineInstanceMigrationQueued)
 			controller.Execute()
 		})
 
+		Context("with queued migrations", func() {
+			addQueuedMigration := func(name string, namespace string, annotations map[string]string) {
+				vmi := newVirtualMachine(name+"-vmi", virtv1.Running)
+				vmi.Namespace = namespace
+				vmi.Status.NodeName = name + "-node"
+				migration := newMigration(name, vmi.Name, virtv1.MigrationPending)
+				migration.Namespace = namespace
+				for key, value := range annotations {
+					migration.Annotations[key] = value
+				}
+				migration.Status.Conditions = []virtv1.VirtualMachineInstanceMigrationCondition{{
+					Type:   virtv1.VirtualMachineInstanceMigrationQueued,
+					Status: k8sv1.ConditionTrue,
+				}}
+
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+			}
+
+			addRunningMigrations := func(count int, namespace string) {
+				for i := 0; i < count; i++ {
+					vmi := newVirtualMachine(fmt.Sprintf("running-vmi%v", i), virtv1.Running)
+					vmi.Namespace = namespace
+					vmi.Status.NodeName = fmt.Sprintf("running-node%v", i)
+					migration := newMigration(fmt.Sprintf("running-migration%v", i), vmi.Name, virtv1.MigrationScheduling)
+					migration.Namespace = namespace
+
+					addMigration(migration)
+					addVirtualMachineInstance(vmi)
+				}
+			}
+
+			expectQueuedReason := func(migration *virtv1.VirtualMachineInstanceMigration, reason string) {
+				migrationInterface.EXPECT().UpdateStatus(gomock.Any()).DoAndReturn(func(arg interface{}) (interface{}, interface{}) {
+					vmim := arg.(*virtv1.VirtualMachineInstanceMigration)
+					Expect(vmim.Name).To(Equal(migration.Name))
+					Expect(vmim.Status.Conditions).To(ContainElement(And(
+						HaveField("Type", virtv1.VirtualMachineInstanceMigrationQueued),
+						HaveField("Reason", reason),
+					)))
+					return arg, nil
+				})
+			}
+
+			It("should wait for queued evacuations before starting a user requested migration", func() {
+				vmi := newVirtualMachine("testvmi", virtv1.Running)
+				migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+
+				addRunningMigrations(4, k8sv1.NamespaceDefault)
+				addQueuedMigration("evacuation", k8sv1.NamespaceDefault, map[string]string{virtv1.EvacuationMigrationAnnotation: "node"})
+
+				expectQueuedReason(migration, virtv1.MigrationQueuedBehindOtherMigrationsReason)
+				controller.Execute()
+			})
+
+			It("should start an evacuation before queued workload updates", func() {
+				vmi := newVirtualMachine("testvmi", virtv1.Running)
+				migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+				migration.Annotations[virtv1.EvacuationMigrationAnnotation] = vmi.Status.NodeName
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+
+				addRunningMigrations(4, k8sv1.NamespaceDefault)
+				addQueuedMigration("workload-update", k8sv1.NamespaceDefault, map[string]string{virtv1.WorkloadUpdateMigrationAnnotation: ""})
+
+				shouldExpectPodCreation(vmi.UID, migration.UID, 1, 0, 0)
+				controller.Execute()
+				testutils.ExpectEvent(recorder, SuccessfulCreatePodReason)
+			})
+
+			It("should let a migration with an explicit priority overtake evacuations", func() {
+				priority := virtv1.MigrationPriorityHigh
+				vmi := newVirtualMachine("testvmi", virtv1.Running)
+				migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+				migration.Spec.Priority = &priority
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+
+				addRunningMigrations(4, "other")
+				addQueuedMigration("evacuation", k8sv1.NamespaceDefault, map[string]string{virtv1.EvacuationMigrationAnnotation: "node"})
+
+				shouldExpectPodCreation(vmi.UID, migration.UID, 1, 0, 0)
+				controller.Execute()
+				testutils.ExpectEvent(recorder, SuccessfulCreatePodReason)
+			})
+
+			It("should prefer migrations from namespaces with less running migrations", func() {
+				vmi := newVirtualMachine("testvmi", virtv1.Running)
+				migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+
+				addRunningMigrations(4, k8sv1.NamespaceDefault)
+				addQueuedMigration("other-namespace", "other", nil)
+
+				expectQueuedReason(migration, virtv1.MigrationQueuedBehindOtherMigrationsReason)
+				controller.Execute()
+			})
+
+			It("should report the cluster limit as the reason for waiting", func() {
+				vmi := newVirtualMachine("testvmi", virtv1.Running)
+				migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+				addMigration(migration)
+				addVirtualMachineInstance(vmi)
+
+				addRunningMigrations(5, k8sv1.NamespaceDefault)
+
+				expectQueuedReason(migration, virtv1.MigrationQueuedClusterLimitReason)
+				controller.Execute()
+			})
+		})
+
 		It("should create target pod and not override existing affinity rules", func() {
 			vmi := newVirtualMachine("testvmi", virtv1.Running)
 			antiAffinityTerm := k8sv1.PodAffinityTerm{
@@ -1761,6 +1878,25 @@ var _ = Describe("Migration watcher", func() {
 				},
 				true,
 			),
+			Entry("set parallel migration streams",
+				func(p *migrationsv1.MigrationPolicySpec) { p.ParallelMigrationStreams = pointer.Uint32(4) },
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.ParallelMigrationStreams).ToNot(BeNil())
+					Expect(*c.ParallelMigrationStreams).To(Equal(uint32(4)))
+				},
+				true,
+			),
+			Entry("set compression",
+				func(p *migrationsv1.MigrationPolicySpec) {
+					compression := virtv1.MigrationCompressionZstd
+					p.Compression = &compression
+				},
+				func(c *virtv1.MigrationConfiguration) {
+					Expect(c.Compression).ToNot(BeNil())
+					Expect(*c.Compression).To(Equal(virtv1.MigrationCompressionZstd))
+				},
+				true,
+			),
 			Entry("nothing is changed",
 				func(p *migrationsv1.MigrationPolicySpec) {},
 				func(c *virtv1.MigrationConfiguration) {},
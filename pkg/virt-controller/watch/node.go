@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
@@ -23,12 +25,29 @@ import (
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/util/lookup"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
 const (
 	// NodeUnresponsiveReason is in various places as reason to indicate that
 	// an action was taken because virt-handler became unresponsive.
 	NodeUnresponsiveReason = "NodeUnresponsive"
+	// NodeFailoverReason is used as reason when VMIs are failed over
+	// because the node they are running on is NotReady.
+	NodeFailoverReason = "NodeFailover"
+	// NodeFailoverWaitingForFencingReason is added in an event if VMIs on a NotReady
+	// node are not failed over because the node was not fenced yet.
+	NodeFailoverWaitingForFencingReason = "NodeFailoverWaitingForFencing"
+)
+
+var (
+	nodeFailovers = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kubevirt_vmi_node_failovers_total",
+			Help: "Total number of VirtualMachineInstances which were failed over because their node was NotReady.",
+		},
+		[]string{"node"},
+	)
 )
 
 // NodeController is the main NodeController struct.
@@ -38,20 +57,27 @@ type NodeController struct {
 	nodeInformer     cache.SharedIndexInformer
 	vmiInformer      cache.SharedIndexInformer
 	recorder         record.EventRecorder
+	clusterConfig    *virtconfig.ClusterConfig
 	heartBeatTimeout time.Duration
 	recheckInterval  time.Duration
+
+	waitingForFencingLock sync.Mutex
+	waitingForFencing     map[string]bool
 }
 
 // NewNodeController creates a new instance of the NodeController struct.
-func NewNodeController(clientset kubecli.KubevirtClient, nodeInformer cache.SharedIndexInformer, vmiInformer cache.SharedIndexInformer, recorder record.EventRecorder) (*NodeController, error) {
+func NewNodeController(clientset kubecli.KubevirtClient, nodeInformer cache.SharedIndexInformer, vmiInformer cache.SharedIndexInformer, recorder record.EventRecorder, clusterConfig *virtconfig.ClusterConfig) (*NodeController, error) {
 	c := &NodeController{
 		clientset:        clientset,
 		Queue:            workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "virt-controller-node"),
 		nodeInformer:     nodeInformer,
 		vmiInformer:      vmiInformer,
 		recorder:         recorder,
+		clusterConfig:    clusterConfig,
 		heartBeatTimeout: 5 * time.Minute,
 		recheckInterval:  1 * time.Minute,
+
+		waitingForFencing: map[string]bool{},
 	}
 
 	_, err := c.nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -183,6 +209,20 @@ func (c *NodeController) execute(key string) error {
 
 	}
 
+	if nodeExists && c.clusterConfig.NodeFailoverEnabled() {
+		if notReadySince, notReady := nodeNotReadySince(node); notReady {
+			if remaining := c.clusterConfig.GetNodeFailoverNotReadyTimeout() - time.Since(notReadySince); remaining > 0 {
+				c.Queue.AddAfter(key, remaining)
+			} else if err := c.failoverNode(node, logger); err != nil {
+				return err
+			}
+		} else {
+			c.setWaitingForFencing(key, false)
+		}
+	} else {
+		c.setWaitingForFencing(key, false)
+	}
+
 	unresponsive, err := isNodeUnresponsive(node, c.heartBeatTimeout)
 	if err != nil {
 		logger.Reason(err).Error("Failed to determine if node is responsive, will not reenqueue")
@@ -269,7 +309,7 @@ func (c *NodeController) createAndApplyFailedVMINodeUnresponsivePatch(vmi *virtv
 	c.recorder.Event(vmi, v1.EventTypeNormal, NodeUnresponsiveReason, fmt.Sprintf("virt-handler on node %s is not responsive, marking VMI as failed", vmi.Status.NodeName))
 	logger.V(2).Infof("Moving vmi %s in namespace %s on unresponsive node to failed state", vmi.Name, vmi.Namespace)
 
-	patchBytes, err := generateFailedVMIPatch(vmi.Status.Reason)
+	patchBytes, err := generateFailedVMIPatch(vmi.Status.Reason, NodeUnresponsiveReason)
 	if err != nil {
 		return err
 	}
@@ -282,7 +322,7 @@ func (c *NodeController) createAndApplyFailedVMINodeUnresponsivePatch(vmi *virtv
 	return nil
 }
 
-func generateFailedVMIPatch(reason string) ([]byte, error) {
+func generateFailedVMIPatch(reason string, newReason string) ([]byte, error) {
 	reasonOp := "add"
 	if reason != "" {
 		reasonOp = "replace"
@@ -297,7 +337,7 @@ func generateFailedVMIPatch(reason string) ([]byte, error) {
 		patch.PatchOperation{
 			Op:    reasonOp,
 			Path:  "/status/reason",
-			Value: NodeUnresponsiveReason,
+			Value: newReason,
 		},
 	)
 }
@@ -475,3 +515,86 @@ func isNodeUnresponsive(node *v1.Node, timeout time.Duration) (bool, error) {
 	}
 	return false, nil
 }
+
+// failoverNode fails all VMIs on a NotReady node, so that their VMs can be restarted on other nodes.
+// The controller never releases volumes itself, once the node is fenced with the out-of-service
+// taint, Kubernetes force deletes the pods and detaches their volumes.
+func (c *NodeController) failoverNode(node *v1.Node, logger *log.FilteredLogger) error {
+	vmis, err := lookup.ActiveVirtualMachinesOnNode(c.clientset, node.Name)
+	if err != nil {
+		logger.Reason(err).Error("Failed fetching vmis for node")
+		return err
+	}
+
+	if !isNodeFenced(node) && c.clusterConfig.NodeFailoverRequiresFencing() {
+		if len(vmis) > 0 && c.setWaitingForFencing(node.Name, true) {
+			c.recorder.Eventf(node, v1.EventTypeWarning, NodeFailoverWaitingForFencingReason, "Node is NotReady, waiting for the node to be fenced before failing over %d VirtualMachineInstances", len(vmis))
+		}
+		return nil
+	}
+	c.setWaitingForFencing(node.Name, false)
+
+	if len(vmis) == 0 {
+		return nil
+	}
+
+	c.recorder.Eventf(node, v1.EventTypeWarning, NodeFailoverReason, "Node is NotReady, failing over %d VirtualMachineInstances", len(vmis))
+	errs := []string{}
+	for _, vmi := range vmis {
+		c.recorder.Eventf(vmi, v1.EventTypeWarning, NodeFailoverReason, "Node %s is NotReady, marking VMI as failed", node.Name)
+		logger.V(2).Infof("Failing over vmi %s in namespace %s on NotReady node", vmi.Name, vmi.Namespace)
+
+		patchBytes, err := generateFailedVMIPatch(vmi.Status.Reason, NodeFailoverReason)
+		if err != nil {
+			return err
+		}
+		if _, err := c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, &metav1.PatchOptions{}); err != nil {
+			errs = append(errs, fmt.Sprintf("failed to fail over vmi %s in namespace %s: %v", vmi.Name, vmi.Namespace, err))
+			continue
+		}
+		nodeFailovers.WithLabelValues(node.Name).Inc()
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%v", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// nodeNotReadySince returns since when the node is NotReady, if it is
+func nodeNotReadySince(node *v1.Node) (time.Time, bool) {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == v1.NodeReady {
+			return condition.LastTransitionTime.Time, condition.Status != v1.ConditionTrue
+		}
+	}
+	return time.Time{}, false
+}
+
+// isNodeFenced returns whether the node was tainted as out-of-service, which signals
+// that it was powered off or isolated from its storage
+func isNodeFenced(node *v1.Node) bool {
+	for _, taint := range node.Spec.Taints {
+		if taint.Key == v1.TaintNodeOutOfService {
+			return true
+		}
+	}
+	return false
+}
+
+// setWaitingForFencing records whether the failover of a node waits for the node to be fenced
+// and returns whether that changed
+func (c *NodeController) setWaitingForFencing(nodeName string, waiting bool) bool {
+	c.waitingForFencingLock.Lock()
+	defer c.waitingForFencingLock.Unlock()
+
+	if c.waitingForFencing[nodeName] == waiting {
+		return false
+	}
+	if waiting {
+		c.waitingForFencing[nodeName] = true
+	} else {
+		delete(c.waitingForFencing, nodeName)
+	}
+	return true
+}
@@ -21,6 +21,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	framework "k8s.io/client-go/tools/cache/testing"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
 
 	"kubevirt.io/client-go/api"
 
@@ -29,6 +30,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
 var _ = Describe("Node controller with", func() {
@@ -46,6 +48,7 @@ var _ = Describe("Node controller with", func() {
 	var virtClient *kubecli.MockKubevirtClient
 	var kubeClient *fake.Clientset
 	var vmiFeeder *testutils.VirtualMachineFeeder
+	var kvInformer cache.SharedIndexInformer
 
 	syncCaches := func(stop chan struct{}) {
 		go nodeInformer.Run(stop)
@@ -64,7 +67,9 @@ var _ = Describe("Node controller with", func() {
 		recorder = record.NewFakeRecorder(100)
 		recorder.IncludeObject = true
 
-		controller, _ = NewNodeController(virtClient, nodeInformer, vmiInformer, recorder)
+		var config *virtconfig.ClusterConfig
+		config, _, kvInformer = testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{})
+		controller, _ = NewNodeController(virtClient, nodeInformer, vmiInformer, recorder, config)
 		// Wrap our workqueue to have a way to detect when we are done processing updates
 		mockQueue = testutils.NewMockWorkQueue(controller.Queue)
 		controller.Queue = mockQueue
@@ -331,6 +336,94 @@ var _ = Describe("Node controller with", func() {
 		)
 	})
 
+	Context("node failover", func() {
+		var node *k8sv1.Node
+		var vmi *virtv1.VirtualMachineInstance
+
+		enableNodeFailover := func(failover *virtv1.NodeFailoverConfiguration) {
+			kv := testutils.GetFakeKubeVirtClusterConfig(kvInformer)
+			kv.Spec.Configuration.NodeFailover = failover
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, kv)
+		}
+
+		expectVMIFailedOver := func() {
+			vmiInterface.EXPECT().List(context.Background(), gomock.Any()).Return(&virtv1.VirtualMachineInstanceList{Items: []virtv1.VirtualMachineInstance{*vmi}}, nil)
+			vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, gomock.Any(), &v1.PatchOptions{}).Do(
+				func(_ context.Context, _ string, _ types.PatchType, data []byte, _ *v1.PatchOptions, _ ...string) {
+					Expect(string(data)).To(ContainSubstring(fmt.Sprintf(`"value":"%s"`, NodeFailoverReason)))
+				})
+		}
+
+		BeforeEach(func() {
+			node = NewHealthyNode("testnode")
+			node.Status.Conditions = []k8sv1.NodeCondition{{
+				Type:               k8sv1.NodeReady,
+				Status:             k8sv1.ConditionUnknown,
+				LastTransitionTime: v1.NewTime(time.Now().Add(-2 * time.Minute)),
+			}}
+			vmi = NewRunningVirtualMachine("vmi", node)
+		})
+
+		It("should do nothing when node failover is disabled", func() {
+			addNode(node)
+
+			controller.Execute()
+		})
+
+		It("should wait for the NotReady timeout to pass", func() {
+			enableNodeFailover(&virtv1.NodeFailoverConfiguration{NotReadyTimeout: &v1.Duration{Duration: 5 * time.Minute}})
+			addNode(node)
+
+			controller.Execute()
+		})
+
+		It("should wait for the node to be fenced", func() {
+			enableNodeFailover(&virtv1.NodeFailoverConfiguration{})
+			addNode(node)
+
+			vmiInterface.EXPECT().List(context.Background(), gomock.Any()).Return(&virtv1.VirtualMachineInstanceList{Items: []virtv1.VirtualMachineInstance{*vmi}}, nil)
+
+			controller.Execute()
+			testutils.ExpectEvent(recorder, NodeFailoverWaitingForFencingReason)
+		})
+
+		It("should fail over the vmis without fencing if fencing is not required", func() {
+			enableNodeFailover(&virtv1.NodeFailoverConfiguration{RequireFencing: pointer.Bool(false)})
+			addNode(node)
+
+			expectVMIFailedOver()
+
+			controller.Execute()
+			testutils.ExpectEvent(recorder, NodeFailoverReason)
+			testutils.ExpectEvent(recorder, NodeFailoverReason)
+		})
+
+		It("should only emit the waiting for fencing event when the node starts waiting", func() {
+			enableNodeFailover(&virtv1.NodeFailoverConfiguration{})
+			addNode(node)
+
+			vmiInterface.EXPECT().List(context.Background(), gomock.Any()).Return(&virtv1.VirtualMachineInstanceList{Items: []virtv1.VirtualMachineInstance{*vmi}}, nil).Times(2)
+
+			controller.Execute()
+			testutils.ExpectEvent(recorder, NodeFailoverWaitingForFencingReason)
+
+			mockQueue.Add(node.Name)
+			controller.Execute()
+		})
+
+		It("should fail over the vmis of a node fenced by the out-of-service taint without deleting anything", func() {
+			enableNodeFailover(&virtv1.NodeFailoverConfiguration{})
+			node.Spec.Taints = []k8sv1.Taint{{Key: k8sv1.TaintNodeOutOfService, Effect: k8sv1.TaintEffectNoExecute}}
+			addNode(node)
+
+			expectVMIFailedOver()
+
+			controller.Execute()
+			testutils.ExpectEvent(recorder, NodeFailoverReason)
+			testutils.ExpectEvent(recorder, NodeFailoverReason)
+		})
+	})
+
 	Context("check for orphaned vmis", func() {
 
 		var node *k8sv1.Node
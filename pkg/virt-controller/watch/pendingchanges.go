@@ -0,0 +1,165 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package watch
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	virtv1 "kubevirt.io/api/core/v1"
+)
+
+// specChange is a field which differs between the spec a VMI was started from and the desired spec.
+// current holds the value of the field on the running VMI, nil if it is not set there.
+type specChange struct {
+	path    string
+	current interface{}
+	desired interface{}
+	// namedEntry is set if the change adds or removes a whole entry of a named list
+	namedEntry bool
+}
+
+// diffSpecs lists the fields which differ between the spec the VMI was started from and the desired spec.
+// Fields which already have their desired value on the running VMI, because they were hotplugged,
+// are left out. Comparing against the spec the VMI was started from, instead of the running VMI itself,
+// keeps the defaults applied to the VMI on creation out of the changes.
+func diffSpecs(started, desired *virtv1.VirtualMachineInstanceSpec, running *virtv1.VirtualMachineInstanceSpec) ([]specChange, error) {
+	var values [3]interface{}
+	for i, spec := range []*virtv1.VirtualMachineInstanceSpec{started, desired, running} {
+		data, err := json.Marshal(spec)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(data, &values[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	var changes []specChange
+	diffValues("spec", values[0], values[1], values[2], &changes)
+	return changes, nil
+}
+
+func diffValues(path string, started, desired, running interface{}, changes *[]specChange) {
+	if reflect.DeepEqual(started, desired) {
+		return
+	}
+
+	startedMap, startedIsMap := started.(map[string]interface{})
+	desiredMap, desiredIsMap := desired.(map[string]interface{})
+	if startedIsMap && desiredIsMap {
+		runningMap, _ := running.(map[string]interface{})
+		for _, key := range unionKeys(startedMap, desiredMap) {
+			diffValues(path+"."+key, startedMap[key], desiredMap[key], runningMap[key], changes)
+		}
+		return
+	}
+
+	startedList, startedIsList := started.([]interface{})
+	desiredList, desiredIsList := desired.([]interface{})
+	if (startedIsList || started == nil) && (desiredIsList || desired == nil) && isNamedList(startedList) && isNamedList(desiredList) {
+		startedEntries, desiredEntries := indexByName(startedList), indexByName(desiredList)
+		runningList, _ := running.([]interface{})
+		runningEntries := indexByName(runningList)
+		for _, name := range unionKeys(startedEntries, desiredEntries) {
+			entryPath := fmt.Sprintf("%s[name=%s]", path, name)
+			if startedEntries[name] == nil || desiredEntries[name] == nil {
+				if !reflect.DeepEqual(runningEntries[name], desiredEntries[name]) {
+					*changes = append(*changes, specChange{entryPath, runningEntries[name], desiredEntries[name], true})
+				}
+				continue
+			}
+			diffValues(entryPath, startedEntries[name], desiredEntries[name], runningEntries[name], changes)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(running, desired) {
+		*changes = append(*changes, specChange{path: path, current: running, desired: desired})
+	}
+}
+
+// isNamedList checks if all entries of the list are objects with a name, like volumes, disks or interfaces
+func isNamedList(list []interface{}) bool {
+	for _, entry := range list {
+		entryMap, isMap := entry.(map[string]interface{})
+		if !isMap {
+			return false
+		}
+		if _, hasName := entryMap["name"].(string); !hasName {
+			return false
+		}
+	}
+	return true
+}
+
+func indexByName(list []interface{}) map[string]interface{} {
+	entries := map[string]interface{}{}
+	for _, entry := range list {
+		if entryMap, isMap := entry.(map[string]interface{}); isMap {
+			if name, hasName := entryMap["name"].(string); hasName {
+				entries[name] = entry
+			}
+		}
+	}
+	return entries
+}
+
+func unionKeys(a, b map[string]interface{}) []string {
+	var keys []string
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, exists := a[key]; !exists {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func encodeChangeValue(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
+
+func (c specChange) toPendingChange(liveUpdatable bool) virtv1.VirtualMachinePendingChange {
+	return virtv1.VirtualMachinePendingChange{
+		Path:          c.path,
+		Current:       encodeChangeValue(c.current),
+		Desired:       encodeChangeValue(c.desired),
+		LiveUpdatable: liveUpdatable,
+	}
+}
+
+// isInterfaceChange checks if the change adds or removes a network interface, which can be hotplugged
+func (c specChange) isInterfaceChange() bool {
+	return c.namedEntry && (strings.HasPrefix(c.path, "spec.domain.devices.interfaces[") || strings.HasPrefix(c.path, "spec.networks["))
+}
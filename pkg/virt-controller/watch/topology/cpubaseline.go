@@ -0,0 +1,195 @@
+package topology
+
+//go:generate mockgen -source $GOFILE -package=$GOPACKAGE -destination=generated_mock_$GOFILE
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/util/status"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// cpuModelGenerations orders the CPU models of libvirt's cpu_map from the oldest to the most recent.
+// Models of different vendors are never supported by the same nodes, only the generic models are.
+var cpuModelGenerations = []string{
+	"486", "pentium", "pentium2", "pentium3", "pentiumpro", "coreduo", "n270", "core2duo",
+	"qemu32", "kvm32", "qemu64", "kvm64",
+	"Conroe", "Penryn", "Nehalem", "Westmere", "SandyBridge", "IvyBridge", "Haswell", "Broadwell",
+	"Skylake-Client", "Skylake-Server", "Cascadelake-Server", "Cooperlake", "Icelake-Client", "Icelake-Server",
+	"SapphireRapids",
+	"athlon", "phenom", "Opteron_G1", "Opteron_G2", "Opteron_G3", "Opteron_G4", "Opteron_G5",
+	"EPYC", "EPYC-Rome", "EPYC-Milan", "EPYC-Genoa",
+}
+
+// CPUBaselineUpdater periodically computes the CPU baselines of the cluster and of the
+// CPU baseline node pools and publishes them in the KubeVirt status
+type CPUBaselineUpdater interface {
+	Run(interval time.Duration, stopChan <-chan struct{})
+}
+
+type cpuBaselineUpdater struct {
+	nodeInformer  cache.SharedIndexInformer
+	clusterConfig *virtconfig.ClusterConfig
+	statusUpdater *status.KVStatusUpdater
+}
+
+func (u *cpuBaselineUpdater) Run(interval time.Duration, stopChan <-chan struct{}) {
+	cache.WaitForCacheSync(stopChan, u.nodeInformer.HasSynced)
+	wait.JitterUntil(func() {
+		if err := u.sync(); err != nil {
+			log.DefaultLogger().Reason(err).Error("Failed to update the CPU baselines")
+		}
+	}, interval, 1.2, true, stopChan)
+}
+
+func (u *cpuBaselineUpdater) sync() error {
+	kv := u.clusterConfig.GetConfigFromKubeVirtCR()
+	if kv == nil {
+		return nil
+	}
+
+	nodes := FilterNodesFromCache(u.nodeInformer.GetStore().List(),
+		IsSchedulable,
+		HasCPUModels,
+	)
+	baselines := CPUBaselines(nodes, u.clusterConfig.GetCPUBaselineNodePools())
+	if equality.Semantic.DeepEqual(kv.Status.CPUBaselines, baselines) {
+		return nil
+	}
+
+	var patchBytes []byte
+	var err error
+	if kv.Status.CPUBaselines == nil {
+		patchBytes, err = patch.GeneratePatchPayload(patch.PatchOperation{
+			Op:    patch.PatchAddOp,
+			Path:  "/status/cpuBaselines",
+			Value: baselines,
+		})
+	} else {
+		patchBytes, err = patch.GenerateTestReplacePatch("/status/cpuBaselines", kv.Status.CPUBaselines, baselines)
+	}
+	if err != nil {
+		return err
+	}
+
+	log.DefaultLogger().Infof("Updating the CPU baselines of %d nodes", len(nodes))
+	return u.statusUpdater.PatchStatus(kv, types.JSONPatchType, patchBytes)
+}
+
+// CPUBaselines computes the CPU baseline of all given nodes and of the nodes of each node pool
+func CPUBaselines(nodes []*v1.Node, nodePools []virtv1.CPUBaselineNodePool) []virtv1.CPUBaseline {
+	baselines := []virtv1.CPUBaseline{CPUBaselineOfNodes(nodes)}
+	for _, pool := range nodePools {
+		selector := labels.SelectorFromSet(pool.NodeSelector)
+		poolNodes := []*v1.Node{}
+		for _, node := range nodes {
+			if selector.Matches(labels.Set(node.Labels)) {
+				poolNodes = append(poolNodes, node)
+			}
+		}
+		baseline := CPUBaselineOfNodes(poolNodes)
+		baseline.NodePool = pool.Name
+		baselines = append(baselines, baseline)
+	}
+	return baselines
+}
+
+// CPUBaselineOfNodes returns the most recent CPU model and the CPU features supported by all given nodes
+func CPUBaselineOfNodes(nodes []*v1.Node) virtv1.CPUBaseline {
+	baseline := virtv1.CPUBaseline{Nodes: len(nodes)}
+	if len(nodes) == 0 {
+		return baseline
+	}
+
+	models := commonLabelSuffixes(nodes, virtv1.CPUModelLabel)
+	for _, model := range models {
+		if baseline.Model == "" || isMoreRecentCPUModel(model, baseline.Model) {
+			baseline.Model = model
+		}
+	}
+	if features := commonLabelSuffixes(nodes, virtv1.CPUFeatureLabel); len(features) > 0 {
+		baseline.Features = features
+	}
+	return baseline
+}
+
+// HasCPUModels returns whether the node labeller published the CPU models supported by the node
+func HasCPUModels(node *v1.Node) bool {
+	if node == nil {
+		return false
+	}
+	for key := range node.Labels {
+		if strings.HasPrefix(key, virtv1.CPUModelLabel) {
+			return true
+		}
+	}
+	return false
+}
+
+// commonLabelSuffixes returns the sorted suffixes of the labels with the given prefix which are set on all nodes
+func commonLabelSuffixes(nodes []*v1.Node, prefix string) []string {
+	counts := map[string]int{}
+	for _, node := range nodes {
+		for key, value := range node.Labels {
+			if value == "true" && strings.HasPrefix(key, prefix) {
+				counts[strings.TrimPrefix(key, prefix)]++
+			}
+		}
+	}
+
+	common := []string{}
+	for suffix, count := range counts {
+		if count == len(nodes) {
+			common = append(common, suffix)
+		}
+	}
+	sort.Strings(common)
+	return common
+}
+
+func isMoreRecentCPUModel(model, other string) bool {
+	if generation, otherGeneration := cpuModelGeneration(model), cpuModelGeneration(other); generation != otherGeneration {
+		return generation > otherGeneration
+	}
+	// variants of the same generation like Haswell-noTSX-IBRS add features to the model
+	if len(model) != len(other) {
+		return len(model) > len(other)
+	}
+	return model > other
+}
+
+// cpuModelGeneration returns the position of the model, or the model it is a variant of, in cpuModelGenerations.
+// Unknown models are considered the oldest.
+func cpuModelGeneration(model string) int {
+	generation := -1
+	matched := 0
+	for i, known := range cpuModelGenerations {
+		if (model == known || strings.HasPrefix(model, known+"-")) && len(known) > matched {
+			generation = i
+			matched = len(known)
+		}
+	}
+	return generation
+}
+
+func NewCPUBaselineUpdater(clientset kubecli.KubevirtClient, nodeInformer cache.SharedIndexInformer, clusterConfig *virtconfig.ClusterConfig) CPUBaselineUpdater {
+	return &cpuBaselineUpdater{
+		nodeInformer:  nodeInformer,
+		clusterConfig: clusterConfig,
+		statusUpdater: status.NewKubeVirtStatusUpdater(clientset),
+	}
+}
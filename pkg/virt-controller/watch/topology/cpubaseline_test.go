@@ -0,0 +1,154 @@
+package topology
+
+import (
+	"encoding/json"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	g "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/util/status"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+var _ = Describe("CPU baseline", func() {
+
+	Context("of nodes", func() {
+
+		It("should pick the most recent CPU model and the features supported by all nodes", func() {
+			baseline := CPUBaselineOfNodes([]*v1.Node{
+				nodeWithCPU("node1", []string{"Penryn", "Nehalem", "Haswell", "Skylake-Server"}, []string{"ssse3", "vmx", "avx512f"}),
+				nodeWithCPU("node2", []string{"Penryn", "Nehalem", "Haswell"}, []string{"ssse3", "vmx"}),
+				nodeWithCPU("node3", []string{"Nehalem", "Haswell", "Haswell-noTSX"}, []string{"vmx", "ssse3"}),
+			})
+			g.Expect(baseline).To(g.Equal(virtv1.CPUBaseline{
+				Model:    "Haswell",
+				Features: []string{"ssse3", "vmx"},
+				Nodes:    3,
+			}))
+		})
+
+		It("should prefer variants of the same CPU model generation", func() {
+			baseline := CPUBaselineOfNodes([]*v1.Node{
+				nodeWithCPU("node1", []string{"Haswell", "Haswell-noTSX", "Haswell-noTSX-IBRS", "IvyBridge"}, nil),
+			})
+			g.Expect(baseline.Model).To(g.Equal("Haswell-noTSX-IBRS"))
+		})
+
+		It("should return an empty baseline if the nodes have no CPU model in common", func() {
+			baseline := CPUBaselineOfNodes([]*v1.Node{
+				nodeWithCPU("node1", []string{"Haswell"}, []string{"vmx"}),
+				nodeWithCPU("node2", []string{"EPYC"}, []string{"svm"}),
+			})
+			g.Expect(baseline).To(g.Equal(virtv1.CPUBaseline{Nodes: 2}))
+		})
+
+		It("should return an empty baseline without nodes", func() {
+			g.Expect(CPUBaselineOfNodes(nil)).To(g.Equal(virtv1.CPUBaseline{}))
+		})
+
+		It("should compute a baseline for each node pool", func() {
+			nodes := []*v1.Node{
+				nodeWithCPU("node1", []string{"Haswell", "Skylake-Server"}, nil),
+				nodeWithCPU("node2", []string{"Haswell", "Skylake-Server"}, nil),
+				nodeWithCPU("node3", []string{"Haswell"}, nil),
+			}
+			nodes[0].Labels["pool"] = "new"
+			nodes[1].Labels["pool"] = "new"
+
+			baselines := CPUBaselines(nodes, []virtv1.CPUBaselineNodePool{
+				{Name: "new", NodeSelector: map[string]string{"pool": "new"}},
+				{Name: "empty", NodeSelector: map[string]string{"pool": "empty"}},
+			})
+			g.Expect(baselines).To(g.Equal([]virtv1.CPUBaseline{
+				{Model: "Haswell", Nodes: 3},
+				{NodePool: "new", Model: "Skylake-Server", Nodes: 2},
+				{NodePool: "empty"},
+			}))
+		})
+	})
+
+	Context("updater", func() {
+
+		var ctrl *gomock.Controller
+		var kvInterface *kubecli.MockKubeVirtInterface
+		var kvInformer cache.SharedIndexInformer
+		var updater *cpuBaselineUpdater
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			virtClient := kubecli.NewMockKubevirtClient(ctrl)
+			kvInterface = kubecli.NewMockKubeVirtInterface(ctrl)
+			virtClient.EXPECT().KubeVirt(gomock.Any()).Return(kvInterface).AnyTimes()
+
+			var config *virtconfig.ClusterConfig
+			config, _, kvInformer = testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{})
+
+			nodes := []*v1.Node{
+				nodeWithCPU("node1", []string{"Haswell", "Skylake-Server"}, []string{"vmx"}),
+				nodeWithCPU("node2", []string{"Haswell"}, []string{"vmx"}),
+			}
+			nodes[1].Labels[virtv1.NodeSchedulable] = "false"
+
+			nodeInformer, _ := testutils.NewFakeInformerFor(&v1.Node{})
+			for _, node := range nodes {
+				g.Expect(nodeInformer.GetStore().Add(node)).To(g.Succeed())
+			}
+
+			updater = &cpuBaselineUpdater{
+				nodeInformer:  nodeInformer,
+				clusterConfig: config,
+				statusUpdater: status.NewKubeVirtStatusUpdater(virtClient),
+			}
+		})
+
+		It("should publish the CPU baselines of the schedulable nodes", func() {
+			kvInterface.EXPECT().PatchStatus(gomock.Any(), types.JSONPatchType, gomock.Any(), gomock.Any()).DoAndReturn(func(_ string, _ types.PatchType, data []byte, _ *metav1.PatchOptions) (*virtv1.KubeVirt, error) {
+				var ops []patch.PatchOperation
+				g.Expect(json.Unmarshal(data, &ops)).To(g.Succeed())
+				g.Expect(ops).To(g.HaveLen(1))
+				g.Expect(ops[0].Op).To(g.Equal(patch.PatchAddOp))
+				g.Expect(ops[0].Path).To(g.Equal("/status/cpuBaselines"))
+				g.Expect(string(data)).To(g.ContainSubstring(`"model":"Skylake-Server"`))
+				g.Expect(string(data)).To(g.ContainSubstring(`"nodes":1`))
+				return nil, nil
+			})
+			g.Expect(updater.sync()).To(g.Succeed())
+		})
+
+		It("should not patch the KubeVirt status if the CPU baselines did not change", func() {
+			kv := testutils.GetFakeKubeVirtClusterConfig(kvInformer)
+			kv.Status.CPUBaselines = []virtv1.CPUBaseline{{Model: "Skylake-Server", Features: []string{"vmx"}, Nodes: 1}}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, kv)
+
+			g.Expect(updater.sync()).To(g.Succeed())
+		})
+	})
+})
+
+func nodeWithCPU(name string, models []string, features []string) *v1.Node {
+	node := &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				virtv1.NodeSchedulable: "true",
+			},
+		},
+	}
+	for _, model := range models {
+		node.Labels[virtv1.CPUModelLabel+model] = "true"
+	}
+	for _, feature := range features {
+		node.Labels[virtv1.CPUFeatureLabel+feature] = "true"
+	}
+	return node
+}
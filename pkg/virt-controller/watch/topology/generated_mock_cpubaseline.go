@@ -0,0 +1,38 @@
+// Automatically generated by MockGen. DO NOT EDIT!
+// Source: cpubaseline.go
+
+package topology
+
+import (
+	gomock "github.com/golang/mock/gomock"
+	time "time"
+)
+
+// Mock of CPUBaselineUpdater interface
+type MockCPUBaselineUpdater struct {
+	ctrl     *gomock.Controller
+	recorder *_MockCPUBaselineUpdaterRecorder
+}
+
+// Recorder for MockCPUBaselineUpdater (not exported)
+type _MockCPUBaselineUpdaterRecorder struct {
+	mock *MockCPUBaselineUpdater
+}
+
+func NewMockCPUBaselineUpdater(ctrl *gomock.Controller) *MockCPUBaselineUpdater {
+	mock := &MockCPUBaselineUpdater{ctrl: ctrl}
+	mock.recorder = &_MockCPUBaselineUpdaterRecorder{mock}
+	return mock
+}
+
+func (_m *MockCPUBaselineUpdater) EXPECT() *_MockCPUBaselineUpdaterRecorder {
+	return _m.recorder
+}
+
+func (_m *MockCPUBaselineUpdater) Run(interval time.Duration, stopChan <-chan struct{}) {
+	_m.ctrl.Call(_m, "Run", interval, stopChan)
+}
+
+func (_mr *_MockCPUBaselineUpdaterRecorder) Run(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "Run", arg0, arg1)
+}
@@ -111,6 +111,8 @@ const (
 	FailedCreateReason                 = "FailedCreate"
 	VMIFailedDeleteReason              = "FailedDelete"
 	HotPlugNetworkInterfaceErrorReason = "HotPlugNetworkInterfaceError"
+	InstancetypeResizedReason          = "InstancetypeResized"
+	TemplateChangedReason              = "TemplateChanged"
 )
 
 const defaultMaxCrashLoopBackoffDelaySeconds = 300
@@ -472,6 +474,14 @@ func (c *VMController) handleDataVolumes(vm *virtv1.VirtualMachine, dataVolumes
 				return ready, fmt.Errorf("unable to create DataVolume manifest: %v", err)
 			}
 
+			// The storage class and access modes can't be changed once the PVC exists, so the DataVolume
+			// is only created once the preference can be applied
+			preferenceSpec, err := c.instancetypeMethods.FindPreferenceSpec(vm)
+			if err != nil {
+				return ready, fmt.Errorf("unable to find preference of DataVolume %s: %v", newDataVolume.Name, err)
+			}
+			instancetype.ApplyDataVolumePreferences(preferenceSpec, &newDataVolume.Spec)
+
 			// We validate requirements that are exclusive to clone DataVolumes
 			if err = c.handleCloneDataVolume(vm, newDataVolume); err != nil {
 				return ready, err
@@ -618,9 +628,9 @@ func (c *VMController) updatePVCMemoryDumpAnnotation(vm *virtv1.VirtualMachine)
 	return nil
 }
 
-func (c *VMController) VMICPUsPatch(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+func (c *VMController) VMICPUsPatch(sockets uint32, vmi *virtv1.VirtualMachineInstance) error {
 	test := fmt.Sprintf(`{ "op": "test", "path": "/spec/domain/cpu/sockets", "value": %s}`, strconv.FormatUint(uint64(vmi.Spec.Domain.CPU.Sockets), 10))
-	update := fmt.Sprintf(`{ "op": "replace", "path": "/spec/domain/cpu/sockets", "value": %s}`, strconv.FormatUint(uint64(vm.Spec.Template.Spec.Domain.CPU.Sockets), 10))
+	update := fmt.Sprintf(`{ "op": "replace", "path": "/spec/domain/cpu/sockets", "value": %s}`, strconv.FormatUint(uint64(sockets), 10))
 	patch := fmt.Sprintf("[%s, %s]", test, update)
 
 	_, err := c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, []byte(patch), &v1.PatchOptions{})
@@ -637,16 +647,31 @@ func (c *VMController) handleCPUChangeRequest(vm *virtv1.VirtualMachine, vmi *vi
 		return nil
 	}
 
-	if vm.Spec.Template.Spec.Domain.CPU == nil || vmi.Spec.Domain.CPU == nil {
+	desiredCPU := vm.Spec.Template.Spec.Domain.CPU
+	if vm.Spec.Instancetype != nil {
+		vmiSpec, err := c.instancetypeVMISpec(vm)
+		if err != nil {
+			return err
+		}
+		desiredCPU = vmiSpec.Domain.CPU
+	}
+
+	if desiredCPU == nil || vmi.Spec.Domain.CPU == nil {
 		return nil
 	}
 
-	vmTemplVCPUs := hardware.GetNumberOfVCPUs(vm.Spec.Template.Spec.Domain.CPU)
+	vmTemplVCPUs := hardware.GetNumberOfVCPUs(desiredCPU)
 	vmiVCPUs := hardware.GetNumberOfVCPUs(vmi.Spec.Domain.CPU)
 	if vmTemplVCPUs == vmiVCPUs {
 		return nil
 	}
 
+	// Unlike the VM template, a resized instancetype is not validated against the running VMI,
+	// changes which can not be hotplugged are reported with the RestartRequired condition instead
+	if vm.Spec.Instancetype != nil && !canHotplugCPU(vm, desiredCPU, vmi) {
+		return nil
+	}
+
 	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
 	if vmiConditions.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceVCPUChange, k8score.ConditionTrue) {
 		return fmt.Errorf("another CPU hotplug is in progress")
@@ -656,7 +681,7 @@ func (c *VMController) handleCPUChangeRequest(vm *virtv1.VirtualMachine, vmi *vi
 		return fmt.Errorf("CPU hotplug is not allowed while VMI is migrating")
 	}
 
-	if err := c.VMICPUsPatch(vm, vmi); err != nil {
+	if err := c.VMICPUsPatch(desiredCPU.Sockets, vmi); err != nil {
 		log.Log.Object(vmi).Errorf("unable to patch vmi to add cpu topology status: %v", err)
 		return err
 	}
@@ -664,6 +689,66 @@ func (c *VMController) handleCPUChangeRequest(vm *virtv1.VirtualMachine, vmi *vi
 	return nil
 }
 
+// instancetypeVMISpec returns the VMI spec of a VM with its current instancetype and preference applied
+func (c *VMController) instancetypeVMISpec(vm *virtv1.VirtualMachine) (*virtv1.VirtualMachineInstanceSpec, error) {
+	instancetypeSpec, err := c.instancetypeMethods.FindInstancetypeSpec(vm)
+	if err != nil {
+		return nil, err
+	}
+	preferenceSpec, err := c.instancetypeMethods.FindPreferenceSpec(vm)
+	if err != nil {
+		return nil, err
+	}
+
+	vmiSpec := vm.Spec.Template.Spec.DeepCopy()
+	if conflicts := c.instancetypeMethods.ApplyToVmi(k8sfield.NewPath("spec"), instancetypeSpec, preferenceSpec, vmiSpec); len(conflicts) > 0 {
+		return nil, fmt.Errorf("VMI conflicts with instancetype spec in fields: [%s]", conflicts.String())
+	}
+	return vmiSpec, nil
+}
+
+// canHotplugCPU checks if the VMI can be switched to the desired CPU topology by hotplugging sockets
+func canHotplugCPU(vm *virtv1.VirtualMachine, desiredCPU *virtv1.CPU, vmi *virtv1.VirtualMachineInstance) bool {
+	if vm.Spec.LiveUpdateFeatures == nil || vm.Spec.LiveUpdateFeatures.CPU == nil {
+		return false
+	}
+	currentCPU := vmi.Spec.Domain.CPU
+	return currentCPU != nil &&
+		desiredCPU.Cores == currentCPU.Cores &&
+		desiredCPU.Threads == currentCPU.Threads &&
+		desiredCPU.Sockets <= currentCPU.MaxSockets
+}
+
+// instancetypeRestartRequired lists the changes of a resized instancetype which can not be applied to the running VMI
+func (c *VMController) instancetypeRestartRequired(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) ([]string, error) {
+	vmiSpec, err := c.instancetypeVMISpec(vm)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []string
+	desiredCPU, currentCPU := vmiSpec.Domain.CPU, vmi.Spec.Domain.CPU
+	if desiredCPU != nil && currentCPU != nil &&
+		hardware.GetNumberOfVCPUs(desiredCPU) != hardware.GetNumberOfVCPUs(currentCPU) &&
+		!canHotplugCPU(vm, desiredCPU, vmi) {
+		changes = append(changes, "CPU")
+	}
+
+	desiredMemory, currentMemory := vmiSpec.Domain.Memory, vmi.Spec.Domain.Memory
+	if desiredMemory != nil && desiredMemory.Guest != nil &&
+		(currentMemory == nil || currentMemory.Guest == nil || desiredMemory.Guest.Cmp(*currentMemory.Guest) != 0) {
+		changes = append(changes, "memory")
+	}
+
+	if !equality.Semantic.DeepEqual(vmiSpec.Domain.Devices.GPUs, vmi.Spec.Domain.Devices.GPUs) {
+		changes = append(changes, "GPUs")
+	}
+	if !equality.Semantic.DeepEqual(vmiSpec.Domain.Devices.HostDevices, vmi.Spec.Domain.Devices.HostDevices) {
+		changes = append(changes, "host devices")
+	}
+	return changes, nil
+}
+
 func (c *VMController) handleMemoryDumpRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
 	if vm.Status.MemoryDumpRequest == nil {
 		return nil
@@ -998,6 +1083,12 @@ func (c *VMController) startVMI(vm *virtv1.VirtualMachine) error {
 		return nil
 	}
 
+	// the VM is woken up again once the VMIs of its dependencies change
+	if unready := c.unreadyDependencies(vm); len(unready) > 0 {
+		log.Log.Object(vm).V(3).Infof("Delaying start of VM until its dependencies %s are ready", strings.Join(unready, ", "))
+		return nil
+	}
+
 	// start it
 	vmi := c.setupVMIFromVM(vm)
 	vmRevisionName, err := c.createVMRevision(vm)
@@ -1321,6 +1412,14 @@ func (c *VMController) stopVMI(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMac
 		return nil
 	}
 
+	// the VM is woken up again once the VMIs of its dependents are gone
+	if !vmi.IsFinal() {
+		if dependents := c.stoppingDependents(vm); len(dependents) > 0 {
+			log.Log.Object(vm).V(3).Infof("Delaying stop of VM until its dependents %s are stopped", strings.Join(dependents, ", "))
+			return nil
+		}
+	}
+
 	// stop it
 	c.expectations.ExpectDeletions(vmKey, []string{controller.VirtualMachineInstanceKey(vmi)})
 	err = c.clientset.VirtualMachineInstance(vm.ObjectMeta.Namespace).Delete(context.Background(), vmi.ObjectMeta.Name, &v1.DeleteOptions{})
@@ -1646,6 +1745,7 @@ func (c *VMController) addVirtualMachineInstance(obj interface{}) {
 	vmi := obj.(*virtv1.VirtualMachineInstance)
 
 	log.Log.Object(vmi).V(4).Info("VirtualMachineInstance added.")
+	c.enqueueDependencyGroup(vmi)
 
 	if vmi.DeletionTimestamp != nil {
 		// on a restart of the controller manager, it's possible a new vmi shows up in a state that
@@ -1699,6 +1799,7 @@ func (c *VMController) updateVirtualMachineInstance(old, cur interface{}) {
 		// Two different versions of the same vmi will always have different RVs.
 		return
 	}
+	c.enqueueDependencyGroup(curVMI)
 
 	labelChanged := !equality.Semantic.DeepEqual(curVMI.Labels, oldVMI.Labels)
 	if curVMI.DeletionTimestamp != nil {
@@ -1775,6 +1876,7 @@ func (c *VMController) deleteVirtualMachineInstance(obj interface{}) {
 			return
 		}
 	}
+	c.enqueueDependencyGroup(vmi)
 
 	controllerRef := v1.GetControllerOf(vmi)
 	if controllerRef == nil {
@@ -2151,6 +2253,7 @@ func (c *VMController) setPrintableStatus(vm *virtv1.VirtualMachine, vmi *virtv1
 		{virtv1.VirtualMachineStatusImagePullBackOff, c.isVirtualMachineStatusImagePullBackOff},
 		{virtv1.VirtualMachineStatusStarting, c.isVirtualMachineStatusStarting},
 		{virtv1.VirtualMachineStatusCrashLoopBackOff, c.isVirtualMachineStatusCrashLoopBackOff},
+		{virtv1.VirtualMachineStatusWaitingForDependencies, c.isVirtualMachineStatusWaitingForDependencies},
 		{virtv1.VirtualMachineStatusStopped, c.isVirtualMachineStatusStopped},
 	}
 
@@ -2341,6 +2444,8 @@ func (c *VMController) syncConditions(vm *virtv1.VirtualMachine, vmi *virtv1.Vir
 	// ready condition is handled differently as it persists regardless if vmi exists or not
 	c.syncReadyConditionFromVMI(vm, vmi)
 	c.processFailureCondition(vm, vmi, syncErr)
+	c.syncRestartRequiredCondition(vm, vmi)
+	c.syncDependenciesPendingCondition(vm, vmi)
 
 	// nothing to do if vmi hasn't been created yet.
 	if vmi == nil {
@@ -2349,8 +2454,10 @@ func (c *VMController) syncConditions(vm *virtv1.VirtualMachine, vmi *virtv1.Vir
 
 	// sync VMI conditions, ignore list represents conditions that are not synced generically
 	syncIgnoreMap := map[string]interface{}{
-		string(virtv1.VirtualMachineReady):   nil,
-		string(virtv1.VirtualMachineFailure): nil,
+		string(virtv1.VirtualMachineReady):               nil,
+		string(virtv1.VirtualMachineFailure):             nil,
+		string(virtv1.VirtualMachineRestartRequired):     nil,
+		string(virtv1.VirtualMachineDependenciesPending): nil,
 	}
 	vmiCondMap := make(map[string]interface{})
 
@@ -2385,6 +2492,170 @@ func (c *VMController) syncConditions(vm *virtv1.VirtualMachine, vmi *virtv1.Vir
 	}
 }
 
+// syncRestartRequiredCondition reports the changes of the VM template and of its instancetype which are not
+// applied to the running VMI yet, and flags the VM if some of them only take effect once the VMI is restarted
+func (c *VMController) syncRestartRequiredCondition(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	vmConditionManager := controller.NewVirtualMachineConditionManager()
+
+	if vmi == nil || vmi.DeletionTimestamp != nil {
+		vm.Status.PendingChanges = nil
+		vmConditionManager.RemoveCondition(vm, virtv1.VirtualMachineRestartRequired)
+		return
+	}
+
+	var instancetypeChanges []string
+	if vm.Spec.Instancetype != nil {
+		var err error
+		if instancetypeChanges, err = c.instancetypeRestartRequired(vm, vmi); err != nil {
+			// Keep the condition as it is until the instancetype can be resolved again
+			log.Log.Object(vm).Reason(err).Warning("Failed to compare the instancetype of the VM with the running VMI")
+			return
+		}
+	}
+
+	pendingChanges, templateRestartPaths, err := c.pendingChanges(vm, vmi)
+	if err != nil {
+		log.Log.Object(vm).Reason(err).Warning("Failed to compare the VM with the running VMI")
+		return
+	}
+	vm.Status.PendingChanges = pendingChanges
+
+	// The instancetype changes are summarized, so that only the template paths are listed next to them
+	restartPaths := templateRestartPaths
+	if len(instancetypeChanges) == 0 {
+		restartPaths = nil
+		for _, change := range pendingChanges {
+			if !change.LiveUpdatable {
+				restartPaths = append(restartPaths, change.Path)
+			}
+		}
+	}
+
+	reason := InstancetypeResizedReason
+	var message string
+	switch {
+	case len(instancetypeChanges) > 0 && len(restartPaths) > 0:
+		message = fmt.Sprintf("The %s changes of the instancetype and the changes of %s are applied on the next restart of the VM",
+			strings.Join(instancetypeChanges, ", "), strings.Join(restartPaths, ", "))
+	case len(instancetypeChanges) > 0:
+		message = fmt.Sprintf("The %s changes of the instancetype are applied on the next restart of the VM", strings.Join(instancetypeChanges, ", "))
+	case len(restartPaths) > 0:
+		reason = TemplateChangedReason
+		message = fmt.Sprintf("The changes of %s are applied on the next restart of the VM", strings.Join(restartPaths, ", "))
+	default:
+		vmConditionManager.RemoveCondition(vm, virtv1.VirtualMachineRestartRequired)
+		return
+	}
+
+	vmConditionManager.UpdateCondition(vm, &virtv1.VirtualMachineCondition{
+		Type:               virtv1.VirtualMachineRestartRequired,
+		Status:             k8score.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: v1.Now(),
+	})
+}
+
+// pendingChanges lists the changes of the VM template since the VMI was started, and the changes
+// of a resized instancetype, which are not applied to the running VMI yet. It returns the paths of
+// the template changes which require a restart separately.
+func (c *VMController) pendingChanges(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) ([]virtv1.VirtualMachinePendingChange, []string, error) {
+	var pendingChanges []virtv1.VirtualMachinePendingChange
+	seen := map[string]bool{}
+	addChanges := func(changes []specChange, desiredCPU *virtv1.CPU) (restartPaths []string) {
+		for _, change := range changes {
+			if seen[change.path] {
+				continue
+			}
+			seen[change.path] = true
+			pendingChange := change.toPendingChange(c.isLiveUpdatable(vm, vmi, desiredCPU, change))
+			pendingChanges = append(pendingChanges, pendingChange)
+			if !pendingChange.LiveUpdatable {
+				restartPaths = append(restartPaths, pendingChange.Path)
+			}
+		}
+		return restartPaths
+	}
+
+	startedTemplate, err := c.startedTemplate(vm, vmi)
+	if err != nil {
+		return nil, nil, err
+	}
+	var templateRestartPaths []string
+	if startedTemplate != nil && vm.Spec.Template != nil {
+		changes, err := diffSpecs(&startedTemplate.Spec, &vm.Spec.Template.Spec, &vmi.Spec)
+		if err != nil {
+			return nil, nil, err
+		}
+		templateRestartPaths = addChanges(changes, vm.Spec.Template.Spec.Domain.CPU)
+	}
+
+	if vm.Spec.Instancetype != nil {
+		vmiSpec, err := c.instancetypeVMISpec(vm)
+		if err != nil {
+			return nil, nil, err
+		}
+		// The VMI is created with the instancetype applied, so that it is compared directly with the
+		// instancetype, limited to the fields which are checked for a restart
+		running, desired := instancetypeFields(&vmi.Spec), instancetypeFields(vmiSpec)
+		changes, err := diffSpecs(running, desired, running)
+		if err != nil {
+			return nil, nil, err
+		}
+		addChanges(changes, vmiSpec.Domain.CPU)
+	}
+
+	return pendingChanges, templateRestartPaths, nil
+}
+
+// startedTemplate returns the VM template the running VMI was created from, nil if the
+// template did not change since then or the revision of the VM is not known
+func (c *VMController) startedTemplate(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (*virtv1.VirtualMachineInstanceTemplateSpec, error) {
+	if vm.Status.ObservedGeneration == vm.Generation || vmi.Status.VirtualMachineRevisionName == "" {
+		return nil, nil
+	}
+
+	obj, exists, err := c.crInformer.GetStore().GetByKey(controller.NamespacedKey(vmi.Namespace, vmi.Status.VirtualMachineRevisionName))
+	if err != nil || !exists {
+		return nil, err
+	}
+	cr, ok := obj.(*appsv1.ControllerRevision)
+	if !ok {
+		return nil, fmt.Errorf("unexpected resource %+v", obj)
+	}
+
+	revisionSpec := &VirtualMachineRevisionData{}
+	if err := json.Unmarshal(cr.Data.Raw, revisionSpec); err != nil {
+		return nil, err
+	}
+	return revisionSpec.Spec.Template, nil
+}
+
+// instancetypeFields returns the fields of the VMI spec an instancetype can change and which are checked for a restart
+func instancetypeFields(spec *virtv1.VirtualMachineInstanceSpec) *virtv1.VirtualMachineInstanceSpec {
+	fields := &virtv1.VirtualMachineInstanceSpec{}
+	if cpu := spec.Domain.CPU; cpu != nil {
+		fields.Domain.CPU = &virtv1.CPU{Sockets: cpu.Sockets, Cores: cpu.Cores, Threads: cpu.Threads}
+	}
+	if memory := spec.Domain.Memory; memory != nil && memory.Guest != nil {
+		fields.Domain.Memory = &virtv1.Memory{Guest: memory.Guest}
+	}
+	fields.Domain.Devices.GPUs = spec.Domain.Devices.GPUs
+	fields.Domain.Devices.HostDevices = spec.Domain.Devices.HostDevices
+	return fields
+}
+
+// isLiveUpdatable checks if a pending change is applied to the running VMI without a restart
+func (c *VMController) isLiveUpdatable(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance, desiredCPU *virtv1.CPU, change specChange) bool {
+	switch {
+	case change.path == "spec.domain.cpu.sockets":
+		return desiredCPU != nil && canHotplugCPU(vm, desiredCPU, vmi)
+	case change.isInterfaceChange():
+		return c.clusterConfig.HotplugNetworkInterfacesEnabled()
+	}
+	return false
+}
+
 func (c *VMController) processFailureCondition(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance, syncErr syncError) {
 
 	vmConditionManager := controller.NewVirtualMachineConditionManager()
@@ -354,6 +354,92 @@ var _ = Describe("VirtualMachine", func() {
 			testutils.ExpectEvent(recorder, SuccessfulDataVolumeCreateReason)
 		})
 
+		It("should apply the storage preferences of the preference to a missing DataVolume", func() {
+			vm, _ := DefaultVirtualMachine(true)
+			vm.Spec.Preference = &virtv1.PreferenceMatcher{Name: "windows"}
+			vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, virtv1.Volume{
+				Name: "test1",
+				VolumeSource: virtv1.VolumeSource{
+					DataVolume: &virtv1.DataVolumeSource{
+						Name: "dv1",
+					},
+				},
+			})
+			vm.Spec.DataVolumeTemplates = append(vm.Spec.DataVolumeTemplates, virtv1.DataVolumeTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "dv1",
+				},
+				Spec: cdiv1.DataVolumeSpec{
+					Storage: &cdiv1.StorageSpec{},
+				},
+			})
+			vm.Status.PrintableStatus = virtv1.VirtualMachineStatusStopped
+			addVirtualMachine(vm)
+
+			instancetypeMethods.FindPreferenceSpecFunc = func(_ *virtv1.VirtualMachine) (*instancetypev1beta1.VirtualMachinePreferenceSpec, error) {
+				return &instancetypev1beta1.VirtualMachinePreferenceSpec{
+					Volumes: &instancetypev1beta1.VolumePreferences{
+						PreferredStorageClassName: "ssd",
+						PreferredAccessMode:       k8sv1.ReadWriteMany,
+					},
+				}, nil
+			}
+
+			createCount := 0
+			cdiClient.Fake.PrependReactor("create", "datavolumes", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				createCount++
+				dataVolume := action.(testing.CreateAction).GetObject().(*cdiv1.DataVolume)
+				Expect(*dataVolume.Spec.Storage.StorageClassName).To(Equal("ssd"))
+				Expect(dataVolume.Spec.Storage.AccessModes).To(ConsistOf(k8sv1.ReadWriteMany))
+				return true, dataVolume, nil
+			})
+
+			vmInterface.EXPECT().UpdateStatus(context.Background(), gomock.Any()).AnyTimes()
+
+			controller.Execute()
+			Expect(createCount).To(Equal(1))
+			testutils.ExpectEvent(recorder, SuccessfulDataVolumeCreateReason)
+		})
+
+		It("should not create a missing DataVolume while the preference can't be found", func() {
+			vm, _ := DefaultVirtualMachine(true)
+			vm.Spec.Preference = &virtv1.PreferenceMatcher{Name: "windows"}
+			vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, virtv1.Volume{
+				Name: "test1",
+				VolumeSource: virtv1.VolumeSource{
+					DataVolume: &virtv1.DataVolumeSource{
+						Name: "dv1",
+					},
+				},
+			})
+			vm.Spec.DataVolumeTemplates = append(vm.Spec.DataVolumeTemplates, virtv1.DataVolumeTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "dv1",
+				},
+				Spec: cdiv1.DataVolumeSpec{
+					Storage: &cdiv1.StorageSpec{},
+				},
+			})
+			vm.Status.PrintableStatus = virtv1.VirtualMachineStatusStopped
+			addVirtualMachine(vm)
+
+			instancetypeMethods.FindPreferenceSpecFunc = func(_ *virtv1.VirtualMachine) (*instancetypev1beta1.VirtualMachinePreferenceSpec, error) {
+				return nil, fmt.Errorf("preference not found")
+			}
+
+			createCount := 0
+			cdiClient.Fake.PrependReactor("create", "datavolumes", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				createCount++
+				return true, action.(testing.CreateAction).GetObject(), nil
+			})
+
+			vmInterface.EXPECT().UpdateStatus(context.Background(), gomock.Any()).AnyTimes()
+
+			controller.Execute()
+			Expect(createCount).To(BeZero())
+			Expect(mockQueue.GetRateLimitedEnqueueCount()).To(Equal(1))
+		})
+
 		DescribeTable("should hotplug a vm", func(isRunning bool) {
 
 			vm, vmi := DefaultVirtualMachine(isRunning)
@@ -1648,6 +1734,97 @@ var _ = Describe("VirtualMachine", func() {
 				)
 			})
 
+			Context("pending changes", func() {
+				var vm *virtv1.VirtualMachine
+				var vmi *virtv1.VirtualMachineInstance
+
+				syncPendingChanges := func() ([]virtv1.VirtualMachinePendingChange, *virtv1.VirtualMachineCondition) {
+					controller.syncConditions(vm, vmi, nil)
+					return vm.Status.PendingChanges, virtcontroller.NewVirtualMachineConditionManager().GetCondition(vm, virtv1.VirtualMachineRestartRequired)
+				}
+
+				addDataVolume := func(spec *virtv1.VirtualMachineInstanceSpec) {
+					spec.Domain.Devices.Disks = append(spec.Domain.Devices.Disks, virtv1.Disk{
+						Name:       "data",
+						DiskDevice: virtv1.DiskDevice{Disk: &virtv1.DiskTarget{Bus: virtv1.DiskBusVirtio}},
+					})
+					spec.Volumes = append(spec.Volumes, virtv1.Volume{
+						Name:         "data",
+						VolumeSource: virtv1.VolumeSource{ContainerDisk: &virtv1.ContainerDiskSource{Image: "data"}},
+					})
+				}
+
+				BeforeEach(func() {
+					vm, vmi = DefaultVirtualMachine(true)
+					vm.Generation = 1
+					vm.Spec.Template.Spec.Domain.CPU = &virtv1.CPU{Sockets: 2, Cores: 1, Threads: 1, MaxSockets: 8}
+					vmi.Spec = *vm.Spec.Template.Spec.DeepCopy()
+					// defaults applied to the VMI on creation are not changes of the template
+					vmi.Spec.Domain.Machine = &virtv1.Machine{Type: "q35"}
+
+					vmRevision := createVMRevision(vm)
+					Expect(crInformer.GetStore().Add(vmRevision)).To(Succeed())
+					vmi.Status.VirtualMachineRevisionName = vmRevision.Name
+
+					vm.Status.ObservedGeneration = 1
+					vm.Generation = 2
+				})
+
+				It("should not report changes while the template matches the started VMI", func() {
+					changes, cond := syncPendingChanges()
+					Expect(changes).To(BeEmpty())
+					Expect(cond).To(BeNil())
+				})
+
+				It("should not report changes which are already applied to the running VMI", func() {
+					addDataVolume(&vm.Spec.Template.Spec)
+					addDataVolume(&vmi.Spec)
+
+					changes, cond := syncPendingChanges()
+					Expect(changes).To(BeEmpty())
+					Expect(cond).To(BeNil())
+				})
+
+				It("should report a live updatable CPU change without requiring a restart", func() {
+					vm.Spec.LiveUpdateFeatures = &virtv1.LiveUpdateFeatures{CPU: &virtv1.LiveUpdateCPU{}}
+					vm.Spec.Template.Spec.Domain.CPU.Sockets = 4
+
+					changes, cond := syncPendingChanges()
+					Expect(changes).To(ConsistOf(virtv1.VirtualMachinePendingChange{
+						Path: "spec.domain.cpu.sockets", Current: "2", Desired: "4", LiveUpdatable: true,
+					}))
+					Expect(cond).To(BeNil())
+				})
+
+				It("should require a restart for changes which can not be applied live", func() {
+					vm.Spec.Template.Spec.Domain.CPU.Sockets = 4
+					addDataVolume(&vm.Spec.Template.Spec)
+
+					changes, cond := syncPendingChanges()
+					Expect(changes).To(Equal([]virtv1.VirtualMachinePendingChange{
+						{Path: "spec.domain.cpu.sockets", Current: "2", Desired: "4"},
+						{Path: "spec.domain.devices.disks[name=data]", Desired: `{"disk":{"bus":"virtio"},"name":"data"}`},
+						{Path: "spec.volumes[name=data]", Desired: `{"containerDisk":{"image":"data"},"name":"data"}`},
+					}))
+					Expect(cond).ToNot(BeNil())
+					Expect(cond.Status).To(Equal(k8sv1.ConditionTrue))
+					Expect(cond.Reason).To(Equal(TemplateChangedReason))
+					Expect(cond.Message).To(Equal("The changes of spec.domain.cpu.sockets, spec.domain.devices.disks[name=data], spec.volumes[name=data] are applied on the next restart of the VM"))
+				})
+
+				It("should clear the pending changes once the VMI is gone", func() {
+					vm.Spec.Template.Spec.Domain.CPU.Sockets = 4
+					changes, cond := syncPendingChanges()
+					Expect(changes).ToNot(BeEmpty())
+					Expect(cond).ToNot(BeNil())
+
+					vmi = nil
+					changes, cond = syncPendingChanges()
+					Expect(changes).To(BeEmpty())
+					Expect(cond).To(BeNil())
+				})
+			})
+
 			DescribeTable("should sync the generation info", func(initialAnnotations map[string]string, desiredAnnotations map[string]string, revisionVmGeneration int64, vmGeneration int64, desiredErr error, expectPatch bool, desiredObservedGeneration int64, desiredDesiredGeneration int64) {
 				vm, vmi := DefaultVirtualMachine(true)
 				vmi.ObjectMeta.Annotations = initialAnnotations
@@ -2006,6 +2183,137 @@ var _ = Describe("VirtualMachine", func() {
 			testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
 		})
 
+		Context("with dependencies", func() {
+			dependencyVMI := func(name string, conditionTypes ...virtv1.VirtualMachineInstanceConditionType) *virtv1.VirtualMachineInstance {
+				_, vmi := DefaultVirtualMachineWithNames(true, name, name)
+				for _, conditionType := range conditionTypes {
+					vmi.Status.Conditions = append(vmi.Status.Conditions, virtv1.VirtualMachineInstanceCondition{
+						Type:   conditionType,
+						Status: k8sv1.ConditionTrue,
+					})
+				}
+				return vmi
+			}
+
+			expectDependenciesPending := func(printableStatus virtv1.VirtualMachinePrintableStatus, reason, message string) {
+				vmInterface.EXPECT().UpdateStatus(context.Background(), gomock.Any()).Do(func(ctx context.Context, arg interface{}) {
+					vm := arg.(*virtv1.VirtualMachine)
+					Expect(vm.Status.PrintableStatus).To(Equal(printableStatus))
+					cond := virtcontroller.NewVirtualMachineConditionManager().GetCondition(vm, virtv1.VirtualMachineDependenciesPending)
+					Expect(cond).ToNot(BeNil())
+					Expect(cond.Status).To(Equal(k8sv1.ConditionTrue))
+					Expect(cond.Reason).To(Equal(reason))
+					Expect(cond.Message).To(Equal(message))
+				}).Return(nil, nil)
+			}
+
+			It("should not start a VM before its dependencies are ready", func() {
+				vm, _ := DefaultVirtualMachine(true)
+				vm.Spec.DependsOn = []virtv1.VirtualMachineDependency{{Name: "db"}, {Name: "cache"}}
+
+				addVirtualMachine(vm)
+				Expect(vmiInformer.GetStore().Add(dependencyVMI("db", virtv1.VirtualMachineInstanceReady))).To(Succeed())
+				Expect(vmiInformer.GetStore().Add(dependencyVMI("cache"))).To(Succeed())
+
+				expectDependenciesPending(virtv1.VirtualMachineStatusWaitingForDependencies, WaitingForDependenciesReason, "Waiting for the VirtualMachines cache before starting")
+
+				controller.Execute()
+			})
+
+			DescribeTable("should start a VM once its dependencies reached their condition", func(condition virtv1.VirtualMachineDependencyCondition, conditionType virtv1.VirtualMachineInstanceConditionType) {
+				vm, vmi := DefaultVirtualMachine(true)
+				vm.Spec.DependsOn = []virtv1.VirtualMachineDependency{{Name: "db", Condition: condition}}
+
+				addVirtualMachine(vm)
+				Expect(vmiInformer.GetStore().Add(dependencyVMI("db", conditionType))).To(Succeed())
+
+				vmiInterface.EXPECT().Create(context.Background(), gomock.Any()).Return(vmi, nil)
+				vmInterface.EXPECT().UpdateStatus(context.Background(), gomock.Any()).Do(func(ctx context.Context, arg interface{}) {
+					cond := virtcontroller.NewVirtualMachineConditionManager().GetCondition(arg.(*virtv1.VirtualMachine), virtv1.VirtualMachineDependenciesPending)
+					Expect(cond).To(BeNil())
+				}).Return(nil, nil)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineReason)
+			},
+				Entry("when the dependency is ready", virtv1.VirtualMachineDependencyCondition(""), virtv1.VirtualMachineInstanceReady),
+				Entry("when the guest agent of the dependency is connected", virtv1.DependencyAgentConnected, virtv1.VirtualMachineInstanceAgentConnected),
+			)
+
+			It("should report a dependency cycle", func() {
+				vm, _ := DefaultVirtualMachineWithNames(true, "app", "app")
+				vm.Spec.DependsOn = []virtv1.VirtualMachineDependency{{Name: "db"}}
+				db, _ := DefaultVirtualMachineWithNames(true, "db", "db")
+				db.Spec.DependsOn = []virtv1.VirtualMachineDependency{{Name: "app"}}
+
+				addVirtualMachine(vm)
+				Expect(vmInformer.GetStore().Add(db)).To(Succeed())
+
+				expectDependenciesPending(virtv1.VirtualMachineStatusWaitingForDependencies, DependencyCycleReason, "The VirtualMachines app -> db -> app depend on each other")
+
+				controller.Execute()
+			})
+
+			It("should stop the dependents of a VM before the VM itself", func() {
+				db, dbVMI := DefaultVirtualMachineWithNames(false, "db", "db")
+				app, appVMI := DefaultVirtualMachineWithNames(false, "app", "app")
+				app.Spec.DependsOn = []virtv1.VirtualMachineDependency{{Name: "db"}}
+
+				addVirtualMachine(db)
+				vmiFeeder.Add(dbVMI)
+				Expect(vmInformer.GetStore().Add(app)).To(Succeed())
+				Expect(vmiInformer.GetStore().Add(appVMI)).To(Succeed())
+
+				vmiInterface.EXPECT().Delete(context.Background(), gomock.Any(), gomock.Any()).Times(0)
+				expectDependenciesPending(virtv1.VirtualMachineStatusRunning, WaitingForDependentsReason, "Waiting for the VirtualMachines app to stop first")
+
+				controller.Execute()
+			})
+
+			It("should stop VMs depending on each other without waiting for each other", func() {
+				db, dbVMI := DefaultVirtualMachineWithNames(false, "db", "db")
+				db.Spec.DependsOn = []virtv1.VirtualMachineDependency{{Name: "app"}}
+				app, appVMI := DefaultVirtualMachineWithNames(false, "app", "app")
+				app.Spec.DependsOn = []virtv1.VirtualMachineDependency{{Name: "db"}}
+
+				addVirtualMachine(db)
+				Expect(vmiInformer.GetStore().Add(dbVMI)).To(Succeed())
+				Expect(vmInformer.GetStore().Add(app)).To(Succeed())
+				Expect(vmiInformer.GetStore().Add(appVMI)).To(Succeed())
+
+				vmiInterface.EXPECT().Delete(context.Background(), "db", gomock.Any()).Return(nil)
+				vmInterface.EXPECT().UpdateStatus(context.Background(), gomock.Any()).Do(func(ctx context.Context, arg interface{}) {
+					cond := virtcontroller.NewVirtualMachineConditionManager().GetCondition(arg.(*virtv1.VirtualMachine), virtv1.VirtualMachineDependenciesPending)
+					Expect(cond).ToNot(BeNil())
+					Expect(cond.Reason).To(Equal(DependencyCycleReason))
+					Expect(cond.Message).To(Equal("The VirtualMachines db -> app -> db depend on each other, stopping without waiting for them"))
+				}).Return(nil, nil)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+			})
+
+			It("should stop a VM whose dependents keep running", func() {
+				db, dbVMI := DefaultVirtualMachineWithNames(false, "db", "db")
+				app, appVMI := DefaultVirtualMachineWithNames(true, "app", "app")
+				app.Spec.DependsOn = []virtv1.VirtualMachineDependency{{Name: "db"}}
+
+				addVirtualMachine(db)
+				vmiFeeder.Add(dbVMI)
+				Expect(vmInformer.GetStore().Add(app)).To(Succeed())
+				Expect(vmiInformer.GetStore().Add(appVMI)).To(Succeed())
+
+				vmiInterface.EXPECT().Delete(context.Background(), "db", gomock.Any()).Return(nil)
+				vmInterface.EXPECT().UpdateStatus(context.Background(), gomock.Any()).Return(nil, nil)
+
+				controller.Execute()
+
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+			})
+		})
+
 		It("should add controller finalizer if VirtualMachine does not have it", func() {
 			vm, _ := DefaultVirtualMachine(false)
 			vm.Finalizers = nil
@@ -4024,6 +4332,109 @@ var _ = Describe("VirtualMachine", func() {
 					testutils.ExpectEvents(recorder, FailedCreateVirtualMachineReason)
 
 				})
+
+				Context("resized on a running VM", func() {
+					resizeInstancetype := func(guestCPUs uint32, guestMemory string) {
+						resized := instancetypeObj.DeepCopy()
+						resized.Spec.CPU.Guest = guestCPUs
+						resized.Spec.Memory.Guest = resource.MustParse(guestMemory)
+						Expect(instancetypeInformerStore.Update(resized)).To(Succeed())
+					}
+
+					getRestartRequiredCondition := func() *virtv1.VirtualMachineCondition {
+						controller.syncConditions(vm, vmi, nil)
+						return virtcontroller.NewVirtualMachineConditionManager().GetCondition(vm, virtv1.VirtualMachineRestartRequired)
+					}
+
+					BeforeEach(func() {
+						vm.Spec.Instancetype = &v1.InstancetypeMatcher{
+							Name: instancetypeObj.Name,
+							Kind: instancetypeapi.SingularResourceName,
+						}
+						vm.Spec.LiveUpdateFeatures = &virtv1.LiveUpdateFeatures{
+							CPU: &virtv1.LiveUpdateCPU{},
+						}
+						vmi.Spec.Domain.CPU = &virtv1.CPU{
+							Sockets:    instancetypeObj.Spec.CPU.Guest,
+							Cores:      1,
+							Threads:    1,
+							MaxSockets: 8,
+						}
+						vmi.Spec.Domain.Memory = &virtv1.Memory{
+							Guest: kvpointer.P(instancetypeObj.Spec.Memory.Guest),
+						}
+					})
+
+					It("should not require a restart while the VMI matches the instancetype", func() {
+						Expect(controller.handleCPUChangeRequest(vm, vmi)).To(Succeed())
+						Expect(getRestartRequiredCondition()).To(BeNil())
+					})
+
+					It("should hotplug the CPU sockets of the new size", func() {
+						resizeInstancetype(4, "128M")
+
+						patch := `[{ "op": "test", "path": "/spec/domain/cpu/sockets", "value": 2}, { "op": "replace", "path": "/spec/domain/cpu/sockets", "value": 4}]`
+						vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, []byte(patch), &metav1.PatchOptions{}).Return(vmi, nil)
+
+						Expect(controller.handleCPUChangeRequest(vm, vmi)).To(Succeed())
+						Expect(getRestartRequiredCondition()).To(BeNil())
+					})
+
+					DescribeTable("should require a restart", func(guestCPUs uint32, guestMemory string, liveUpdateFeatures *virtv1.LiveUpdateFeatures, expectedMessage string) {
+						vm.Spec.LiveUpdateFeatures = liveUpdateFeatures
+						resizeInstancetype(guestCPUs, guestMemory)
+
+						Expect(controller.handleCPUChangeRequest(vm, vmi)).To(Succeed())
+
+						cond := getRestartRequiredCondition()
+						Expect(cond).ToNot(BeNil())
+						Expect(cond.Status).To(Equal(k8sv1.ConditionTrue))
+						Expect(cond.Reason).To(Equal(InstancetypeResizedReason))
+						Expect(cond.Message).To(Equal(expectedMessage))
+					},
+						Entry("without CPU live updates", uint32(4), "128M", nil, "The CPU changes of the instancetype are applied on the next restart of the VM"),
+						Entry("when the CPU sockets exceed the maximum", uint32(16), "128M", &virtv1.LiveUpdateFeatures{CPU: &virtv1.LiveUpdateCPU{}}, "The CPU changes of the instancetype are applied on the next restart of the VM"),
+						Entry("when the memory changes", uint32(2), "256M", &virtv1.LiveUpdateFeatures{CPU: &virtv1.LiveUpdateCPU{}}, "The memory changes of the instancetype are applied on the next restart of the VM"),
+					)
+
+					It("should list the template changes next to the instancetype changes", func() {
+						vm.Generation = 1
+						vmRevision := createVMRevision(vm)
+						Expect(crInformer.GetStore().Add(vmRevision)).To(Succeed())
+						vmi.Status.VirtualMachineRevisionName = vmRevision.Name
+						vm.Status.ObservedGeneration = 1
+						vm.Generation = 2
+
+						vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, virtv1.Volume{
+							Name:         "data",
+							VolumeSource: virtv1.VolumeSource{ContainerDisk: &virtv1.ContainerDiskSource{Image: "data"}},
+						})
+						resizeInstancetype(2, "256M")
+
+						cond := getRestartRequiredCondition()
+						Expect(cond).ToNot(BeNil())
+						Expect(cond.Reason).To(Equal(InstancetypeResizedReason))
+						Expect(cond.Message).To(Equal("The memory changes of the instancetype and the changes of spec.volumes[name=data] are applied on the next restart of the VM"))
+					})
+
+					It("should list the changes of the new size as pending changes", func() {
+						resizeInstancetype(4, "256M")
+
+						Expect(getRestartRequiredCondition()).ToNot(BeNil())
+						Expect(vm.Status.PendingChanges).To(Equal([]virtv1.VirtualMachinePendingChange{
+							{Path: "spec.domain.cpu.sockets", Current: "2", Desired: "4", LiveUpdatable: true},
+							{Path: "spec.domain.memory.guest", Current: `"128M"`, Desired: `"256M"`},
+						}))
+					})
+
+					It("should remove the RestartRequired condition once the VMI is gone", func() {
+						resizeInstancetype(2, "256M")
+						Expect(getRestartRequiredCondition()).ToNot(BeNil())
+
+						vmi = nil
+						Expect(getRestartRequiredCondition()).To(BeNil())
+					})
+				})
 			})
 
 			Context("preference", func() {
@@ -0,0 +1,170 @@
+package workloadupdater
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+// maintenanceWindowStartTimeLayout is the layout of the start time of a maintenance window
+const maintenanceWindowStartTimeLayout = "15:04"
+
+// the longest a maintenance window can stay open is a week, so looking back
+// that many days covers every window which could still be open
+const maintenanceWindowLookbackDays = 7
+
+type workloadUpdateMigrations struct {
+	succeeded int
+	failed    int
+	inFlight  int
+}
+
+// reportsRolloutStatus returns whether the rollout of the workload updates is tracked in the KubeVirt status.
+// This is only the case when the rollout is restricted by any of the rollout controls.
+func reportsRolloutStatus(kv *virtv1.KubeVirt) bool {
+	strategy := kv.Spec.WorkloadUpdateStrategy
+	return kv.Status.WorkloadUpdateStatus != nil ||
+		len(strategy.MaintenanceWindows) > 0 ||
+		(strategy.CanaryPercentage != nil && *strategy.CanaryPercentage > 0) ||
+		strategy.MaxMigrationFailurePercentage != nil
+}
+
+// isExcluded returns whether a VMI opted out of automated workload updates
+func isExcluded(strategy virtv1.KubeVirtWorkloadUpdateStrategy, vmi *virtv1.VirtualMachineInstance) bool {
+	for _, namespace := range strategy.ExcludedNamespaces {
+		if vmi.Namespace == namespace {
+			return true
+		}
+	}
+
+	if strategy.ExcludedSelector == nil {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(strategy.ExcludedSelector)
+	if err != nil {
+		log.Log.Reason(err).Error("Invalid excludedSelector in the workload update strategy")
+		return false
+	}
+	return selector.Matches(labels.Set(vmi.Labels))
+}
+
+// inMaintenanceWindow returns whether any of the windows is open at the given time
+func inMaintenanceWindow(windows []virtv1.WorkloadUpdateMaintenanceWindow, now time.Time) bool {
+	if len(windows) == 0 {
+		return true
+	}
+
+	now = now.UTC()
+	for _, window := range windows {
+		startTime, err := time.Parse(maintenanceWindowStartTimeLayout, window.StartTime)
+		if err != nil {
+			log.Log.Reason(err).Errorf("Invalid startTime %s of a maintenance window", window.StartTime)
+			continue
+		}
+
+		for daysAgo := 0; daysAgo <= maintenanceWindowLookbackDays; daysAgo++ {
+			day := now.AddDate(0, 0, -daysAgo)
+			if !opensOn(window, day.Weekday()) {
+				continue
+			}
+			start := time.Date(day.Year(), day.Month(), day.Day(), startTime.Hour(), startTime.Minute(), 0, 0, time.UTC)
+			if !now.Before(start) && now.Before(start.Add(window.Duration.Duration)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func opensOn(window virtv1.WorkloadUpdateMaintenanceWindow, weekday time.Weekday) bool {
+	if len(window.Days) == 0 {
+		return true
+	}
+	for _, day := range window.Days {
+		if string(day) == weekday.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// countWorkloadUpdateMigrations counts the migrations created to update the workloads to the given launcher image
+func (c *WorkloadUpdateController) countWorkloadUpdateMigrations(launcherImage string) workloadUpdateMigrations {
+	counts := workloadUpdateMigrations{}
+	for _, obj := range c.migrationInformer.GetStore().List() {
+		migration := obj.(*virtv1.VirtualMachineInstanceMigration)
+		if image, exists := migration.Annotations[virtv1.WorkloadUpdateMigrationAnnotation]; !exists || image != launcherImage {
+			continue
+		}
+
+		switch migration.Status.Phase {
+		case virtv1.MigrationSucceeded:
+			counts.succeeded++
+		case virtv1.MigrationFailed:
+			counts.failed++
+		default:
+			counts.inFlight++
+		}
+	}
+	return counts
+}
+
+// rolloutStatus computes the status of the rollout to the current launcher image and returns it together
+// with the number of VMIs which may still be migrated or evicted during this sync. A negative budget means unlimited.
+func (c *WorkloadUpdateController) rolloutStatus(kv *virtv1.KubeVirt, data *updateData, now time.Time) (*virtv1.KubeVirtWorkloadUpdateStatus, int) {
+	strategy := kv.Spec.WorkloadUpdateStrategy
+
+	status := &virtv1.KubeVirtWorkloadUpdateStatus{LauncherImage: c.launcherImage}
+	if kv.Status.WorkloadUpdateStatus != nil && kv.Status.WorkloadUpdateStatus.LauncherImage == c.launcherImage {
+		status = kv.Status.WorkloadUpdateStatus.DeepCopy()
+	} else if strategy.CanaryPercentage != nil {
+		// a new rollout starts, the canaries are picked from the VMIs outdated right now
+		status.CanaryTarget = int(math.Ceil(float64(data.numUpdateCandidates) * float64(*strategy.CanaryPercentage) / 100))
+	}
+
+	// finished migrations get garbage collected over time, so never let the counters go backwards
+	migrations := c.countWorkloadUpdateMigrations(c.launcherImage)
+	status.SucceededMigrations = maxInt(status.SucceededMigrations, migrations.succeeded)
+	status.FailedMigrations = maxInt(status.FailedMigrations, migrations.failed)
+
+	finished := status.SucceededMigrations + status.FailedMigrations
+	updated := status.SucceededMigrations + status.Evictions
+
+	switch {
+	case data.numUpdateCandidates == 0:
+		status.Phase = virtv1.WorkloadUpdatePhaseCompleted
+		status.Reason = "All workloads which can be updated automatically are up to date"
+		return status, 0
+	case strategy.MaxMigrationFailurePercentage != nil && finished > 0 &&
+		status.FailedMigrations*100 > *strategy.MaxMigrationFailurePercentage*finished:
+		status.Phase = virtv1.WorkloadUpdatePhasePaused
+		status.Reason = fmt.Sprintf("%d of %d workload update migrations failed, which exceeds the threshold of %d%%",
+			status.FailedMigrations, finished, *strategy.MaxMigrationFailurePercentage)
+		return status, 0
+	case !inMaintenanceWindow(strategy.MaintenanceWindows, now):
+		status.Phase = virtv1.WorkloadUpdatePhaseWaitingForMaintenanceWindow
+		status.Reason = "No maintenance window is open"
+		return status, 0
+	case updated < status.CanaryTarget:
+		status.Phase = virtv1.WorkloadUpdatePhaseCanary
+		status.Reason = fmt.Sprintf("%d of %d canary workloads updated", updated, status.CanaryTarget)
+		return status, maxInt(status.CanaryTarget-updated-migrations.inFlight, 0)
+	default:
+		status.Phase = virtv1.WorkloadUpdatePhaseProgressing
+		status.Reason = fmt.Sprintf("%d workloads left to update", data.numUpdateCandidates)
+		return status, -1
+	}
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -6,12 +6,14 @@ import (
 	"math"
 	"math/rand"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"golang.org/x/time/rate"
 	k8sv1 "k8s.io/api/core/v1"
 	policy "k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -88,6 +90,8 @@ type updateData struct {
 	evictOutdatedVMIs      []*virtv1.VirtualMachineInstance
 
 	numActiveMigrations int
+	// numUpdateCandidates is the number of outdated VMIs which did not opt out of automated workload updates
+	numUpdateCandidates int
 }
 
 func NewWorkloadUpdateController(
@@ -363,6 +367,11 @@ func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) *updateDat
 
 		data.allOutdatedVMIs = append(data.allOutdatedVMIs, vmi)
 
+		if isExcluded(kv.Spec.WorkloadUpdateStrategy, vmi) {
+			continue
+		}
+		data.numUpdateCandidates++
+
 		// don't consider VMIs with migrations inflight as migratable for our dataset
 		// while a migrating workload can still be counted towards
 		// the outDatedVMIs list, we don't want to add it to any
@@ -481,12 +490,12 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 
 	now := time.Now()
 
-	nextBatch := c.lastDeletionBatch.Add(batchDeletionInterval)
-	if now.After(nextBatch) && len(data.evictOutdatedVMIs) > 0 {
-		batchDeletionCount = int(math.Min(float64(batchDeletionCount), float64(len(data.evictOutdatedVMIs))))
-		c.lastDeletionBatch = now
-	} else {
-		batchDeletionCount = 0
+	// budget limits the number of VMIs updated in this sync while the rollout
+	// is restricted, e.g. to the canaries. A negative budget is unlimited.
+	budget := -1
+	var rollout *virtv1.KubeVirtWorkloadUpdateStatus
+	if reportsRolloutStatus(kv) {
+		rollout, budget = c.rolloutStatus(kv, data, now)
 	}
 
 	// This is a best effort attempt at not creating a bunch of pending migrations
@@ -502,6 +511,22 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 	}
 
 	migrateCount := int(math.Min(float64(maxNewMigrations), float64(len(data.migratableOutdatedVMIs))))
+	if budget >= 0 {
+		migrateCount = int(math.Min(float64(migrateCount), float64(budget)))
+		budget -= migrateCount
+	}
+
+	nextBatch := c.lastDeletionBatch.Add(batchDeletionInterval)
+	if now.After(nextBatch) && len(data.evictOutdatedVMIs) > 0 && budget != 0 {
+		batchDeletionCount = int(math.Min(float64(batchDeletionCount), float64(len(data.evictOutdatedVMIs))))
+		if budget > 0 {
+			batchDeletionCount = int(math.Min(float64(batchDeletionCount), float64(budget)))
+		}
+		c.lastDeletionBatch = now
+	} else {
+		batchDeletionCount = 0
+	}
+
 	migrationCandidates := []*virtv1.VirtualMachineInstance{}
 	if migrateCount > 0 {
 		migrationCandidates = data.migratableOutdatedVMIs[0:migrateCount]
@@ -516,6 +541,7 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 	wg := &sync.WaitGroup{}
 	wg.Add(wgLen)
 	errChan := make(chan error, wgLen)
+	var evictions int32
 
 	c.migrationExpectations.ExpectCreations(key, migrateCount)
 	for _, vmi := range migrationCandidates {
@@ -524,7 +550,7 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 			createdMigration, err := c.clientset.VirtualMachineInstanceMigration(vmi.Namespace).Create(&virtv1.VirtualMachineInstanceMigration{
 				ObjectMeta: metav1.ObjectMeta{
 					Annotations: map[string]string{
-						virtv1.WorkloadUpdateMigrationAnnotation: "",
+						virtv1.WorkloadUpdateMigrationAnnotation: c.launcherImage,
 					},
 					GenerateName: "kubevirt-workload-update-",
 				},
@@ -555,6 +581,7 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 				log.Log.Object(vmi).Reason(err).Errorf("Failed to detect active pod for vmi during workload update")
 				c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, FailedEvictVirtualMachineInstanceReason, "Error detecting active pod for VMI during workload update: %v", err)
 				errChan <- err
+				return
 			}
 
 			err = c.clientset.CoreV1().Pods(vmi.Namespace).EvictV1beta1(context.Background(),
@@ -571,6 +598,11 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 				c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, FailedEvictVirtualMachineInstanceReason, "Error deleting VMI during automated workload update: %v", err)
 				errChan <- err
 			} else {
+				// only evictions which went through count towards the canaries, a pod which
+				// was already gone did not update anything
+				if err == nil {
+					atomic.AddInt32(&evictions, 1)
+				}
 				log.Log.Object(vmi).Infof("Evicted vmi pod as part of workload update")
 				c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, SuccessfulEvictVirtualMachineInstanceReason, "Initiated eviction of VMI as part of automated workload update: %v", err)
 			}
@@ -579,6 +611,13 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 
 	wg.Wait()
 
+	if rollout != nil {
+		rollout.Evictions += int(evictions)
+		if err := c.patchRolloutStatus(kv, rollout); err != nil {
+			return err
+		}
+	}
+
 	select {
 	case err := <-errChan:
 		return err
@@ -587,3 +626,32 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 
 	return nil
 }
+
+func (c *WorkloadUpdateController) patchRolloutStatus(kv *virtv1.KubeVirt, rollout *virtv1.KubeVirtWorkloadUpdateStatus) error {
+	if equality.Semantic.DeepEqual(kv.Status.WorkloadUpdateStatus, rollout) {
+		return nil
+	}
+
+	newJson, err := json.Marshal(rollout)
+	if err != nil {
+		return err
+	}
+
+	patch := ""
+	if kv.Status.WorkloadUpdateStatus == nil {
+		patch = fmt.Sprintf(`[{ "op": "add", "path": "/status/workloadUpdateStatus", "value": %s}]`, string(newJson))
+	} else {
+		oldJson, err := json.Marshal(kv.Status.WorkloadUpdateStatus)
+		if err != nil {
+			return err
+		}
+		test := fmt.Sprintf(`{ "op": "test", "path": "/status/workloadUpdateStatus", "value": %s}`, string(oldJson))
+		update := fmt.Sprintf(`{ "op": "replace", "path": "/status/workloadUpdateStatus", "value": %s}`, string(newJson))
+		patch = fmt.Sprintf("[%s, %s]", test, update)
+	}
+
+	if err := c.statusUpdater.PatchStatus(kv, types.JSONPatchType, []byte(patch)); err != nil {
+		return fmt.Errorf("unable to patch kubevirt obj status to update the workloadUpdateStatus: %v", err)
+	}
+	return nil
+}
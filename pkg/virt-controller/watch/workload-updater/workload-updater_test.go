@@ -1,11 +1,13 @@
 package workloadupdater
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/golang/mock/gomock"
 	v12 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v13 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -15,6 +17,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	framework "k8s.io/client-go/tools/cache/testing"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
 
 	"kubevirt.io/client-go/api"
 
@@ -437,6 +440,149 @@ var _ = Describe("Workload Updater", func() {
 
 	})
 
+	Context("workload update rollout controls", func() {
+		expectRolloutStatus := func(phase v1.WorkloadUpdatePhase, canaryTarget int) {
+			kubeVirtInterface.EXPECT().PatchStatus(gomock.Any(), types.JSONPatchType, gomock.Any(), gomock.Any()).Do(func(name string, pt types.PatchType, data []byte, patchOptions *metav1.PatchOptions) {
+				patch := []struct {
+					Op    string                           `json:"op"`
+					Path  string                           `json:"path"`
+					Value *v1.KubeVirtWorkloadUpdateStatus `json:"value"`
+				}{}
+				Expect(json.Unmarshal(data, &patch)).To(Succeed())
+				Expect(patch).To(HaveLen(1))
+				Expect(patch[0].Op).To(Equal("add"))
+				Expect(patch[0].Path).To(Equal("/status/workloadUpdateStatus"))
+				Expect(patch[0].Value.LauncherImage).To(Equal(expectedImage))
+				Expect(patch[0].Value.Phase).To(Equal(phase))
+				Expect(patch[0].Value.CanaryTarget).To(Equal(canaryTarget))
+			}).Return(nil, nil).Times(1)
+		}
+
+		It("should not update VMIs in excluded namespaces", func() {
+			newVirtualMachine("testvm", true, "madeup", vmiSource, podSource)
+			newVirtualMachine("testvm-non-migratable", false, "madeup", vmiSource, podSource)
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 2)
+			kv := newKubeVirt(2)
+			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate, v1.WorkloadUpdateMethodEvict}
+			kv.Spec.WorkloadUpdateStrategy.ExcludedNamespaces = []string{v12.NamespaceDefault}
+			addKubeVirt(kv)
+
+			controller.Execute()
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should not update VMIs matching the excluded selector", func() {
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Labels = map[string]string{"update": "manual"}
+			Expect(isExcluded(v1.KubeVirtWorkloadUpdateStrategy{
+				ExcludedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"update": "manual"}},
+			}, vmi)).To(BeTrue())
+			Expect(isExcluded(v1.KubeVirtWorkloadUpdateStrategy{
+				ExcludedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"update": "automatic"}},
+			}, vmi)).To(BeFalse())
+		})
+
+		It("should only update the canaries when a canary percentage is set", func() {
+			const totalVMs = 10
+			for i := 0; i < totalVMs; i++ {
+				newVirtualMachine(fmt.Sprintf("testvm-migratable-%d", i), true, "madeup", vmiSource, podSource)
+			}
+			waitForNumberOfInstancesOnVMIInformerCache(controller, totalVMs)
+			kv := newKubeVirt(totalVMs)
+			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate}
+			kv.Spec.WorkloadUpdateStrategy.CanaryPercentage = pointer.Int(15)
+			addKubeVirt(kv)
+
+			migrationInterface.EXPECT().Create(gomock.Any(), &metav1.CreateOptions{}).Do(func(migration *v1.VirtualMachineInstanceMigration, _ *metav1.CreateOptions) {
+				Expect(migration.Annotations).To(HaveKeyWithValue(v1.WorkloadUpdateMigrationAnnotation, expectedImage))
+			}).Return(&v1.VirtualMachineInstanceMigration{ObjectMeta: v13.ObjectMeta{Name: "something"}}, nil).Times(2)
+			expectRolloutStatus(v1.WorkloadUpdatePhaseCanary, 2)
+
+			controller.Execute()
+			testutils.ExpectEvents(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+		})
+
+		It("should pause the rollout when too many workload update migrations failed", func() {
+			newVirtualMachine("testvm", true, "madeup", vmiSource, podSource)
+			newVirtualMachine("testvm-non-migratable", false, "madeup", vmiSource, podSource)
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 2)
+			kv := newKubeVirt(2)
+			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate, v1.WorkloadUpdateMethodEvict}
+			kv.Spec.WorkloadUpdateStrategy.MaxMigrationFailurePercentage = pointer.Int(25)
+			addKubeVirt(kv)
+			for i, phase := range []v1.VirtualMachineInstanceMigrationPhase{v1.MigrationSucceeded, v1.MigrationFailed} {
+				migration := newMigration(fmt.Sprintf("vmim-%d", i), fmt.Sprintf("updated-vm-%d", i), phase)
+				migration.Annotations = map[string]string{v1.WorkloadUpdateMigrationAnnotation: expectedImage}
+				migrationFeeder.Add(migration)
+			}
+
+			expectRolloutStatus(v1.WorkloadUpdatePhasePaused, 0)
+
+			controller.Execute()
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should wait for a maintenance window to open", func() {
+			newVirtualMachine("testvm", true, "madeup", vmiSource, podSource)
+			newVirtualMachine("testvm-non-migratable", false, "madeup", vmiSource, podSource)
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 2)
+			kv := newKubeVirt(2)
+			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate, v1.WorkloadUpdateMethodEvict}
+			kv.Spec.WorkloadUpdateStrategy.MaintenanceWindows = []v1.WorkloadUpdateMaintenanceWindow{{
+				StartTime: time.Now().UTC().Add(12 * time.Hour).Format(maintenanceWindowStartTimeLayout),
+				Duration:  metav1.Duration{Duration: time.Hour},
+			}}
+			addKubeVirt(kv)
+
+			expectRolloutStatus(v1.WorkloadUpdatePhaseWaitingForMaintenanceWindow, 0)
+
+			controller.Execute()
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		DescribeTable("should only count evictions which went through towards the canaries", func(evictionErr error, expectedEvictions int, expectedEvent string) {
+			newVirtualMachine("testvm-non-migratable", false, "madeup", vmiSource, podSource)
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 1)
+			kv := newKubeVirt(1)
+			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodEvict}
+			kv.Spec.WorkloadUpdateStrategy.CanaryPercentage = pointer.Int(100)
+			addKubeVirt(kv)
+
+			kubeClient.Fake.PrependReactor("create", "pods", func(action testing.Action) (handled bool, ret runtime.Object, err error) {
+				return action.GetSubresource() == "eviction", nil, evictionErr
+			})
+			kubeVirtInterface.EXPECT().PatchStatus(gomock.Any(), types.JSONPatchType, gomock.Any(), gomock.Any()).Do(func(name string, pt types.PatchType, data []byte, patchOptions *metav1.PatchOptions) {
+				patch := []struct {
+					Value *v1.KubeVirtWorkloadUpdateStatus `json:"value"`
+				}{}
+				Expect(json.Unmarshal(data, &patch)).To(Succeed())
+				Expect(patch).To(HaveLen(1))
+				Expect(patch[0].Value.Evictions).To(Equal(expectedEvictions))
+			}).Return(nil, nil).Times(1)
+
+			_ = controller.sync(kv)
+			testutils.ExpectEvent(recorder, expectedEvent)
+		},
+			Entry("with a successful eviction", nil, 1, SuccessfulEvictVirtualMachineInstanceReason),
+			Entry("with an eviction blocked by a disruption budget", k8serrors.NewTooManyRequests("blocked", 10), 0, FailedEvictVirtualMachineInstanceReason),
+			Entry("with a pod which is already gone", k8serrors.NewNotFound(k8sv1.Resource("pods"), "virt-launcher"), 0, SuccessfulEvictVirtualMachineInstanceReason),
+		)
+
+		DescribeTable("should detect open maintenance windows", func(window v1.WorkloadUpdateMaintenanceWindow, now string, expected bool) {
+			nowTime, err := time.Parse(time.RFC3339, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(inMaintenanceWindow([]v1.WorkloadUpdateMaintenanceWindow{window}, nowTime)).To(Equal(expected))
+		},
+			// 2023-01-02 is a Monday
+			Entry("inside a daily window", v1.WorkloadUpdateMaintenanceWindow{StartTime: "01:00", Duration: metav1.Duration{Duration: 2 * time.Hour}}, "2023-01-02T02:00:00Z", true),
+			Entry("before a daily window", v1.WorkloadUpdateMaintenanceWindow{StartTime: "01:00", Duration: metav1.Duration{Duration: 2 * time.Hour}}, "2023-01-02T00:59:00Z", false),
+			Entry("after a daily window", v1.WorkloadUpdateMaintenanceWindow{StartTime: "01:00", Duration: metav1.Duration{Duration: 2 * time.Hour}}, "2023-01-02T03:00:00Z", false),
+			Entry("inside a window opened the day before", v1.WorkloadUpdateMaintenanceWindow{Days: []v1.WeekDay{"Sunday"}, StartTime: "22:00", Duration: metav1.Duration{Duration: 4 * time.Hour}}, "2023-01-02T01:00:00Z", true),
+			Entry("on a day the window does not open", v1.WorkloadUpdateMaintenanceWindow{Days: []v1.WeekDay{"Saturday"}, StartTime: "01:00", Duration: metav1.Duration{Duration: 2 * time.Hour}}, "2023-01-02T02:00:00Z", false),
+			Entry("in a different time zone", v1.WorkloadUpdateMaintenanceWindow{StartTime: "01:00", Duration: metav1.Duration{Duration: 2 * time.Hour}}, "2023-01-02T03:30:00+02:00", true),
+		)
+	})
+
 	AfterEach(func() {
 
 		close(stop)
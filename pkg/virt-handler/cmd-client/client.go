@@ -79,6 +79,7 @@ type MigrationOptions struct {
 	AllowAutoConverge        bool
 	AllowPostCopy            bool
 	ParallelMigrationThreads *uint
+	Compression              v1.MigrationCompression
 }
 
 type LauncherClient interface {
@@ -102,6 +103,7 @@ type LauncherClient interface {
 	GetGuestInfo() (*v1.VirtualMachineInstanceGuestAgentInfo, error)
 	GetUsers() (v1.VirtualMachineInstanceGuestOSUserList, error)
 	GetFilesystems() (v1.VirtualMachineInstanceFileSystemList, error)
+	GetGuestAgentCommandResults() (v1.VirtualMachineInstanceGuestAgentCommandResultList, error)
 	Exec(string, string, []string, int32) (int, string, error)
 	Ping() error
 	GuestPing(string, int32) error
@@ -733,6 +735,36 @@ func (c *VirtLauncherClient) GetFilesystems() (v1.VirtualMachineInstanceFileSyst
 	return filesystemList, nil
 }
 
+// GetGuestAgentCommandResults returns the latest results of the custom guest agent commands
+func (c *VirtLauncherClient) GetGuestAgentCommandResults() (v1.VirtualMachineInstanceGuestAgentCommandResultList, error) {
+	results := []v1.VirtualMachineInstanceGuestAgentCommandResult{}
+
+	request := &cmdv1.EmptyRequest{}
+	ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
+	defer cancel()
+
+	cResponse, err := c.v1client.GetGuestAgentCommandResults(ctx, request)
+	var response *cmdv1.Response
+	if cResponse != nil {
+		response = cResponse.Response
+	}
+
+	if err = handleError(err, "GetGuestAgentCommandResults", response); err != nil {
+		return v1.VirtualMachineInstanceGuestAgentCommandResultList{}, err
+	}
+
+	if cResponse.GetGuestAgentCommandResultsResponse() != "" {
+		if err := json.Unmarshal([]byte(cResponse.GetGuestAgentCommandResultsResponse()), &results); err != nil {
+			log.Log.Reason(err).Error("error unmarshalling guest agent command results response")
+			return v1.VirtualMachineInstanceGuestAgentCommandResultList{}, err
+		}
+	}
+
+	return v1.VirtualMachineInstanceGuestAgentCommandResultList{
+		Items: results,
+	}, nil
+}
+
 // Exec the command with args on the guest and return the resulting status code, stdOut and error
 func (c *VirtLauncherClient) Exec(domainName, command string, args []string, timeoutSeconds int32) (int, string, error) {
 	request := &cmdv1.ExecRequest{
@@ -240,6 +240,17 @@ func (_mr *_MockLauncherClientRecorder) GetFilesystems() *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetFilesystems")
 }
 
+func (_m *MockLauncherClient) GetGuestAgentCommandResults() (v1.VirtualMachineInstanceGuestAgentCommandResultList, error) {
+	ret := _m.ctrl.Call(_m, "GetGuestAgentCommandResults")
+	ret0, _ := ret[0].(v1.VirtualMachineInstanceGuestAgentCommandResultList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockLauncherClientRecorder) GetGuestAgentCommandResults() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetGuestAgentCommandResults")
+}
+
 func (_m *MockLauncherClient) Exec(_param0 string, _param1 string, _param2 []string, _param3 int32) (int, string, error) {
 	ret := _m.ctrl.Call(_m, "Exec", _param0, _param1, _param2, _param3)
 	ret0, _ := ret[0].(int)
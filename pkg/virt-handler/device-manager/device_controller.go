@@ -20,8 +20,10 @@
 package device_manager
 
 import (
+	"fmt"
 	"math"
 	"os"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -30,8 +32,10 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8scli "k8s.io/client-go/kubernetes/typed/core/v1"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/deviceplugin"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
@@ -43,6 +47,32 @@ type controlledDevice struct {
 	started      bool
 	stopChan     chan struct{}
 	backoff      []time.Duration
+	host         string
+	restarts     *restartTracker
+}
+
+// restartTracker counts the restarts of a device plugin and remembers why it was last restarted
+type restartTracker struct {
+	lock      sync.Mutex
+	restarts  int
+	lastError string
+}
+
+func (r *restartTracker) record(err error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.restarts++
+	if err != nil {
+		r.lastError = err.Error()
+	} else {
+		r.lastError = "device plugin stopped serving, the kubelet probably restarted"
+	}
+}
+
+func (r *restartTracker) get() (int, string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.restarts, r.lastError
 }
 
 func (c *controlledDevice) Start() {
@@ -62,6 +92,11 @@ func (c *controlledDevice) Start() {
 	if backoff == nil {
 		backoff = defaultBackoffTime
 	}
+	if c.restarts == nil {
+		c.restarts = &restartTracker{}
+	}
+	restarts := c.restarts
+	host := c.host
 
 	go func() {
 		for {
@@ -77,6 +112,15 @@ func (c *controlledDevice) Start() {
 			case <-stop:
 				// Ok we don't want to re-register
 				return
+			default:
+			}
+
+			restarts.record(err)
+			metrics.IncRestarts(host, resourceNameOf(deviceName))
+
+			select {
+			case <-stop:
+				return
 			case <-time.After(backoff[retries]):
 				// Wait a little and re-register
 				continue
@@ -119,6 +163,19 @@ func PermanentHostDevicePlugins(maxDevices int, permissions string) []Device {
 type DeviceControllerInterface interface {
 	Initialized() bool
 	RefreshMediatedDeviceTypes()
+	DevicePluginStatuses() []DevicePluginStatus
+}
+
+// DevicePluginStatus describes the state of a device plugin started by the device controller
+type DevicePluginStatus struct {
+	// ResourceName is the name of the resource advertised to the kubelet
+	ResourceName string
+	// Initialized is true while the device plugin is serving and registered with the kubelet
+	Initialized bool
+	// Restarts counts how often the device plugin stopped and was restarted
+	Restarts int
+	// LastError is the reason of the last restart
+	LastError string
 }
 
 type DeviceController struct {
@@ -236,6 +293,30 @@ func (c *DeviceController) updatePermittedHostDevicePlugins() []Device {
 			permittedDevices = append(permittedDevices, NewMediatedDevicePlugin(mdevUUIDs, mdevResourceName))
 		}
 	}
+	for _, inputDev := range hostDevs.InputDevices {
+		// do not add a device plugin for this resource if it's being provided via an external device plugin
+		if inputDev.ExternalResourceProvider || !c.NodeHasDevice(inputDev.EvdevPath) {
+			continue
+		}
+		log.Log.V(4).Infof("Discovered input device on the node, path: %s, resourceName: %s", inputDev.EvdevPath, inputDev.ResourceName)
+		permittedDevices = append(permittedDevices, NewInputDevicePlugin(inputDev.ResourceName, inputDev.EvdevPath))
+	}
+	if len(hostDevs.USB) != 0 {
+		var supportedUSBDevices []v1.USBHostDevice
+		for _, usbDev := range hostDevs.USB {
+			log.Log.V(4).Infof("Permitted USB device in the cluster, resourceName: %s, externalProvider: %t",
+				usbDev.ResourceName,
+				usbDev.ExternalResourceProvider)
+			// do not add a device plugin for this resource if it's being provided via an external device plugin
+			if !usbDev.ExternalResourceProvider {
+				supportedUSBDevices = append(supportedUSBDevices, usbDev)
+			}
+		}
+		for usbResourceName, usbDevices := range discoverPermittedHostUSBDevices(supportedUSBDevices) {
+			log.Log.V(4).Infof("Discovered %d USB devices on the node for the resource: %s", len(usbDevices), usbResourceName)
+			permittedDevices = append(permittedDevices, NewUSBDevicePlugin(usbDevices, usbResourceName))
+		}
+	}
 	return permittedDevices
 }
 
@@ -352,6 +433,7 @@ func (c *DeviceController) startDevice(resourceName string, dev Device) {
 	controlledDev := controlledDevice{
 		devicePlugin: dev,
 		backoff:      c.backoff,
+		host:         c.host,
 	}
 	controlledDev.Start()
 	c.startedPlugins[resourceName] = controlledDev
@@ -362,6 +444,7 @@ func (c *DeviceController) stopDevice(resourceName string) {
 	if exists {
 		dev.Stop()
 		delete(c.startedPlugins, resourceName)
+		metrics.DeleteMetrics(c.host, resourceNameOf(resourceName))
 	}
 }
 
@@ -410,3 +493,32 @@ func (c *DeviceController) Initialized() bool {
 
 	return true
 }
+
+// DevicePluginStatuses returns the state of all started device plugins
+func (c *DeviceController) DevicePluginStatuses() []DevicePluginStatus {
+	c.startedPluginsMutex.Lock()
+	defer c.startedPluginsMutex.Unlock()
+	statuses := make([]DevicePluginStatus, 0, len(c.startedPlugins))
+	for name, dev := range c.startedPlugins {
+		restarts, lastError := dev.restarts.get()
+		statuses = append(statuses, DevicePluginStatus{
+			ResourceName: resourceNameOf(name),
+			Initialized:  dev.devicePlugin.GetInitialized(),
+			Restarts:     restarts,
+			LastError:    lastError,
+		})
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].ResourceName < statuses[j].ResourceName
+	})
+	return statuses
+}
+
+// resourceNameOf returns the resource name advertised by the device plugin of the given device.
+// Plugins of permitted host devices are named after their resource, the others live in the KubeVirt device namespace.
+func resourceNameOf(deviceName string) string {
+	if strings.Contains(deviceName, "/") {
+		return deviceName
+	}
+	return fmt.Sprintf("%s/%s", DeviceNamespace, deviceName)
+}
@@ -164,6 +164,23 @@ var _ = Describe("Device Controller", func() {
 
 		})
 
+		It("should report the restarts of a failing device plugin", func() {
+			plugin2.Error = fmt.Errorf("failing")
+			initialDevices := []Device{plugin2}
+
+			deviceController := NewDeviceController(host, maxDevices, permissions, initialDevices, fakeConfigMap, clientTest.CoreV1())
+			deviceController.backoff = []time.Duration{10 * time.Millisecond}
+
+			go deviceController.Run(stop)
+			Eventually(func() []DevicePluginStatus {
+				return deviceController.DevicePluginStatuses()
+			}, 5*time.Second).Should(ConsistOf(And(
+				HaveField("ResourceName", "devices.kubevirt.io/fake-device2"),
+				HaveField("Restarts", BeNumerically(">=", 2)),
+				HaveField("LastError", "failing"),
+			)))
+		})
+
 		It("Should not block on other plugins", func() {
 			initialDevices := []Device{plugin1, plugin2}
 			deviceController := NewDeviceController(host, maxDevices, permissions, initialDevices, fakeConfigMap, clientTest.CoreV1())
@@ -27,6 +27,7 @@ import (
 	"path"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -34,6 +35,7 @@ import (
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/util"
@@ -67,6 +69,7 @@ type GenericDevicePlugin struct {
 	initialized  bool
 	lock         *sync.Mutex
 	permissions  string
+	envs         map[string]string
 	deregistered chan struct{}
 }
 
@@ -97,6 +100,20 @@ func NewGenericDevicePlugin(deviceName string, devicePath string, maxDevices int
 	return dpi
 }
 
+// NewInputDevicePlugin creates a device plugin for a single host evdev input device.
+// The device is exposed under the permitted resource name and its path is passed to
+// virt-launcher through an environment variable.
+func NewInputDevicePlugin(resourceName string, evdevPath string) *GenericDevicePlugin {
+	dpi := NewGenericDevicePlugin(strings.Replace(resourceName, "/", "-", -1), evdevPath, 1, "rw", false)
+	dpi.deviceName = resourceName
+	dpi.resourceName = resourceName
+	dpi.envs = map[string]string{
+		util.ResourceNameToEnvVar(v1.InputResourcePrefix, resourceName): evdevPath,
+	}
+
+	return dpi
+}
+
 func (dpi *GenericDevicePlugin) GetDevicePath() string {
 	return dpi.devicePath
 }
@@ -249,6 +266,7 @@ func (dpi *GenericDevicePlugin) Allocate(ctx context.Context, r *pluginapi.Alloc
 	dev.ContainerPath = dpi.devicePath
 	dev.Permissions = dpi.permissions
 	containerResponse.Devices = []*pluginapi.DeviceSpec{dev}
+	containerResponse.Envs = dpi.envs
 
 	response.ContainerResponses = []*pluginapi.ContainerAllocateResponse{containerResponse}
 
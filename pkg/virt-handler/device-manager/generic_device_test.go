@@ -1,6 +1,7 @@
 package device_manager
 
 import (
+	"context"
 	"os"
 	"path"
 	"path/filepath"
@@ -85,4 +86,23 @@ var _ = Describe("Generic Device", func() {
 			return (<-dpi.health).Health
 		}, 5*time.Second).Should(Equal(pluginapi.Healthy))
 	})
+
+	It("Should pass the input device path to virt-launcher on allocation", func() {
+		inputDpi := NewInputDevicePlugin("example.org/keyboard", "/dev/input/event3")
+		Expect(inputDpi.GetDeviceName()).To(Equal("example.org/keyboard"))
+		Expect(inputDpi.socketPath).To(HaveSuffix("kubevirt-example.org-keyboard.sock"))
+		Expect(inputDpi.devs).To(HaveLen(1))
+
+		response, err := inputDpi.Allocate(context.Background(), &pluginapi.AllocateRequest{
+			ContainerRequests: []*pluginapi.ContainerAllocateRequest{{DevicesIDs: []string{inputDpi.devs[0].ID}}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.ContainerResponses).To(HaveLen(1))
+		Expect(response.ContainerResponses[0].Devices).To(ConsistOf(&pluginapi.DeviceSpec{
+			HostPath:      "/dev/input/event3",
+			ContainerPath: "/dev/input/event3",
+			Permissions:   "rw",
+		}))
+		Expect(response.ContainerResponses[0].Envs).To(HaveKeyWithValue("INPUT_RESOURCE_EXAMPLE_ORG_KEYBOARD", "/dev/input/event3"))
+	})
 })
@@ -0,0 +1,25 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1 holds the subset of the kubelet PodResources API described in api.proto
+package v1
+
+const (
+	// PodResourcesPath is the folder the kubelet places the PodResources socket in
+	PodResourcesPath = "/var/lib/kubelet/pod-resources/"
+	// KubeletSocket is the path of the kubelet PodResources socket
+	KubeletSocket = PodResourcesPath + "kubelet.sock"
+)
@@ -0,0 +1,462 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package device_manager
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/util"
+	pluginapi "kubevirt.io/kubevirt/pkg/virt-handler/device-manager/deviceplugin/v1beta1"
+)
+
+const usbDevicePath = "/dev/bus/usb"
+
+var usbBasePath = "/sys/bus/usb/devices"
+
+type USBDevice struct {
+	// sysfs name of the device, which is its bus path, e.g. 3-1.2
+	name         string
+	vendor       string
+	product      string
+	bus          int
+	deviceNumber int
+}
+
+// DevicePath returns the path of the device node, e.g. /dev/bus/usb/003/007
+func (dev *USBDevice) DevicePath() string {
+	return filepath.Join(usbDevicePath, fmt.Sprintf("%03d", dev.bus), fmt.Sprintf("%03d", dev.deviceNumber))
+}
+
+// Address returns the <bus>:<device> address of the device as consumed by virt-launcher
+func (dev *USBDevice) Address() string {
+	return fmt.Sprintf("%d:%d", dev.bus, dev.deviceNumber)
+}
+
+type USBDevicePlugin struct {
+	devs         []*pluginapi.Device
+	server       *grpc.Server
+	socketPath   string
+	stop         <-chan struct{}
+	health       chan deviceHealth
+	resourceName string
+	done         chan struct{}
+	deviceRoot   string
+	// usbDevices is keyed by the bus path of the devices, which unlike
+	// the device node stays the same when a device is plugged in again
+	usbDevices   map[string]*USBDevice
+	devicesLock  *sync.Mutex
+	initialized  bool
+	lock         *sync.Mutex
+	deregistered chan struct{}
+}
+
+func NewUSBDevicePlugin(usbDevices []*USBDevice, resourceName string) *USBDevicePlugin {
+	serverSock := SocketPath(strings.Replace(resourceName, "/", "-", -1))
+
+	dpi := &USBDevicePlugin{
+		socketPath:   serverSock,
+		resourceName: resourceName,
+		deviceRoot:   util.HostRootMount,
+		usbDevices:   make(map[string]*USBDevice),
+		devicesLock:  &sync.Mutex{},
+		health:       make(chan deviceHealth),
+		initialized:  false,
+		lock:         &sync.Mutex{},
+	}
+	for _, usbDevice := range usbDevices {
+		dpi.usbDevices[usbDevice.name] = usbDevice
+		dpi.devs = append(dpi.devs, &pluginapi.Device{
+			ID:     usbDevice.name,
+			Health: pluginapi.Healthy,
+		})
+	}
+	return dpi
+}
+
+// Start starts the device plugin
+func (dpi *USBDevicePlugin) Start(stop <-chan struct{}) (err error) {
+	logger := log.DefaultLogger()
+	dpi.stop = stop
+	dpi.done = make(chan struct{})
+	dpi.deregistered = make(chan struct{})
+
+	err = dpi.cleanup()
+	if err != nil {
+		return err
+	}
+
+	sock, err := net.Listen("unix", dpi.socketPath)
+	if err != nil {
+		return fmt.Errorf("error creating GRPC server socket: %v", err)
+	}
+
+	dpi.server = grpc.NewServer([]grpc.ServerOption{}...)
+	defer dpi.stopDevicePlugin()
+
+	pluginapi.RegisterDevicePluginServer(dpi.server, dpi)
+
+	errChan := make(chan error, 2)
+
+	go func() {
+		errChan <- dpi.server.Serve(sock)
+	}()
+
+	err = waitForGRPCServer(dpi.socketPath, connectionTimeout)
+	if err != nil {
+		return fmt.Errorf("error starting the GRPC server: %v", err)
+	}
+
+	err = dpi.register()
+	if err != nil {
+		return fmt.Errorf("error registering with device plugin manager: %v", err)
+	}
+
+	go func() {
+		errChan <- dpi.healthCheck()
+	}()
+
+	dpi.setInitialized(true)
+	logger.Infof("%s device plugin started", dpi.resourceName)
+	err = <-errChan
+
+	return err
+}
+
+func (dpi *USBDevicePlugin) ListAndWatch(_ *pluginapi.Empty, s pluginapi.DevicePlugin_ListAndWatchServer) error {
+	s.Send(&pluginapi.ListAndWatchResponse{Devices: dpi.devs})
+
+	done := false
+	for {
+		select {
+		case devHealth := <-dpi.health:
+			for _, dev := range dpi.devs {
+				if devHealth.DevId == dev.ID {
+					dev.Health = devHealth.Health
+				}
+			}
+			s.Send(&pluginapi.ListAndWatchResponse{Devices: dpi.devs})
+		case <-dpi.stop:
+			done = true
+		case <-dpi.done:
+			done = true
+		}
+		if done {
+			break
+		}
+	}
+	// Send empty list to increase the chance that the kubelet acts fast on stopped device plugins
+	// There exists no explicit way to deregister devices
+	emptyList := []*pluginapi.Device{}
+	if err := s.Send(&pluginapi.ListAndWatchResponse{Devices: emptyList}); err != nil {
+		log.DefaultLogger().Reason(err).Infof("%s device plugin failed to deregister", dpi.resourceName)
+	}
+	close(dpi.deregistered)
+	return nil
+}
+
+func (dpi *USBDevicePlugin) Allocate(_ context.Context, r *pluginapi.AllocateRequest) (*pluginapi.AllocateResponse, error) {
+	resourceNameEnvVar := util.ResourceNameToEnvVar(v1.USBResourcePrefix, dpi.resourceName)
+	resp := new(pluginapi.AllocateResponse)
+
+	for _, request := range r.ContainerRequests {
+		containerResponse := new(pluginapi.ContainerAllocateResponse)
+		allocatedDevices := []string{}
+		for _, devID := range request.DevicesIDs {
+			usbDevice := dpi.getUSBDevice(devID)
+			if usbDevice == nil {
+				continue
+			}
+			allocatedDevices = append(allocatedDevices, usbDevice.Address())
+			containerResponse.Devices = append(containerResponse.Devices, &pluginapi.DeviceSpec{
+				ContainerPath: usbDevice.DevicePath(),
+				HostPath:      usbDevice.DevicePath(),
+				Permissions:   "mrw",
+			})
+		}
+		containerResponse.Envs = map[string]string{
+			resourceNameEnvVar: strings.Join(allocatedDevices, ","),
+		}
+		resp.ContainerResponses = append(resp.ContainerResponses, containerResponse)
+	}
+	return resp, nil
+}
+
+func (dpi *USBDevicePlugin) getUSBDevice(name string) *USBDevice {
+	dpi.devicesLock.Lock()
+	defer dpi.devicesLock.Unlock()
+	return dpi.usbDevices[name]
+}
+
+// rediscoverDevices reads the devices again from their bus paths, as a device which is
+// plugged in again gets a new device number, and returns the devices whose health changed.
+// A device is only healthy if the same vendor and product is plugged into its port.
+func (dpi *USBDevicePlugin) rediscoverDevices(healthy map[string]bool) []deviceHealth {
+	dpi.devicesLock.Lock()
+	defer dpi.devicesLock.Unlock()
+
+	var changes []deviceHealth
+	for name, usbDevice := range dpi.usbDevices {
+		current, err := readUSBDevice(name)
+		isHealthy := err == nil && current.vendor == usbDevice.vendor && current.product == usbDevice.product
+		if isHealthy {
+			if _, err := os.Stat(filepath.Join(dpi.deviceRoot, current.DevicePath())); err != nil {
+				isHealthy = false
+			}
+		}
+		if isHealthy {
+			dpi.usbDevices[name] = current
+		}
+		if healthy[name] != isHealthy {
+			healthy[name] = isHealthy
+			health := pluginapi.Unhealthy
+			if isHealthy {
+				health = pluginapi.Healthy
+			}
+			changes = append(changes, deviceHealth{DevId: name, Health: health})
+		}
+	}
+	return changes
+}
+
+func (dpi *USBDevicePlugin) healthCheck() error {
+	logger := log.DefaultLogger()
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to creating a fsnotify watcher: %v", err)
+	}
+	defer watcher.Close()
+
+	// watch the bus directories of all devices, a device which is plugged in again
+	// appears with a new device number on the same bus
+	monitoredBuses := make(map[string]bool)
+	healthy := make(map[string]bool)
+	for name, usbDevice := range dpi.usbDevices {
+		// This way we don't have to mount /dev from the node
+		busPath := filepath.Dir(filepath.Join(dpi.deviceRoot, usbDevice.DevicePath()))
+		if !monitoredBuses[busPath] {
+			err = watcher.Add(busPath)
+			if err != nil {
+				return fmt.Errorf("failed to add the USB bus %s to the watcher: %v", busPath, err)
+			}
+			monitoredBuses[busPath] = true
+		}
+		healthy[name] = true
+	}
+
+	dirName := filepath.Dir(dpi.socketPath)
+	err = watcher.Add(dirName)
+
+	if err != nil {
+		return fmt.Errorf("failed to add the device-plugin kubelet path to the watcher: %v", err)
+	}
+	_, err = os.Stat(dpi.socketPath)
+	if err != nil {
+		return fmt.Errorf("failed to stat the device-plugin socket: %v", err)
+	}
+
+	for {
+		select {
+		case <-dpi.stop:
+			return nil
+		case err := <-watcher.Errors:
+			logger.Reason(err).Errorf("error watching devices and device plugin directory")
+		case event := <-watcher.Events:
+			logger.V(4).Infof("health Event: %v", event)
+			if monitoredBuses[filepath.Dir(event.Name)] {
+				for _, change := range dpi.rediscoverDevices(healthy) {
+					if change.Health == pluginapi.Healthy {
+						logger.Infof("monitored device %s of %s appeared", change.DevId, dpi.resourceName)
+					} else {
+						logger.Infof("monitored device %s of %s disappeared", change.DevId, dpi.resourceName)
+					}
+					dpi.health <- change
+				}
+			} else if event.Name == dpi.socketPath && event.Op == fsnotify.Remove {
+				logger.Infof("device socket file for device %s was removed, kubelet probably restarted.", dpi.resourceName)
+				return nil
+			}
+		}
+	}
+}
+
+func (dpi *USBDevicePlugin) GetDevicePath() string {
+	return usbDevicePath
+}
+
+func (dpi *USBDevicePlugin) GetDeviceName() string {
+	return dpi.resourceName
+}
+
+// Stop stops the gRPC server
+func (dpi *USBDevicePlugin) stopDevicePlugin() error {
+	defer func() {
+		if !IsChanClosed(dpi.done) {
+			close(dpi.done)
+		}
+	}()
+
+	// Give the device plugin one second to properly deregister
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
+	select {
+	case <-dpi.deregistered:
+	case <-ticker.C:
+	}
+
+	dpi.server.Stop()
+	dpi.setInitialized(false)
+	return dpi.cleanup()
+}
+
+// Register registers the device plugin for the given resourceName with Kubelet.
+func (dpi *USBDevicePlugin) register() error {
+	conn, err := gRPCConnect(pluginapi.KubeletSocket, connectionTimeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := pluginapi.NewRegistrationClient(conn)
+	reqt := &pluginapi.RegisterRequest{
+		Version:      pluginapi.Version,
+		Endpoint:     path.Base(dpi.socketPath),
+		ResourceName: dpi.resourceName,
+	}
+
+	_, err = client.Register(context.Background(), reqt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (dpi *USBDevicePlugin) cleanup() error {
+	if err := os.Remove(dpi.socketPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (dpi *USBDevicePlugin) GetDevicePluginOptions(_ context.Context, _ *pluginapi.Empty) (*pluginapi.DevicePluginOptions, error) {
+	options := &pluginapi.DevicePluginOptions{
+		PreStartRequired: false,
+	}
+	return options, nil
+}
+
+func (dpi *USBDevicePlugin) PreStartContainer(_ context.Context, _ *pluginapi.PreStartContainerRequest) (*pluginapi.PreStartContainerResponse, error) {
+	res := &pluginapi.PreStartContainerResponse{}
+	return res, nil
+}
+
+func (dpi *USBDevicePlugin) GetInitialized() bool {
+	dpi.lock.Lock()
+	defer dpi.lock.Unlock()
+	return dpi.initialized
+}
+
+func (dpi *USBDevicePlugin) setInitialized(initialized bool) {
+	dpi.lock.Lock()
+	dpi.initialized = initialized
+	dpi.lock.Unlock()
+}
+
+func readUSBDeviceAttribute(deviceName string, attribute string) (string, error) {
+	content, err := os.ReadFile(filepath.Join(usbBasePath, deviceName, attribute))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(content)), nil
+}
+
+func readUSBDevice(deviceName string) (*USBDevice, error) {
+	usbDevice := &USBDevice{name: deviceName}
+	var err error
+	if usbDevice.vendor, err = readUSBDeviceAttribute(deviceName, "idVendor"); err != nil {
+		return nil, err
+	}
+	if usbDevice.product, err = readUSBDeviceAttribute(deviceName, "idProduct"); err != nil {
+		return nil, err
+	}
+	for attribute, value := range map[string]*int{"busnum": &usbDevice.bus, "devnum": &usbDevice.deviceNumber} {
+		content, err := readUSBDeviceAttribute(deviceName, attribute)
+		if err != nil {
+			return nil, err
+		}
+		if *value, err = strconv.Atoi(content); err != nil {
+			return nil, fmt.Errorf("failed to parse %s of USB device %s: %v", attribute, deviceName, err)
+		}
+	}
+	return usbDevice, nil
+}
+
+func usbSelectorMatches(selector v1.USBSelector, usbDevice *USBDevice) bool {
+	return strings.EqualFold(selector.Vendor, usbDevice.vendor) &&
+		strings.EqualFold(selector.Product, usbDevice.product) &&
+		(selector.BusPath == "" || selector.BusPath == usbDevice.name)
+}
+
+func discoverPermittedHostUSBDevices(usbHostDevices []v1.USBHostDevice) map[string][]*USBDevice {
+	usbDevicesMap := make(map[string][]*USBDevice)
+	entries, err := os.ReadDir(usbBasePath)
+	if err != nil {
+		log.DefaultLogger().Reason(err).Errorf("failed to discover USB host devices")
+		return usbDevicesMap
+	}
+	for _, entry := range entries {
+		// skip the root hubs (usbN) and the interfaces (<bus path>:<config>.<interface>)
+		if strings.HasPrefix(entry.Name(), "usb") || strings.Contains(entry.Name(), ":") {
+			continue
+		}
+		usbDevice, err := readUSBDevice(entry.Name())
+		if err != nil {
+			log.DefaultLogger().Reason(err).Errorf("failed to read USB device %s", entry.Name())
+			continue
+		}
+	resources:
+		for _, usbHostDevice := range usbHostDevices {
+			for _, selector := range usbHostDevice.Selectors {
+				if usbSelectorMatches(selector, usbDevice) {
+					usbDevicesMap[usbHostDevice.ResourceName] = append(usbDevicesMap[usbHostDevice.ResourceName], usbDevice)
+					// a device can only be allocated through a single resource
+					break resources
+				}
+			}
+		}
+	}
+	return usbDevicesMap
+}
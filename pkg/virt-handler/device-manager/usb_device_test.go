@@ -0,0 +1,140 @@
+package device_manager
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	pluginapi "kubevirt.io/kubevirt/pkg/virt-handler/device-manager/deviceplugin/v1beta1"
+)
+
+var _ = Describe("USB Device", func() {
+	var originalUSBBasePath string
+
+	createFakeUSBDevice := func(name, vendor, product, busnum, devnum string) {
+		devicePath := filepath.Join(usbBasePath, name)
+		Expect(os.MkdirAll(devicePath, 0755)).To(Succeed())
+		for attribute, value := range map[string]string{"idVendor": vendor, "idProduct": product, "busnum": busnum, "devnum": devnum} {
+			Expect(os.WriteFile(filepath.Join(devicePath, attribute), []byte(value+"\n"), 0644)).To(Succeed())
+		}
+	}
+
+	BeforeEach(func() {
+		originalUSBBasePath = usbBasePath
+		usbBasePath = GinkgoT().TempDir()
+
+		createFakeUSBDevice("usb3", "1d6b", "0003", "3", "1")
+		createFakeUSBDevice("3-1", "046b", "ff10", "3", "4")
+		createFakeUSBDevice("3-2", "046b", "ff10", "3", "5")
+		createFakeUSBDevice("3-3", "0403", "6001", "3", "6")
+		Expect(os.MkdirAll(filepath.Join(usbBasePath, "3-1:1.0"), 0755)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		usbBasePath = originalUSBBasePath
+	})
+
+	It("should discover the host USB devices matching the selectors", func() {
+		usbDevices := discoverPermittedHostUSBDevices([]v1.USBHostDevice{
+			{
+				ResourceName: "example.org/dongle",
+				Selectors:    []v1.USBSelector{{Vendor: "046B", Product: "ff10"}},
+			},
+			{
+				ResourceName: "example.org/serial",
+				Selectors:    []v1.USBSelector{{Vendor: "0403", Product: "6001"}},
+			},
+		})
+		Expect(usbDevices).To(HaveLen(2))
+		Expect(usbDevices["example.org/dongle"]).To(ConsistOf(
+			&USBDevice{name: "3-1", vendor: "046b", product: "ff10", bus: 3, deviceNumber: 4},
+			&USBDevice{name: "3-2", vendor: "046b", product: "ff10", bus: 3, deviceNumber: 5},
+		))
+		Expect(usbDevices["example.org/serial"]).To(ConsistOf(
+			&USBDevice{name: "3-3", vendor: "0403", product: "6001", bus: 3, deviceNumber: 6},
+		))
+	})
+
+	It("should only discover the host USB device plugged into the selected port", func() {
+		usbDevices := discoverPermittedHostUSBDevices([]v1.USBHostDevice{
+			{
+				ResourceName: "example.org/dongle",
+				Selectors:    []v1.USBSelector{{Vendor: "046b", Product: "ff10", BusPath: "3-2"}},
+			},
+		})
+		Expect(usbDevices["example.org/dongle"]).To(ConsistOf(
+			&USBDevice{name: "3-2", vendor: "046b", product: "ff10", bus: 3, deviceNumber: 5},
+		))
+	})
+
+	It("should allocate the device node and expose the device address", func() {
+		dpi := NewUSBDevicePlugin([]*USBDevice{
+			{name: "3-1", vendor: "046b", product: "ff10", bus: 3, deviceNumber: 4},
+			{name: "3-2", vendor: "046b", product: "ff10", bus: 3, deviceNumber: 5},
+		}, "example.org/dongle")
+		Expect(dpi.devs).To(HaveLen(2))
+
+		resp, err := dpi.Allocate(context.Background(), &pluginapi.AllocateRequest{
+			ContainerRequests: []*pluginapi.ContainerAllocateRequest{{DevicesIDs: []string{"3-2"}}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.ContainerResponses).To(HaveLen(1))
+		Expect(resp.ContainerResponses[0].Devices).To(Equal([]*pluginapi.DeviceSpec{{
+			ContainerPath: "/dev/bus/usb/003/005",
+			HostPath:      "/dev/bus/usb/003/005",
+			Permissions:   "mrw",
+		}}))
+		Expect(resp.ContainerResponses[0].Envs).To(Equal(map[string]string{
+			"USB_RESOURCE_EXAMPLE_ORG_DONGLE": "3:5",
+		}))
+	})
+
+	Context("on health check", func() {
+		var dpi *USBDevicePlugin
+		var healthy map[string]bool
+
+		createDeviceNode := func(busnum, devnum string) {
+			busPath := filepath.Join(dpi.deviceRoot, "dev", "bus", "usb", busnum)
+			Expect(os.MkdirAll(busPath, 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(busPath, devnum), nil, 0644)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			dpi = NewUSBDevicePlugin([]*USBDevice{
+				{name: "3-1", vendor: "046b", product: "ff10", bus: 3, deviceNumber: 4},
+			}, "example.org/dongle")
+			dpi.deviceRoot = GinkgoT().TempDir()
+			createDeviceNode("003", "004")
+			healthy = map[string]bool{"3-1": true}
+		})
+
+		It("should pick up the new device number of a device plugged in again", func() {
+			Expect(os.RemoveAll(filepath.Join(usbBasePath, "3-1"))).To(Succeed())
+			Expect(dpi.rediscoverDevices(healthy)).To(ConsistOf(deviceHealth{DevId: "3-1", Health: pluginapi.Unhealthy}))
+
+			createFakeUSBDevice("3-1", "046b", "ff10", "3", "9")
+			createDeviceNode("003", "009")
+			Expect(dpi.rediscoverDevices(healthy)).To(ConsistOf(deviceHealth{DevId: "3-1", Health: pluginapi.Healthy}))
+			Expect(dpi.rediscoverDevices(healthy)).To(BeEmpty())
+
+			resp, err := dpi.Allocate(context.Background(), &pluginapi.AllocateRequest{
+				ContainerRequests: []*pluginapi.ContainerAllocateRequest{{DevicesIDs: []string{"3-1"}}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.ContainerResponses[0].Devices[0].HostPath).To(Equal("/dev/bus/usb/003/009"))
+			Expect(resp.ContainerResponses[0].Envs).To(HaveKeyWithValue("USB_RESOURCE_EXAMPLE_ORG_DONGLE", "3:9"))
+		})
+
+		It("should not hand out a different device plugged into the same port", func() {
+			createFakeUSBDevice("3-1", "0403", "6001", "3", "9")
+			createDeviceNode("003", "009")
+			Expect(dpi.rediscoverDevices(healthy)).To(ConsistOf(deviceHealth{DevId: "3-1", Health: pluginapi.Unhealthy}))
+			Expect(dpi.getUSBDevice("3-1").deviceNumber).To(Equal(4))
+		})
+	})
+})
@@ -0,0 +1,224 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package heartbeat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+	"unicode"
+
+	"google.golang.org/grpc"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"kubevirt.io/client-go/log"
+
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/deviceplugin"
+	device_manager "kubevirt.io/kubevirt/pkg/virt-handler/device-manager"
+	podresourcesv1 "kubevirt.io/kubevirt/pkg/virt-handler/device-manager/podresources/v1"
+)
+
+const (
+	devicePluginConditionPrefix = "KubevirtDevicePlugin"
+	devicePluginConditionSuffix = "Healthy"
+
+	// DevicePluginHealthyReason is set on the node condition of a device plugin which advertises healthy devices
+	DevicePluginHealthyReason = "DevicePluginHealthy"
+	// DevicePluginNotRegisteredReason is set on the node condition of a device plugin which is not registered with the kubelet
+	DevicePluginNotRegisteredReason = "DevicePluginNotRegistered"
+	// DevicePluginNoHealthyDevicesReason is set on the node condition of a device plugin without allocatable devices
+	DevicePluginNoHealthyDevicesReason = "NoHealthyDevices"
+
+	// DevicePluginUnhealthyEvent is recorded on the node when a device plugin becomes unhealthy
+	DevicePluginUnhealthyEvent = "DevicePluginUnhealthy"
+	// DevicePluginRecoveredEvent is recorded on the node when a device plugin becomes healthy again
+	DevicePluginRecoveredEvent = "DevicePluginRecovered"
+
+	podResourcesTimeout = 5 * time.Second
+)
+
+// updateDevicePluginHealth reflects the health of the device plugins in the node conditions and the metrics.
+// A device plugin is healthy if it is registered with the kubelet and the kubelet reports allocatable devices for it.
+// Only the conditions of the device plugins are patched and only when they change.
+func (h *HeartBeat) updateDevicePluginHealth() error {
+	node, err := h.clientset.Nodes().Get(context.Background(), h.host, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	allocated, err := h.allocatedDevices()
+	if err != nil {
+		log.DefaultLogger().Reason(err).Warningf("Can't get the devices allocated on node %s from the kubelet", h.host)
+	}
+
+	now := metav1.Now()
+	var changed []interface{}
+	reported := map[k8sv1.NodeConditionType]bool{}
+	for _, status := range h.deviceManagerController.DevicePluginStatuses() {
+		allocatableQuantity := node.Status.Allocatable[k8sv1.ResourceName(status.ResourceName)]
+		allocatable := allocatableQuantity.Value()
+		condition := devicePluginCondition(status, allocatable)
+		metrics.SetDevices(h.host, status.ResourceName, condition.Status == k8sv1.ConditionTrue, allocatable, allocated[status.ResourceName])
+		reported[condition.Type] = true
+
+		oldCondition := findNodeCondition(node.Status.Conditions, condition.Type)
+		if oldCondition != nil && oldCondition.Status == condition.Status &&
+			oldCondition.Reason == condition.Reason && oldCondition.Message == condition.Message {
+			continue
+		}
+		condition.LastHeartbeatTime = now
+		condition.LastTransitionTime = now
+		if oldCondition != nil && oldCondition.Status == condition.Status {
+			condition.LastTransitionTime = oldCondition.LastTransitionTime
+		} else {
+			h.recordDevicePluginTransition(node, status.ResourceName, condition)
+		}
+		changed = append(changed, condition)
+	}
+
+	for _, condition := range node.Status.Conditions {
+		if isDevicePluginCondition(condition.Type) && !reported[condition.Type] {
+			changed = append(changed, map[string]interface{}{"type": condition.Type, "$patch": "delete"})
+		}
+	}
+
+	if len(changed) == 0 {
+		return nil
+	}
+	return h.patchNodeConditions(changed)
+}
+
+func devicePluginCondition(status device_manager.DevicePluginStatus, allocatable int64) k8sv1.NodeCondition {
+	condition := k8sv1.NodeCondition{
+		Type:    DevicePluginConditionType(status.ResourceName),
+		Status:  k8sv1.ConditionTrue,
+		Reason:  DevicePluginHealthyReason,
+		Message: fmt.Sprintf("device plugin for %s advertises %d allocatable devices", status.ResourceName, allocatable),
+	}
+	switch {
+	case !status.Initialized:
+		condition.Status = k8sv1.ConditionFalse
+		condition.Reason = DevicePluginNotRegisteredReason
+		condition.Message = fmt.Sprintf("device plugin for %s is not registered with the kubelet", status.ResourceName)
+		if status.LastError != "" {
+			condition.Message = fmt.Sprintf("%s, it was restarted %d times, last error: %s", condition.Message, status.Restarts, status.LastError)
+		}
+	case allocatable == 0:
+		condition.Status = k8sv1.ConditionFalse
+		condition.Reason = DevicePluginNoHealthyDevicesReason
+		condition.Message = fmt.Sprintf("device plugin for %s advertises no healthy devices", status.ResourceName)
+	}
+	return condition
+}
+
+func (h *HeartBeat) recordDevicePluginTransition(node *k8sv1.Node, resourceName string, condition k8sv1.NodeCondition) {
+	if h.recorder == nil {
+		return
+	}
+	if condition.Status == k8sv1.ConditionTrue {
+		h.recorder.Eventf(node, k8sv1.EventTypeNormal, DevicePluginRecoveredEvent, "Device plugin for %s is healthy", resourceName)
+		return
+	}
+	h.recorder.Eventf(node, k8sv1.EventTypeWarning, DevicePluginUnhealthyEvent, "Device plugin for %s is unhealthy: %s", resourceName, condition.Message)
+}
+
+// allocatedDevices counts the devices which the kubelet allocated to the containers of the node
+func (h *HeartBeat) allocatedDevices() (map[string]int64, error) {
+	conn, err := grpc.Dial(h.podResourcesSocket,
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, "unix", addr)
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), podResourcesTimeout)
+	defer cancel()
+	resp, err := podresourcesv1.NewPodResourcesListerClient(conn).List(ctx, &podresourcesv1.ListPodResourcesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	allocated := map[string]int64{}
+	for _, pod := range resp.PodResources {
+		for _, container := range pod.Containers {
+			for _, devices := range container.Devices {
+				allocated[devices.ResourceName] += int64(len(devices.DeviceIds))
+			}
+		}
+	}
+	return allocated, nil
+}
+
+// patchNodeConditions merges the given conditions by their type into the node conditions,
+// so that the conditions maintained by others are not touched
+func (h *HeartBeat) patchNodeConditions(conditions []interface{}) error {
+	data, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"conditions": conditions,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := h.clientset.Nodes().Patch(context.Background(), h.host, types.StrategicMergePatchType, data, metav1.PatchOptions{}, "status"); err != nil {
+		return err
+	}
+	log.DefaultLogger().V(4).Infof("Updated the device plugin conditions of node %s", h.host)
+	return nil
+}
+
+// DevicePluginConditionType returns the node condition type reflecting the health of the device plugin of a resource,
+// e.g. KubevirtDevicePluginKvmHealthy for devices.kubevirt.io/kvm
+func DevicePluginConditionType(resourceName string) k8sv1.NodeConditionType {
+	name := strings.TrimPrefix(resourceName, device_manager.DeviceNamespace+"/")
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	var conditionType strings.Builder
+	conditionType.WriteString(devicePluginConditionPrefix)
+	for _, part := range parts {
+		runes := []rune(part)
+		conditionType.WriteRune(unicode.ToUpper(runes[0]))
+		conditionType.WriteString(string(runes[1:]))
+	}
+	conditionType.WriteString(devicePluginConditionSuffix)
+	return k8sv1.NodeConditionType(conditionType.String())
+}
+
+func isDevicePluginCondition(conditionType k8sv1.NodeConditionType) bool {
+	return strings.HasPrefix(string(conditionType), devicePluginConditionPrefix) && strings.HasSuffix(string(conditionType), devicePluginConditionSuffix)
+}
+
+func findNodeCondition(conditions []k8sv1.NodeCondition, conditionType k8sv1.NodeConditionType) *k8sv1.NodeCondition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
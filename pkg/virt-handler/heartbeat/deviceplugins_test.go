@@ -0,0 +1,205 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package heartbeat
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+
+	device_manager "kubevirt.io/kubevirt/pkg/virt-handler/device-manager"
+	podresourcesv1 "kubevirt.io/kubevirt/pkg/virt-handler/device-manager/podresources/v1"
+)
+
+var _ = Describe("Device plugin health", func() {
+
+	const (
+		kvmResource    = "devices.kubevirt.io/kvm"
+		tunResource    = "devices.kubevirt.io/tun"
+		kvmCondition   = v1.NodeConditionType("KubevirtDevicePluginKvmHealthy")
+		tunCondition   = v1.NodeConditionType("KubevirtDevicePluginTunHealthy")
+		readyCondition = v1.NodeReady
+	)
+
+	var (
+		fakeClient *fake.Clientset
+		recorder   *record.FakeRecorder
+		controller *fakeDeviceController
+		heartbeat  *HeartBeat
+	)
+
+	getConditions := func() []v1.NodeCondition {
+		node, err := fakeClient.CoreV1().Nodes().Get(context.Background(), "mynode", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return node.Status.Conditions
+	}
+
+	BeforeEach(func() {
+		node := &v1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "mynode"},
+			Status: v1.NodeStatus{
+				Allocatable: v1.ResourceList{
+					kvmResource: resource.MustParse("1k"),
+					tunResource: resource.MustParse("0"),
+				},
+				Conditions: []v1.NodeCondition{{Type: readyCondition, Status: v1.ConditionTrue}},
+			},
+		}
+		fakeClient = fake.NewSimpleClientset(node)
+		recorder = record.NewFakeRecorder(10)
+		controller = &fakeDeviceController{initialized: true}
+		heartbeat = NewHeartBeat(fakeClient.CoreV1(), controller, config(), "mynode", recorder)
+
+		socketDir, err := os.MkdirTemp("", "podresources")
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(os.RemoveAll, socketDir)
+		heartbeat.podResourcesSocket = filepath.Join(socketDir, "kubelet.sock")
+		listener, err := net.Listen("unix", heartbeat.podResourcesSocket)
+		Expect(err).ToNot(HaveOccurred())
+		server := grpc.NewServer()
+		podresourcesv1.RegisterPodResourcesListerServer(server, &fakePodResourcesLister{
+			resp: &podresourcesv1.ListPodResourcesResponse{PodResources: []*podresourcesv1.PodResources{{
+				Name:      "virt-launcher-testvmi",
+				Namespace: metav1.NamespaceDefault,
+				Containers: []*podresourcesv1.ContainerResources{{
+					Name:    "compute",
+					Devices: []*podresourcesv1.ContainerDevices{{ResourceName: kvmResource, DeviceIds: []string{"kvm1"}}},
+				}},
+			}}},
+		})
+		go func() { _ = server.Serve(listener) }()
+		DeferCleanup(server.Stop)
+	})
+
+	It("should set a healthy condition for a registered device plugin with allocatable devices", func() {
+		controller.statuses = []device_manager.DevicePluginStatus{{ResourceName: kvmResource, Initialized: true}}
+		Expect(heartbeat.updateDevicePluginHealth()).To(Succeed())
+
+		Expect(getConditions()).To(ConsistOf(
+			HaveField("Type", readyCondition),
+			And(
+				HaveField("Type", kvmCondition),
+				HaveField("Status", v1.ConditionTrue),
+				HaveField("Reason", DevicePluginHealthyReason),
+			),
+		))
+		Expect(recorder.Events).To(Receive(ContainSubstring(DevicePluginRecoveredEvent)))
+	})
+
+	DescribeTable("should set an unhealthy condition and record an event", func(status device_manager.DevicePluginStatus, conditionType v1.NodeConditionType, reason string) {
+		controller.statuses = []device_manager.DevicePluginStatus{status}
+		Expect(heartbeat.updateDevicePluginHealth()).To(Succeed())
+
+		Expect(getConditions()).To(ContainElement(And(
+			HaveField("Type", conditionType),
+			HaveField("Status", v1.ConditionFalse),
+			HaveField("Reason", reason),
+		)))
+		Expect(recorder.Events).To(Receive(ContainSubstring(DevicePluginUnhealthyEvent)))
+	},
+		Entry("if the device plugin is not registered",
+			device_manager.DevicePluginStatus{ResourceName: kvmResource, Restarts: 3, LastError: "socket removed"}, kvmCondition, DevicePluginNotRegisteredReason),
+		Entry("if no device is allocatable",
+			device_manager.DevicePluginStatus{ResourceName: tunResource, Initialized: true}, tunCondition, DevicePluginNoHealthyDevicesReason),
+	)
+
+	It("should only record an event when the health changes", func() {
+		controller.statuses = []device_manager.DevicePluginStatus{{ResourceName: kvmResource, Initialized: true}}
+		Expect(heartbeat.updateDevicePluginHealth()).To(Succeed())
+		Expect(recorder.Events).To(Receive())
+
+		Expect(heartbeat.updateDevicePluginHealth()).To(Succeed())
+		Expect(recorder.Events).ToNot(Receive())
+
+		controller.statuses[0].Initialized = false
+		Expect(heartbeat.updateDevicePluginHealth()).To(Succeed())
+		Expect(recorder.Events).To(Receive(ContainSubstring(DevicePluginUnhealthyEvent)))
+	})
+
+	It("should only patch the node when a condition changes", func() {
+		controller.statuses = []device_manager.DevicePluginStatus{{ResourceName: kvmResource, Initialized: true}}
+		Expect(heartbeat.updateDevicePluginHealth()).To(Succeed())
+		fakeClient.ClearActions()
+
+		Expect(heartbeat.updateDevicePluginHealth()).To(Succeed())
+		for _, action := range fakeClient.Actions() {
+			Expect(action.GetVerb()).ToNot(Equal("patch"))
+		}
+	})
+
+	It("should only patch the conditions of the device plugins", func() {
+		controller.statuses = []device_manager.DevicePluginStatus{{ResourceName: kvmResource, Initialized: true}}
+		Expect(heartbeat.updateDevicePluginHealth()).To(Succeed())
+
+		patches := []string{}
+		for _, action := range fakeClient.Actions() {
+			if patchAction, ok := action.(k8stesting.PatchAction); ok {
+				Expect(patchAction.GetPatchType()).To(Equal(types.StrategicMergePatchType))
+				patches = append(patches, string(patchAction.GetPatch()))
+			}
+		}
+		Expect(patches).To(HaveLen(1))
+		Expect(patches[0]).To(ContainSubstring(string(kvmCondition)))
+		Expect(patches[0]).ToNot(ContainSubstring(string(readyCondition)))
+	})
+
+	It("should remove the conditions of stopped device plugins", func() {
+		controller.statuses = []device_manager.DevicePluginStatus{{ResourceName: kvmResource, Initialized: true}}
+		Expect(heartbeat.updateDevicePluginHealth()).To(Succeed())
+		Expect(getConditions()).To(HaveLen(2))
+
+		controller.statuses = nil
+		Expect(heartbeat.updateDevicePluginHealth()).To(Succeed())
+		Expect(getConditions()).To(ConsistOf(HaveField("Type", readyCondition)))
+	})
+
+	It("should count the devices the kubelet allocated to the pods of the node", func() {
+		allocated, err := heartbeat.allocatedDevices()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(allocated).To(HaveKeyWithValue(kvmResource, int64(1)))
+	})
+
+	DescribeTable("should name the node condition after the resource", func(resourceName string, expected v1.NodeConditionType) {
+		Expect(DevicePluginConditionType(resourceName)).To(Equal(expected))
+	},
+		Entry("for a KubeVirt device", "devices.kubevirt.io/vhost-net", v1.NodeConditionType("KubevirtDevicePluginVhostNetHealthy")),
+		Entry("for a permitted host device", "nvidia.com/GP102GL_Tesla_P40", v1.NodeConditionType("KubevirtDevicePluginNvidiaComGP102GLTeslaP40Healthy")),
+	)
+})
+
+type fakePodResourcesLister struct {
+	resp *podresourcesv1.ListPodResourcesResponse
+}
+
+func (f *fakePodResourcesLister) List(_ context.Context, _ *podresourcesv1.ListPodResourcesRequest) (*podresourcesv1.ListPodResourcesResponse, error) {
+	return f.resp, nil
+}
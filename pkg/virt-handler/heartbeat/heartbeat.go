@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -12,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 	utilwait "k8s.io/apimachinery/pkg/util/wait"
 	k8scli "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
@@ -19,6 +21,7 @@ import (
 	virtutil "kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	device_manager "kubevirt.io/kubevirt/pkg/virt-handler/device-manager"
+	podresourcesv1 "kubevirt.io/kubevirt/pkg/virt-handler/device-manager/podresources/v1"
 )
 
 const failedSetCPUManagerLabelFmt = "failed to set a cpu manager label on host %s"
@@ -27,20 +30,24 @@ type HeartBeat struct {
 	clientset                 k8scli.CoreV1Interface
 	deviceManagerController   device_manager.DeviceControllerInterface
 	clusterConfig             *virtconfig.ClusterConfig
+	recorder                  record.EventRecorder
 	host                      string
 	cpuManagerPaths           []string
+	podResourcesSocket        string
 	devicePluginPollIntervall time.Duration
 	devicePluginWaitTimeout   time.Duration
 }
 
-func NewHeartBeat(clientset k8scli.CoreV1Interface, deviceManager device_manager.DeviceControllerInterface, clusterConfig *virtconfig.ClusterConfig, host string) *HeartBeat {
+func NewHeartBeat(clientset k8scli.CoreV1Interface, deviceManager device_manager.DeviceControllerInterface, clusterConfig *virtconfig.ClusterConfig, host string, recorder record.EventRecorder) *HeartBeat {
 	return &HeartBeat{
 		clientset:               clientset,
 		deviceManagerController: deviceManager,
 		clusterConfig:           clusterConfig,
+		recorder:                recorder,
 		host:                    host,
 		// This is a temporary workaround until k8s bug #66525 is resolved
 		cpuManagerPaths:           []string{virtutil.CPUManagerPath, virtutil.CPUManagerOS3Path},
+		podResourcesSocket:        filepath.Join(virtutil.HostRootMount, podresourcesv1.KubeletSocket),
 		devicePluginPollIntervall: 1 * time.Second,
 		devicePluginWaitTimeout:   10 * time.Second,
 	}
@@ -146,6 +153,12 @@ func (h *HeartBeat) do() {
 		return
 	}
 
+	// Device plugins can stop advertising devices at any time, e.g. when their socket is removed or a device
+	// disappears from sysfs. Reflect it on the node, so that it does not go unnoticed.
+	if err := h.updateDevicePluginHealth(); err != nil {
+		log.DefaultLogger().Reason(err).Errorf("Can't update the device plugin conditions of node %s", h.host)
+	}
+
 	// A configuration of mediated devices types on this node depends on the existing node labels
 	// and a MediatedDevicesConfiguration in KubeVirt CR.
 	// When labels change we should initialize a refresh to create/remove mdev types and start/stop
@@ -29,10 +29,14 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
 
 	"kubevirt.io/client-go/log"
 
 	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
+	proxymetrics "kubevirt.io/kubevirt/pkg/monitoring/migrationproxy"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/net/ip"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -43,6 +47,11 @@ const (
 	LibvirtBlockMigrationPort  = 49153
 )
 
+const (
+	sourceRole = "source"
+	targetRole = "target"
+)
+
 var migrationPortsRange = []int{LibvirtDirectMigrationPort, LibvirtBlockMigrationPort}
 
 type ProxyManager interface {
@@ -89,9 +98,36 @@ type migrationProxy struct {
 	serverTLSConfig *tls.Config
 	clientTLSConfig *tls.Config
 
+	vmiUID  string
+	role    string
+	channel string
+	// connections counts the connections accepted so far and is used to label the per connection metrics
+	connections uint64
+
 	logger *log.FilteredLogger
 }
 
+// migrationChannel names the libvirt stream a proxy carries based on the port it was created for
+func migrationChannel(port int) string {
+	switch port {
+	case LibvirtDirectMigrationPort:
+		return "direct"
+	case LibvirtBlockMigrationPort:
+		return "block"
+	default:
+		return "libvirt"
+	}
+}
+
+func portFromSocket(key string, path string) int {
+	for _, port := range migrationPortsRange {
+		if strings.Contains(path, ConstructProxyKey(key, port)) {
+			return port
+		}
+	}
+	return 0
+}
+
 func (m *migrationProxyManager) InitiateGracefulShutdown() {
 	m.managerLock.Lock()
 	defer m.managerLock.Unlock()
@@ -178,6 +214,7 @@ func (m *migrationProxyManager) StartTargetListener(key string, targetUnixFiles
 	for _, targetUnixFile := range targetUnixFiles {
 		// 0 means random port is used
 		proxy := NewTargetProxy(zeroAddress, 0, serverTLSConfig, clientTLSConfig, targetUnixFile, key)
+		proxy.channel = migrationChannel(portFromSocket(key, targetUnixFile))
 
 		err := proxy.Start()
 		if err != nil {
@@ -221,23 +258,13 @@ func (m *migrationProxyManager) GetTargetListenerPorts(key string) map[string]in
 	m.managerLock.Lock()
 	defer m.managerLock.Unlock()
 
-	getPortFromSocket := func(id string, path string) int {
-		for _, port := range migrationPortsRange {
-			key := ConstructProxyKey(id, port)
-			if strings.Contains(path, key) {
-				return port
-			}
-		}
-		return 0
-	}
-
 	curProxies, exists := m.targetProxies[key]
 	targetSrcPortMap := make(map[string]int)
 
 	if exists {
 		for _, curProxy := range curProxies {
 			port := strconv.Itoa(curProxy.tcpBindPort)
-			targetSrcPortMap[port] = getPortFromSocket(key, curProxy.targetAddress)
+			targetSrcPortMap[port] = portFromSocket(key, curProxy.targetAddress)
 		}
 	}
 	return targetSrcPortMap
@@ -254,6 +281,7 @@ func (m *migrationProxyManager) StopTargetListener(key string) {
 			curProxy.Stop()
 			delete(m.targetProxies, key)
 		}
+		proxymetrics.DeleteMetrics(key)
 	}
 }
 
@@ -311,6 +339,7 @@ func (m *migrationProxyManager) StartSourceListener(key string, targetAddress st
 		os.RemoveAll(filePath)
 
 		proxy := NewSourceProxy(filePath, targetFullAddr, serverTLSConfig, clientTLSConfig, key)
+		proxy.channel = migrationChannel(srcPort)
 
 		err := proxy.Start()
 		if err != nil {
@@ -340,6 +369,7 @@ func (m *migrationProxyManager) StopSourceListener(key string) {
 			os.RemoveAll(curProxy.unixSocketPath)
 		}
 		delete(m.sourceProxies, key)
+		proxymetrics.DeleteMetrics(key)
 	}
 }
 
@@ -356,6 +386,8 @@ func NewSourceProxy(unixSocketPath string, tcpTargetAddress string, serverTLSCon
 		listenErrChan:   make(chan error, 1),
 		serverTLSConfig: serverTLSConfig,
 		clientTLSConfig: clientTLSConfig,
+		vmiUID:          vmiUID,
+		role:            sourceRole,
 		logger:          log.Log.With("uid", vmiUID).With("listening", filepath.Base(unixSocketPath)).With("outbound", tcpTargetAddress),
 	}
 }
@@ -372,6 +404,8 @@ func NewTargetProxy(tcpBindAddress string, tcpBindPort int, serverTLSConfig *tls
 		listenErrChan:   make(chan error, 1),
 		serverTLSConfig: serverTLSConfig,
 		clientTLSConfig: clientTLSConfig,
+		vmiUID:          vmiUID,
+		role:            targetRole,
 		logger:          log.Log.With("uid", vmiUID).With("outbound", filepath.Base(virtqemudSocketPath)),
 	}
 
@@ -454,15 +488,25 @@ func (m *migrationProxy) handleConnection(fd net.Conn) {
 		return
 	}
 
+	activeConnection := proxymetrics.NewActiveConnection(m.vmiUID, m.role, m.channel)
+	defer activeConnection.Dec()
+	connection := strconv.FormatUint(atomic.AddUint64(&m.connections, 1)-1, 10)
+
 	go func() {
 		//from outbound connection to proxy
-		n, err := io.Copy(fd, conn)
+		n, err := io.Copy(&countingWriter{
+			writer:  fd,
+			counter: proxymetrics.NewTransferredBytesCounter(m.vmiUID, m.role, m.channel, connection, proxymetrics.DirectionInbound),
+		}, conn)
 		m.logger.Infof("%d bytes copied outbound to inbound", n)
 		inBoundErr <- err
 	}()
 	go func() {
 		//from proxy to outbound connection
-		n, err := io.Copy(conn, fd)
+		n, err := io.Copy(&countingWriter{
+			writer:  conn,
+			counter: proxymetrics.NewTransferredBytesCounter(m.vmiUID, m.role, m.channel, connection, proxymetrics.DirectionOutbound),
+		}, fd)
 		m.logger.Infof("%d bytes copied from inbound to outbound", n)
 		outBoundErr <- err
 	}()
@@ -481,6 +525,19 @@ func (m *migrationProxy) handleConnection(fd net.Conn) {
 	}
 }
 
+// countingWriter accounts every chunk written to the proxied connection, so that the
+// metrics reflect the throughput while the migration is still running
+type countingWriter struct {
+	writer  io.Writer
+	counter prometheus.Counter
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	n, err := w.writer.Write(p)
+	w.counter.Add(float64(n))
+	return n, err
+}
+
 func (m *migrationProxy) Start() error {
 
 	if m.unixSocketPath != "" {
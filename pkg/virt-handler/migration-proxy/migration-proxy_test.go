@@ -28,12 +28,14 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	io_prometheus_client "github.com/prometheus/client_model/go"
 	"k8s.io/utils/pointer"
 
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/certificates"
 	ephemeraldiskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
+	proxymetrics "kubevirt.io/kubevirt/pkg/monitoring/migrationproxy"
 	"kubevirt.io/kubevirt/pkg/testutils"
 )
 
@@ -150,6 +152,45 @@ var _ = Describe("MigrationProxy", func() {
 				Expect(num).To(Equal(sentLen))
 			})
 
+			It("by counting the bytes piped through the proxy", func() {
+				sourceSock := filepath.Join(tmpDir, "source-sock")
+
+				listener, err := tls.Listen("tcp", "127.0.0.1:12345", tlsConfig)
+				Expect(err).ShouldNot(HaveOccurred())
+				defer listener.Close()
+
+				sourceProxy := NewSourceProxy(sourceSock, "127.0.0.1:12345", tlsConfig, tlsConfig, "metrics-uid")
+				sourceProxy.channel = migrationChannel(LibvirtDirectMigrationPort)
+				defer proxymetrics.DeleteMetrics("metrics-uid")
+				defer sourceProxy.Stop()
+
+				Expect(sourceProxy.Start()).To(Succeed())
+
+				go func() {
+					defer GinkgoRecover()
+					fd, err := listener.Accept()
+					Expect(err).ShouldNot(HaveOccurred())
+					var bytes [1024]byte
+					_, err = fd.Read(bytes[0:])
+					Expect(err).ShouldNot(HaveOccurred())
+				}()
+
+				conn, err := net.Dial("unix", sourceSock)
+				Expect(err).ShouldNot(HaveOccurred())
+				defer conn.Close()
+
+				message := []byte("some message")
+				_, err = conn.Write(message)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				counter := proxymetrics.NewTransferredBytesCounter("metrics-uid", sourceRole, "direct", "0", proxymetrics.DirectionOutbound)
+				Eventually(func() float64 {
+					metric := &io_prometheus_client.Metric{}
+					Expect(counter.Write(metric)).To(Succeed())
+					return metric.GetCounter().GetValue()
+				}).Should(BeEquivalentTo(len(message)))
+			})
+
 			DescribeTable("by creating both ends with a manager and sending a message", func(migrationConfig *v1.MigrationConfiguration) {
 				directMigrationPort := "49152"
 				virtqemudSock := filepath.Join(tmpDir, "virtqemud-sock")
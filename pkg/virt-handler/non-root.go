@@ -17,6 +17,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/namescheme"
 	"kubevirt.io/kubevirt/pkg/safepath"
 	"kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
 )
 
@@ -235,6 +236,93 @@ func (*VirtualMachineController) prepareVFIO(vmi *v1.VirtualMachineInstance, res
 	return nil
 }
 
+func (*VirtualMachineController) prepareUSB(vmi *v1.VirtualMachineInstance, res isolation.IsolationResult) error {
+	usbBasePath, err := isolation.SafeJoin(res, "dev", "bus", "usb")
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	var buses []os.DirEntry
+	err = usbBasePath.ExecuteNoFollow(func(safePath string) (err error) {
+		buses, err = os.ReadDir(safePath)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, bus := range buses {
+		busPath, err := safepath.JoinNoFollow(usbBasePath, bus.Name())
+		if err != nil {
+			return err
+		}
+		var devices []os.DirEntry
+		err = busPath.ExecuteNoFollow(func(safePath string) (err error) {
+			devices, err = os.ReadDir(safePath)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		for _, device := range devices {
+			devicePath, err := safepath.JoinNoFollow(busPath, device.Name())
+			if err != nil {
+				return err
+			}
+			if err := diskutils.DefaultOwnershipManager.SetFileOwnership(devicePath); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// prepareInput hands the passed-through evdev nodes, which may also be placed below
+// /dev/input/by-id or /dev/input/by-path, over to the non-root qemu process
+func (*VirtualMachineController) prepareInput(vmi *v1.VirtualMachineInstance, res isolation.IsolationResult) error {
+	if !util.IsInputPassthroughVMI(vmi) {
+		return nil
+	}
+	inputBasePath, err := isolation.SafeJoin(res, "dev", "input")
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	return changeOwnershipOfDeviceTree(inputBasePath)
+}
+
+func changeOwnershipOfDeviceTree(dirPath *safepath.Path) error {
+	var entries []os.DirEntry
+	err := dirPath.ExecuteNoFollow(func(safePath string) (err error) {
+		entries, err = os.ReadDir(safePath)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		entryPath, err := safepath.JoinNoFollow(dirPath, entry.Name())
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			err = changeOwnershipOfDeviceTree(entryPath)
+		} else {
+			err = diskutils.DefaultOwnershipManager.SetFileOwnership(entryPath)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (d *VirtualMachineController) nonRootSetup(origVMI, vmi *v1.VirtualMachineInstance) error {
 	res, err := d.podIsolationDetector.Detect(origVMI)
 	if err != nil {
@@ -249,5 +337,11 @@ func (d *VirtualMachineController) nonRootSetup(origVMI, vmi *v1.VirtualMachineI
 	if err := d.prepareVFIO(origVMI, res); err != nil {
 		return err
 	}
+	if err := d.prepareUSB(origVMI, res); err != nil {
+		return err
+	}
+	if err := d.prepareInput(origVMI, res); err != nil {
+		return err
+	}
 	return nil
 }
@@ -22,6 +22,7 @@ package virthandler
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
@@ -29,6 +30,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	"kubevirt.io/kubevirt/pkg/safepath"
 	"kubevirt.io/kubevirt/pkg/unsafepath"
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
@@ -136,3 +138,59 @@ func podNetwork() v1.Network {
 		},
 	}
 }
+
+type recordingOwnershipManager struct {
+	paths []string
+}
+
+func (m *recordingOwnershipManager) UnsafeSetFileOwnership(file string) error {
+	m.paths = append(m.paths, file)
+	return nil
+}
+
+func (m *recordingOwnershipManager) SetFileOwnership(file *safepath.Path) error {
+	m.paths = append(m.paths, unsafepath.UnsafeAbsolute(file.Raw()))
+	return nil
+}
+
+var _ = Describe("prepareInput", func() {
+	var mockIsolationResult *isolation.MockIsolationResult
+	var ownershipManager *recordingOwnershipManager
+	var testsRootDir string
+
+	BeforeEach(func() {
+		testsRootDir = GinkgoT().TempDir()
+		Expect(os.MkdirAll(filepath.Join(testsRootDir, "dev", "input", "by-id"), 0777)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(testsRootDir, "dev", "input", "event3"), nil, 0600)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(testsRootDir, "dev", "input", "by-id", "usb-keyboard-event-kbd"), nil, 0600)).To(Succeed())
+
+		mockIsolationResult = isolation.NewMockIsolationResult(gomock.NewController(GinkgoT()))
+		testsRootDirPath, err := safepath.JoinAndResolveWithRelativeRoot(testsRootDir)
+		Expect(err).ToNot(HaveOccurred())
+		mockIsolationResult.EXPECT().MountRoot().Return(testsRootDirPath, nil).AnyTimes()
+
+		ownershipManager = &recordingOwnershipManager{}
+		previousManager := diskutils.DefaultOwnershipManager
+		diskutils.DefaultOwnershipManager = ownershipManager
+		DeferCleanup(func() { diskutils.DefaultOwnershipManager = previousManager })
+	})
+
+	It("should change the ownership of the passed-through input devices", func() {
+		vmi := &v1.VirtualMachineInstance{}
+		vmi.Spec.Domain.Devices.Inputs = []v1.Input{{Name: "keyboard", Type: v1.InputTypePassthrough, DeviceName: "example.org/keyboard"}}
+
+		Expect((&VirtualMachineController{}).prepareInput(vmi, mockIsolationResult)).To(Succeed())
+		Expect(ownershipManager.paths).To(ConsistOf(
+			filepath.Join(testsRootDir, "dev", "input", "event3"),
+			filepath.Join(testsRootDir, "dev", "input", "by-id", "usb-keyboard-event-kbd"),
+		))
+	})
+
+	It("should not touch the input devices without passthrough", func() {
+		vmi := &v1.VirtualMachineInstance{}
+		vmi.Spec.Domain.Devices.Inputs = []v1.Input{{Name: "tablet", Type: v1.InputTypeTablet}}
+
+		Expect((&VirtualMachineController{}).prepareInput(vmi, mockIsolationResult)).To(Succeed())
+		Expect(ownershipManager.paths).To(BeEmpty())
+	})
+})
@@ -51,6 +51,7 @@ type ConsoleHandler struct {
 	podIsolationDetector isolation.PodIsolationDetector
 	serialStopChans      map[types.UID](chan struct{})
 	vncStopChans         map[types.UID](chan struct{})
+	vncSharedConnections map[types.UID]int
 	serialLock           *sync.Mutex
 	vncLock              *sync.Mutex
 	vmiInformer          cache.SharedIndexInformer
@@ -68,6 +69,7 @@ func NewConsoleHandler(podIsolationDetector isolation.PodIsolationDetector, vmiI
 		podIsolationDetector: podIsolationDetector,
 		serialStopChans:      make(map[types.UID](chan struct{})),
 		vncStopChans:         make(map[types.UID](chan struct{})),
+		vncSharedConnections: make(map[types.UID]int),
 		serialLock:           &sync.Mutex{},
 		vncLock:              &sync.Mutex{},
 		usbredirLock:         &sync.Mutex{},
@@ -154,11 +156,48 @@ func (t *ConsoleHandler) VNCHandler(request *restful.Request, response *restful.
 		return
 	}
 	uid := vmi.GetUID()
-	stopChn := newStopChan(uid, t.vncLock, t.vncStopChans)
-	defer deleteStopChan(uid, stopChn, t.vncLock, t.vncStopChans)
+	shared := request.QueryParameter("shared") == "true"
+	stopChn := t.newVNCStopChan(uid, shared)
+	defer t.deleteVNCStopChan(uid, stopChn)
 	t.stream(vmi, request, response, unixSocketDialer(vmi, unixSocketPath), stopChn)
 }
 
+// newVNCStopChan returns the stop channel of a new VNC connection. A new connection closes the
+// current connections of the VMI, unless both the new and the current connections are shared.
+func (t *ConsoleHandler) newVNCStopChan(uid types.UID, shared bool) chan struct{} {
+	t.vncLock.Lock()
+	defer t.vncLock.Unlock()
+	if c, ok := t.vncStopChans[uid]; ok {
+		if shared && t.vncSharedConnections[uid] > 0 {
+			t.vncSharedConnections[uid]++
+			return c
+		}
+		delete(t.vncStopChans, uid)
+		delete(t.vncSharedConnections, uid)
+		close(c)
+	}
+	stopCh := make(chan struct{})
+	t.vncStopChans[uid] = stopCh
+	if shared {
+		t.vncSharedConnections[uid] = 1
+	}
+	return stopCh
+}
+
+func (t *ConsoleHandler) deleteVNCStopChan(uid types.UID, stopChn chan struct{}) {
+	t.vncLock.Lock()
+	defer t.vncLock.Unlock()
+	if c, ok := t.vncStopChans[uid]; !ok || c != stopChn {
+		return
+	}
+	if t.vncSharedConnections[uid] > 1 {
+		t.vncSharedConnections[uid]--
+		return
+	}
+	delete(t.vncStopChans, uid)
+	delete(t.vncSharedConnections, uid)
+}
+
 func (t *ConsoleHandler) SerialHandler(request *restful.Request, response *restful.Response) {
 	vmi, code, err := getVMI(request, t.vmiInformer)
 	if err != nil {
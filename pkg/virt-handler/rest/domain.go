@@ -0,0 +1,92 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"encoding/xml"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const redacted = "REDACTED"
+
+func (lh *LifecycleHandler) GetDomainXML(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	domain, exists, err := client.GetDomain()
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get domain")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	if !exists {
+		response.WriteErrorString(http.StatusNotFound, "domain does not exist")
+		return
+	}
+
+	spec := domain.Spec.DeepCopy()
+	redactDomainSpec(spec)
+	domainXML, err := xml.MarshalIndent(spec, "", "  ")
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to marshal domain")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteEntity(v1.VirtualMachineInstanceDomainXML{XML: string(domainXML)})
+}
+
+// redactDomainSpec removes everything from the domain which allows to access
+// the disks or the memory of the guest, so that it can be shared for debugging
+func redactDomainSpec(spec *api.DomainSpec) {
+	for i := range spec.Devices.Disks {
+		if auth := spec.Devices.Disks[i].Auth; auth != nil {
+			auth.Username = redacted
+			if auth.Secret != nil {
+				auth.Secret.Usage = redacted
+				auth.Secret.UUID = redacted
+			}
+		}
+	}
+
+	if launchSecurity := spec.LaunchSecurity; launchSecurity != nil {
+		if launchSecurity.DHCert != "" {
+			launchSecurity.DHCert = redacted
+		}
+		if launchSecurity.Session != "" {
+			launchSecurity.Session = redacted
+		}
+	}
+
+	if spec.QEMUCmd != nil {
+		for i := range spec.QEMUCmd.QEMUEnv {
+			spec.QEMUCmd.QEMUEnv[i].Value = redacted
+		}
+	}
+}
@@ -0,0 +1,126 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+func (lh *LifecycleHandler) GetStats(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	domainStats, exists, err := client.GetDomainStats()
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get domain stats")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	if !exists || domainStats == nil {
+		response.WriteErrorString(http.StatusNotFound, "domain stats are not available yet")
+		return
+	}
+
+	response.WriteEntity(toVMIStats(domainStats))
+}
+
+func toVMIStats(domainStats *stats.DomainStats) *v1.VirtualMachineInstanceStats {
+	vmiStats := &v1.VirtualMachineInstanceStats{
+		Timestamp: metav1.NowMicro(),
+	}
+
+	if cpu := domainStats.Cpu; cpu != nil && cpu.TimeSet {
+		vmiStats.CPU = &v1.VirtualMachineInstanceCPUStats{
+			VCPUs:             domainStats.NrVirtCpu,
+			TimeNanoseconds:   cpu.Time,
+			UserNanoseconds:   cpu.User,
+			SystemNanoseconds: cpu.System,
+		}
+	}
+
+	if memory := domainStats.Memory; memory != nil {
+		vmiStats.Memory = &v1.VirtualMachineInstanceMemoryStats{}
+		// libvirt reports the memory stats in KiB
+		if memory.AvailableSet {
+			vmiStats.Memory.AvailableBytes = memory.Available * 1024
+		}
+		if memory.UnusedSet {
+			vmiStats.Memory.UnusedBytes = memory.Unused * 1024
+		}
+		if memory.UsableSet {
+			vmiStats.Memory.UsableBytes = memory.Usable * 1024
+		}
+		if memory.ActualBalloonSet {
+			vmiStats.Memory.ActualBalloonBytes = memory.ActualBalloon * 1024
+		}
+		if memory.RSSSet {
+			vmiStats.Memory.ResidentBytes = memory.RSS * 1024
+		}
+	}
+
+	for _, block := range domainStats.Block {
+		if !block.NameSet {
+			continue
+		}
+		name := block.Name
+		if block.Alias != "" {
+			name = block.Alias
+		}
+		vmiStats.Disks = append(vmiStats.Disks, v1.VirtualMachineInstanceDiskStats{
+			Name:          name,
+			ReadBytes:     block.RdBytes,
+			ReadRequests:  block.RdReqs,
+			WriteBytes:    block.WrBytes,
+			WriteRequests: block.WrReqs,
+		})
+	}
+
+	for _, net := range domainStats.Net {
+		if !net.NameSet {
+			continue
+		}
+		name := net.Name
+		if net.AliasSet {
+			name = net.Alias
+		}
+		vmiStats.Interfaces = append(vmiStats.Interfaces, v1.VirtualMachineInstanceInterfaceStats{
+			Name:      name,
+			RxBytes:   net.RxBytes,
+			RxPackets: net.RxPkts,
+			RxErrors:  net.RxErrs,
+			TxBytes:   net.TxBytes,
+			TxPackets: net.TxPkts,
+			TxErrors:  net.TxErrs,
+		})
+	}
+
+	return vmiStats
+}
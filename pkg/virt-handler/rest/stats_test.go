@@ -0,0 +1,99 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+var _ = Describe("VMI stats", func() {
+	DescribeTable("should convert CPU stats", func(cpu *stats.DomainStatsCPU, expected *v1.VirtualMachineInstanceCPUStats) {
+		vmiStats := toVMIStats(&stats.DomainStats{Cpu: cpu, NrVirtCpu: 2})
+		Expect(vmiStats.CPU).To(Equal(expected))
+	},
+		Entry("when CPU stats are missing", nil, nil),
+		Entry("when the CPU time is not set", &stats.DomainStatsCPU{User: 10, UserSet: true}, nil),
+		Entry("when the CPU time is set",
+			&stats.DomainStatsCPU{TimeSet: true, Time: 30, UserSet: true, User: 10, SystemSet: true, System: 20},
+			&v1.VirtualMachineInstanceCPUStats{VCPUs: 2, TimeNanoseconds: 30, UserNanoseconds: 10, SystemNanoseconds: 20},
+		),
+	)
+
+	DescribeTable("should convert memory stats from KiB to bytes", func(memory *stats.DomainStatsMemory, expected *v1.VirtualMachineInstanceMemoryStats) {
+		vmiStats := toVMIStats(&stats.DomainStats{Memory: memory})
+		Expect(vmiStats.Memory).To(Equal(expected))
+	},
+		Entry("when memory stats are missing", nil, nil),
+		Entry("when no memory stat is set", &stats.DomainStatsMemory{Available: 1}, &v1.VirtualMachineInstanceMemoryStats{}),
+		Entry("when all memory stats are set",
+			&stats.DomainStatsMemory{
+				AvailableSet: true, Available: 1,
+				UnusedSet: true, Unused: 2,
+				UsableSet: true, Usable: 3,
+				ActualBalloonSet: true, ActualBalloon: 4,
+				RSSSet: true, RSS: 5,
+			},
+			&v1.VirtualMachineInstanceMemoryStats{
+				AvailableBytes:     1024,
+				UnusedBytes:        2048,
+				UsableBytes:        3072,
+				ActualBalloonBytes: 4096,
+				ResidentBytes:      5120,
+			},
+		),
+		Entry("when only some memory stats are set",
+			&stats.DomainStatsMemory{UnusedSet: true, Unused: 2, RSS: 5},
+			&v1.VirtualMachineInstanceMemoryStats{UnusedBytes: 2048},
+		),
+	)
+
+	It("should name disks after their alias and skip unnamed ones", func() {
+		vmiStats := toVMIStats(&stats.DomainStats{
+			Block: []stats.DomainStatsBlock{
+				{NameSet: true, Name: "vda", Alias: "rootdisk", RdBytes: 1, RdReqs: 2, WrBytes: 3, WrReqs: 4},
+				{NameSet: true, Name: "vdb"},
+				{Name: "vdc"},
+			},
+		})
+		Expect(vmiStats.Disks).To(Equal([]v1.VirtualMachineInstanceDiskStats{
+			{Name: "rootdisk", ReadBytes: 1, ReadRequests: 2, WriteBytes: 3, WriteRequests: 4},
+			{Name: "vdb"},
+		}))
+	})
+
+	It("should name interfaces after their alias and skip unnamed ones", func() {
+		vmiStats := toVMIStats(&stats.DomainStats{
+			Net: []stats.DomainStatsNet{
+				{NameSet: true, Name: "tap0", AliasSet: true, Alias: "default", RxBytes: 1, RxPkts: 2, RxErrs: 3, TxBytes: 4, TxPkts: 5, TxErrs: 6},
+				{NameSet: true, Name: "tap1"},
+				{Name: "tap2"},
+			},
+		})
+		Expect(vmiStats.Interfaces).To(Equal([]v1.VirtualMachineInstanceInterfaceStats{
+			{Name: "default", RxBytes: 1, RxPackets: 2, RxErrors: 3, TxBytes: 4, TxPackets: 5, TxErrors: 6},
+			{Name: "tap1"},
+		}))
+	})
+})
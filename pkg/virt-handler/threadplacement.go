@@ -0,0 +1,187 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package virthandler
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"golang.org/x/sys/unix"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var (
+	procPath = "/proc"
+
+	getCgroupManager = func(vmi *v1.VirtualMachineInstance) (cgroup.Manager, error) {
+		return cgroup.NewManagerFromVM(vmi)
+	}
+
+	setThreadAffinity = func(tid int, mask *unix.CPUSet) error {
+		return unix.SchedSetaffinity(tid, mask)
+	}
+)
+
+// configureThreadPlacement pins every QEMU thread which is not a vCPU, i.e. the emulator and the I/O threads,
+// as well as the vhost-net kernel threads serving the VMI, to the pCPUs of the pod which are not used by the vCPUs.
+// Setting the affinity again is harmless, so it is applied on every sync to also place threads started later on.
+func (d *VirtualMachineController) configureThreadPlacement(vmi *v1.VirtualMachineInstance) error {
+	domain, domainExists, _, err := d.getDomainFromCache(controller.VirtualMachineInstanceKey(vmi))
+	if err != nil {
+		return err
+	}
+	// bail out if domain does not exist
+	if !domainExists || domain.Spec.CPUTune == nil {
+		return nil
+	}
+
+	cgroupManager, err := getCgroupManager(vmi)
+	if err != nil {
+		return err
+	}
+	cpusetStr, err := cgroupManager.GetCpuSet()
+	if err != nil {
+		return err
+	}
+	podCPUs, err := hardware.ParseCPUSetLine(cpusetStr, 50000)
+	if err != nil {
+		return fmt.Errorf("failed to parse the VMI cpuset: %v", err)
+	}
+	reservedCPUs, err := getReservedCPUs(podCPUs, domain.Spec.CPUTune)
+	if err != nil {
+		return err
+	}
+
+	res, err := d.podIsolationDetector.Detect(vmi)
+	if err != nil {
+		return err
+	}
+	qemuProcess, err := res.GetQEMUProcess()
+	if err != nil {
+		return err
+	}
+	tids, err := getNonVCPUThreadIDs(procPath, qemuProcess.Pid())
+	if err != nil {
+		return err
+	}
+	vhostPids, err := getVhostThreadIDs(procPath, qemuProcess.Pid())
+	if err != nil {
+		return err
+	}
+
+	log.Log.V(3).Object(vmi).Infof("placing threads %v and vhost threads %v on the reserved cpus %v", tids, vhostPids, reservedCPUs)
+	mask := unix.CPUSet{}
+	for _, cpu := range reservedCPUs {
+		mask.Set(cpu)
+	}
+	for _, tid := range append(tids, vhostPids...) {
+		if err := setThreadAffinity(tid, &mask); err != nil {
+			return fmt.Errorf("failed to pin thread %d to the reserved cpus %v: %v", tid, reservedCPUs, err)
+		}
+	}
+	return nil
+}
+
+// getReservedCPUs returns the pCPUs of the pod on which no vCPU is pinned
+func getReservedCPUs(podCPUs []int, cpuTune *api.CPUTune) ([]int, error) {
+	vcpuCPUs := map[int]bool{}
+	for _, pin := range cpuTune.VCPUPin {
+		cpus, err := hardware.ParseCPUSetLine(pin.CPUSet, 50000)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the cpuset of vcpu %d: %v", pin.VCPU, err)
+		}
+		for _, cpu := range cpus {
+			vcpuCPUs[cpu] = true
+		}
+	}
+
+	var reservedCPUs []int
+	for _, cpu := range podCPUs {
+		if !vcpuCPUs[cpu] {
+			reservedCPUs = append(reservedCPUs, cpu)
+		}
+	}
+	if len(reservedCPUs) == 0 {
+		return nil, fmt.Errorf("no cpu of the pod is left for the reserved threads")
+	}
+	return reservedCPUs, nil
+}
+
+// getNonVCPUThreadIDs returns the IDs of all threads of the QEMU process except the vCPU threads
+func getNonVCPUThreadIDs(procRoot string, pid int) ([]int, error) {
+	taskPath := filepath.Join(procRoot, strconv.Itoa(pid), "task")
+	entries, err := os.ReadDir(taskPath)
+	if err != nil {
+		return nil, err
+	}
+	var tids []int
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		tid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		comm, err := os.ReadFile(filepath.Join(taskPath, entry.Name(), "comm"))
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := isVCPU(comm); !ok {
+			tids = append(tids, tid)
+		}
+	}
+	return tids, nil
+}
+
+// getVhostThreadIDs returns the IDs of the vhost kernel threads serving the QEMU process.
+// The kernel names them after the process owning the vhost device, i.e. "vhost-<qemu pid>".
+func getVhostThreadIDs(procRoot string, qemuPid int) ([]int, error) {
+	entries, err := os.ReadDir(procRoot)
+	if err != nil {
+		return nil, err
+	}
+	vhostComm := fmt.Sprintf("vhost-%d\n", qemuPid)
+	var pids []int
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil || !entry.IsDir() || pid == qemuPid {
+			continue
+		}
+		comm, err := os.ReadFile(filepath.Join(procRoot, entry.Name(), "comm"))
+		if err != nil {
+			// the process may have exited in the meantime
+			continue
+		}
+		if string(comm) == vhostComm {
+			pids = append(pids, pid)
+		}
+	}
+	return pids, nil
+}
@@ -0,0 +1,100 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package virthandler
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+var _ = Describe("Thread placement", func() {
+
+	Context("reserved cpus", func() {
+
+		It("should return the cpus of the pod without a vCPU pinned to them", func() {
+			cpuTune := &api.CPUTune{VCPUPin: []api.CPUTuneVCPUPin{
+				{VCPU: 0, CPUSet: "2"},
+				{VCPU: 1, CPUSet: "3"},
+			}}
+			Expect(getReservedCPUs([]int{2, 3, 4, 5}, cpuTune)).To(Equal([]int{4, 5}))
+		})
+
+		It("should fail if all cpus of the pod are used by vCPUs", func() {
+			cpuTune := &api.CPUTune{VCPUPin: []api.CPUTuneVCPUPin{
+				{VCPU: 0, CPUSet: "2-3"},
+			}}
+			_, err := getReservedCPUs([]int{2, 3}, cpuTune)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("thread discovery", func() {
+
+		const qemuPid = 100
+
+		var procRoot string
+
+		addTask := func(path, comm string) {
+			Expect(os.MkdirAll(path, 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(path, "comm"), []byte(comm+"\n"), 0644)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			procRoot = GinkgoT().TempDir()
+			addTask(filepath.Join(procRoot, "100"), "qemu-kvm")
+			addTask(filepath.Join(procRoot, "100", "task", "100"), "qemu-kvm")
+			addTask(filepath.Join(procRoot, "100", "task", "101"), "IO iothread1")
+			addTask(filepath.Join(procRoot, "100", "task", "102"), "CPU 0/KVM")
+			addTask(filepath.Join(procRoot, "100", "task", "103"), "CPU 1/KVM")
+			addTask(filepath.Join(procRoot, "200"), "vhost-100")
+			addTask(filepath.Join(procRoot, "201"), "vhost-1000")
+			addTask(filepath.Join(procRoot, "202"), "kworker/0:1")
+		})
+
+		It("should find the threads of the QEMU process which are not vCPUs", func() {
+			Expect(getNonVCPUThreadIDs(procRoot, qemuPid)).To(ConsistOf(100, 101))
+		})
+
+		It("should find the vhost threads of the QEMU process", func() {
+			Expect(getVhostThreadIDs(procRoot, qemuPid)).To(ConsistOf(200))
+		})
+	})
+})
+
+type fakeProcess struct {
+	pid int
+}
+
+func (p *fakeProcess) Pid() int {
+	return p.pid
+}
+
+func (p *fakeProcess) PPid() int {
+	return 1
+}
+
+func (p *fakeProcess) Executable() string {
+	return "qemu-kvm"
+}
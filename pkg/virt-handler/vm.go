@@ -284,7 +284,7 @@ func NewController(
 		device_manager.PermanentHostDevicePlugins(maxDevices, permissions),
 		clusterConfig,
 		clientset.CoreV1())
-	c.heartBeat = heartbeat.NewHeartBeat(clientset.CoreV1(), c.deviceManagerController, clusterConfig, host)
+	c.heartBeat = heartbeat.NewHeartBeat(clientset.CoreV1(), c.deviceManagerController, clusterConfig, host, recorder)
 
 	return c, nil
 }
@@ -944,6 +944,19 @@ func (d *VirtualMachineController) updateGuestInfoFromDomain(vmi *v1.VirtualMach
 	}
 }
 
+func (d *VirtualMachineController) updateGuestTimeSyncFromDomain(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if domain == nil || domain.Spec.Metadata.KubeVirt.GuestTimeSync == nil {
+		return
+	}
+
+	timeSyncMetadata := domain.Spec.Metadata.KubeVirt.GuestTimeSync
+	vmi.Status.GuestTimeSync = &v1.GuestTimeSyncStatus{
+		LastSyncTime:      timeSyncMetadata.Timestamp,
+		Reason:            v1.GuestTimeSyncReason(timeSyncMetadata.Reason),
+		DriftMilliseconds: timeSyncMetadata.DriftMilliseconds,
+	}
+}
+
 func (d *VirtualMachineController) updateAccessCredentialConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
 
 	if domain == nil || domain.Spec.Metadata.KubeVirt.AccessCredential == nil {
@@ -1271,6 +1284,7 @@ func (d *VirtualMachineController) updateVMIStatusFromDomain(vmi *v1.VirtualMach
 	}
 	d.setMigrationProgressStatus(vmi, domain)
 	d.updateGuestInfoFromDomain(vmi, domain)
+	d.updateGuestTimeSyncFromDomain(vmi, domain)
 	d.updateVolumeStatusesFromDomain(vmi, domain)
 	d.updateFSFreezeStatus(vmi, domain)
 	d.updateMachineType(vmi, domain)
@@ -1483,10 +1497,18 @@ func (d *VirtualMachineController) calculateLiveMigrationCondition(vmi *v1.Virtu
 		return newNonMigratableCondition("VMI uses virtiofs", v1.VirtualMachineInstanceReasonVirtIOFSNotMigratable), isBlockMigration
 	}
 
+	if util.IsVhostUserBlkVMI(vmi) {
+		return newNonMigratableCondition("VMI uses vhost-user-blk disks", v1.VirtualMachineInstanceReasonVhostUserBlkNotMigratable), isBlockMigration
+	}
+
 	if vmiContainsPCIHostDevice(vmi) {
 		return newNonMigratableCondition("VMI uses a PCI host devices", v1.VirtualMachineInstanceReasonHostDeviceNotMigratable), isBlockMigration
 	}
 
+	if util.IsInputPassthroughVMI(vmi) {
+		return newNonMigratableCondition("VMI uses passthrough input devices", v1.VirtualMachineInstanceReasonInputPassthroughNotMigratable), isBlockMigration
+	}
+
 	if util.IsSEVVMI(vmi) {
 		return newNonMigratableCondition("VMI uses SEV", v1.VirtualMachineInstanceReasonSEVNotMigratable), isBlockMigration
 	}
@@ -2592,6 +2614,13 @@ func (d *VirtualMachineController) vmUpdateHelperMigrationSource(origVMI *v1.Vir
 			AllowPostCopy:           *migrationConfiguration.AllowPostCopy,
 		}
 
+		if streams := migrationConfiguration.ParallelMigrationStreams; streams != nil && *streams > 1 {
+			options.ParallelMigrationThreads = pointer.P(uint(*streams))
+		}
+		if migrationConfiguration.Compression != nil {
+			options.Compression = *migrationConfiguration.Compression
+		}
+
 		if threadCountStr, exists := origVMI.Annotations[cmdclient.MultiThreadedQemuMigrationAnnotation]; exists {
 			threadCount, err := strconv.Atoi(threadCountStr)
 
@@ -2602,6 +2631,13 @@ func (d *VirtualMachineController) vmUpdateHelperMigrationSource(origVMI *v1.Vir
 			}
 		}
 
+		// a policy can combine xbzrle with the parallel streams of the cluster configuration,
+		// which libvirt refuses, the compression wins in that case
+		if options.Compression == v1.MigrationCompressionXBZRLE && options.ParallelMigrationThreads != nil {
+			log.Log.Object(origVMI).Warningf("ignoring parallel migration streams, they can not be used with %s compression", v1.MigrationCompressionXBZRLE)
+			options.ParallelMigrationThreads = nil
+		}
+
 		marshalledOptions, err := json.Marshal(options)
 		if err != nil {
 			log.Log.Object(origVMI).Warning("failed to marshall matched migration options")
@@ -3034,6 +3070,12 @@ func (d *VirtualMachineController) vmUpdateHelperDefault(origVMI *v1.VirtualMach
 			return err
 		}
 	}
+	if vmi.IsCPUDedicated() && vmi.Spec.Domain.CPU.ThreadPlacement != nil && !vmi.IsFinal() {
+		log.Log.V(3).Object(vmi).Info("Placing emulator, I/O and vhost threads on the reserved cpus")
+		if err := d.configureThreadPlacement(vmi); err != nil {
+			return err
+		}
+	}
 	if !domainExists {
 		d.recorder.Event(vmi, k8sv1.EventTypeNormal, v1.Created.String(), VMIDefined)
 	}
@@ -3288,6 +3330,14 @@ func (d *VirtualMachineController) finalizeMigration(vmi *v1.VirtualMachineInsta
 		d.recorder.Event(vmi, k8sv1.EventTypeWarning, err.Error(), "failed to change vCPUs")
 	}
 
+	// The domain of the target does not pin the I/O threads, they have to be placed like on the source
+	if vmi.IsCPUDedicated() && vmi.Spec.Domain.CPU.ThreadPlacement != nil {
+		if err := d.configureThreadPlacement(vmi); err != nil {
+			log.Log.Object(vmi).Reason(err).Error(errorMessage)
+			d.recorder.Event(vmi, k8sv1.EventTypeWarning, err.Error(), "failed to place the emulator, I/O and vhost threads")
+		}
+	}
+
 	if err := client.FinalizeVirtualMachineMigration(vmi); err != nil {
 		log.Log.Object(vmi).Reason(err).Error(errorMessage)
 		return fmt.Errorf("%s: %v", errorMessage, err)
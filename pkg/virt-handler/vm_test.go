@@ -51,6 +51,7 @@ import (
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"golang.org/x/sys/unix"
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -75,6 +76,7 @@ import (
 	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtcache "kubevirt.io/kubevirt/pkg/virt-handler/cache"
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
 	migrationproxy "kubevirt.io/kubevirt/pkg/virt-handler/migration-proxy"
@@ -973,6 +975,42 @@ var _ = Describe("VirtualMachineInstance", func() {
 			expectEvent(string(v1.AccessCredentialsSyncSuccess), true)
 		})
 
+		It("should report the last guest time sync from the domain", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			mockWatchdog.CreateFile(vmi)
+
+			syncTime := metav1.Now()
+			drift := int64(1500)
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Metadata.KubeVirt.GuestTimeSync = &api.GuestTimeSyncMetadata{
+				Reason:            string(v1.GuestTimeSyncReasonUnpause),
+				Timestamp:         &syncTime,
+				DriftMilliseconds: &drift,
+			}
+
+			vmiFeeder.Add(vmi)
+			domainFeeder.Add(domain)
+
+			client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+			vmiInterface.EXPECT().Update(context.Background(), gomock.Any()).Do(func(ctx context.Context, vmi *v1.VirtualMachineInstance) {
+				Expect(vmi.Status.GuestTimeSync).To(Equal(&v1.GuestTimeSyncStatus{
+					LastSyncTime:      &syncTime,
+					Reason:            v1.GuestTimeSyncReasonUnpause,
+					DriftMilliseconds: &drift,
+				}))
+			})
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any()).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any()).Return(nil)
+
+			controller.Execute()
+		})
+
 		It("should do nothing if access credential condition already exists", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -2130,6 +2168,85 @@ var _ = Describe("VirtualMachineInstance", func() {
 
 			controller.Execute()
 		})
+
+		It("should place the emulator, I/O and vhost threads on the migration target", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi.Labels = make(map[string]string)
+			vmi.Status.NodeName = "othernode"
+			vmi.Labels[v1.MigrationTargetNodeNameLabel] = host
+			pastTime := metav1.NewTime(metav1.Now().Add(time.Duration(-10) * time.Second))
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				TargetNode:               host,
+				TargetNodeAddress:        "127.0.0.1:12345",
+				SourceNode:               "othernode",
+				MigrationUID:             "123",
+				TargetNodeDomainDetected: false,
+				StartTimestamp:           &pastTime,
+			}
+			vmi.Spec.Domain.CPU = &v1.CPU{
+				Cores:                 2,
+				DedicatedCPUPlacement: true,
+				ThreadPlacement:       &v1.ThreadPlacement{},
+			}
+
+			mockWatchdog.CreateFile(vmi)
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.CPUTune = &api.CPUTune{VCPUPin: []api.CPUTuneVCPUPin{
+				{VCPU: 0, CPUSet: "2"},
+				{VCPU: 1, CPUSet: "3"},
+			}}
+			domain.Spec.Metadata.KubeVirt.Migration = &api.MigrationMetadata{
+				UID:            "123",
+				StartTimestamp: &pastTime,
+			}
+
+			procRoot := GinkgoT().TempDir()
+			for path, comm := range map[string]string{
+				"100/task/100": "qemu-kvm",
+				"100/task/101": "IO iothread1",
+				"100/task/102": "CPU 0/KVM",
+				"100/task/103": "CPU 1/KVM",
+				"200":          "vhost-100",
+			} {
+				Expect(os.MkdirAll(filepath.Join(procRoot, path), 0755)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(procRoot, path, "comm"), []byte(comm+"\n"), 0644)).To(Succeed())
+			}
+			cgroupManager := cgroup.NewMockManager(ctrl)
+			cgroupManager.EXPECT().GetCpuSet().Return("2-4", nil)
+			mockIsolationResult.EXPECT().GetQEMUProcess().Return(&fakeProcess{pid: 100}, nil)
+
+			placed := map[int][]int{}
+			origProcPath, origGetCgroupManager, origSetThreadAffinity := procPath, getCgroupManager, setThreadAffinity
+			DeferCleanup(func() {
+				procPath, getCgroupManager, setThreadAffinity = origProcPath, origGetCgroupManager, origSetThreadAffinity
+			})
+			procPath = procRoot
+			getCgroupManager = func(_ *v1.VirtualMachineInstance) (cgroup.Manager, error) {
+				return cgroupManager, nil
+			}
+			setThreadAffinity = func(tid int, mask *unix.CPUSet) error {
+				for cpu := 0; cpu < 8; cpu++ {
+					if mask.IsSet(cpu) {
+						placed[tid] = append(placed[tid], cpu)
+					}
+				}
+				return nil
+			}
+
+			domainFeeder.Add(domain)
+			vmiFeeder.Add(vmi)
+
+			client.EXPECT().Ping().AnyTimes()
+			client.EXPECT().FinalizeVirtualMachineMigration(gomock.Any())
+			vmiInterface.EXPECT().Update(context.Background(), gomock.Any())
+
+			controller.Execute()
+			Expect(placed).To(Equal(map[int][]int{100: {4}, 101: {4}, 200: {4}}))
+		})
 	})
 
 	It("should always remove the VirtualMachineInstanceVCPUChange condition even if hotplug CPU has failed", func() {
@@ -2593,6 +2710,39 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(condition.Reason).To(Equal(v1.VirtualMachineInstanceReasonVirtIOFSNotMigratable))
 		})
 
+		It("should not be allowed to live-migrate if the VMI uses vhost-user-blk disks", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Volumes = []v1.Volume{
+				{
+					Name: "spdk",
+					VolumeSource: v1.VolumeSource{
+						VhostUserBlk: &v1.VhostUserBlkSource{Image: "spdk:latest"},
+					},
+				},
+			}
+
+			condition, _ := controller.calculateLiveMigrationCondition(vmi)
+			Expect(condition.Type).To(Equal(v1.VirtualMachineInstanceIsMigratable))
+			Expect(condition.Status).To(Equal(k8sv1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(v1.VirtualMachineInstanceReasonVhostUserBlkNotMigratable))
+		})
+
+		It("should not be allowed to live-migrate if the VMI passes through host input devices", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Inputs = []v1.Input{
+				{
+					Name:       "keyboard",
+					Type:       v1.InputTypePassthrough,
+					DeviceName: "example.org/keyboard",
+				},
+			}
+
+			condition, _ := controller.calculateLiveMigrationCondition(vmi)
+			Expect(condition.Type).To(Equal(v1.VirtualMachineInstanceIsMigratable))
+			Expect(condition.Status).To(Equal(k8sv1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(v1.VirtualMachineInstanceReasonInputPassthroughNotMigratable))
+		})
+
 		It("should not be allowed to live-migrate if the VMI does not use masquerade to connect to the pod network", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 
@@ -3184,6 +3334,45 @@ var _ = Describe("VirtualMachineInstance", func() {
 			controller.Execute()
 			testutils.ExpectEvent(recorder, VMIMigrating)
 		})
+
+		It("should not use parallel streams with xbzrle compression", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.Status.Phase = v1.Running
+			compression := v1.MigrationCompressionXBZRLE
+			migrationConfiguration := controller.clusterConfig.GetMigrationConfiguration().DeepCopy()
+			migrationConfiguration.ParallelMigrationStreams = pointer.Uint32(4)
+			migrationConfiguration.Compression = &compression
+			vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+				TargetNode:                     "othernode",
+				TargetNodeAddress:              "127.0.0.1:12345",
+				SourceNode:                     host,
+				MigrationUID:                   "123",
+				TargetDirectMigrationNodePorts: map[string]int{"49152": 12132},
+				MigrationConfiguration:         migrationConfiguration,
+			}
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{
+					Type:   v1.VirtualMachineInstanceIsMigratable,
+					Status: k8sv1.ConditionTrue,
+				},
+			}
+			vmi = addActivePods(vmi, podTestUUID, host)
+
+			mockWatchdog.CreateFile(vmi)
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domainFeeder.Add(domain)
+			vmiFeeder.Add(vmi)
+
+			client.EXPECT().MigrateVirtualMachine(gomock.Any(), gomock.Any()).Do(func(_ *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions) {
+				Expect(options.Compression).To(Equal(v1.MigrationCompressionXBZRLE))
+				Expect(options.ParallelMigrationThreads).To(BeNil())
+			}).Times(1).Return(nil)
+
+			controller.Execute()
+			testutils.ExpectEvent(recorder, VMIMigrating)
+		})
 	})
 
 })
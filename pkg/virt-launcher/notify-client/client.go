@@ -386,6 +386,8 @@ func (n *Notifier) StartDomainNotifier(
 	qemuAgentUserInterval time.Duration,
 	qemuAgentVersionInterval time.Duration,
 	qemuAgentFSFreezeStatusInterval time.Duration,
+	qemuAgentUsageInterval time.Duration,
+	qemuAgentCustomCommands []agentpoller.AgentCommand,
 	metadataCache *metadata.Cache,
 ) error {
 
@@ -407,6 +409,8 @@ func (n *Notifier) StartDomainNotifier(
 		qemuAgentUserInterval,
 		qemuAgentVersionInterval,
 		qemuAgentFSFreezeStatusInterval,
+		qemuAgentUsageInterval,
+		qemuAgentCustomCommands,
 	)
 
 	// Run the event process logic in a separate go-routine to not block libvirt
@@ -88,6 +88,49 @@ type Filesystem struct {
 	TotalBytes int    `json:"total-bytes,omitempty"`
 }
 
+// DiskAddress is the address of a guest disk
+type DiskAddress struct {
+	BusType string `json:"bus-type"`
+	Serial  string `json:"serial,omitempty"`
+}
+
+// Disk of the guest
+type Disk struct {
+	Name         string       `json:"name"`
+	Partition    bool         `json:"partition"`
+	Dependencies []string     `json:"dependencies,omitempty"`
+	Address      *DiskAddress `json:"address,omitempty"`
+	Alias        string       `json:"alias,omitempty"`
+}
+
+// CPUStats of a single guest CPU in milliseconds
+type CPUStats struct {
+	Type      string `json:"type"`
+	CPU       int    `json:"cpu"`
+	User      int64  `json:"user"`
+	Nice      int64  `json:"nice"`
+	System    int64  `json:"system"`
+	Idle      int64  `json:"idle"`
+	IOWait    int64  `json:"iowait,omitempty"`
+	IRQ       int64  `json:"irq,omitempty"`
+	SoftIRQ   int64  `json:"softirq,omitempty"`
+	Steal     int64  `json:"steal,omitempty"`
+	Guest     int64  `json:"guest,omitempty"`
+	GuestNice int64  `json:"guestnice,omitempty"`
+}
+
+// MemoryBlockInfo of the guest
+type MemoryBlockInfo struct {
+	Size int64 `json:"size"`
+}
+
+// Load averages of the guest
+type Load struct {
+	Load1m  float64 `json:"load1m"`
+	Load5m  float64 `json:"load5m"`
+	Load15m float64 `json:"load15m"`
+}
+
 // AgentInfo from the guest VM serves the purpose
 // of checking the GA presence and version compatibility
 type AgentInfo struct {
@@ -225,6 +268,117 @@ func parseUsers(agentReply string) ([]api.User, error) {
 	return convertedResult, nil
 }
 
+// parseDisks from the agent response
+func parseDisks(agentReply string) ([]api.GuestDisk, error) {
+	result := []Disk{}
+	response := stripAgentResponse(agentReply)
+
+	err := json.Unmarshal([]byte(response), &result)
+	if err != nil {
+		return []api.GuestDisk{}, err
+	}
+
+	convertedResult := []api.GuestDisk{}
+
+	for _, disk := range result {
+		guestDisk := api.GuestDisk{
+			Name:         disk.Name,
+			Partition:    disk.Partition,
+			Dependencies: disk.Dependencies,
+			Alias:        disk.Alias,
+		}
+		if disk.Address != nil {
+			guestDisk.BusType = disk.Address.BusType
+			guestDisk.Serial = disk.Address.Serial
+		}
+		convertedResult = append(convertedResult, guestDisk)
+	}
+
+	return convertedResult, nil
+}
+
+// parseCPUStats from the agent response
+func parseCPUStats(agentReply string) ([]api.GuestCPUStats, error) {
+	result := []CPUStats{}
+	response := stripAgentResponse(agentReply)
+
+	err := json.Unmarshal([]byte(response), &result)
+	if err != nil {
+		return []api.GuestCPUStats{}, err
+	}
+
+	convertedResult := []api.GuestCPUStats{}
+
+	for _, stats := range result {
+		convertedResult = append(convertedResult, api.GuestCPUStats{
+			CPU:       stats.CPU,
+			User:      stats.User,
+			Nice:      stats.Nice,
+			System:    stats.System,
+			Idle:      stats.Idle,
+			IOWait:    stats.IOWait,
+			IRQ:       stats.IRQ,
+			SoftIRQ:   stats.SoftIRQ,
+			Steal:     stats.Steal,
+			Guest:     stats.Guest,
+			GuestNice: stats.GuestNice,
+		})
+	}
+
+	return convertedResult, nil
+}
+
+// parseMemoryBlockInfo from the agent response
+func parseMemoryBlockInfo(agentReply string) (api.GuestMemoryBlockInfo, error) {
+	result := MemoryBlockInfo{}
+	response := stripAgentResponse(agentReply)
+
+	err := json.Unmarshal([]byte(response), &result)
+	if err != nil {
+		return api.GuestMemoryBlockInfo{}, err
+	}
+
+	return api.GuestMemoryBlockInfo{
+		Size: result.Size,
+	}, nil
+}
+
+// parseLoad from the agent response
+func parseLoad(agentReply string) (api.GuestLoad, error) {
+	result := Load{}
+	response := stripAgentResponse(agentReply)
+
+	err := json.Unmarshal([]byte(response), &result)
+	if err != nil {
+		return api.GuestLoad{}, err
+	}
+
+	return api.GuestLoad{
+		Load1m:  result.Load1m,
+		Load5m:  result.Load5m,
+		Load15m: result.Load15m,
+	}, nil
+}
+
+// parseCustomCommand extracts the raw JSON value returned by a custom agent command.
+// Unlike the other commands the response can be of any JSON type, so it is
+// unmarshalled instead of being stripped by a regular expression.
+func parseCustomCommand(agentReply string) (string, error) {
+	result := struct {
+		Return json.RawMessage `json:"return"`
+	}{}
+
+	err := json.Unmarshal([]byte(agentReply), &result)
+	if err != nil {
+		return "", err
+	}
+	if result.Return == nil {
+		return "", fmt.Errorf("no return value in guest agent response: %s", agentReply)
+	}
+
+	return string(result.Return), nil
+}
+
 // parseAgent gets the agent version from response
 func parseAgent(agentReply string) (AgentInfo, error) {
 	gaInfo := AgentInfo{}
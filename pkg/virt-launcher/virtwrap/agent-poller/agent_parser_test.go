@@ -306,5 +306,101 @@ var _ = Describe("Qemu agent poller", func() {
 			}
 			Expect(parseUsers(jsonInput)).To(Equal(expectedUsers))
 		})
+
+		It("should parse Disks", func() {
+
+			jsonInput := `{
+                "return":[
+                    {
+                        "name":"/dev/vda1",
+                        "partition":true,
+                        "dependencies":["/dev/vda"]
+                    },
+                    {
+                        "name":"/dev/vda",
+                        "partition":false,
+                        "address":{
+                            "bus-type":"virtio",
+                            "serial":"disk1"
+                        }
+                    }
+                ]
+            }`
+
+			expectedDisks := []api.GuestDisk{
+				{
+					Name:         "/dev/vda1",
+					Partition:    true,
+					Dependencies: []string{"/dev/vda"},
+				},
+				{
+					Name:    "/dev/vda",
+					BusType: "virtio",
+					Serial:  "disk1",
+				},
+			}
+			Expect(parseDisks(jsonInput)).To(Equal(expectedDisks))
+		})
+
+		It("should parse CPU stats", func() {
+
+			jsonInput := `{
+                "return":[
+                    {
+                        "type":"linux",
+                        "cpu":0,
+                        "user":1000,
+                        "nice":10,
+                        "system":500,
+                        "idle":90000,
+                        "iowait":20,
+                        "steal":5
+                    }
+                ]
+            }`
+
+			expectedStats := []api.GuestCPUStats{
+				{
+					CPU:    0,
+					User:   1000,
+					Nice:   10,
+					System: 500,
+					Idle:   90000,
+					IOWait: 20,
+					Steal:  5,
+				},
+			}
+			Expect(parseCPUStats(jsonInput)).To(Equal(expectedStats))
+		})
+
+		It("should parse memory block info", func() {
+			jsonInput := `{"return":{"size":134217728}}`
+
+			Expect(parseMemoryBlockInfo(jsonInput)).To(Equal(api.GuestMemoryBlockInfo{Size: 134217728}))
+		})
+
+		It("should parse load", func() {
+			jsonInput := `{"return":{"load1m":0.5,"load5m":0.25,"load15m":0.125}}`
+
+			expectedLoad := api.GuestLoad{
+				Load1m:  0.5,
+				Load5m:  0.25,
+				Load15m: 0.125,
+			}
+			Expect(parseLoad(jsonInput)).To(Equal(expectedLoad))
+		})
+
+		DescribeTable("should parse custom command results", func(jsonInput, expectedResult string) {
+			Expect(parseCustomCommand(jsonInput)).To(Equal(expectedResult))
+		},
+			Entry("with an object", `{"return":{"zone":"UTC","offset":0}}`, `{"zone":"UTC","offset":0}`),
+			Entry("with a list", `{"return":[1,2]}`, `[1,2]`),
+			Entry("with an empty object", `{"return":{}}`, `{}`),
+		)
+
+		It("should not parse custom command results without a return value", func() {
+			_, err := parseCustomCommand(`{"error":{"class":"CommandNotFound"}}`)
+			Expect(err).To(HaveOccurred())
+		})
 	})
 })
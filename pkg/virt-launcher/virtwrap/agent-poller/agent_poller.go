@@ -19,10 +19,13 @@
 package agentpoller
 
 import (
+	"encoding/json"
+	"sort"
 	"sync"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
 	"kubevirt.io/client-go/log"
@@ -47,6 +50,10 @@ const (
 	GET_FILESYSTEM      AgentCommand = "guest-get-fsinfo"
 	GET_AGENT           AgentCommand = "guest-info"
 	GET_FSFREEZE_STATUS AgentCommand = "guest-fsfreeze-status"
+	GET_DISKS           AgentCommand = "guest-get-disks"
+	GET_CPUSTATS        AgentCommand = "guest-get-cpustats"
+	GET_MEMORY_BLOCK    AgentCommand = "guest-get-memory-block-info"
+	GET_LOAD            AgentCommand = "guest-get-load"
 
 	pollInitialInterval = 10 * time.Second
 )
@@ -57,19 +64,28 @@ type AgentUpdatedEvent struct {
 	DomainInfo api.DomainGuestInfo
 }
 
+// usageCommands report data which changes on every poll, it is only
+// served on request and does not fire up updated events
+var usageCommands = map[AgentCommand]bool{
+	GET_CPUSTATS: true,
+	GET_LOAD:     true,
+}
+
 // AsyncAgentStore stores the agent data converted to api domain objects
 // it offers methods to get the data and fire up an event when there
 // is a change of the data
 type AsyncAgentStore struct {
-	store        sync.Map
-	AgentUpdated chan AgentUpdatedEvent
+	store         sync.Map
+	customResults sync.Map
+	AgentUpdated  chan AgentUpdatedEvent
 }
 
 // NewAsyncAgentStore creates new agent store
 func NewAsyncAgentStore() AsyncAgentStore {
 	return AsyncAgentStore{
-		store:        sync.Map{},
-		AgentUpdated: make(chan AgentUpdatedEvent, 10),
+		store:         sync.Map{},
+		customResults: sync.Map{},
+		AgentUpdated:  make(chan AgentUpdatedEvent, 10),
 	}
 }
 
@@ -82,7 +98,7 @@ func (s *AsyncAgentStore) Store(key AgentCommand, value interface{}) {
 
 	s.store.Store(key, value)
 
-	if updated {
+	if updated && !usageCommands[key] {
 		domainInfo := api.DomainGuestInfo{}
 		// Fill only updated part of the domainInfo
 		// not everything have to be watched for
@@ -218,6 +234,68 @@ func (s *AsyncAgentStore) GetUsers(limit int) []api.User {
 	return limitedUsers
 }
 
+// GetDisks returns the disks Guest Agent reported
+func (s *AsyncAgentStore) GetDisks() []api.GuestDisk {
+	data, ok := s.store.Load(GET_DISKS)
+	if ok {
+		return data.([]api.GuestDisk)
+	}
+
+	return nil
+}
+
+// GetCPUStats returns the per CPU usage counters Guest Agent reported
+func (s *AsyncAgentStore) GetCPUStats() []api.GuestCPUStats {
+	data, ok := s.store.Load(GET_CPUSTATS)
+	if ok {
+		return data.([]api.GuestCPUStats)
+	}
+
+	return nil
+}
+
+// GetMemoryBlockInfo returns the memory block information Guest Agent reported
+func (s *AsyncAgentStore) GetMemoryBlockInfo() *api.GuestMemoryBlockInfo {
+	data, ok := s.store.Load(GET_MEMORY_BLOCK)
+	if ok {
+		info := data.(api.GuestMemoryBlockInfo)
+		return &info
+	}
+
+	return nil
+}
+
+// GetLoad returns the load averages Guest Agent reported
+func (s *AsyncAgentStore) GetLoad() *api.GuestLoad {
+	data, ok := s.store.Load(GET_LOAD)
+	if ok {
+		load := data.(api.GuestLoad)
+		return &load
+	}
+
+	return nil
+}
+
+// StoreCustomCommandResult saves the latest result of a custom command.
+// Custom commands are kept apart from the built-in ones and never fire up updated events.
+func (s *AsyncAgentStore) StoreCustomCommandResult(result api.GuestAgentCommandResult) {
+	s.customResults.Store(result.Command, result)
+}
+
+// GetCustomCommandResults returns the latest results of all custom commands sorted by command
+func (s *AsyncAgentStore) GetCustomCommandResults() []api.GuestAgentCommandResult {
+	results := []api.GuestAgentCommandResult{}
+	s.customResults.Range(func(_, value interface{}) bool {
+		results = append(results, value.(api.GuestAgentCommandResult))
+		return true
+	})
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].Command < results[j].Command
+	})
+
+	return results
+}
+
 // PollerWorker collects the data from the guest agent
 // only unique items are stored as configuration
 type PollerWorker struct {
@@ -267,12 +345,13 @@ func incrementPollInterval(interval time.Duration, maxInterval time.Duration) ti
 }
 
 type AgentPoller struct {
-	Connection cli.Connection
-	VmiUID     types.UID
-	domainName string
-	agentDone  chan struct{}
-	workers    []PollerWorker
-	agentStore *AsyncAgentStore
+	Connection   cli.Connection
+	VmiUID       types.UID
+	domainName   string
+	agentDone    chan struct{}
+	workers      []PollerWorker
+	customWorker *PollerWorker
+	agentStore   *AsyncAgentStore
 }
 
 // CreatePoller creates the new structure that holds guest agent pollers
@@ -286,6 +365,8 @@ func CreatePoller(
 	qemuAgentUserInterval time.Duration,
 	qemuAgentVersionInterval time.Duration,
 	qemuAgentFSFreezeStatusInterval time.Duration,
+	qemuAgentUsageInterval time.Duration,
+	customCommands []AgentCommand,
 ) *AgentPoller {
 	p := &AgentPoller{
 		Connection: connecton,
@@ -303,12 +384,12 @@ func CreatePoller(
 	// sys command group
 	p.workers = append(p.workers, PollerWorker{
 		CallTick:      qemuAgentSysInterval,
-		AgentCommands: []AgentCommand{GET_INTERFACES, GET_OSINFO, GET_TIMEZONE, GET_HOSTNAME},
+		AgentCommands: []AgentCommand{GET_INTERFACES, GET_OSINFO, GET_TIMEZONE, GET_HOSTNAME, GET_MEMORY_BLOCK},
 	})
 	// filesystem command group
 	p.workers = append(p.workers, PollerWorker{
 		CallTick:      qemuAgentFileInterval,
-		AgentCommands: []AgentCommand{GET_FILESYSTEM},
+		AgentCommands: []AgentCommand{GET_FILESYSTEM, GET_DISKS},
 	})
 	// user command group
 	p.workers = append(p.workers, PollerWorker{
@@ -320,6 +401,18 @@ func CreatePoller(
 		CallTick:      qemuAgentFSFreezeStatusInterval,
 		AgentCommands: []AgentCommand{GET_FSFREEZE_STATUS},
 	})
+	// usage command group
+	p.workers = append(p.workers, PollerWorker{
+		CallTick:      qemuAgentUsageInterval,
+		AgentCommands: []AgentCommand{GET_CPUSTATS, GET_LOAD},
+	})
+	// custom commands configured by the cluster admin, polled with the sys commands
+	if len(customCommands) > 0 {
+		p.customWorker = &PollerWorker{
+			CallTick:      qemuAgentSysInterval,
+			AgentCommands: customCommands,
+		}
+	}
 
 	return p
 }
@@ -337,6 +430,13 @@ func (p *AgentPoller) Start() {
 			executeAgentCommands(commands, p.Connection, p.agentStore, p.domainName)
 		}, p.agentDone, pollInitialInterval)
 	}
+
+	if p.customWorker != nil {
+		log.Log.Infof("Starting agent poller with custom commands: %v", p.customWorker.AgentCommands)
+		go p.customWorker.Poll(func(commands []AgentCommand) {
+			executeCustomAgentCommands(commands, p.Connection, p.agentStore, p.domainName)
+		}, p.agentDone, pollInitialInterval)
+	}
 }
 
 // Stop all poller workers
@@ -416,6 +516,68 @@ func executeAgentCommands(commands []AgentCommand, con cli.Connection, agentStor
 				continue
 			}
 			agentStore.Store(GET_AGENT, agent)
+		case GET_DISKS:
+			disks, err := parseDisks(cmdResult)
+			if err != nil {
+				log.Log.Errorf("Cannot parse guest agent disks %s", err.Error())
+				continue
+			}
+			agentStore.Store(GET_DISKS, disks)
+		case GET_CPUSTATS:
+			cpuStats, err := parseCPUStats(cmdResult)
+			if err != nil {
+				log.Log.Errorf("Cannot parse guest agent cpu stats %s", err.Error())
+				continue
+			}
+			agentStore.Store(GET_CPUSTATS, cpuStats)
+		case GET_MEMORY_BLOCK:
+			memoryBlockInfo, err := parseMemoryBlockInfo(cmdResult)
+			if err != nil {
+				log.Log.Errorf("Cannot parse guest agent memory block info %s", err.Error())
+				continue
+			}
+			agentStore.Store(GET_MEMORY_BLOCK, memoryBlockInfo)
+		case GET_LOAD:
+			load, err := parseLoad(cmdResult)
+			if err != nil {
+				log.Log.Errorf("Cannot parse guest agent load %s", err.Error())
+				continue
+			}
+			agentStore.Store(GET_LOAD, load)
+		}
+	}
+}
+
+// runCustomAgentCommand marshals the command instead of formatting it into the request,
+// so the command name can never add arguments or further fields to it
+func runCustomAgentCommand(command AgentCommand, con cli.Connection, domainName string) (string, error) {
+	request, err := json.Marshal(struct {
+		Execute string `json:"execute"`
+	}{Execute: string(command)})
+	if err != nil {
+		return "", err
+	}
+	return con.QemuAgentCommand(string(request), domainName)
+}
+
+// executeCustomAgentCommands runs the commands configured by the cluster admin
+// and stores their raw results, failures are stored as well so they can be
+// told apart from commands which were not executed yet
+func executeCustomAgentCommands(commands []AgentCommand, con cli.Connection, agentStore *AsyncAgentStore, domainName string) {
+	for _, command := range commands {
+		result := api.GuestAgentCommandResult{
+			Command:    string(command),
+			UpdateTime: metav1.Now(),
+		}
+
+		cmdResult, err := runCustomAgentCommand(command, con, domainName)
+		if err == nil {
+			result.Result, err = parseCustomCommand(cmdResult)
+		}
+		if err != nil {
+			result.Error = err.Error()
 		}
+
+		agentStore.StoreCustomCommandResult(result)
 	}
 }
@@ -22,10 +22,12 @@ package agentpoller
 import (
 	"time"
 
+	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
 )
 
 var _ = Describe("Qemu agent poller", func() {
@@ -54,6 +56,41 @@ var _ = Describe("Qemu agent poller", func() {
 			})))
 		})
 
+		It("should not fire an event for usage data", func() {
+			var agentStore = NewAsyncAgentStore()
+			load := api.GuestLoad{Load1m: 0.5}
+			agentStore.Store(GET_LOAD, load)
+
+			Expect(agentStore.AgentUpdated).ToNot(Receive())
+			Expect(*agentStore.GetLoad()).To(Equal(load))
+		})
+
+		It("should report custom command results sorted by command", func() {
+			var agentStore = NewAsyncAgentStore()
+			agentStore.StoreCustomCommandResult(api.GuestAgentCommandResult{Command: "guest-get-timezone", Result: "{}"})
+			agentStore.StoreCustomCommandResult(api.GuestAgentCommandResult{Command: "guest-get-hostname", Error: "failed"})
+
+			results := agentStore.GetCustomCommandResults()
+			Expect(results).To(HaveLen(2))
+			Expect(results[0].Command).To(Equal("guest-get-hostname"))
+			Expect(results[0].Error).To(Equal("failed"))
+			Expect(results[1].Command).To(Equal("guest-get-timezone"))
+			Expect(results[1].Result).To(Equal("{}"))
+		})
+
+		It("should escape custom command names in the agent request", func() {
+			var agentStore = NewAsyncAgentStore()
+			connection := cli.NewMockConnection(gomock.NewController(GinkgoT()))
+			connection.EXPECT().QemuAgentCommand(`{"execute":"guest-get-time\",\"arguments\":{}"}`, "domain").
+				Return(`{"return":{}}`, nil)
+
+			executeCustomAgentCommands([]AgentCommand{`guest-get-time","arguments":{}`}, connection, &agentStore, "domain")
+
+			results := agentStore.GetCustomCommandResults()
+			Expect(results).To(HaveLen(1))
+			Expect(results[0].Error).To(BeEmpty())
+		})
+
 		It("should not fire an event for the same fsfreezestatus", func() {
 			var agentStore = NewAsyncAgentStore()
 			fakeFSFreezeStatus := api.FSFreeze{
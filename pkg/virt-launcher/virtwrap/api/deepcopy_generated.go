@@ -850,6 +850,11 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(Shareable)
 		**out = **in
 	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(DiskIOTune)
+		**out = **in
+	}
 	return
 }
 
@@ -910,6 +915,22 @@ func (in *DiskDriver) DeepCopy() *DiskDriver {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTune) DeepCopyInto(out *DiskIOTune) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTune.
+func (in *DiskIOTune) DeepCopy() *DiskIOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskSecret) DeepCopyInto(out *DiskSecret) {
 	*out = *in
@@ -939,6 +960,11 @@ func (in *DiskSource) DeepCopyInto(out *DiskSource) {
 		*out = new(Reservations)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Reconnect != nil {
+		in, out := &in.Reconnect, &out.Reconnect
+		*out = new(DiskSourceReconnect)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -968,6 +994,27 @@ func (in *DiskSourceHost) DeepCopy() *DiskSourceHost {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskSourceReconnect) DeepCopyInto(out *DiskSourceReconnect) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(uint)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskSourceReconnect.
+func (in *DiskSourceReconnect) DeepCopy() *DiskSourceReconnect {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskSourceReconnect)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskTarget) DeepCopyInto(out *DiskTarget) {
 	*out = *in
@@ -1735,6 +1782,92 @@ func (in *GraphicsListen) DeepCopy() *GraphicsListen {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestAgentCommandResult) DeepCopyInto(out *GuestAgentCommandResult) {
+	*out = *in
+	in.UpdateTime.DeepCopyInto(&out.UpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestAgentCommandResult.
+func (in *GuestAgentCommandResult) DeepCopy() *GuestAgentCommandResult {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestAgentCommandResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestCPUStats) DeepCopyInto(out *GuestCPUStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestCPUStats.
+func (in *GuestCPUStats) DeepCopy() *GuestCPUStats {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestCPUStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestDisk) DeepCopyInto(out *GuestDisk) {
+	*out = *in
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestDisk.
+func (in *GuestDisk) DeepCopy() *GuestDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestLoad) DeepCopyInto(out *GuestLoad) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestLoad.
+func (in *GuestLoad) DeepCopy() *GuestLoad {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestLoad)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestMemoryBlockInfo) DeepCopyInto(out *GuestMemoryBlockInfo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestMemoryBlockInfo.
+func (in *GuestMemoryBlockInfo) DeepCopy() *GuestMemoryBlockInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestMemoryBlockInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GuestOSInfo) DeepCopyInto(out *GuestOSInfo) {
 	*out = *in
@@ -1751,6 +1884,31 @@ func (in *GuestOSInfo) DeepCopy() *GuestOSInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestTimeSyncMetadata) DeepCopyInto(out *GuestTimeSyncMetadata) {
+	*out = *in
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.DriftMilliseconds != nil {
+		in, out := &in.DriftMilliseconds, &out.DriftMilliseconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestTimeSyncMetadata.
+func (in *GuestTimeSyncMetadata) DeepCopy() *GuestTimeSyncMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestTimeSyncMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostDevice) DeepCopyInto(out *HostDevice) {
 	*out = *in
@@ -1871,6 +2029,11 @@ func (in *Input) DeepCopyInto(out *Input) {
 		*out = new(Address)
 		**out = **in
 	}
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(InputSource)
+		**out = **in
+	}
 	return
 }
 
@@ -1884,6 +2047,22 @@ func (in *Input) DeepCopy() *Input {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InputSource) DeepCopyInto(out *InputSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InputSource.
+func (in *InputSource) DeepCopy() *InputSource {
+	if in == nil {
+		return nil
+	}
+	out := new(InputSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Interface) DeepCopyInto(out *Interface) {
 	*out = *in
@@ -2068,6 +2247,11 @@ func (in *KubeVirtMetadata) DeepCopyInto(out *KubeVirtMetadata) {
 		*out = new(MemoryDumpMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GuestTimeSync != nil {
+		in, out := &in.GuestTimeSync, &out.GuestTimeSync
+		*out = new(GuestTimeSyncMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
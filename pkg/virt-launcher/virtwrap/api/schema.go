@@ -99,6 +99,7 @@ const (
 
 	HostDevicePCI  = "pci"
 	HostDeviceMDev = "mdev"
+	HostDeviceUSB  = "usb"
 	AddressPCI     = "pci"
 )
 
@@ -170,6 +171,47 @@ type User struct {
 	LoginTime float64
 }
 
+type GuestDisk struct {
+	Name         string
+	Partition    bool
+	Dependencies []string
+	Alias        string
+	Serial       string
+	BusType      string
+}
+
+type GuestCPUStats struct {
+	CPU       int
+	User      int64
+	Nice      int64
+	System    int64
+	Idle      int64
+	IOWait    int64
+	IRQ       int64
+	SoftIRQ   int64
+	Steal     int64
+	Guest     int64
+	GuestNice int64
+}
+
+type GuestMemoryBlockInfo struct {
+	Size int64
+}
+
+type GuestLoad struct {
+	Load1m  float64
+	Load5m  float64
+	Load15m float64
+}
+
+// GuestAgentCommandResult is the raw result of a custom guest agent command
+type GuestAgentCommandResult struct {
+	Command    string
+	Result     string
+	Error      string
+	UpdateTime metav1.Time
+}
+
 // DomainGuestInfo represent guest agent info for specific domain
 type DomainGuestInfo struct {
 	Interfaces     []InterfaceStatus
@@ -367,6 +409,13 @@ type KubeVirtMetadata struct {
 	Migration        *MigrationMetadata        `xml:"migration,omitempty"`
 	AccessCredential *AccessCredentialMetadata `xml:"accessCredential,omitempty"`
 	MemoryDump       *MemoryDumpMetadata       `xml:"memoryDump,omitempty"`
+	GuestTimeSync    *GuestTimeSyncMetadata    `xml:"guestTimeSync,omitempty"`
+}
+
+type GuestTimeSyncMetadata struct {
+	Reason            string       `xml:"reason,omitempty"`
+	Timestamp         *metav1.Time `xml:"timestamp,omitempty"`
+	DriftMilliseconds *int64       `xml:"driftMilliseconds,omitempty"`
 }
 
 type AccessCredentialMetadata struct {
@@ -557,6 +606,11 @@ type Input struct {
 	Alias   *Alias       `xml:"alias,omitempty"`
 	Address *Address     `xml:"address,omitempty"`
 	Model   string       `xml:"model,attr,omitempty"`
+	Source  *InputSource `xml:"source,omitempty"`
+}
+
+type InputSource struct {
+	Evdev string `xml:"evdev,attr"`
 }
 
 // BEGIN HostDevice -----------------------------
@@ -625,6 +679,28 @@ type Disk struct {
 	Capacity           *int64         `xml:"capacity,omitempty"`
 	ExpandDisksEnabled bool           `xml:"expandDisksEnabled,omitempty"`
 	Shareable          *Shareable     `xml:"shareable,omitempty"`
+	IOTune             *DiskIOTune    `xml:"iotune,omitempty"`
+}
+
+type DiskIOTune struct {
+	TotalBytesSec          uint64 `xml:"total_bytes_sec,omitempty"`
+	ReadBytesSec           uint64 `xml:"read_bytes_sec,omitempty"`
+	WriteBytesSec          uint64 `xml:"write_bytes_sec,omitempty"`
+	TotalIopsSec           uint64 `xml:"total_iops_sec,omitempty"`
+	ReadIopsSec            uint64 `xml:"read_iops_sec,omitempty"`
+	WriteIopsSec           uint64 `xml:"write_iops_sec,omitempty"`
+	TotalBytesSecMax       uint64 `xml:"total_bytes_sec_max,omitempty"`
+	ReadBytesSecMax        uint64 `xml:"read_bytes_sec_max,omitempty"`
+	WriteBytesSecMax       uint64 `xml:"write_bytes_sec_max,omitempty"`
+	TotalIopsSecMax        uint64 `xml:"total_iops_sec_max,omitempty"`
+	ReadIopsSecMax         uint64 `xml:"read_iops_sec_max,omitempty"`
+	WriteIopsSecMax        uint64 `xml:"write_iops_sec_max,omitempty"`
+	TotalBytesSecMaxLength uint64 `xml:"total_bytes_sec_max_length,omitempty"`
+	ReadBytesSecMaxLength  uint64 `xml:"read_bytes_sec_max_length,omitempty"`
+	WriteBytesSecMaxLength uint64 `xml:"write_bytes_sec_max_length,omitempty"`
+	TotalIopsSecMaxLength  uint64 `xml:"total_iops_sec_max_length,omitempty"`
+	ReadIopsSecMaxLength   uint64 `xml:"read_iops_sec_max_length,omitempty"`
+	WriteIopsSecMaxLength  uint64 `xml:"write_iops_sec_max_length,omitempty"`
 }
 
 type DiskAuth struct {
@@ -641,13 +717,21 @@ type DiskSecret struct {
 type ReadOnly struct{}
 
 type DiskSource struct {
-	Dev           string          `xml:"dev,attr,omitempty"`
-	File          string          `xml:"file,attr,omitempty"`
-	StartupPolicy string          `xml:"startupPolicy,attr,omitempty"`
-	Protocol      string          `xml:"protocol,attr,omitempty"`
-	Name          string          `xml:"name,attr,omitempty"`
-	Host          *DiskSourceHost `xml:"host,omitempty"`
-	Reservations  *Reservations   `xml:"reservations,omitempty"`
+	Dev           string               `xml:"dev,attr,omitempty"`
+	File          string               `xml:"file,attr,omitempty"`
+	StartupPolicy string               `xml:"startupPolicy,attr,omitempty"`
+	Protocol      string               `xml:"protocol,attr,omitempty"`
+	Name          string               `xml:"name,attr,omitempty"`
+	Type          string               `xml:"type,attr,omitempty"`
+	Path          string               `xml:"path,attr,omitempty"`
+	Host          *DiskSourceHost      `xml:"host,omitempty"`
+	Reservations  *Reservations        `xml:"reservations,omitempty"`
+	Reconnect     *DiskSourceReconnect `xml:"reconnect,omitempty"`
+}
+
+type DiskSourceReconnect struct {
+	Enabled string `xml:"enabled,attr"`
+	Timeout *uint  `xml:"timeout,attr,omitempty"`
 }
 
 type DiskTarget struct {
@@ -1046,6 +1130,7 @@ type Address struct {
 	Target     string `xml:"target,attr,omitempty"`
 	Unit       string `xml:"unit,attr,omitempty"`
 	UUID       string `xml:"uuid,attr,omitempty"`
+	Device     string `xml:"device,attr,omitempty"`
 }
 
 //END Video -------------------
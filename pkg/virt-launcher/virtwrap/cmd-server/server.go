@@ -540,6 +540,27 @@ func (l *Launcher) GetFilesystems(_ context.Context, _ *cmdv1.EmptyRequest) (*cm
 	return response, nil
 }
 
+// GetGuestAgentCommandResults returns the latest results of the custom guest agent commands
+func (l *Launcher) GetGuestAgentCommandResults(_ context.Context, _ *cmdv1.EmptyRequest) (*cmdv1.GuestAgentCommandResultsResponse, error) {
+	response := &cmdv1.GuestAgentCommandResultsResponse{
+		Response: &cmdv1.Response{
+			Success: true,
+		},
+	}
+
+	results := l.domainManager.GetGuestAgentCommandResults()
+	if jResults, err := json.Marshal(results); err != nil {
+		log.Log.Reason(err).Errorf("Failed to marshal guest agent command results")
+		response.Response.Success = false
+		response.Response.Message = getErrorMessage(err)
+		return response, nil
+	} else {
+		response.GuestAgentCommandResultsResponse = string(jResults)
+	}
+
+	return response, nil
+}
+
 // Exec the provided command and return it's success
 func (l *Launcher) Exec(ctx context.Context, request *cmdv1.ExecRequest) (*cmdv1.ExecResponse, error) {
 	resp := &cmdv1.ExecResponse{
@@ -65,6 +65,7 @@ import (
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	"kubevirt.io/kubevirt/pkg/ignition"
 	"kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/vhostuserblk"
 )
 
 const deviceTypeNotCompatibleFmt = "device %s is of type lun. Not compatible with a file based disk"
@@ -233,6 +234,7 @@ func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk
 		disk.Driver.Queues = numQueues
 	}
 	disk.Alias = api.NewUserDefinedAlias(diskDevice.Name)
+	disk.IOTune = Convert_v1_DiskIOTune_To_api_DiskIOTune(diskDevice.IOTune)
 	if diskDevice.BootOrder != nil {
 		disk.BootOrder = &api.BootOrder{Order: *diskDevice.BootOrder}
 	}
@@ -276,6 +278,46 @@ func min(one, two int64) int64 {
 	return two
 }
 
+// Convert_v1_DiskIOTune_To_api_DiskIOTune converts the I/O limits of a disk to libvirt's iotune element.
+// Since QEMU reports a burst length of one second for every burst limit, the length is always set for them.
+func Convert_v1_DiskIOTune_To_api_DiskIOTune(ioTune *v1.DiskIOTune) *api.DiskIOTune {
+	if ioTune == nil || *ioTune == (v1.DiskIOTune{}) {
+		return nil
+	}
+
+	maxLength := ioTune.MaxLengthSeconds
+	if maxLength == 0 {
+		maxLength = 1
+	}
+	burstLength := func(max uint64) uint64 {
+		if max == 0 {
+			return 0
+		}
+		return maxLength
+	}
+
+	return &api.DiskIOTune{
+		TotalBytesSec:          ioTune.TotalBytesSec,
+		ReadBytesSec:           ioTune.ReadBytesSec,
+		WriteBytesSec:          ioTune.WriteBytesSec,
+		TotalIopsSec:           ioTune.TotalIOPSSec,
+		ReadIopsSec:            ioTune.ReadIOPSSec,
+		WriteIopsSec:           ioTune.WriteIOPSSec,
+		TotalBytesSecMax:       ioTune.TotalBytesSecMax,
+		ReadBytesSecMax:        ioTune.ReadBytesSecMax,
+		WriteBytesSecMax:       ioTune.WriteBytesSecMax,
+		TotalIopsSecMax:        ioTune.TotalIOPSSecMax,
+		ReadIopsSecMax:         ioTune.ReadIOPSSecMax,
+		WriteIopsSecMax:        ioTune.WriteIOPSSecMax,
+		TotalBytesSecMaxLength: burstLength(ioTune.TotalBytesSecMax),
+		ReadBytesSecMaxLength:  burstLength(ioTune.ReadBytesSecMax),
+		WriteBytesSecMaxLength: burstLength(ioTune.WriteBytesSecMax),
+		TotalIopsSecMaxLength:  burstLength(ioTune.TotalIOPSSecMax),
+		ReadIopsSecMaxLength:   burstLength(ioTune.ReadIOPSSecMax),
+		WriteIopsSecMaxLength:  burstLength(ioTune.WriteIOPSSecMax),
+	}
+}
+
 func setReservation(disk *api.Disk) {
 	disk.Source.Reservations = &api.Reservations{
 		Managed: "no",
@@ -435,6 +477,11 @@ func SetDriverCacheMode(disk *api.Disk, directIOChecker DirectIOChecker) error {
 	mode := v1.DriverCache(disk.Driver.Cache)
 	isBlockDev := false
 
+	// The cache of vhost-user disks is managed by the backend
+	if isVhostUserDisk(disk) {
+		return nil
+	}
+
 	if disk.Source.File != "" {
 		path = disk.Source.File
 	} else if disk.Source.Dev != "" {
@@ -654,6 +701,9 @@ func Convert_v1_Volume_To_api_Disk(source *v1.Volume, disk *api.Disk, c *Convert
 	if source.DownwardMetrics != nil {
 		return Convert_v1_DownwardMetricSource_To_api_Disk(disk, c)
 	}
+	if source.VhostUserBlk != nil {
+		return Convert_v1_VhostUserBlkSource_To_api_Disk(source, disk)
+	}
 
 	return fmt.Errorf("disk %s references an unsupported source", disk.Alias.GetName())
 }
@@ -804,6 +854,35 @@ func Convert_v1_HostDisk_To_api_Disk(volumeName string, path string, disk *api.D
 	return nil
 }
 
+func Convert_v1_VhostUserBlkSource_To_api_Disk(volume *v1.Volume, disk *api.Disk) error {
+	if disk.Device != "disk" || disk.Target.Bus != v1.DiskBusVirtio {
+		return fmt.Errorf("vhost-user-blk disk %s must be a disk on the virtio bus", disk.Alias.GetName())
+	}
+	disk.Type = "vhostuser"
+	disk.Source.Type = "unix"
+	disk.Source.Path = vhostuserblk.SocketPath(volume)
+	// Let QEMU reconnect when the storage plugin is (re)started after the domain
+	reconnectTimeout := uint(10)
+	disk.Source.Reconnect = &api.DiskSourceReconnect{
+		Enabled: "yes",
+		Timeout: &reconnectTimeout,
+	}
+	disk.Driver.Type = "raw"
+	// vhost-user-blk disks are handled by the backend, QEMU does not support any of the I/O tunables
+	disk.Driver.Cache = ""
+	disk.Driver.IO = ""
+	disk.Driver.Discard = ""
+	if queues := volume.VhostUserBlk.Queues; queues != nil {
+		numQueues := uint(*queues)
+		disk.Driver.Queues = &numQueues
+	}
+	return nil
+}
+
+func isVhostUserDisk(disk *api.Disk) bool {
+	return disk.Type == "vhostuser"
+}
+
 func Convert_v1_SysprepSource_To_api_Disk(volumeName string, disk *api.Disk) error {
 	if disk.Type == "lun" {
 		return fmt.Errorf(deviceTypeNotCompatibleFmt, disk.Alias.GetName())
@@ -987,8 +1066,8 @@ func Convert_v1_Sound_To_api_Sound(vmi *v1.VirtualMachineInstance, domainDevices
 	}
 
 	model := "ich9"
-	if sound.Model == "ac97" {
-		model = "ac97"
+	if sound.Model == "ac97" || sound.Model == "virtio" {
+		model = sound.Model
 	}
 
 	soundCards := make([]api.SoundCard, 1)
@@ -1006,11 +1085,24 @@ func Convert_v1_Input_To_api_InputDevice(input *v1.Input, inputDevice *api.Input
 		return fmt.Errorf("input contains unsupported bus %s", input.Bus)
 	}
 
-	if input.Bus != v1.InputBusVirtio && input.Bus != v1.InputBusUSB {
-		input.Bus = v1.InputBusUSB
-	}
+	switch input.Type {
+	case v1.InputTypeTablet:
+		if input.Bus != v1.InputBusVirtio && input.Bus != v1.InputBusUSB {
+			input.Bus = v1.InputBusUSB
+		}
+	case v1.InputTypePassthrough:
+		if input.Bus == v1.InputBusUSB {
+			return fmt.Errorf("passthrough input %s supports only the virtio bus", input.Name)
+		}
+		input.Bus = v1.InputBusVirtio
 
-	if input.Type != v1.InputTypeTablet {
+		evdevEnvVarName := util.ResourceNameToEnvVar(v1.InputResourcePrefix, input.DeviceName)
+		evdevPath, isSet := os.LookupEnv(evdevEnvVarName)
+		if !isSet || evdevPath == "" {
+			return fmt.Errorf("failed to find the host device of passthrough input %s: %s not set", input.Name, evdevEnvVarName)
+		}
+		inputDevice.Source = &api.InputSource{Evdev: evdevPath}
+	default:
 		return fmt.Errorf("input contains unsupported type %s", input.Type)
 	}
 
@@ -1217,6 +1309,12 @@ func isUSBNeeded(c *ConverterContext, vmi *v1.VirtualMachineInstance) bool {
 		return true
 	}
 
+	for _, hostDevice := range c.GenericHostDevices {
+		if hostDevice.Type == api.HostDeviceUSB {
+			return true
+		}
+	}
+
 	return false
 }
 
@@ -1301,6 +1399,9 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 				Template: c.EFIConfiguration.EFIVars,
 				NVRam:    filepath.Join(services.PathForNVram(vmi), vmi.Name+"_VARS.fd"),
 			}
+			if nvramTemplate := vmi.Spec.Domain.Firmware.Bootloader.EFI.NVRAMTemplate; nvramTemplate != nil {
+				domain.Spec.OS.NVRam.Template = config.GetNVRAMTemplatePath(nvramTemplate)
+			}
 		}
 
 		if vmi.Spec.Domain.Firmware.Bootloader != nil && vmi.Spec.Domain.Firmware.Bootloader.BIOS != nil {
@@ -1427,8 +1528,8 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 			isMemfdRequired = true
 		}
 	}
-	// virtiofs require shared access
-	if util.IsVMIVirtiofsEnabled(vmi) {
+	// virtiofs and vhost-user-blk require shared access
+	if util.IsVMIVirtiofsEnabled(vmi) || util.IsVhostUserBlkVMI(vmi) {
 		if domain.Spec.MemoryBacking == nil {
 			domain.Spec.MemoryBacking = &api.MemoryBacking{}
 		}
@@ -1562,7 +1663,7 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 			return err
 		}
 
-		if useIOThreads {
+		if useIOThreads && !isVhostUserDisk(&newDisk) {
 			if _, ok := c.HotplugVolumes[disk.Name]; !ok {
 				ioThreadId := defaultIOThread
 				dedicatedThread := false
@@ -1590,7 +1691,9 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		if _, ok := c.PermanentVolumes[disk.Name]; ok || len(c.PermanentVolumes) == 0 || (hpOk && (hpStatus.Phase == v1.HotplugVolumeMounted || hpStatus.Phase == v1.VolumeReady)) {
 			domain.Spec.Devices.Disks = append(domain.Spec.Devices.Disks, newDisk)
 		}
-		if err := setErrorPolicy(&disk, &newDisk); err != nil {
+		if isVhostUserDisk(&newDisk) {
+			newDisk.Driver.ErrorPolicy = ""
+		} else if err := setErrorPolicy(&disk, &newDisk); err != nil {
 			return err
 		}
 	}
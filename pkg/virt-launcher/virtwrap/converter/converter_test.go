@@ -1032,6 +1032,51 @@ var _ = Describe("Converter", func() {
 			Expect(domain.Spec.Devices.Inputs[0].Bus).To(Equal(v1.InputBusUSB), "Expect usb bus")
 		})
 
+		It("should pass through a permitted host input device", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.Inputs = []v1.Input{{
+				Name:       "keyboard",
+				Type:       v1.InputTypePassthrough,
+				DeviceName: "example.org/keyboard",
+			}}
+			os.Setenv("INPUT_RESOURCE_EXAMPLE_ORG_KEYBOARD", "/dev/input/event3")
+			defer os.Unsetenv("INPUT_RESOURCE_EXAMPLE_ORG_KEYBOARD")
+
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Devices.Inputs).To(Equal([]api.Input{{
+				Type:   v1.InputTypePassthrough,
+				Bus:    v1.InputBusVirtio,
+				Model:  v1.VirtIO,
+				Alias:  api.NewUserDefinedAlias("keyboard"),
+				Source: &api.InputSource{Evdev: "/dev/input/event3"},
+			}}))
+		})
+
+		It("should fail to pass through a host input device on the usb bus", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.Inputs = []v1.Input{{
+				Name:       "keyboard",
+				Type:       v1.InputTypePassthrough,
+				Bus:        v1.InputBusUSB,
+				DeviceName: "example.org/keyboard",
+			}}
+			os.Setenv("INPUT_RESOURCE_EXAMPLE_ORG_KEYBOARD", "/dev/input/event3")
+			defer os.Unsetenv("INPUT_RESOURCE_EXAMPLE_ORG_KEYBOARD")
+
+			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, &api.Domain{}, c)).ToNot(Succeed())
+		})
+
+		It("should fail to pass through a host input device which was not allocated", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.Inputs = []v1.Input{{
+				Name:       "keyboard",
+				Type:       v1.InputTypePassthrough,
+				DeviceName: "example.org/keyboard",
+			}}
+
+			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, &api.Domain{}, c)).To(MatchError(ContainSubstring("INPUT_RESOURCE_EXAMPLE_ORG_KEYBOARD not set")))
+		})
+
 		It("should not enable sound cards emulation by default", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			vmi.Spec.Domain.Devices.Sound = nil
@@ -1068,6 +1113,21 @@ var _ = Describe("Converter", func() {
 			}))
 		})
 
+		It("should enable virtio sound card", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			name := "audio-virtio"
+			vmi.Spec.Domain.Devices.Sound = &v1.SoundDevice{
+				Name:  name,
+				Model: "virtio",
+			}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Devices.SoundCards).To(HaveLen(1))
+			Expect(domain.Spec.Devices.SoundCards).To(ContainElement(api.SoundCard{
+				Alias: api.NewUserDefinedAlias(name),
+				Model: "virtio",
+			}))
+		})
+
 		It("should enable usb redirection when number of USB client devices > 0", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			vmi.Spec.Domain.Devices.ClientPassthrough = &v1.ClientPassthroughDevices{}
@@ -1080,6 +1140,26 @@ var _ = Describe("Converter", func() {
 			}))
 		})
 
+		It("should enable the usb controller when a USB host device is passed through", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			c.Architecture = "amd64"
+			usbHostDevice := api.HostDevice{
+				Alias:   api.NewUserDefinedAlias("hostdevice-dongle"),
+				Source:  api.HostDeviceSource{Address: &api.Address{Bus: "3", Device: "7"}},
+				Type:    api.HostDeviceUSB,
+				Mode:    "subsystem",
+				Managed: "no",
+			}
+			c.GenericHostDevices = []api.HostDevice{usbHostDevice}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Devices.HostDevices).To(ContainElement(usbHostDevice))
+			Expect(domain.Spec.Devices.Controllers).To(ContainElement(api.Controller{
+				Type:  "usb",
+				Index: "0",
+				Model: "qemu-xhci",
+			}))
+		})
+
 		It("should not enable usb redirection when numberOfDevices == 0", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			vmi.Spec.Domain.Devices.ClientPassthrough = nil
@@ -1297,6 +1377,30 @@ var _ = Describe("Converter", func() {
 			Entry("ErrorPolicy equal to enospace", kubevirtpointer.P(v1.DiskErrorPolicyEnospace), "enospace"),
 		)
 
+		DescribeTable("Should convert the I/O limits", func(ioTune *v1.DiskIOTune, expected *api.DiskIOTune) {
+			vmi.Spec.Domain.Devices.Disks[0].IOTune = ioTune
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+			Expect(domainSpec.Devices.Disks[0].IOTune).To(Equal(expected))
+		},
+			Entry("without I/O limits", nil, nil),
+			Entry("with empty I/O limits", &v1.DiskIOTune{}, nil),
+			Entry("with total limits",
+				&v1.DiskIOTune{TotalBytesSec: 10485760, TotalIOPSSec: 1000},
+				&api.DiskIOTune{TotalBytesSec: 10485760, TotalIopsSec: 1000},
+			),
+			Entry("with read and write burst limits and the default burst length",
+				&v1.DiskIOTune{ReadIOPSSec: 100, ReadIOPSSecMax: 500, WriteBytesSec: 1024, WriteBytesSecMax: 4096},
+				&api.DiskIOTune{
+					ReadIopsSec: 100, ReadIopsSecMax: 500, ReadIopsSecMaxLength: 1,
+					WriteBytesSec: 1024, WriteBytesSecMax: 4096, WriteBytesSecMaxLength: 1,
+				},
+			),
+			Entry("with a custom burst length",
+				&v1.DiskIOTune{TotalIOPSSec: 100, TotalIOPSSecMax: 500, MaxLengthSeconds: 30},
+				&api.DiskIOTune{TotalIopsSec: 100, TotalIopsSecMax: 500, TotalIopsSecMaxLength: 30},
+			),
+		)
+
 	})
 	Context("Network convert", func() {
 		var vmi *v1.VirtualMachineInstance
@@ -1776,6 +1880,71 @@ var _ = Describe("Converter", func() {
 		)
 	})
 
+	Context("vhost-user-blk disks", func() {
+		var vmi *v1.VirtualMachineInstance
+		var c *ConverterContext
+
+		BeforeEach(func() {
+			vmi = kvapi.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Disks = []v1.Disk{{
+				Name: "spdk",
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{Bus: v1.DiskBusVirtio},
+				},
+			}}
+			vmi.Spec.Volumes = []v1.Volume{{
+				Name: "spdk",
+				VolumeSource: v1.VolumeSource{
+					VhostUserBlk: &v1.VhostUserBlkSource{
+						Image:  "spdk:latest",
+						Queues: pointer.Uint32(4),
+					},
+				},
+			}}
+			c = &ConverterContext{AllowEmulation: true}
+		})
+
+		It("should connect the disk to the socket of the storage plugin sidecar", func() {
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Devices.Disks).To(HaveLen(1))
+			disk := domain.Spec.Devices.Disks[0]
+			Expect(disk.Type).To(Equal("vhostuser"))
+			Expect(disk.Source.Type).To(Equal("unix"))
+			Expect(disk.Source.Path).To(Equal("/var/run/kubevirt/vhost-user-blk-sockets/spdk.sock"))
+			Expect(disk.Source.Reconnect).ToNot(BeNil())
+			Expect(disk.Source.Reconnect.Enabled).To(Equal("yes"))
+			Expect(disk.Driver.Type).To(Equal("raw"))
+			Expect(disk.Driver.Cache).To(BeEmpty())
+			Expect(disk.Driver.ErrorPolicy).To(BeEmpty())
+			Expect(disk.Driver.IOThread).To(BeNil())
+			Expect(*disk.Driver.Queues).To(Equal(uint(4)))
+		})
+
+		It("should connect the disk to the socket of the node agent", func() {
+			vmi.Spec.Volumes[0].VhostUserBlk = &v1.VhostUserBlkSource{SocketName: "nvme0.sock"}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Devices.Disks[0].Source.Path).To(Equal("/var/run/kubevirt/vhost-user-blk-node-sockets/nvme0.sock"))
+		})
+
+		It("should share the guest memory with the backend", func() {
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.MemoryBacking).ToNot(BeNil())
+			Expect(domain.Spec.MemoryBacking.Access).To(Equal(&api.MemoryBackingAccess{Mode: "shared"}))
+			Expect(domain.Spec.MemoryBacking.Source).To(Equal(&api.MemoryBackingSource{Type: "memfd"}))
+		})
+
+		It("should not set a driver cache mode", func() {
+			domain := vmiToDomain(vmi, c)
+			Expect(SetDriverCacheMode(&domain.Spec.Devices.Disks[0], nil)).To(Succeed())
+			Expect(domain.Spec.Devices.Disks[0].Driver.Cache).To(BeEmpty())
+		})
+
+		It("should fail if the disk is not on the virtio bus", func() {
+			vmi.Spec.Domain.Devices.Disks[0].Disk.Bus = v1.DiskBusSATA
+			Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, &api.Domain{}, c)).To(MatchError(ContainSubstring("must be a disk on the virtio bus")))
+		})
+	})
+
 	Context("IOThreads", func() {
 
 		DescribeTable("Should use correct IOThreads policies", func(policy v1.IOThreadsPolicy, cpuCores int, threadCount int, threadIDs []int) {
@@ -2345,6 +2514,29 @@ var _ = Describe("Converter", func() {
 			Expect(domainSpec.OS.NVRam.NVRam).To(Equal("/var/lib/libvirt/qemu/nvram/testvmi_VARS.fd"))
 		})
 
+		It("EFI vars should be initialized from a custom NVRAM template", func() {
+			c.EFIConfiguration = &EFIConfiguration{
+				EFICode:      "OVMF_CODE.secboot.fd",
+				EFIVars:      "OVMF_VARS.secboot.fd",
+				SecureLoader: true,
+			}
+
+			vmi.Spec.Domain.Firmware = &v1.Firmware{
+				Bootloader: &v1.Bootloader{
+					EFI: &v1.EFI{
+						NVRAMTemplate: &v1.NVRAMTemplateSource{
+							Secret: &k8sv1.LocalObjectReference{Name: "company-keys"},
+							Key:    "company_VARS.fd",
+						},
+					},
+				},
+			}
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+			Expect(path.Base(domainSpec.OS.BootLoader.Path)).To(Equal(c.EFIConfiguration.EFICode))
+			Expect(domainSpec.OS.NVRam.Template).To(Equal("/var/run/kubevirt-private/nvram-template/company_VARS.fd"))
+			Expect(domainSpec.OS.NVRam.NVRam).To(Equal("/var/lib/libvirt/qemu/nvram/testvmi_VARS.fd"))
+		})
+
 		DescribeTable("display device should be set to", func(bootloader v1.Bootloader, enableFG bool, expectedDevice string) {
 			vmi.Spec.Domain.Firmware = &v1.Firmware{Bootloader: &bootloader}
 			c = &ConverterContext{
@@ -464,7 +464,8 @@ func AdjustDomainForTopologyAndCPUSet(domain *api.Domain, vmi *v12.VirtualMachin
 		}
 		appendDomainEmulatorThreadPin(domain, emulatorThread)
 	}
-	if useIOThreads {
+	// with a thread placement, virt-handler pins the I/O threads to the reserved pCPUs after the domain is started
+	if useIOThreads && vmi.Spec.Domain.CPU.ThreadPlacement == nil {
 		if err := FormatDomainIOThreadPin(vmi, domain, emulatorThread, cpuset); err != nil {
 			log.Log.Reason(err).Error("failed to format domain iothread pinning.")
 			return err
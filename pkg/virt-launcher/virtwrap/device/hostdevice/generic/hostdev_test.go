@@ -58,7 +58,7 @@ var _ = Describe("Generic HostDevice", func() {
 		mdevPool := newAddressPoolStub()
 		mdevPool.AddResource(hostdevResource1, hostdevPCIAddress1)
 
-		_, err := generic.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.HostDevices, pciPool, mdevPool)
+		_, err := generic.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.HostDevices, pciPool, mdevPool, newAddressPoolStub())
 		Expect(err).To(HaveOccurred())
 	})
 
@@ -89,9 +89,39 @@ var _ = Describe("Generic HostDevice", func() {
 			Model:  "vfio-pci",
 		}
 
-		Expect(generic.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.HostDevices, pciPool, mdevPool)).
+		Expect(generic.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.HostDevices, pciPool, mdevPool, newAddressPoolStub())).
 			To(Equal([]api.HostDevice{expectHostDevice0, expectHostDevice1}))
 	})
+
+	It("creates a USB device", func() {
+		vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{
+			{DeviceName: hostdevResource0, Name: hostdevName0},
+		}
+		usbPool := newAddressPoolStub()
+		usbPool.AddResource(hostdevResource0, "3:7")
+
+		expectHostDevice := api.HostDevice{
+			Alias:   api.NewUserDefinedAlias(generic.AliasPrefix + hostdevName0),
+			Source:  api.HostDeviceSource{Address: &api.Address{Bus: "3", Device: "7"}},
+			Type:    api.HostDeviceUSB,
+			Mode:    "subsystem",
+			Managed: "no",
+		}
+
+		Expect(generic.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.HostDevices, newAddressPoolStub(), newAddressPoolStub(), usbPool)).
+			To(Equal([]api.HostDevice{expectHostDevice}))
+	})
+
+	It("fails to create a USB device given an invalid address", func() {
+		vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{
+			{DeviceName: hostdevResource0, Name: hostdevName0},
+		}
+		usbPool := newAddressPoolStub()
+		usbPool.AddResource(hostdevResource0, "3-7")
+
+		_, err := generic.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.HostDevices, newAddressPoolStub(), newAddressPoolStub(), usbPool)
+		Expect(err).To(HaveOccurred())
+	})
 })
 
 type stubAddressPool struct {
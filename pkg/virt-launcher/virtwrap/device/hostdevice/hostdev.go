@@ -59,6 +59,10 @@ func CreateMDEVHostDevices(hostDevicesData []HostDeviceMetaData, mdevAddrPool Ad
 	return createHostDevices(hostDevicesData, mdevAddrPool, createMDEVHostDevice)
 }
 
+func CreateUSBHostDevices(hostDevicesData []HostDeviceMetaData, usbAddrPool AddressPooler) ([]api.HostDevice, error) {
+	return createHostDevices(hostDevicesData, usbAddrPool, createUSBHostDevice)
+}
+
 func createHostDevices(hostDevicesData []HostDeviceMetaData, addrPool AddressPooler, createHostDev createHostDevice) ([]api.HostDevice, error) {
 	var (
 		hostDevices          []api.HostDevice
@@ -147,3 +151,24 @@ func createMDEVHostDevice(hostDeviceData HostDeviceMetaData, mdevUUID string) (*
 	}
 	return domainHostDevice, nil
 }
+
+// createUSBHostDevice creates a USB host-device from a <bus>:<device> address
+func createUSBHostDevice(hostDeviceData HostDeviceMetaData, hostUSBAddress string) (*api.HostDevice, error) {
+	bus, deviceNumber, found := strings.Cut(hostUSBAddress, ":")
+	if !found || bus == "" || deviceNumber == "" {
+		return nil, fmt.Errorf("failed to create USB device for %s: invalid address %s", hostDeviceData.Name, hostUSBAddress)
+	}
+	domainHostDevice := &api.HostDevice{
+		Alias: api.NewUserDefinedAlias(hostDeviceData.AliasPrefix + hostDeviceData.Name),
+		Source: api.HostDeviceSource{
+			Address: &api.Address{
+				Bus:    bus,
+				Device: deviceNumber,
+			},
+		},
+		Type:    api.HostDeviceUSB,
+		Mode:    "subsystem",
+		Managed: "no",
+	}
+	return domainHostDevice, nil
+}
@@ -215,6 +215,16 @@ func (_mr *_MockDomainManagerRecorder) GetFilesystems() *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetFilesystems")
 }
 
+func (_m *MockDomainManager) GetGuestAgentCommandResults() []v1.VirtualMachineInstanceGuestAgentCommandResult {
+	ret := _m.ctrl.Call(_m, "GetGuestAgentCommandResults")
+	ret0, _ := ret[0].([]v1.VirtualMachineInstanceGuestAgentCommandResult)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) GetGuestAgentCommandResults() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetGuestAgentCommandResults")
+}
+
 func (_m *MockDomainManager) FinalizeVirtualMachineMigration(_param0 *v1.VirtualMachineInstance) error {
 	ret := _m.ctrl.Call(_m, "FinalizeVirtualMachineMigration", _param0)
 	ret0, _ := ret[0].(error)
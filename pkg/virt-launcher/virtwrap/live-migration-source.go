@@ -111,6 +111,13 @@ func generateMigrationFlags(isBlockMigration, migratePaused bool, options *cmdcl
 	if options.ParallelMigrationThreads != nil {
 		migrateFlags |= libvirt.MIGRATE_PARALLEL
 	}
+	if isMigrationCompressed(options) {
+		migrateFlags |= libvirt.MIGRATE_COMPRESSED
+		// zstd compression is only implemented for multifd migrations
+		if options.Compression == v1.MigrationCompressionZstd {
+			migrateFlags |= libvirt.MIGRATE_PARALLEL
+		}
+	}
 
 	return migrateFlags
 
@@ -820,6 +827,10 @@ func isBlockMigration(vmi *v1.VirtualMachineInstance) bool {
 	return vmi.Status.MigrationMethod == v1.BlockMigration
 }
 
+func isMigrationCompressed(options *cmdclient.MigrationOptions) bool {
+	return options.Compression != "" && options.Compression != v1.MigrationCompressionNone
+}
+
 func generateMigrationParams(dom cli.VirDomain, vmi *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions, virtShareDir string, domSpec *api.DomainSpec) (*libvirt.DomainMigrateParameters, error) {
 	bandwidth, err := vcpu.QuantityToMebiByte(options.Bandwidth)
 	if err != nil {
@@ -838,6 +849,12 @@ func generateMigrationParams(dom cli.VirDomain, vmi *v1.VirtualMachineInstance,
 		parallelMigrationThreads = int(*options.ParallelMigrationThreads)
 	}
 
+	compressionSet := isMigrationCompressed(options)
+	var compression string
+	if compressionSet {
+		compression = string(options.Compression)
+	}
+
 	key := migrationproxy.ConstructProxyKey(string(vmi.UID), migrationproxy.LibvirtDirectMigrationPort)
 	migrURI := fmt.Sprintf("unix://%s", migrationproxy.SourceUnixFile(virtShareDir, key))
 	params := &libvirt.DomainMigrateParameters{
@@ -851,6 +868,8 @@ func generateMigrationParams(dom cli.VirDomain, vmi *v1.VirtualMachineInstance,
 		PersistXMLSet:          true,
 		ParallelConnectionsSet: parallelMigrationSet,
 		ParallelConnections:    parallelMigrationThreads,
+		CompressionSet:         compressionSet,
+		Compression:            compression,
 	}
 
 	copyDisks := getDiskTargetsForMigration(dom, vmi)
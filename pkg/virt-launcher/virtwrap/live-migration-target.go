@@ -39,7 +39,7 @@ import (
 )
 
 func (l *LibvirtDomainManager) finalizeMigrationTarget(vmi *v1.VirtualMachineInstance) error {
-	if err := l.setGuestTime(vmi); err != nil {
+	if err := l.setGuestTime(vmi, v1.GuestTimeSyncReasonMigration); err != nil {
 		return err
 	}
 
@@ -35,6 +35,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"strconv"
 	"strings"
@@ -58,6 +59,7 @@ import (
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"libvirt.org/go/libvirt"
 
@@ -126,6 +128,7 @@ type DomainManager interface {
 	GetGuestInfo() v1.VirtualMachineInstanceGuestAgentInfo
 	GetUsers() []v1.VirtualMachineInstanceGuestOSUser
 	GetFilesystems() []v1.VirtualMachineInstanceFileSystem
+	GetGuestAgentCommandResults() []v1.VirtualMachineInstanceGuestAgentCommandResult
 	FinalizeVirtualMachineMigration(*v1.VirtualMachineInstance) error
 	HotplugHostDevices(vmi *v1.VirtualMachineInstance) error
 	InterfacesStatus() []api.InterfaceStatus
@@ -254,7 +257,23 @@ func getAllDomainDisks(dom cli.VirDomain) ([]api.Disk, error) {
 	return devices.Disks, nil
 }
 
-func (l *LibvirtDomainManager) setGuestTime(vmi *v1.VirtualMachineInstance) error {
+func isGuestTimeSyncDisabled(vmi *v1.VirtualMachineInstance) bool {
+	clock := vmi.Spec.Domain.Clock
+	return clock != nil && clock.GuestTimeSync != nil && clock.GuestTimeSync.Policy == v1.GuestTimeSyncDisabled
+}
+
+// measureGuestTimeDrift returns the difference between the host and the guest clock in milliseconds,
+// or nil if the guest time could not be read.
+func measureGuestTimeDrift(dom cli.VirDomain) *int64 {
+	secs, nsecs, err := dom.GetTime(0)
+	if err != nil {
+		return nil
+	}
+	drift := time.Since(time.Unix(secs, int64(nsecs))).Milliseconds()
+	return &drift
+}
+
+func (l *LibvirtDomainManager) setGuestTime(vmi *v1.VirtualMachineInstance, reason v1.GuestTimeSyncReason) error {
 	// Try to set VM time to the current value.  This is typically useful
 	// when clock wasn't running on the VM for some time (e.g. during
 	// suspension or migration), especially if the time delay exceeds NTP
@@ -263,6 +282,11 @@ func (l *LibvirtDomainManager) setGuestTime(vmi *v1.VirtualMachineInstance) erro
 	// environment, especially QEMU agent presence) or that the set time is
 	// very precise (NTP in the guest should take care of it if needed).
 
+	if isGuestTimeSyncDisabled(vmi) {
+		log.Log.Object(vmi).V(3).Infof("guest time sync after %s is disabled", reason)
+		return nil
+	}
+
 	domName := api.VMINamespaceKeyFunc(vmi)
 	dom, err := l.virConn.LookupDomainByName(domName)
 	if err != nil {
@@ -294,6 +318,7 @@ func (l *LibvirtDomainManager) setGuestTime(vmi *v1.VirtualMachineInstance) erro
 			case <-ctx.Done():
 				return
 			case <-ticker.C:
+				drift := measureGuestTimeDrift(dom)
 				currTime := time.Now()
 				secs := currTime.Unix()
 				nsecs := uint(currTime.Nanosecond())
@@ -325,6 +350,12 @@ func (l *LibvirtDomainManager) setGuestTime(vmi *v1.VirtualMachineInstance) erro
 				} else {
 					latestErr = nil
 					log.Log.Object(vmi).Info("guest VM time sync finished successfully")
+					syncTime := metav1.NewTime(currTime)
+					l.metadataCache.GuestTimeSync.Store(api.GuestTimeSyncMetadata{
+						Reason:            string(reason),
+						Timestamp:         &syncTime,
+						DriftMilliseconds: drift,
+					})
 					return
 				}
 			}
@@ -1122,6 +1153,16 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 		}
 	}
 
+	// Apply changed I/O limits to the disks
+	for _, disk := range getDisksWithChangedIOTune(oldSpec.Devices.Disks, domain.Spec.Devices.Disks) {
+		logger.V(1).Infof("Changing the I/O limits of disk %s, target %s", disk.Alias.GetName(), disk.Target.Device)
+		err = dom.SetBlockIoTune(disk.Target.Device, toBlockIoTuneParameters(disk.IOTune), libvirt.DOMAIN_AFFECT_LIVE)
+		if err != nil {
+			logger.Reason(err).Errorf("libvirt failed to change the I/O limits of disk %s", disk.Alias.GetName())
+			return nil, err
+		}
+	}
+
 	if vmi.IsRunning() {
 		networkInterfaceManager := newVirtIOInterfaceManager(
 			dom, netsetup.NewVMNetworkConfigurator(vmi, cache.CacheCreator{}))
@@ -1224,6 +1265,73 @@ func getAttachedDisks(oldDisks, newDisks []api.Disk) []api.Disk {
 	return res
 }
 
+// getDisksWithChangedIOTune returns the new disks which are already attached with different I/O limits
+func getDisksWithChangedIOTune(oldDisks, newDisks []api.Disk) []api.Disk {
+	oldDiskMap := make(map[string]api.Disk)
+	for _, disk := range oldDisks {
+		if disk.Alias != nil {
+			oldDiskMap[disk.Alias.GetName()] = disk
+		}
+	}
+	res := make([]api.Disk, 0)
+	for _, newDisk := range newDisks {
+		if newDisk.Alias == nil {
+			continue
+		}
+		oldDisk, ok := oldDiskMap[newDisk.Alias.GetName()]
+		if !ok || reflect.DeepEqual(oldDisk.IOTune, newDisk.IOTune) {
+			continue
+		}
+		res = append(res, newDisk)
+	}
+	return res
+}
+
+// toBlockIoTuneParameters sets all parameters, so that limits which are not part of the given iotune are removed
+func toBlockIoTuneParameters(ioTune *api.DiskIOTune) *libvirt.DomainBlockIoTuneParameters {
+	if ioTune == nil {
+		ioTune = &api.DiskIOTune{}
+	}
+	return &libvirt.DomainBlockIoTuneParameters{
+		TotalBytesSecSet:          true,
+		TotalBytesSec:             ioTune.TotalBytesSec,
+		ReadBytesSecSet:           true,
+		ReadBytesSec:              ioTune.ReadBytesSec,
+		WriteBytesSecSet:          true,
+		WriteBytesSec:             ioTune.WriteBytesSec,
+		TotalIopsSecSet:           true,
+		TotalIopsSec:              ioTune.TotalIopsSec,
+		ReadIopsSecSet:            true,
+		ReadIopsSec:               ioTune.ReadIopsSec,
+		WriteIopsSecSet:           true,
+		WriteIopsSec:              ioTune.WriteIopsSec,
+		TotalBytesSecMaxSet:       true,
+		TotalBytesSecMax:          ioTune.TotalBytesSecMax,
+		ReadBytesSecMaxSet:        true,
+		ReadBytesSecMax:           ioTune.ReadBytesSecMax,
+		WriteBytesSecMaxSet:       true,
+		WriteBytesSecMax:          ioTune.WriteBytesSecMax,
+		TotalIopsSecMaxSet:        true,
+		TotalIopsSecMax:           ioTune.TotalIopsSecMax,
+		ReadIopsSecMaxSet:         true,
+		ReadIopsSecMax:            ioTune.ReadIopsSecMax,
+		WriteIopsSecMaxSet:        true,
+		WriteIopsSecMax:           ioTune.WriteIopsSecMax,
+		TotalBytesSecMaxLengthSet: ioTune.TotalBytesSecMaxLength != 0,
+		TotalBytesSecMaxLength:    ioTune.TotalBytesSecMaxLength,
+		ReadBytesSecMaxLengthSet:  ioTune.ReadBytesSecMaxLength != 0,
+		ReadBytesSecMaxLength:     ioTune.ReadBytesSecMaxLength,
+		WriteBytesSecMaxLengthSet: ioTune.WriteBytesSecMaxLength != 0,
+		WriteBytesSecMaxLength:    ioTune.WriteBytesSecMaxLength,
+		TotalIopsSecMaxLengthSet:  ioTune.TotalIopsSecMaxLength != 0,
+		TotalIopsSecMaxLength:     ioTune.TotalIopsSecMaxLength,
+		ReadIopsSecMaxLengthSet:   ioTune.ReadIopsSecMaxLength != 0,
+		ReadIopsSecMaxLength:      ioTune.ReadIopsSecMaxLength,
+		WriteIopsSecMaxLengthSet:  ioTune.WriteIopsSecMaxLength != 0,
+		WriteIopsSecMaxLength:     ioTune.WriteIopsSecMaxLength,
+	}
+}
+
 var isHotplugBlockDeviceVolume = isHotplugBlockDeviceVolumeFunc
 
 func isHotplugBlockDeviceVolumeFunc(volumeName string) bool {
@@ -1478,7 +1586,7 @@ func (l *LibvirtDomainManager) UnpauseVMI(vmi *v1.VirtualMachineInstance) error
 		l.paused.remove(vmi.UID)
 		// Try to set guest time after this commands execution.
 		// This operation is not disruptive.
-		if err := l.setGuestTime(vmi); err != nil {
+		if err := l.setGuestTime(vmi, v1.GuestTimeSyncReasonUnpause); err != nil {
 			return err
 		}
 
@@ -1572,6 +1680,11 @@ func (l *LibvirtDomainManager) UnfreezeVMI(vmi *v1.VirtualMachineInstance) error
 		log.Log.Errorf("Failed to unfreeze vmi, %s", err.Error())
 		return err
 	}
+	// The guest could have been frozen for a while, try to set the guest time.
+	// This operation is not disruptive and does not fail the thaw.
+	if err := l.setGuestTime(vmi, v1.GuestTimeSyncReasonThaw); err != nil {
+		log.Log.Object(vmi).Reason(err).Warning("Failed to set the guest time after unfreezing the vmi")
+	}
 	return nil
 }
 
@@ -1962,6 +2075,47 @@ func (l *LibvirtDomainManager) GetGuestInfo() v1.VirtualMachineInstanceGuestAgen
 		})
 	}
 
+	for _, disk := range l.agentData.GetDisks() {
+		guestInfo.Disks = append(guestInfo.Disks, v1.VirtualMachineInstanceGuestOSDisk{
+			Name:         disk.Name,
+			Partition:    disk.Partition,
+			Dependencies: disk.Dependencies,
+			Alias:        disk.Alias,
+			Serial:       disk.Serial,
+			BusType:      disk.BusType,
+		})
+	}
+
+	for _, stats := range l.agentData.GetCPUStats() {
+		guestInfo.CPUStats = append(guestInfo.CPUStats, v1.VirtualMachineInstanceGuestOSCPUStats{
+			CPU:       stats.CPU,
+			User:      stats.User,
+			Nice:      stats.Nice,
+			System:    stats.System,
+			Idle:      stats.Idle,
+			IOWait:    stats.IOWait,
+			IRQ:       stats.IRQ,
+			SoftIRQ:   stats.SoftIRQ,
+			Steal:     stats.Steal,
+			Guest:     stats.Guest,
+			GuestNice: stats.GuestNice,
+		})
+	}
+
+	if memoryBlockInfo := l.agentData.GetMemoryBlockInfo(); memoryBlockInfo != nil {
+		guestInfo.MemoryBlockInfo = &v1.VirtualMachineInstanceGuestOSMemoryBlockInfo{
+			Size: memoryBlockInfo.Size,
+		}
+	}
+
+	if load := l.agentData.GetLoad(); load != nil {
+		guestInfo.Load = &v1.VirtualMachineInstanceGuestOSLoad{
+			Load1m:  load.Load1m,
+			Load5m:  load.Load5m,
+			Load15m: load.Load15m,
+		}
+	}
+
 	return guestInfo
 }
 
@@ -2009,6 +2163,25 @@ func (l *LibvirtDomainManager) GetFilesystems() []v1.VirtualMachineInstanceFileS
 	return fsList
 }
 
+// GetGuestAgentCommandResults returns the latest results of the custom guest agent commands
+func (l *LibvirtDomainManager) GetGuestAgentCommandResults() []v1.VirtualMachineInstanceGuestAgentCommandResult {
+	results := []v1.VirtualMachineInstanceGuestAgentCommandResult{}
+
+	for _, result := range l.agentData.GetCustomCommandResults() {
+		commandResult := v1.VirtualMachineInstanceGuestAgentCommandResult{
+			Command:        result.Command,
+			Error:          result.Error,
+			LastUpdateTime: result.UpdateTime,
+		}
+		if result.Result != "" {
+			commandResult.Result = &k8sruntime.RawExtension{Raw: []byte(result.Result)}
+		}
+		results = append(results, commandResult)
+	}
+
+	return results
+}
+
 func (l *LibvirtDomainManager) GetSEVInfo() (*v1.SEVPlatformInfo, error) {
 	sevNodeParameters, err := l.virConn.GetSEVInfo()
 	if err != nil {
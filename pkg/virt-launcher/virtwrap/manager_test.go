@@ -430,6 +430,17 @@ var _ = Describe("Manager", func() {
 		return mockDomain, nil
 	}
 
+	// expectGuestTimeSync returns a channel which is closed once the
+	// asynchronous guest time sync freed the domain
+	expectGuestTimeSync := func() chan struct{} {
+		done := make(chan struct{})
+		mockConn.EXPECT().LookupDomainByName(testDomainName).Return(mockDomain, nil)
+		mockDomain.EXPECT().GetTime(uint32(0)).Return(time.Now().Add(-2*time.Second).Unix(), uint(0), nil)
+		mockDomain.EXPECT().SetTime(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+		mockDomain.EXPECT().Free().Do(func() { close(done) })
+		return done
+	}
+
 	Context("on successful VirtualMachineInstance sync", func() {
 		It("should define and start a new VirtualMachineInstance", func() {
 			vmi := newVMI(testNamespace, testVmName)
@@ -601,9 +612,45 @@ var _ = Describe("Manager", func() {
 
 			mockConn.EXPECT().QemuAgentCommand(`{"execute":"`+string(agentpoller.GET_FSFREEZE_STATUS)+`"}`, testDomainName).Return(expectedFrozenOutput, nil)
 			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-fsfreeze-thaw"}`, testDomainName).Return("1", nil)
+			timeSynced := expectGuestTimeSync()
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+
+			Expect(manager.UnfreezeVMI(vmi)).To(Succeed())
+			Eventually(timeSynced, 5*time.Second).Should(BeClosed())
+
+			timeSync, exists := metadataCache.GuestTimeSync.Load()
+			Expect(exists).To(BeTrue())
+			Expect(timeSync.Reason).To(Equal(string(v1.GuestTimeSyncReasonThaw)))
+			Expect(timeSync.Timestamp).ToNot(BeNil())
+			Expect(timeSync.DriftMilliseconds).ToNot(BeNil())
+			Expect(*timeSync.DriftMilliseconds).To(BeNumerically(">=", 2000))
+		})
+		It("should not sync the guest time on unfreeze if it is disabled", func() {
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.Domain.Clock = &v1.Clock{
+				GuestTimeSync: &v1.GuestTimeSync{Policy: v1.GuestTimeSyncDisabled},
+			}
+
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"`+string(agentpoller.GET_FSFREEZE_STATUS)+`"}`, testDomainName).Return(expectedFrozenOutput, nil)
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-fsfreeze-thaw"}`, testDomainName).Return("1", nil)
+			// no call to set the guest time
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+
+			Expect(manager.UnfreezeVMI(vmi)).To(Succeed())
+			_, exists := metadataCache.GuestTimeSync.Load()
+			Expect(exists).To(BeFalse())
+		})
+		It("should unfreeze a VirtualMachineInstance even if the guest time can't be set", func() {
+			vmi := newVMI(testNamespace, testVmName)
+
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"`+string(agentpoller.GET_FSFREEZE_STATUS)+`"}`, testDomainName).Return(expectedFrozenOutput, nil)
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-fsfreeze-thaw"}`, testDomainName).Return("1", nil)
+			mockConn.EXPECT().LookupDomainByName(testDomainName).Return(nil, libvirt.Error{Code: libvirt.ERR_NO_DOMAIN})
 			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
 
 			Expect(manager.UnfreezeVMI(vmi)).To(Succeed())
+			_, exists := metadataCache.GuestTimeSync.Load()
+			Expect(exists).To(BeFalse())
 		})
 		It("should automatically unfreeze after a timeout a frozen VirtualMachineInstance", func() {
 			vmi := newVMI(testNamespace, testVmName)
@@ -612,12 +659,14 @@ var _ = Describe("Manager", func() {
 			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-fsfreeze-freeze"}`, testDomainName).Return("1", nil)
 			mockConn.EXPECT().QemuAgentCommand(`{"execute":"`+string(agentpoller.GET_FSFREEZE_STATUS)+`"}`, testDomainName).Return(expectedFrozenOutput, nil)
 			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-fsfreeze-thaw"}`, testDomainName).Return("1", nil)
+			timeSynced := expectGuestTimeSync()
 			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
 
 			var unfreezeTimeout time.Duration = 3 * time.Second
 			Expect(manager.FreezeVMI(vmi, int32(unfreezeTimeout.Seconds()))).To(Succeed())
 			// wait for the unfreeze timeout
 			time.Sleep(unfreezeTimeout + 2*time.Second)
+			Eventually(timeSynced, 5*time.Second).Should(BeClosed())
 		})
 		It("should freeze and unfreeze a VirtualMachineInstance without a trigger to the unfreeze timeout", func() {
 			vmi := newVMI(testNamespace, testVmName)
@@ -626,6 +675,7 @@ var _ = Describe("Manager", func() {
 			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-fsfreeze-freeze"}`, testDomainName).Return("1", nil)
 			mockConn.EXPECT().QemuAgentCommand(`{"execute":"`+string(agentpoller.GET_FSFREEZE_STATUS)+`"}`, testDomainName).Return(expectedFrozenOutput, nil)
 			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-fsfreeze-thaw"}`, testDomainName).Return("1", nil)
+			timeSynced := expectGuestTimeSync()
 
 			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
 
@@ -635,6 +685,7 @@ var _ = Describe("Manager", func() {
 			Expect(manager.UnfreezeVMI(vmi)).To(Succeed())
 			// wait for the unfreeze timeout
 			time.Sleep(unfreezeTimeout + 2*time.Second)
+			Eventually(timeSynced, 5*time.Second).Should(BeClosed())
 		})
 		It("should update domain with memory dump info when completed successfully", func() {
 			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
@@ -716,6 +767,7 @@ var _ = Describe("Manager", func() {
 			mockConn.EXPECT().LookupDomainByName(testDomainName).MaxTimes(2).Return(mockDomain, nil)
 			mockDomain.EXPECT().GetState().Return(libvirt.DOMAIN_PAUSED, 1, nil)
 			mockDomain.EXPECT().Resume().Return(nil)
+			mockDomain.EXPECT().GetTime(gomock.Any()).AnyTimes()
 			mockDomain.EXPECT().SetTime(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Do(func(interface{}, interface{}, interface{}) {
 				isSetTimeCalled <- true
 			})
@@ -1932,6 +1984,16 @@ var _ = Describe("Manager", func() {
 		Entry("migration with parallel threads", "parallel"),
 	)
 
+	DescribeTable("check migration compression flags",
+		func(options *cmdclient.MigrationOptions, expectedFlags libvirt.DomainMigrateFlags) {
+			flags := generateMigrationFlags(false, false, options)
+			Expect(flags).To(Equal(libvirt.MIGRATE_LIVE | libvirt.MIGRATE_PEER2PEER | libvirt.MIGRATE_PERSIST_DEST | expectedFlags))
+		},
+		Entry("without compression", &cmdclient.MigrationOptions{Compression: v1.MigrationCompressionNone}, libvirt.DomainMigrateFlags(0)),
+		Entry("with xbzrle compression", &cmdclient.MigrationOptions{Compression: v1.MigrationCompressionXBZRLE}, libvirt.MIGRATE_COMPRESSED),
+		Entry("with zstd compression", &cmdclient.MigrationOptions{Compression: v1.MigrationCompressionZstd}, libvirt.MIGRATE_COMPRESSED|libvirt.MIGRATE_PARALLEL),
+	)
+
 	DescribeTable("on successful list all domains",
 		func(state libvirt.DomainState, kubevirtState api.LifeCycle, libvirtReason int, kubevirtReason api.StateChangeReason) {
 
@@ -2355,6 +2417,46 @@ var _ = Describe("getAttachedDisks", func() {
 	)
 })
 
+var _ = Describe("getDisksWithChangedIOTune", func() {
+	diskWithIOTune := func(name string, ioTune *api.DiskIOTune) api.Disk {
+		return api.Disk{
+			Alias:  api.NewUserDefinedAlias(name),
+			IOTune: ioTune,
+		}
+	}
+
+	DescribeTable("should return the correct values", func(oldDisks, newDisks, expected []api.Disk) {
+		Expect(getDisksWithChangedIOTune(oldDisks, newDisks)).To(Equal(expected))
+	},
+		Entry("be empty with identical disks",
+			[]api.Disk{diskWithIOTune("disk0", &api.DiskIOTune{TotalIopsSec: 100}), diskWithIOTune("disk1", nil)},
+			[]api.Disk{diskWithIOTune("disk0", &api.DiskIOTune{TotalIopsSec: 100}), diskWithIOTune("disk1", nil)},
+			[]api.Disk{}),
+		Entry("contain disks with added, changed and removed I/O limits",
+			[]api.Disk{diskWithIOTune("disk0", nil), diskWithIOTune("disk1", &api.DiskIOTune{TotalIopsSec: 100}), diskWithIOTune("disk2", &api.DiskIOTune{TotalIopsSec: 100})},
+			[]api.Disk{diskWithIOTune("disk0", &api.DiskIOTune{TotalIopsSec: 100}), diskWithIOTune("disk1", &api.DiskIOTune{TotalIopsSec: 200}), diskWithIOTune("disk2", nil)},
+			[]api.Disk{diskWithIOTune("disk0", &api.DiskIOTune{TotalIopsSec: 100}), diskWithIOTune("disk1", &api.DiskIOTune{TotalIopsSec: 200}), diskWithIOTune("disk2", nil)}),
+		Entry("be empty for disks which are not attached yet",
+			[]api.Disk{},
+			[]api.Disk{diskWithIOTune("disk0", &api.DiskIOTune{TotalIopsSec: 100})},
+			[]api.Disk{}),
+	)
+
+	It("should reset all limits which are not set", func() {
+		params := toBlockIoTuneParameters(&api.DiskIOTune{ReadIopsSec: 100, ReadIopsSecMax: 500, ReadIopsSecMaxLength: 1})
+		Expect(params.ReadIopsSecSet).To(BeTrue())
+		Expect(params.ReadIopsSec).To(BeEquivalentTo(100))
+		Expect(params.ReadIopsSecMaxLengthSet).To(BeTrue())
+		Expect(params.TotalIopsSecSet).To(BeTrue())
+		Expect(params.TotalIopsSec).To(BeZero())
+		Expect(params.TotalIopsSecMaxLengthSet).To(BeFalse())
+
+		params = toBlockIoTuneParameters(nil)
+		Expect(params.TotalBytesSecSet).To(BeTrue())
+		Expect(params.TotalBytesSec).To(BeZero())
+	})
+})
+
 var _ = Describe("getDetachedDisks", func() {
 	DescribeTable("should return the correct values", func(oldDisks, newDisks, expected []api.Disk) {
 		res := getDetachedDisks(oldDisks, newDisks)
@@ -860,6 +860,32 @@ var CRDsValidation map[string]string = map[string]string{
                       type: object
                   type: object
               type: object
+            cpuBaselineNodePools:
+              description: CPUBaselineNodePools split the cluster into pools of nodes
+                which get a CPU baseline of their own. VMIs requesting the cluster-baseline
+                CPU model use the baseline of the pool whose node selector is part
+                of their own node selector, and the baseline of the whole cluster
+                otherwise.
+              items:
+                description: CPUBaselineNodePool is a pool of nodes which gets a CPU
+                  baseline of its own
+                properties:
+                  name:
+                    description: Name of the node pool
+                    type: string
+                  nodeSelector:
+                    additionalProperties:
+                      type: string
+                    description: NodeSelector selects the nodes of the pool
+                    type: object
+                required:
+                - name
+                - nodeSelector
+                type: object
+              type: array
+              x-kubernetes-list-map-keys:
+              - name
+              x-kubernetes-list-type: map
             cpuModel:
               type: string
             cpuRequest:
@@ -967,6 +993,22 @@ var CRDsValidation map[string]string = map[string]string{
                 the VirtualMachineInstance specific field is set it overrides the
                 cluster level one.
               type: string
+            guestAgentConfiguration:
+              description: GuestAgentConfiguration holds the cluster wide configuration
+                of the guest agent polling
+              properties:
+                customCommands:
+                  description: CustomCommands is a list of additional read-only guest
+                    agent commands (e.g. guest-get-vcpus) which are executed periodically
+                    in every VirtualMachineInstance with a connected guest agent.
+                    Their results are exposed through the guestinfo subresource of
+                    the VirtualMachineInstance. Only guest-info and guest-get-* commands
+                    are accepted.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: set
+              type: object
             handlerConfiguration:
               description: ReloadableComponentConfiguration holds all generic k8s
                 configuration options which can be reloaded by components without
@@ -1151,6 +1193,17 @@ var CRDsValidation map[string]string = map[string]string{
                     true. Defaults to 800
                   format: int64
                   type: integer
+                compression:
+                  description: Compression is the method used to compress the guest
+                    memory pages sent during live migrations. Zstd compression is
+                    only available together with multiple parallel migration streams,
+                    while xbzrle only sends the delta of pages which were already
+                    transferred. Defaults to none
+                  enum:
+                  - none
+                  - zstd
+                  - xbzrle
+                  type: string
                 disableTLS:
                   description: When set to true, DisableTLS will disable the additional
                     layer of live migration encryption provided by KubeVirt. This
@@ -1174,6 +1227,13 @@ var CRDsValidation map[string]string = map[string]string{
                     a node should be drained. Note: this option relies on the deprecated
                     node taint feature. Default: kubevirt.io/drain'
                   type: string
+                parallelMigrationStreams:
+                  description: ParallelMigrationStreams is the number of parallel
+                    connections used to transfer the guest memory. Using more than
+                    one stream switches the migration to multifd. Defaults to a single
+                    stream
+                  format: int32
+                  type: integer
                 parallelMigrationsPerCluster:
                   description: ParallelMigrationsPerCluster is the total number of
                     concurrent live migrations allowed cluster-wide. Defaults to 5
@@ -1221,6 +1281,26 @@ var CRDsValidation map[string]string = map[string]string{
                 permitSlirpInterface:
                   type: boolean
               type: object
+            nodeFailover:
+              description: NodeFailover configures the failover of VirtualMachineInstances
+                running on NotReady nodes. When unset, VirtualMachineInstances are
+                only failed once virt-handler stops sending heartbeats.
+              properties:
+                notReadyTimeout:
+                  description: NotReadyTimeout is how long a node has to be NotReady
+                    before the VirtualMachineInstances running on it are failed over.
+                    Defaults to 1m
+                  type: string
+                requireFencing:
+                  description: RequireFencing only fails over VirtualMachineInstances
+                    once the node was fenced, which is signaled by the node.kubernetes.io/out-of-service
+                    taint. Kubernetes then force deletes the virt-launcher pods on
+                    the node and detaches their volumes. Without fencing the VirtualMachineInstances
+                    are failed right away, but their volumes are still only released
+                    once the node is fenced, since the node may still be writing to
+                    them. Defaults to true
+                  type: boolean
+              type: object
             obsoleteCPUModels:
               additionalProperties:
                 type: boolean
@@ -1231,6 +1311,30 @@ var CRDsValidation map[string]string = map[string]string{
               description: PermittedHostDevices holds information about devices allowed
                 for passthrough
               properties:
+                inputDevices:
+                  items:
+                    description: InputHostDevice represents a host evdev input device
+                      allowed for passthrough
+                    properties:
+                      evdevPath:
+                        description: EvdevPath is the path of the evdev input device
+                          on the host, preferably a stable one like /dev/input/by-id/usb-Vendor_Keyboard-event-kbd
+                        type: string
+                      externalResourceProvider:
+                        description: If true, KubeVirt will leave the allocation and
+                          monitoring to an external device plugin
+                        type: boolean
+                      resourceName:
+                        description: The name of the resource that is representing
+                          the device. Exposed by a device plugin and requested by
+                          VMs.
+                        type: string
+                    required:
+                    - evdevPath
+                    - resourceName
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 mediatedDevices:
                   items:
                     description: MediatedHostDevice represents a host mediated device
@@ -1274,6 +1378,50 @@ var CRDsValidation map[string]string = map[string]string{
                     type: object
                   type: array
                   x-kubernetes-list-type: atomic
+                usb:
+                  items:
+                    description: USBHostDevice represents host USB devices allowed
+                      for passthrough
+                    properties:
+                      externalResourceProvider:
+                        description: If true, KubeVirt will leave the allocation and
+                          monitoring to an external device plugin
+                        type: boolean
+                      resourceName:
+                        description: The name of the resource that is representing
+                          the devices. Exposed by a device plugin and requested by
+                          VMs. Typically of the form vendor.com/product_name
+                        type: string
+                      selectors:
+                        description: Selectors of the USB devices exposed as this
+                          resource. Every host USB device matching one of the selectors
+                          can be allocated.
+                        items:
+                          description: USBSelector selects host USB devices
+                          properties:
+                            busPath:
+                              description: BusPath restricts the selector to the device
+                                plugged into the given port, using the sysfs notation
+                                <bus>-<port>[.<port>...], e.g. 3-1.2
+                              type: string
+                            product:
+                              description: The product ID of the USB device, e.g.
+                                ff10
+                              type: string
+                            vendor:
+                              description: The vendor ID of the USB device, e.g. 046b
+                              type: string
+                          required:
+                          - product
+                          - vendor
+                          type: object
+                        type: array
+                        x-kubernetes-list-type: atomic
+                    required:
+                    - resourceName
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
               type: object
             seccompConfiguration:
               description: SeccompConfiguration holds Seccomp configuration for Kubevirt
@@ -2468,6 +2616,105 @@ var CRDsValidation map[string]string = map[string]string{
                 be forced updated per the BatchShutdownInteral interval \n Defaults
                 to 10"
               type: integer
+            canaryPercentage:
+              description: "CanaryPercentage is the percentage of the outdated VMIs
+                which are updated first. The rollout only continues to the remaining
+                VMIs once all canaries were updated successfully. \n Defaults to 0,
+                which disables the canary phase"
+              type: integer
+            excludedNamespaces:
+              description: ExcludedNamespaces lists namespaces whose VMIs are never
+                updated automatically
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: set
+            excludedSelector:
+              description: ExcludedSelector selects VMIs by their labels which are
+                never updated automatically
+              properties:
+                matchExpressions:
+                  description: matchExpressions is a list of label selector requirements.
+                    The requirements are ANDed.
+                  items:
+                    description: A label selector requirement is a selector that contains
+                      values, a key, and an operator that relates the key and values.
+                    properties:
+                      key:
+                        description: key is the label key that the selector applies
+                          to.
+                        type: string
+                      operator:
+                        description: operator represents a key's relationship to a
+                          set of values. Valid operators are In, NotIn, Exists and
+                          DoesNotExist.
+                        type: string
+                      values:
+                        description: values is an array of string values. If the operator
+                          is In or NotIn, the values array must be non-empty. If the
+                          operator is Exists or DoesNotExist, the values array must
+                          be empty. This array is replaced during a strategic merge
+                          patch.
+                        items:
+                          type: string
+                        type: array
+                    required:
+                    - key
+                    - operator
+                    type: object
+                  type: array
+                matchLabels:
+                  additionalProperties:
+                    type: string
+                  description: matchLabels is a map of {key,value} pairs. A single
+                    {key,value} in the matchLabels map is equivalent to an element
+                    of matchExpressions, whose key field is "key", the operator is
+                    "In", and the values array contains only "value". The requirements
+                    are ANDed.
+                  type: object
+              type: object
+            maintenanceWindows:
+              description: "MaintenanceWindows restricts automated workload updates
+                to the given windows. Outside of all windows no new migrations or
+                evictions are issued. \n An empty list allows workload updates at
+                any time"
+              items:
+                description: WorkloadUpdateMaintenanceWindow is a recurring time window
+                  in which automated workload updates are allowed
+                properties:
+                  days:
+                    description: Days of the week the window opens on. An empty list
+                      opens the window every day
+                    items:
+                      enum:
+                      - Monday
+                      - Tuesday
+                      - Wednesday
+                      - Thursday
+                      - Friday
+                      - Saturday
+                      - Sunday
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: set
+                  duration:
+                    description: Duration is how long the window stays open
+                    type: string
+                  startTime:
+                    description: StartTime is the time of the day in UTC the window
+                      opens at, in the format HH:MM
+                    type: string
+                required:
+                - duration
+                - startTime
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            maxMigrationFailurePercentage:
+              description: MaxMigrationFailurePercentage pauses the rollout once the
+                percentage of failed workload update migrations exceeds it. The rollout
+                stays paused until the threshold is raised or a new rollout starts.
+              type: integer
             workloadUpdateMethods:
               description: "WorkloadUpdateMethods defines the methods that can be
                 used to disrupt workloads during automated workload updates. When
@@ -3412,6 +3659,38 @@ var CRDsValidation map[string]string = map[string]string{
             - type
             type: object
           type: array
+        cpuBaselines:
+          description: CPUBaselines are the CPU models and features supported by all
+            schedulable nodes of the cluster and of each configured CPU baseline node
+            pool. VMIs requesting the cluster-baseline CPU model are started with
+            them.
+          items:
+            description: CPUBaseline is the CPU model and the CPU features supported
+              by a set of nodes
+            properties:
+              features:
+                description: Features are the CPU features supported by all nodes
+                items:
+                  type: string
+                type: array
+                x-kubernetes-list-type: set
+              model:
+                description: Model is the most recent CPU model supported by all nodes,
+                  empty if there is none
+                type: string
+              nodePool:
+                description: NodePool is the name of the CPU baseline node pool, empty
+                  for the whole cluster
+                type: string
+              nodes:
+                description: Nodes is the number of nodes the baseline was computed
+                  from
+                type: integer
+            required:
+            - nodes
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         defaultArchitecture:
           type: string
         generations:
@@ -3475,6 +3754,41 @@ var CRDsValidation map[string]string = map[string]string{
           type: string
         targetKubeVirtVersion:
           type: string
+        workloadUpdateStatus:
+          description: WorkloadUpdateStatus reports the progress of the automated
+            workload updates. It is only reported when a canary, maintenance windows
+            or a migration failure threshold are configured.
+          properties:
+            canaryTarget:
+              description: CanaryTarget is the number of VMIs which have to be updated
+                before the rollout continues
+              type: integer
+            evictions:
+              description: Evictions is the number of VMIs evicted during the rollout
+              type: integer
+            failedMigrations:
+              description: FailedMigrations is the number of workload update migrations
+                which failed during the rollout
+              type: integer
+            launcherImage:
+              description: LauncherImage is the virt-launcher image the workloads
+                are updated to
+              type: string
+            phase:
+              description: Phase is the phase of the rollout
+              type: string
+            reason:
+              description: Reason is a human readable explanation of the phase
+              type: string
+            succeededMigrations:
+              description: SucceededMigrations is the number of workload update migrations
+                which succeeded during the rollout
+              type: integer
+          required:
+          - evictions
+          - failedMigrations
+          - succeededMigrations
+          type: object
       type: object
   required:
   - spec
@@ -3511,6 +3825,13 @@ var CRDsValidation map[string]string = map[string]string{
         completionTimeoutPerGiB:
           format: int64
           type: integer
+        compression:
+          description: MigrationCompression is the method used to compress the guest
+            memory during live migrations
+          type: string
+        parallelMigrationStreams:
+          format: int32
+          type: integer
         selectors:
           properties:
             namespaceSelector:
@@ -4233,6 +4554,26 @@ var CRDsValidation map[string]string = map[string]string{
             - spec
             type: object
           type: array
+        dependsOn:
+          description: DependsOn lists the VirtualMachines of the same namespace which
+            have to be running before this VirtualMachine is started. When stopped
+            together, dependents are stopped before their dependencies.
+          items:
+            description: VirtualMachineDependency references a VirtualMachine which
+              has to be running before its dependent is started
+            properties:
+              condition:
+                description: Condition the VirtualMachineInstance of the dependency
+                  has to reach, Ready if not set
+                type: string
+              name:
+                description: Name of the VirtualMachine in the same namespace
+                type: string
+            required:
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         instancetype:
           description: InstancetypeMatcher references a instancetype that is used
             to fill fields in Template
@@ -5320,6 +5661,18 @@ var CRDsValidation map[string]string = map[string]string{
                     clock:
                       description: Clock sets the clock and timers of the vmi.
                       properties:
+                        guestTimeSync:
+                          description: 'GuestTimeSync configures the resynchronization
+                            of the guest clock through the guest agent after the guest
+                            was not running for a while: after unpause, live migration
+                            and filesystem thaw.'
+                          properties:
+                            policy:
+                              description: 'Policy defines when the guest clock is
+                                resynchronized. One of: Auto, Disabled. Defaults to
+                                Auto.'
+                              type: string
+                          type: object
                         timer:
                           description: Timer specifies whih timers are attached to
                             the vmi.
@@ -5469,7 +5822,10 @@ var CRDsValidation map[string]string = map[string]string{
                             List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
                             It is possible to specify special cases like "host-passthrough"
                             to get the same CPU as the node and "host-model" to get
-                            CPU closest to the node one. Defaults to host-model.
+                            CPU closest to the node one. "cluster-baseline" is replaced
+                            with the most recent CPU model and the CPU features supported
+                            by all nodes when the VMI is created, keeping it migratable
+                            across the cluster. Defaults to host-model.
                           type: string
                         numa:
                           description: NUMA allows specifying settings for the guest
@@ -5498,6 +5854,22 @@ var CRDsValidation map[string]string = map[string]string{
                             the vmi. Must be a value greater or equal 1.
                           format: int32
                           type: integer
+                        threadPlacement:
+                          description: ThreadPlacement requests additional dedicated
+                            pCPUs to be allocated for the VMI and places the emulator,
+                            the I/O threads and the vhost-net threads on them, away
+                            from the vCPUs. Requires DedicatedCPUPlacement and cannot
+                            be combined with IsolateEmulatorThread.
+                          properties:
+                            reservedCPUs:
+                              description: ReservedCPUs is the number of dedicated
+                                pCPUs allocated in addition to the vCPUs. The emulator,
+                                the I/O threads and the vhost-net threads are pinned
+                                to them by virt-handler once the domain is started.
+                                Defaults to 1.
+                              format: int32
+                              type: integer
+                          type: object
                         threads:
                           description: Threads specifies the number of threads inside
                             the vmi. Must be a value greater or equal 1.
@@ -5641,6 +6013,78 @@ var CRDsValidation map[string]string = map[string]string{
                                   should be used. Supported values are: native, default,
                                   threads.'
                                 type: string
+                              ioTune:
+                                description: If specified, the I/O of the disk is
+                                  throttled to the given limits. The limits can be
+                                  changed on a running VMI through the iotune subresource.
+                                properties:
+                                  maxLengthSeconds:
+                                    description: MaxLengthSeconds is how long in seconds
+                                      the disk can burst to the maximum limits. Defaults
+                                      to 1 second.
+                                    format: int64
+                                    type: integer
+                                  readBytesSec:
+                                    description: ReadBytesSec limits the read throughput
+                                      of the disk in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  readBytesSecMax:
+                                    description: ReadBytesSecMax is the read throughput
+                                      in bytes per second the disk can burst to.
+                                    format: int64
+                                    type: integer
+                                  readIOPSSec:
+                                    description: ReadIOPSSec limits the read I/O operations
+                                      per second of the disk.
+                                    format: int64
+                                    type: integer
+                                  readIOPSSecMax:
+                                    description: ReadIOPSSecMax is the read I/O operations
+                                      per second the disk can burst to.
+                                    format: int64
+                                    type: integer
+                                  totalBytesSec:
+                                    description: TotalBytesSec limits the total throughput
+                                      of the disk in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  totalBytesSecMax:
+                                    description: TotalBytesSecMax is the total throughput
+                                      in bytes per second the disk can burst to.
+                                    format: int64
+                                    type: integer
+                                  totalIOPSSec:
+                                    description: TotalIOPSSec limits the total I/O
+                                      operations per second of the disk.
+                                    format: int64
+                                    type: integer
+                                  totalIOPSSecMax:
+                                    description: TotalIOPSSecMax is the total I/O
+                                      operations per second the disk can burst to.
+                                    format: int64
+                                    type: integer
+                                  writeBytesSec:
+                                    description: WriteBytesSec limits the write throughput
+                                      of the disk in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  writeBytesSecMax:
+                                    description: WriteBytesSecMax is the write throughput
+                                      in bytes per second the disk can burst to.
+                                    format: int64
+                                    type: integer
+                                  writeIOPSSec:
+                                    description: WriteIOPSSec limits the write I/O
+                                      operations per second of the disk.
+                                    format: int64
+                                    type: integer
+                                  writeIOPSSecMax:
+                                    description: WriteIOPSSecMax is the write I/O
+                                      operations per second the disk can burst to.
+                                    format: int64
+                                    type: integer
+                                type: object
                               lun:
                                 description: Attach a volume as a LUN to the vmi.
                                 properties:
@@ -5766,12 +6210,18 @@ var CRDsValidation map[string]string = map[string]string{
                                 description: 'Bus indicates the bus of input device
                                   to emulate. Supported values: virtio, usb.'
                                 type: string
+                              deviceName:
+                                description: DeviceName is the resource name of the
+                                  permitted host input device which is passed through
+                                  to the guest. Required for the passthrough type,
+                                  which only supports the virtio bus.
+                                type: string
                               name:
                                 description: Name is the device name
                                 type: string
                               type:
                                 description: 'Type indicated the type of input device.
-                                  Supported values: tablet.'
+                                  Supported values: tablet, passthrough.'
                                 type: string
                             required:
                             - name
@@ -5951,9 +6401,9 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Whether to emulate a sound device.
                           properties:
                             model:
-                              description: 'We only support ich9 or ac97. If SoundDevice
-                                is not set: No sound card is emulated. If SoundDevice
-                                is set but Model is not: ich9'
+                              description: 'We only support ich9, ac97 or virtio.
+                                If SoundDevice is not set: No sound card is emulated.
+                                If SoundDevice is set but Model is not: ich9'
                               type: string
                             name:
                               description: User's defined name for this sound device
@@ -6236,6 +6686,56 @@ var CRDsValidation map[string]string = map[string]string{
                             efi:
                               description: If set, EFI will be used instead of BIOS.
                               properties:
+                                nvramTemplate:
+                                  description: NVRAMTemplate references a custom UEFI
+                                    variable store which is used to initialize the
+                                    NVRAM instead of the default OVMF vars file, e.g.
+                                    to boot with custom enrolled Secure Boot keys
+                                    (PK, KEK, db). If the NVRAM is persistent, the
+                                    template is only used when no NVRAM was persisted
+                                    yet.
+                                  properties:
+                                    configMap:
+                                      description: ConfigMap references a ConfigMap
+                                        that contains the variable store as binary
+                                        data.
+                                      properties:
+                                        name:
+                                          description: 'Name of the referent. More
+                                            info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                            TODO: Add other useful fields. apiVersion,
+                                            kind, uid?'
+                                          type: string
+                                      type: object
+                                    key:
+                                      description: Key is the name of the variable
+                                        store file in the referenced source. Defaults
+                                        to OVMF_VARS.fd
+                                      type: string
+                                    persistentVolumeClaim:
+                                      description: PersistentVolumeClaim references
+                                        a filesystem PVC that contains the variable
+                                        store.
+                                      properties:
+                                        name:
+                                          description: 'Name of the referent. More
+                                            info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                            TODO: Add other useful fields. apiVersion,
+                                            kind, uid?'
+                                          type: string
+                                      type: object
+                                    secret:
+                                      description: Secret references a k8s Secret
+                                        that contains the variable store.
+                                      properties:
+                                        name:
+                                          description: 'Name of the referent. More
+                                            info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                            TODO: Add other useful fields. apiVersion,
+                                            kind, uid?'
+                                          type: string
+                                      type: object
+                                  type: object
                                 persistent:
                                   description: If set to true, Persistent will persist
                                     the EFI NVRAM across reboots. Defaults to false
@@ -7351,6 +7851,36 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: string
                             type: object
                         type: object
+                      vhostUserBlk:
+                        description: VhostUserBlk connects the disk to a vhost-user-blk
+                          backend, e.g. an SPDK target, which is served over a unix
+                          socket by a storage plugin sidecar or by a node agent.
+                        properties:
+                          args:
+                            description: Arguments passed to the storage plugin sidecar.
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          image:
+                            description: Image of the storage plugin sidecar which
+                              serves the vhost-user-blk socket. The sidecar receives
+                              the socket path in the VHOST_USER_BLK_SOCKET environment
+                              variable. If empty, the socket is expected to be provided
+                              by a node agent in the /var/run/kubevirt-vhost-user-blk/<namespace>/<vmi
+                              name> host directory.
+                            type: string
+                          queues:
+                            description: Queues is the number of virtqueues of the
+                              disk. Defaults to the number of queues given by blockMultiQueue.
+                            format: int32
+                            type: integer
+                          socketName:
+                            description: SocketName is the file name of the vhost-user-blk
+                              socket, it must not contain a path. Defaults to "<volume
+                              name>.sock" for sidecars and is required for node agents.
+                            type: string
+                        type: object
                     required:
                     - name
                     type: object
@@ -7444,6 +7974,35 @@ var CRDsValidation map[string]string = map[string]string{
             started.
           format: int64
           type: integer
+        pendingChanges:
+          description: PendingChanges lists the changes of the VM template or of its
+            instancetype which are not applied to the running VMI yet.
+          items:
+            description: VirtualMachinePendingChange is a difference between the desired
+              spec of a VM and its running VMI
+            properties:
+              current:
+                description: Current is the JSON encoded value of the field on the
+                  running VMI, empty if the field is not set
+                type: string
+              desired:
+                description: Desired is the JSON encoded value of the field in the
+                  VM, empty if the field was removed
+                type: string
+              liveUpdatable:
+                description: LiveUpdatable indicates that the change is applied to
+                  the running VMI without a restart
+                type: boolean
+              path:
+                description: Path of the changed field in the VMI spec, e.g. spec.domain.cpu.sockets.
+                  Entries of named lists are addressed by their name, e.g. spec.volumes[name=data].
+                type: string
+            required:
+            - liveUpdatable
+            - path
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         printableStatus:
           description: PrintableStatus is a human readable, high-level representation
             of the status of the virtual machine
@@ -7593,6 +8152,78 @@ var CRDsValidation map[string]string = map[string]string{
                         description: 'IO specifies which QEMU disk IO mode should
                           be used. Supported values are: native, default, threads.'
                         type: string
+                      ioTune:
+                        description: If specified, the I/O of the disk is throttled
+                          to the given limits. The limits can be changed on a running
+                          VMI through the iotune subresource.
+                        properties:
+                          maxLengthSeconds:
+                            description: MaxLengthSeconds is how long in seconds the
+                              disk can burst to the maximum limits. Defaults to 1
+                              second.
+                            format: int64
+                            type: integer
+                          readBytesSec:
+                            description: ReadBytesSec limits the read throughput of
+                              the disk in bytes per second.
+                            format: int64
+                            type: integer
+                          readBytesSecMax:
+                            description: ReadBytesSecMax is the read throughput in
+                              bytes per second the disk can burst to.
+                            format: int64
+                            type: integer
+                          readIOPSSec:
+                            description: ReadIOPSSec limits the read I/O operations
+                              per second of the disk.
+                            format: int64
+                            type: integer
+                          readIOPSSecMax:
+                            description: ReadIOPSSecMax is the read I/O operations
+                              per second the disk can burst to.
+                            format: int64
+                            type: integer
+                          totalBytesSec:
+                            description: TotalBytesSec limits the total throughput
+                              of the disk in bytes per second.
+                            format: int64
+                            type: integer
+                          totalBytesSecMax:
+                            description: TotalBytesSecMax is the total throughput
+                              in bytes per second the disk can burst to.
+                            format: int64
+                            type: integer
+                          totalIOPSSec:
+                            description: TotalIOPSSec limits the total I/O operations
+                              per second of the disk.
+                            format: int64
+                            type: integer
+                          totalIOPSSecMax:
+                            description: TotalIOPSSecMax is the total I/O operations
+                              per second the disk can burst to.
+                            format: int64
+                            type: integer
+                          writeBytesSec:
+                            description: WriteBytesSec limits the write throughput
+                              of the disk in bytes per second.
+                            format: int64
+                            type: integer
+                          writeBytesSecMax:
+                            description: WriteBytesSecMax is the write throughput
+                              in bytes per second the disk can burst to.
+                            format: int64
+                            type: integer
+                          writeIOPSSec:
+                            description: WriteIOPSSec limits the write I/O operations
+                              per second of the disk.
+                            format: int64
+                            type: integer
+                          writeIOPSSecMax:
+                            description: WriteIOPSSecMax is the write I/O operations
+                              per second the disk can burst to.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -8307,6 +8938,17 @@ var CRDsValidation map[string]string = map[string]string{
               description: PreferredInputType optionally defines the preferred type
                 for Input devices.
               type: string
+            preferredInterfaceBinding:
+              description: PreferredInterfaceBinding optionally defines the preferred
+                binding plugin to use with each network interface.
+              properties:
+                name:
+                  description: 'Name references to the binding name as denined in
+                    the kubevirt CR. version: 1alphav1'
+                  type: string
+              required:
+              - name
+              type: object
             preferredInterfaceMasquerade:
               description: PreferredInterfaceMasquerade optionally defines the preferred
                 masquerade configuration to use with each network interface.
@@ -8632,6 +9274,10 @@ var CRDsValidation map[string]string = map[string]string{
           description: Volumes optionally defines preferences associated with the
             Volumes attribute of a VirtualMachineInstace DomainSpec
           properties:
+            preferredAccessMode:
+              description: PreferredAccessMode optionally defines the preferred access
+                mode of DataVolumeTemplates not requesting one
+              type: string
             preferredStorageClassName:
               description: PreffereedStorageClassName optionally defines the preferred
                 storageClass
@@ -9846,6 +10492,17 @@ var CRDsValidation map[string]string = map[string]string{
             clock:
               description: Clock sets the clock and timers of the vmi.
               properties:
+                guestTimeSync:
+                  description: 'GuestTimeSync configures the resynchronization of
+                    the guest clock through the guest agent after the guest was not
+                    running for a while: after unpause, live migration and filesystem
+                    thaw.'
+                  properties:
+                    policy:
+                      description: 'Policy defines when the guest clock is resynchronized.
+                        One of: Auto, Disabled. Defaults to Auto.'
+                      type: string
+                  type: object
                 timer:
                   description: Timer specifies whih timers are attached to the vmi.
                   properties:
@@ -9982,7 +10639,10 @@ var CRDsValidation map[string]string = map[string]string{
                     of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
                     It is possible to specify special cases like "host-passthrough"
                     to get the same CPU as the node and "host-model" to get CPU closest
-                    to the node one. Defaults to host-model.
+                    to the node one. "cluster-baseline" is replaced with the most
+                    recent CPU model and the CPU features supported by all nodes when
+                    the VMI is created, keeping it migratable across the cluster.
+                    Defaults to host-model.
                   type: string
                 numa:
                   description: NUMA allows specifying settings for the guest NUMA
@@ -10011,6 +10671,20 @@ var CRDsValidation map[string]string = map[string]string{
                     vmi. Must be a value greater or equal 1.
                   format: int32
                   type: integer
+                threadPlacement:
+                  description: ThreadPlacement requests additional dedicated pCPUs
+                    to be allocated for the VMI and places the emulator, the I/O threads
+                    and the vhost-net threads on them, away from the vCPUs. Requires
+                    DedicatedCPUPlacement and cannot be combined with IsolateEmulatorThread.
+                  properties:
+                    reservedCPUs:
+                      description: ReservedCPUs is the number of dedicated pCPUs allocated
+                        in addition to the vCPUs. The emulator, the I/O threads and
+                        the vhost-net threads are pinned to them by virt-handler once
+                        the domain is started. Defaults to 1.
+                      format: int32
+                      type: integer
+                  type: object
                 threads:
                   description: Threads specifies the number of threads inside the
                     vmi. Must be a value greater or equal 1.
@@ -10142,6 +10816,78 @@ var CRDsValidation map[string]string = map[string]string{
                         description: 'IO specifies which QEMU disk IO mode should
                           be used. Supported values are: native, default, threads.'
                         type: string
+                      ioTune:
+                        description: If specified, the I/O of the disk is throttled
+                          to the given limits. The limits can be changed on a running
+                          VMI through the iotune subresource.
+                        properties:
+                          maxLengthSeconds:
+                            description: MaxLengthSeconds is how long in seconds the
+                              disk can burst to the maximum limits. Defaults to 1
+                              second.
+                            format: int64
+                            type: integer
+                          readBytesSec:
+                            description: ReadBytesSec limits the read throughput of
+                              the disk in bytes per second.
+                            format: int64
+                            type: integer
+                          readBytesSecMax:
+                            description: ReadBytesSecMax is the read throughput in
+                              bytes per second the disk can burst to.
+                            format: int64
+                            type: integer
+                          readIOPSSec:
+                            description: ReadIOPSSec limits the read I/O operations
+                              per second of the disk.
+                            format: int64
+                            type: integer
+                          readIOPSSecMax:
+                            description: ReadIOPSSecMax is the read I/O operations
+                              per second the disk can burst to.
+                            format: int64
+                            type: integer
+                          totalBytesSec:
+                            description: TotalBytesSec limits the total throughput
+                              of the disk in bytes per second.
+                            format: int64
+                            type: integer
+                          totalBytesSecMax:
+                            description: TotalBytesSecMax is the total throughput
+                              in bytes per second the disk can burst to.
+                            format: int64
+                            type: integer
+                          totalIOPSSec:
+                            description: TotalIOPSSec limits the total I/O operations
+                              per second of the disk.
+                            format: int64
+                            type: integer
+                          totalIOPSSecMax:
+                            description: TotalIOPSSecMax is the total I/O operations
+                              per second the disk can burst to.
+                            format: int64
+                            type: integer
+                          writeBytesSec:
+                            description: WriteBytesSec limits the write throughput
+                              of the disk in bytes per second.
+                            format: int64
+                            type: integer
+                          writeBytesSecMax:
+                            description: WriteBytesSecMax is the write throughput
+                              in bytes per second the disk can burst to.
+                            format: int64
+                            type: integer
+                          writeIOPSSec:
+                            description: WriteIOPSSec limits the write I/O operations
+                              per second of the disk.
+                            format: int64
+                            type: integer
+                          writeIOPSSecMax:
+                            description: WriteIOPSSecMax is the write I/O operations
+                              per second the disk can burst to.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -10262,12 +11008,18 @@ var CRDsValidation map[string]string = map[string]string{
                         description: 'Bus indicates the bus of input device to emulate.
                           Supported values: virtio, usb.'
                         type: string
+                      deviceName:
+                        description: DeviceName is the resource name of the permitted
+                          host input device which is passed through to the guest.
+                          Required for the passthrough type, which only supports the
+                          virtio bus.
+                        type: string
                       name:
                         description: Name is the device name
                         type: string
                       type:
                         description: 'Type indicated the type of input device. Supported
-                          values: tablet.'
+                          values: tablet, passthrough.'
                         type: string
                     required:
                     - name
@@ -10438,8 +11190,8 @@ var CRDsValidation map[string]string = map[string]string{
                   description: Whether to emulate a sound device.
                   properties:
                     model:
-                      description: 'We only support ich9 or ac97. If SoundDevice is
-                        not set: No sound card is emulated. If SoundDevice is set
+                      description: 'We only support ich9, ac97 or virtio. If SoundDevice
+                        is not set: No sound card is emulated. If SoundDevice is set
                         but Model is not: ich9'
                       type: string
                     name:
@@ -10698,6 +11450,49 @@ var CRDsValidation map[string]string = map[string]string{
                     efi:
                       description: If set, EFI will be used instead of BIOS.
                       properties:
+                        nvramTemplate:
+                          description: NVRAMTemplate references a custom UEFI variable
+                            store which is used to initialize the NVRAM instead of
+                            the default OVMF vars file, e.g. to boot with custom enrolled
+                            Secure Boot keys (PK, KEK, db). If the NVRAM is persistent,
+                            the template is only used when no NVRAM was persisted
+                            yet.
+                          properties:
+                            configMap:
+                              description: ConfigMap references a ConfigMap that contains
+                                the variable store as binary data.
+                              properties:
+                                name:
+                                  description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                    TODO: Add other useful fields. apiVersion, kind,
+                                    uid?'
+                                  type: string
+                              type: object
+                            key:
+                              description: Key is the name of the variable store file
+                                in the referenced source. Defaults to OVMF_VARS.fd
+                              type: string
+                            persistentVolumeClaim:
+                              description: PersistentVolumeClaim references a filesystem
+                                PVC that contains the variable store.
+                              properties:
+                                name:
+                                  description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                    TODO: Add other useful fields. apiVersion, kind,
+                                    uid?'
+                                  type: string
+                              type: object
+                            secret:
+                              description: Secret references a k8s Secret that contains
+                                the variable store.
+                              properties:
+                                name:
+                                  description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                    TODO: Add other useful fields. apiVersion, kind,
+                                    uid?'
+                                  type: string
+                              type: object
+                          type: object
                         persistent:
                           description: If set to true, Persistent will persist the
                             EFI NVRAM across reboots. Defaults to false
@@ -11753,6 +12548,36 @@ var CRDsValidation map[string]string = map[string]string{
                         type: string
                     type: object
                 type: object
+              vhostUserBlk:
+                description: VhostUserBlk connects the disk to a vhost-user-blk backend,
+                  e.g. an SPDK target, which is served over a unix socket by a storage
+                  plugin sidecar or by a node agent.
+                properties:
+                  args:
+                    description: Arguments passed to the storage plugin sidecar.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                  image:
+                    description: Image of the storage plugin sidecar which serves
+                      the vhost-user-blk socket. The sidecar receives the socket path
+                      in the VHOST_USER_BLK_SOCKET environment variable. If empty,
+                      the socket is expected to be provided by a node agent in the
+                      /var/run/kubevirt-vhost-user-blk/<namespace>/<vmi name> host
+                      directory.
+                    type: string
+                  queues:
+                    description: Queues is the number of virtqueues of the disk. Defaults
+                      to the number of queues given by blockMultiQueue.
+                    format: int32
+                    type: integer
+                  socketName:
+                    description: SocketName is the file name of the vhost-user-blk
+                      socket, it must not contain a path. Defaults to "<volume name>.sock"
+                      for sidecars and is required for node agents.
+                    type: string
+                type: object
             required:
             - name
             type: object
@@ -11859,6 +12684,24 @@ var CRDsValidation map[string]string = map[string]string{
               description: Version ID of the Guest OS
               type: string
           type: object
+        guestTimeSync:
+          description: GuestTimeSync reports the last resynchronization of the guest
+            clock through the guest agent
+          properties:
+            driftMilliseconds:
+              description: DriftMilliseconds is the difference between the host and
+                the guest clock measured right before the last resynchronization.
+                A positive value means the guest clock was behind.
+              format: int64
+              type: integer
+            lastSyncTime:
+              description: LastSyncTime is the time of the last successful resynchronization
+              format: date-time
+              type: string
+            reason:
+              description: Reason is the event which triggered the last resynchronization
+              type: string
+          type: object
         interfaces:
           description: Interfaces represent the details of available network interfaces.
           items:
@@ -11962,6 +12805,17 @@ var CRDsValidation map[string]string = map[string]string{
                     true. Defaults to 800
                   format: int64
                   type: integer
+                compression:
+                  description: Compression is the method used to compress the guest
+                    memory pages sent during live migrations. Zstd compression is
+                    only available together with multiple parallel migration streams,
+                    while xbzrle only sends the delta of pages which were already
+                    transferred. Defaults to none
+                  enum:
+                  - none
+                  - zstd
+                  - xbzrle
+                  type: string
                 disableTLS:
                   description: When set to true, DisableTLS will disable the additional
                     layer of live migration encryption provided by KubeVirt. This
@@ -11985,6 +12839,13 @@ var CRDsValidation map[string]string = map[string]string{
                     a node should be drained. Note: this option relies on the deprecated
                     node taint feature. Default: kubevirt.io/drain'
                   type: string
+                parallelMigrationStreams:
+                  description: ParallelMigrationStreams is the number of parallel
+                    connections used to transfer the guest memory. Using more than
+                    one stream switches the migration to multifd. Defaults to a single
+                    stream
+                  format: int32
+                  type: integer
                 parallelMigrationsPerCluster:
                   description: ParallelMigrationsPerCluster is the total number of
                     concurrent live migrations allowed cluster-wide. Defaults to 5
@@ -12264,6 +13125,16 @@ var CRDsValidation map[string]string = map[string]string{
       type: object
     spec:
       properties:
+        priority:
+          description: Priority defines the order in which pending migrations are
+            started once the cluster or node migration limits are reached. Defaults
+            to High for evacuations, Low for workload updates and Normal for everything
+            else. High can only be requested by users allowed to migrate in all namespaces
+          enum:
+          - High
+          - Normal
+          - Low
+          type: string
         vmiName:
           description: The name of the VMI to perform the migration on. VMI must exist
             in the migration objects namespace
@@ -12350,6 +13221,17 @@ var CRDsValidation map[string]string = map[string]string{
                     true. Defaults to 800
                   format: int64
                   type: integer
+                compression:
+                  description: Compression is the method used to compress the guest
+                    memory pages sent during live migrations. Zstd compression is
+                    only available together with multiple parallel migration streams,
+                    while xbzrle only sends the delta of pages which were already
+                    transferred. Defaults to none
+                  enum:
+                  - none
+                  - zstd
+                  - xbzrle
+                  type: string
                 disableTLS:
                   description: When set to true, DisableTLS will disable the additional
                     layer of live migration encryption provided by KubeVirt. This
@@ -12373,6 +13255,13 @@ var CRDsValidation map[string]string = map[string]string{
                     a node should be drained. Note: this option relies on the deprecated
                     node taint feature. Default: kubevirt.io/drain'
                   type: string
+                parallelMigrationStreams:
+                  description: ParallelMigrationStreams is the number of parallel
+                    connections used to transfer the guest memory. Using more than
+                    one stream switches the migration to multifd. Defaults to a single
+                    stream
+                  format: int32
+                  type: integer
                 parallelMigrationsPerCluster:
                   description: ParallelMigrationsPerCluster is the total number of
                     concurrent live migrations allowed cluster-wide. Defaults to 5
@@ -12527,6 +13416,17 @@ var CRDsValidation map[string]string = map[string]string{
             clock:
               description: Clock sets the clock and timers of the vmi.
               properties:
+                guestTimeSync:
+                  description: 'GuestTimeSync configures the resynchronization of
+                    the guest clock through the guest agent after the guest was not
+                    running for a while: after unpause, live migration and filesystem
+                    thaw.'
+                  properties:
+                    policy:
+                      description: 'Policy defines when the guest clock is resynchronized.
+                        One of: Auto, Disabled. Defaults to Auto.'
+                      type: string
+                  type: object
                 timer:
                   description: Timer specifies whih timers are attached to the vmi.
                   properties:
@@ -12663,7 +13563,10 @@ var CRDsValidation map[string]string = map[string]string{
                     of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
                     It is possible to specify special cases like "host-passthrough"
                     to get the same CPU as the node and "host-model" to get CPU closest
-                    to the node one. Defaults to host-model.
+                    to the node one. "cluster-baseline" is replaced with the most
+                    recent CPU model and the CPU features supported by all nodes when
+                    the VMI is created, keeping it migratable across the cluster.
+                    Defaults to host-model.
                   type: string
                 numa:
                   description: NUMA allows specifying settings for the guest NUMA
@@ -12692,6 +13595,20 @@ var CRDsValidation map[string]string = map[string]string{
                     vmi. Must be a value greater or equal 1.
                   format: int32
                   type: integer
+                threadPlacement:
+                  description: ThreadPlacement requests additional dedicated pCPUs
+                    to be allocated for the VMI and places the emulator, the I/O threads
+                    and the vhost-net threads on them, away from the vCPUs. Requires
+                    DedicatedCPUPlacement and cannot be combined with IsolateEmulatorThread.
+                  properties:
+                    reservedCPUs:
+                      description: ReservedCPUs is the number of dedicated pCPUs allocated
+                        in addition to the vCPUs. The emulator, the I/O threads and
+                        the vhost-net threads are pinned to them by virt-handler once
+                        the domain is started. Defaults to 1.
+                      format: int32
+                      type: integer
+                  type: object
                 threads:
                   description: Threads specifies the number of threads inside the
                     vmi. Must be a value greater or equal 1.
@@ -12823,6 +13740,78 @@ var CRDsValidation map[string]string = map[string]string{
                         description: 'IO specifies which QEMU disk IO mode should
                           be used. Supported values are: native, default, threads.'
                         type: string
+                      ioTune:
+                        description: If specified, the I/O of the disk is throttled
+                          to the given limits. The limits can be changed on a running
+                          VMI through the iotune subresource.
+                        properties:
+                          maxLengthSeconds:
+                            description: MaxLengthSeconds is how long in seconds the
+                              disk can burst to the maximum limits. Defaults to 1
+                              second.
+                            format: int64
+                            type: integer
+                          readBytesSec:
+                            description: ReadBytesSec limits the read throughput of
+                              the disk in bytes per second.
+                            format: int64
+                            type: integer
+                          readBytesSecMax:
+                            description: ReadBytesSecMax is the read throughput in
+                              bytes per second the disk can burst to.
+                            format: int64
+                            type: integer
+                          readIOPSSec:
+                            description: ReadIOPSSec limits the read I/O operations
+                              per second of the disk.
+                            format: int64
+                            type: integer
+                          readIOPSSecMax:
+                            description: ReadIOPSSecMax is the read I/O operations
+                              per second the disk can burst to.
+                            format: int64
+                            type: integer
+                          totalBytesSec:
+                            description: TotalBytesSec limits the total throughput
+                              of the disk in bytes per second.
+                            format: int64
+                            type: integer
+                          totalBytesSecMax:
+                            description: TotalBytesSecMax is the total throughput
+                              in bytes per second the disk can burst to.
+                            format: int64
+                            type: integer
+                          totalIOPSSec:
+                            description: TotalIOPSSec limits the total I/O operations
+                              per second of the disk.
+                            format: int64
+                            type: integer
+                          totalIOPSSecMax:
+                            description: TotalIOPSSecMax is the total I/O operations
+                              per second the disk can burst to.
+                            format: int64
+                            type: integer
+                          writeBytesSec:
+                            description: WriteBytesSec limits the write throughput
+                              of the disk in bytes per second.
+                            format: int64
+                            type: integer
+                          writeBytesSecMax:
+                            description: WriteBytesSecMax is the write throughput
+                              in bytes per second the disk can burst to.
+                            format: int64
+                            type: integer
+                          writeIOPSSec:
+                            description: WriteIOPSSec limits the write I/O operations
+                              per second of the disk.
+                            format: int64
+                            type: integer
+                          writeIOPSSecMax:
+                            description: WriteIOPSSecMax is the write I/O operations
+                              per second the disk can burst to.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -12943,12 +13932,18 @@ var CRDsValidation map[string]string = map[string]string{
                         description: 'Bus indicates the bus of input device to emulate.
                           Supported values: virtio, usb.'
                         type: string
+                      deviceName:
+                        description: DeviceName is the resource name of the permitted
+                          host input device which is passed through to the guest.
+                          Required for the passthrough type, which only supports the
+                          virtio bus.
+                        type: string
                       name:
                         description: Name is the device name
                         type: string
                       type:
                         description: 'Type indicated the type of input device. Supported
-                          values: tablet.'
+                          values: tablet, passthrough.'
                         type: string
                     required:
                     - name
@@ -13119,8 +14114,8 @@ var CRDsValidation map[string]string = map[string]string{
                   description: Whether to emulate a sound device.
                   properties:
                     model:
-                      description: 'We only support ich9 or ac97. If SoundDevice is
-                        not set: No sound card is emulated. If SoundDevice is set
+                      description: 'We only support ich9, ac97 or virtio. If SoundDevice
+                        is not set: No sound card is emulated. If SoundDevice is set
                         but Model is not: ich9'
                       type: string
                     name:
@@ -13379,6 +14374,49 @@ var CRDsValidation map[string]string = map[string]string{
                     efi:
                       description: If set, EFI will be used instead of BIOS.
                       properties:
+                        nvramTemplate:
+                          description: NVRAMTemplate references a custom UEFI variable
+                            store which is used to initialize the NVRAM instead of
+                            the default OVMF vars file, e.g. to boot with custom enrolled
+                            Secure Boot keys (PK, KEK, db). If the NVRAM is persistent,
+                            the template is only used when no NVRAM was persisted
+                            yet.
+                          properties:
+                            configMap:
+                              description: ConfigMap references a ConfigMap that contains
+                                the variable store as binary data.
+                              properties:
+                                name:
+                                  description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                    TODO: Add other useful fields. apiVersion, kind,
+                                    uid?'
+                                  type: string
+                              type: object
+                            key:
+                              description: Key is the name of the variable store file
+                                in the referenced source. Defaults to OVMF_VARS.fd
+                              type: string
+                            persistentVolumeClaim:
+                              description: PersistentVolumeClaim references a filesystem
+                                PVC that contains the variable store.
+                              properties:
+                                name:
+                                  description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                    TODO: Add other useful fields. apiVersion, kind,
+                                    uid?'
+                                  type: string
+                              type: object
+                            secret:
+                              description: Secret references a k8s Secret that contains
+                                the variable store.
+                              properties:
+                                name:
+                                  description: 'Name of the referent. More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                    TODO: Add other useful fields. apiVersion, kind,
+                                    uid?'
+                                  type: string
+                              type: object
+                          type: object
                         persistent:
                           description: If set to true, Persistent will persist the
                             EFI NVRAM across reboots. Defaults to false
@@ -14653,6 +15691,18 @@ var CRDsValidation map[string]string = map[string]string{
                     clock:
                       description: Clock sets the clock and timers of the vmi.
                       properties:
+                        guestTimeSync:
+                          description: 'GuestTimeSync configures the resynchronization
+                            of the guest clock through the guest agent after the guest
+                            was not running for a while: after unpause, live migration
+                            and filesystem thaw.'
+                          properties:
+                            policy:
+                              description: 'Policy defines when the guest clock is
+                                resynchronized. One of: Auto, Disabled. Defaults to
+                                Auto.'
+                              type: string
+                          type: object
                         timer:
                           description: Timer specifies whih timers are attached to
                             the vmi.
@@ -14802,7 +15852,10 @@ var CRDsValidation map[string]string = map[string]string{
                             List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
                             It is possible to specify special cases like "host-passthrough"
                             to get the same CPU as the node and "host-model" to get
-                            CPU closest to the node one. Defaults to host-model.
+                            CPU closest to the node one. "cluster-baseline" is replaced
+                            with the most recent CPU model and the CPU features supported
+                            by all nodes when the VMI is created, keeping it migratable
+                            across the cluster. Defaults to host-model.
                           type: string
                         numa:
                           description: NUMA allows specifying settings for the guest
@@ -14831,6 +15884,22 @@ var CRDsValidation map[string]string = map[string]string{
                             the vmi. Must be a value greater or equal 1.
                           format: int32
                           type: integer
+                        threadPlacement:
+                          description: ThreadPlacement requests additional dedicated
+                            pCPUs to be allocated for the VMI and places the emulator,
+                            the I/O threads and the vhost-net threads on them, away
+                            from the vCPUs. Requires DedicatedCPUPlacement and cannot
+                            be combined with IsolateEmulatorThread.
+                          properties:
+                            reservedCPUs:
+                              description: ReservedCPUs is the number of dedicated
+                                pCPUs allocated in addition to the vCPUs. The emulator,
+                                the I/O threads and the vhost-net threads are pinned
+                                to them by virt-handler once the domain is started.
+                                Defaults to 1.
+                              format: int32
+                              type: integer
+                          type: object
                         threads:
                           description: Threads specifies the number of threads inside
                             the vmi. Must be a value greater or equal 1.
@@ -14974,6 +16043,78 @@ var CRDsValidation map[string]string = map[string]string{
                                   should be used. Supported values are: native, default,
                                   threads.'
                                 type: string
+                              ioTune:
+                                description: If specified, the I/O of the disk is
+                                  throttled to the given limits. The limits can be
+                                  changed on a running VMI through the iotune subresource.
+                                properties:
+                                  maxLengthSeconds:
+                                    description: MaxLengthSeconds is how long in seconds
+                                      the disk can burst to the maximum limits. Defaults
+                                      to 1 second.
+                                    format: int64
+                                    type: integer
+                                  readBytesSec:
+                                    description: ReadBytesSec limits the read throughput
+                                      of the disk in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  readBytesSecMax:
+                                    description: ReadBytesSecMax is the read throughput
+                                      in bytes per second the disk can burst to.
+                                    format: int64
+                                    type: integer
+                                  readIOPSSec:
+                                    description: ReadIOPSSec limits the read I/O operations
+                                      per second of the disk.
+                                    format: int64
+                                    type: integer
+                                  readIOPSSecMax:
+                                    description: ReadIOPSSecMax is the read I/O operations
+                                      per second the disk can burst to.
+                                    format: int64
+                                    type: integer
+                                  totalBytesSec:
+                                    description: TotalBytesSec limits the total throughput
+                                      of the disk in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  totalBytesSecMax:
+                                    description: TotalBytesSecMax is the total throughput
+                                      in bytes per second the disk can burst to.
+                                    format: int64
+                                    type: integer
+                                  totalIOPSSec:
+                                    description: TotalIOPSSec limits the total I/O
+                                      operations per second of the disk.
+                                    format: int64
+                                    type: integer
+                                  totalIOPSSecMax:
+                                    description: TotalIOPSSecMax is the total I/O
+                                      operations per second the disk can burst to.
+                                    format: int64
+                                    type: integer
+                                  writeBytesSec:
+                                    description: WriteBytesSec limits the write throughput
+                                      of the disk in bytes per second.
+                                    format: int64
+                                    type: integer
+                                  writeBytesSecMax:
+                                    description: WriteBytesSecMax is the write throughput
+                                      in bytes per second the disk can burst to.
+                                    format: int64
+                                    type: integer
+                                  writeIOPSSec:
+                                    description: WriteIOPSSec limits the write I/O
+                                      operations per second of the disk.
+                                    format: int64
+                                    type: integer
+                                  writeIOPSSecMax:
+                                    description: WriteIOPSSecMax is the write I/O
+                                      operations per second the disk can burst to.
+                                    format: int64
+                                    type: integer
+                                type: object
                               lun:
                                 description: Attach a volume as a LUN to the vmi.
                                 properties:
@@ -15099,12 +16240,18 @@ var CRDsValidation map[string]string = map[string]string{
                                 description: 'Bus indicates the bus of input device
                                   to emulate. Supported values: virtio, usb.'
                                 type: string
+                              deviceName:
+                                description: DeviceName is the resource name of the
+                                  permitted host input device which is passed through
+                                  to the guest. Required for the passthrough type,
+                                  which only supports the virtio bus.
+                                type: string
                               name:
                                 description: Name is the device name
                                 type: string
                               type:
                                 description: 'Type indicated the type of input device.
-                                  Supported values: tablet.'
+                                  Supported values: tablet, passthrough.'
                                 type: string
                             required:
                             - name
@@ -15284,9 +16431,9 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Whether to emulate a sound device.
                           properties:
                             model:
-                              description: 'We only support ich9 or ac97. If SoundDevice
-                                is not set: No sound card is emulated. If SoundDevice
-                                is set but Model is not: ich9'
+                              description: 'We only support ich9, ac97 or virtio.
+                                If SoundDevice is not set: No sound card is emulated.
+                                If SoundDevice is set but Model is not: ich9'
                               type: string
                             name:
                               description: User's defined name for this sound device
@@ -15569,6 +16716,56 @@ var CRDsValidation map[string]string = map[string]string{
                             efi:
                               description: If set, EFI will be used instead of BIOS.
                               properties:
+                                nvramTemplate:
+                                  description: NVRAMTemplate references a custom UEFI
+                                    variable store which is used to initialize the
+                                    NVRAM instead of the default OVMF vars file, e.g.
+                                    to boot with custom enrolled Secure Boot keys
+                                    (PK, KEK, db). If the NVRAM is persistent, the
+                                    template is only used when no NVRAM was persisted
+                                    yet.
+                                  properties:
+                                    configMap:
+                                      description: ConfigMap references a ConfigMap
+                                        that contains the variable store as binary
+                                        data.
+                                      properties:
+                                        name:
+                                          description: 'Name of the referent. More
+                                            info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                            TODO: Add other useful fields. apiVersion,
+                                            kind, uid?'
+                                          type: string
+                                      type: object
+                                    key:
+                                      description: Key is the name of the variable
+                                        store file in the referenced source. Defaults
+                                        to OVMF_VARS.fd
+                                      type: string
+                                    persistentVolumeClaim:
+                                      description: PersistentVolumeClaim references
+                                        a filesystem PVC that contains the variable
+                                        store.
+                                      properties:
+                                        name:
+                                          description: 'Name of the referent. More
+                                            info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                            TODO: Add other useful fields. apiVersion,
+                                            kind, uid?'
+                                          type: string
+                                      type: object
+                                    secret:
+                                      description: Secret references a k8s Secret
+                                        that contains the variable store.
+                                      properties:
+                                        name:
+                                          description: 'Name of the referent. More
+                                            info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                            TODO: Add other useful fields. apiVersion,
+                                            kind, uid?'
+                                          type: string
+                                      type: object
+                                  type: object
                                 persistent:
                                   description: If set to true, Persistent will persist
                                     the EFI NVRAM across reboots. Defaults to false
@@ -16684,6 +17881,36 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: string
                             type: object
                         type: object
+                      vhostUserBlk:
+                        description: VhostUserBlk connects the disk to a vhost-user-blk
+                          backend, e.g. an SPDK target, which is served over a unix
+                          socket by a storage plugin sidecar or by a node agent.
+                        properties:
+                          args:
+                            description: Arguments passed to the storage plugin sidecar.
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          image:
+                            description: Image of the storage plugin sidecar which
+                              serves the vhost-user-blk socket. The sidecar receives
+                              the socket path in the VHOST_USER_BLK_SOCKET environment
+                              variable. If empty, the socket is expected to be provided
+                              by a node agent in the /var/run/kubevirt-vhost-user-blk/<namespace>/<vmi
+                              name> host directory.
+                            type: string
+                          queues:
+                            description: Queues is the number of virtqueues of the
+                              disk. Defaults to the number of queues given by blockMultiQueue.
+                            format: int32
+                            type: integer
+                          socketName:
+                            description: SocketName is the file name of the vhost-user-blk
+                              socket, it must not contain a path. Defaults to "<volume
+                              name>.sock" for sidecars and is required for node agents.
+                            type: string
+                        type: object
                     required:
                     - name
                     type: object
@@ -17752,6 +18979,26 @@ var CRDsValidation map[string]string = map[string]string{
                     - spec
                     type: object
                   type: array
+                dependsOn:
+                  description: DependsOn lists the VirtualMachines of the same namespace
+                    which have to be running before this VirtualMachine is started.
+                    When stopped together, dependents are stopped before their dependencies.
+                  items:
+                    description: VirtualMachineDependency references a VirtualMachine
+                      which has to be running before its dependent is started
+                    properties:
+                      condition:
+                        description: Condition the VirtualMachineInstance of the dependency
+                          has to reach, Ready if not set
+                        type: string
+                      name:
+                        description: Name of the VirtualMachine in the same namespace
+                        type: string
+                    required:
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 instancetype:
                   description: InstancetypeMatcher references a instancetype that
                     is used to fill fields in Template
@@ -18935,6 +20182,18 @@ var CRDsValidation map[string]string = map[string]string{
                               description: Clock sets the clock and timers of the
                                 vmi.
                               properties:
+                                guestTimeSync:
+                                  description: 'GuestTimeSync configures the resynchronization
+                                    of the guest clock through the guest agent after
+                                    the guest was not running for a while: after unpause,
+                                    live migration and filesystem thaw.'
+                                  properties:
+                                    policy:
+                                      description: 'Policy defines when the guest
+                                        clock is resynchronized. One of: Auto, Disabled.
+                                        Defaults to Auto.'
+                                      type: string
+                                  type: object
                                 timer:
                                   description: Timer specifies whih timers are attached
                                     to the vmi.
@@ -19094,8 +20353,11 @@ var CRDsValidation map[string]string = map[string]string{
                                     the VMI. List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
                                     It is possible to specify special cases like "host-passthrough"
                                     to get the same CPU as the node and "host-model"
-                                    to get CPU closest to the node one. Defaults to
-                                    host-model.
+                                    to get CPU closest to the node one. "cluster-baseline"
+                                    is replaced with the most recent CPU model and
+                                    the CPU features supported by all nodes when the
+                                    VMI is created, keeping it migratable across the
+                                    cluster. Defaults to host-model.
                                   type: string
                                 numa:
                                   description: NUMA allows specifying settings for
@@ -19128,6 +20390,23 @@ var CRDsValidation map[string]string = map[string]string{
                                     1.
                                   format: int32
                                   type: integer
+                                threadPlacement:
+                                  description: ThreadPlacement requests additional
+                                    dedicated pCPUs to be allocated for the VMI and
+                                    places the emulator, the I/O threads and the vhost-net
+                                    threads on them, away from the vCPUs. Requires
+                                    DedicatedCPUPlacement and cannot be combined with
+                                    IsolateEmulatorThread.
+                                  properties:
+                                    reservedCPUs:
+                                      description: ReservedCPUs is the number of dedicated
+                                        pCPUs allocated in addition to the vCPUs.
+                                        The emulator, the I/O threads and the vhost-net
+                                        threads are pinned to them by virt-handler
+                                        once the domain is started. Defaults to 1.
+                                      format: int32
+                                      type: integer
+                                  type: object
                                 threads:
                                   description: Threads specifies the number of threads
                                     inside the vmi. Must be a value greater or equal
@@ -19280,6 +20559,88 @@ var CRDsValidation map[string]string = map[string]string{
                                           IO mode should be used. Supported values
                                           are: native, default, threads.'
                                         type: string
+                                      ioTune:
+                                        description: If specified, the I/O of the
+                                          disk is throttled to the given limits. The
+                                          limits can be changed on a running VMI through
+                                          the iotune subresource.
+                                        properties:
+                                          maxLengthSeconds:
+                                            description: MaxLengthSeconds is how long
+                                              in seconds the disk can burst to the
+                                              maximum limits. Defaults to 1 second.
+                                            format: int64
+                                            type: integer
+                                          readBytesSec:
+                                            description: ReadBytesSec limits the read
+                                              throughput of the disk in bytes per
+                                              second.
+                                            format: int64
+                                            type: integer
+                                          readBytesSecMax:
+                                            description: ReadBytesSecMax is the read
+                                              throughput in bytes per second the disk
+                                              can burst to.
+                                            format: int64
+                                            type: integer
+                                          readIOPSSec:
+                                            description: ReadIOPSSec limits the read
+                                              I/O operations per second of the disk.
+                                            format: int64
+                                            type: integer
+                                          readIOPSSecMax:
+                                            description: ReadIOPSSecMax is the read
+                                              I/O operations per second the disk can
+                                              burst to.
+                                            format: int64
+                                            type: integer
+                                          totalBytesSec:
+                                            description: TotalBytesSec limits the
+                                              total throughput of the disk in bytes
+                                              per second.
+                                            format: int64
+                                            type: integer
+                                          totalBytesSecMax:
+                                            description: TotalBytesSecMax is the total
+                                              throughput in bytes per second the disk
+                                              can burst to.
+                                            format: int64
+                                            type: integer
+                                          totalIOPSSec:
+                                            description: TotalIOPSSec limits the total
+                                              I/O operations per second of the disk.
+                                            format: int64
+                                            type: integer
+                                          totalIOPSSecMax:
+                                            description: TotalIOPSSecMax is the total
+                                              I/O operations per second the disk can
+                                              burst to.
+                                            format: int64
+                                            type: integer
+                                          writeBytesSec:
+                                            description: WriteBytesSec limits the
+                                              write throughput of the disk in bytes
+                                              per second.
+                                            format: int64
+                                            type: integer
+                                          writeBytesSecMax:
+                                            description: WriteBytesSecMax is the write
+                                              throughput in bytes per second the disk
+                                              can burst to.
+                                            format: int64
+                                            type: integer
+                                          writeIOPSSec:
+                                            description: WriteIOPSSec limits the write
+                                              I/O operations per second of the disk.
+                                            format: int64
+                                            type: integer
+                                          writeIOPSSecMax:
+                                            description: WriteIOPSSecMax is the write
+                                              I/O operations per second the disk can
+                                              burst to.
+                                            format: int64
+                                            type: integer
+                                        type: object
                                       lun:
                                         description: Attach a volume as a LUN to the
                                           vmi.
@@ -19413,12 +20774,19 @@ var CRDsValidation map[string]string = map[string]string{
                                           device to emulate. Supported values: virtio,
                                           usb.'
                                         type: string
+                                      deviceName:
+                                        description: DeviceName is the resource name
+                                          of the permitted host input device which
+                                          is passed through to the guest. Required
+                                          for the passthrough type, which only supports
+                                          the virtio bus.
+                                        type: string
                                       name:
                                         description: Name is the device name
                                         type: string
                                       type:
                                         description: 'Type indicated the type of input
-                                          device. Supported values: tablet.'
+                                          device. Supported values: tablet, passthrough.'
                                         type: string
                                     required:
                                     - name
@@ -19613,10 +20981,10 @@ var CRDsValidation map[string]string = map[string]string{
                                   description: Whether to emulate a sound device.
                                   properties:
                                     model:
-                                      description: 'We only support ich9 or ac97.
-                                        If SoundDevice is not set: No sound card is
-                                        emulated. If SoundDevice is set but Model
-                                        is not: ich9'
+                                      description: 'We only support ich9, ac97 or
+                                        virtio. If SoundDevice is not set: No sound
+                                        card is emulated. If SoundDevice is set but
+                                        Model is not: ich9'
                                       type: string
                                     name:
                                       description: User's defined name for this sound
@@ -19912,6 +21280,58 @@ var CRDsValidation map[string]string = map[string]string{
                                       description: If set, EFI will be used instead
                                         of BIOS.
                                       properties:
+                                        nvramTemplate:
+                                          description: NVRAMTemplate references a
+                                            custom UEFI variable store which is used
+                                            to initialize the NVRAM instead of the
+                                            default OVMF vars file, e.g. to boot with
+                                            custom enrolled Secure Boot keys (PK,
+                                            KEK, db). If the NVRAM is persistent,
+                                            the template is only used when no NVRAM
+                                            was persisted yet.
+                                          properties:
+                                            configMap:
+                                              description: ConfigMap references a
+                                                ConfigMap that contains the variable
+                                                store as binary data.
+                                              properties:
+                                                name:
+                                                  description: 'Name of the referent.
+                                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                    TODO: Add other useful fields.
+                                                    apiVersion, kind, uid?'
+                                                  type: string
+                                              type: object
+                                            key:
+                                              description: Key is the name of the
+                                                variable store file in the referenced
+                                                source. Defaults to OVMF_VARS.fd
+                                              type: string
+                                            persistentVolumeClaim:
+                                              description: PersistentVolumeClaim references
+                                                a filesystem PVC that contains the
+                                                variable store.
+                                              properties:
+                                                name:
+                                                  description: 'Name of the referent.
+                                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                    TODO: Add other useful fields.
+                                                    apiVersion, kind, uid?'
+                                                  type: string
+                                              type: object
+                                            secret:
+                                              description: Secret references a k8s
+                                                Secret that contains the variable
+                                                store.
+                                              properties:
+                                                name:
+                                                  description: 'Name of the referent.
+                                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                    TODO: Add other useful fields.
+                                                    apiVersion, kind, uid?'
+                                                  type: string
+                                              type: object
+                                          type: object
                                         persistent:
                                           description: If set to true, Persistent
                                             will persist the EFI NVRAM across reboots.
@@ -21101,6 +22521,41 @@ var CRDsValidation map[string]string = map[string]string{
                                         type: string
                                     type: object
                                 type: object
+                              vhostUserBlk:
+                                description: VhostUserBlk connects the disk to a vhost-user-blk
+                                  backend, e.g. an SPDK target, which is served over
+                                  a unix socket by a storage plugin sidecar or by
+                                  a node agent.
+                                properties:
+                                  args:
+                                    description: Arguments passed to the storage plugin
+                                      sidecar.
+                                    items:
+                                      type: string
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  image:
+                                    description: Image of the storage plugin sidecar
+                                      which serves the vhost-user-blk socket. The
+                                      sidecar receives the socket path in the VHOST_USER_BLK_SOCKET
+                                      environment variable. If empty, the socket is
+                                      expected to be provided by a node agent in the
+                                      /var/run/kubevirt-vhost-user-blk/<namespace>/<vmi
+                                      name> host directory.
+                                    type: string
+                                  queues:
+                                    description: Queues is the number of virtqueues
+                                      of the disk. Defaults to the number of queues
+                                      given by blockMultiQueue.
+                                    format: int32
+                                    type: integer
+                                  socketName:
+                                    description: SocketName is the file name of the
+                                      vhost-user-blk socket, it must not contain a
+                                      path. Defaults to "<volume name>.sock" for sidecars
+                                      and is required for node agents.
+                                    type: string
+                                type: object
                             required:
                             - name
                             type: object
@@ -21389,6 +22844,17 @@ var CRDsValidation map[string]string = map[string]string{
               description: PreferredInputType optionally defines the preferred type
                 for Input devices.
               type: string
+            preferredInterfaceBinding:
+              description: PreferredInterfaceBinding optionally defines the preferred
+                binding plugin to use with each network interface.
+              properties:
+                name:
+                  description: 'Name references to the binding name as denined in
+                    the kubevirt CR. version: 1alphav1'
+                  type: string
+              required:
+              - name
+              type: object
             preferredInterfaceMasquerade:
               description: PreferredInterfaceMasquerade optionally defines the preferred
                 masquerade configuration to use with each network interface.
@@ -21714,6 +23180,10 @@ var CRDsValidation map[string]string = map[string]string{
           description: Volumes optionally defines preferences associated with the
             Volumes attribute of a VirtualMachineInstace DomainSpec
           properties:
+            preferredAccessMode:
+              description: PreferredAccessMode optionally defines the preferred access
+                mode of DataVolumeTemplates not requesting one
+              type: string
             preferredStorageClassName:
               description: PreffereedStorageClassName optionally defines the preferred
                 storageClass
@@ -22758,6 +24228,27 @@ var CRDsValidation map[string]string = map[string]string{
                         - spec
                         type: object
                       type: array
+                    dependsOn:
+                      description: DependsOn lists the VirtualMachines of the same
+                        namespace which have to be running before this VirtualMachine
+                        is started. When stopped together, dependents are stopped
+                        before their dependencies.
+                      items:
+                        description: VirtualMachineDependency references a VirtualMachine
+                          which has to be running before its dependent is started
+                        properties:
+                          condition:
+                            description: Condition the VirtualMachineInstance of the
+                              dependency has to reach, Ready if not set
+                            type: string
+                          name:
+                            description: Name of the VirtualMachine in the same namespace
+                            type: string
+                        required:
+                        - name
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
                     instancetype:
                       description: InstancetypeMatcher references a instancetype that
                         is used to fill fields in Template
@@ -24016,6 +25507,19 @@ var CRDsValidation map[string]string = map[string]string{
                                   description: Clock sets the clock and timers of
                                     the vmi.
                                   properties:
+                                    guestTimeSync:
+                                      description: 'GuestTimeSync configures the resynchronization
+                                        of the guest clock through the guest agent
+                                        after the guest was not running for a while:
+                                        after unpause, live migration and filesystem
+                                        thaw.'
+                                      properties:
+                                        policy:
+                                          description: 'Policy defines when the guest
+                                            clock is resynchronized. One of: Auto,
+                                            Disabled. Defaults to Auto.'
+                                          type: string
+                                      type: object
                                     timer:
                                       description: Timer specifies whih timers are
                                         attached to the vmi.
@@ -24180,7 +25684,11 @@ var CRDsValidation map[string]string = map[string]string{
                                         It is possible to specify special cases like
                                         "host-passthrough" to get the same CPU as
                                         the node and "host-model" to get CPU closest
-                                        to the node one. Defaults to host-model.
+                                        to the node one. "cluster-baseline" is replaced
+                                        with the most recent CPU model and the CPU
+                                        features supported by all nodes when the VMI
+                                        is created, keeping it migratable across the
+                                        cluster. Defaults to host-model.
                                       type: string
                                     numa:
                                       description: NUMA allows specifying settings
@@ -24213,6 +25721,24 @@ var CRDsValidation map[string]string = map[string]string{
                                         or equal 1.
                                       format: int32
                                       type: integer
+                                    threadPlacement:
+                                      description: ThreadPlacement requests additional
+                                        dedicated pCPUs to be allocated for the VMI
+                                        and places the emulator, the I/O threads and
+                                        the vhost-net threads on them, away from the
+                                        vCPUs. Requires DedicatedCPUPlacement and
+                                        cannot be combined with IsolateEmulatorThread.
+                                      properties:
+                                        reservedCPUs:
+                                          description: ReservedCPUs is the number
+                                            of dedicated pCPUs allocated in addition
+                                            to the vCPUs. The emulator, the I/O threads
+                                            and the vhost-net threads are pinned to
+                                            them by virt-handler once the domain is
+                                            started. Defaults to 1.
+                                          format: int32
+                                          type: integer
+                                      type: object
                                     threads:
                                       description: Threads specifies the number of
                                         threads inside the vmi. Must be a value greater
@@ -24371,6 +25897,92 @@ var CRDsValidation map[string]string = map[string]string{
                                               disk IO mode should be used. Supported
                                               values are: native, default, threads.'
                                             type: string
+                                          ioTune:
+                                            description: If specified, the I/O of
+                                              the disk is throttled to the given limits.
+                                              The limits can be changed on a running
+                                              VMI through the iotune subresource.
+                                            properties:
+                                              maxLengthSeconds:
+                                                description: MaxLengthSeconds is how
+                                                  long in seconds the disk can burst
+                                                  to the maximum limits. Defaults
+                                                  to 1 second.
+                                                format: int64
+                                                type: integer
+                                              readBytesSec:
+                                                description: ReadBytesSec limits the
+                                                  read throughput of the disk in bytes
+                                                  per second.
+                                                format: int64
+                                                type: integer
+                                              readBytesSecMax:
+                                                description: ReadBytesSecMax is the
+                                                  read throughput in bytes per second
+                                                  the disk can burst to.
+                                                format: int64
+                                                type: integer
+                                              readIOPSSec:
+                                                description: ReadIOPSSec limits the
+                                                  read I/O operations per second of
+                                                  the disk.
+                                                format: int64
+                                                type: integer
+                                              readIOPSSecMax:
+                                                description: ReadIOPSSecMax is the
+                                                  read I/O operations per second the
+                                                  disk can burst to.
+                                                format: int64
+                                                type: integer
+                                              totalBytesSec:
+                                                description: TotalBytesSec limits
+                                                  the total throughput of the disk
+                                                  in bytes per second.
+                                                format: int64
+                                                type: integer
+                                              totalBytesSecMax:
+                                                description: TotalBytesSecMax is the
+                                                  total throughput in bytes per second
+                                                  the disk can burst to.
+                                                format: int64
+                                                type: integer
+                                              totalIOPSSec:
+                                                description: TotalIOPSSec limits the
+                                                  total I/O operations per second
+                                                  of the disk.
+                                                format: int64
+                                                type: integer
+                                              totalIOPSSecMax:
+                                                description: TotalIOPSSecMax is the
+                                                  total I/O operations per second
+                                                  the disk can burst to.
+                                                format: int64
+                                                type: integer
+                                              writeBytesSec:
+                                                description: WriteBytesSec limits
+                                                  the write throughput of the disk
+                                                  in bytes per second.
+                                                format: int64
+                                                type: integer
+                                              writeBytesSecMax:
+                                                description: WriteBytesSecMax is the
+                                                  write throughput in bytes per second
+                                                  the disk can burst to.
+                                                format: int64
+                                                type: integer
+                                              writeIOPSSec:
+                                                description: WriteIOPSSec limits the
+                                                  write I/O operations per second
+                                                  of the disk.
+                                                format: int64
+                                                type: integer
+                                              writeIOPSSecMax:
+                                                description: WriteIOPSSecMax is the
+                                                  write I/O operations per second
+                                                  the disk can burst to.
+                                                format: int64
+                                                type: integer
+                                            type: object
                                           lun:
                                             description: Attach a volume as a LUN
                                               to the vmi.
@@ -24511,12 +26123,20 @@ var CRDsValidation map[string]string = map[string]string{
                                               input device to emulate. Supported values:
                                               virtio, usb.'
                                             type: string
+                                          deviceName:
+                                            description: DeviceName is the resource
+                                              name of the permitted host input device
+                                              which is passed through to the guest.
+                                              Required for the passthrough type, which
+                                              only supports the virtio bus.
+                                            type: string
                                           name:
                                             description: Name is the device name
                                             type: string
                                           type:
                                             description: 'Type indicated the type
-                                              of input device. Supported values: tablet.'
+                                              of input device. Supported values: tablet,
+                                              passthrough.'
                                             type: string
                                         required:
                                         - name
@@ -24719,10 +26339,10 @@ var CRDsValidation map[string]string = map[string]string{
                                       description: Whether to emulate a sound device.
                                       properties:
                                         model:
-                                          description: 'We only support ich9 or ac97.
-                                            If SoundDevice is not set: No sound card
-                                            is emulated. If SoundDevice is set but
-                                            Model is not: ich9'
+                                          description: 'We only support ich9, ac97
+                                            or virtio. If SoundDevice is not set:
+                                            No sound card is emulated. If SoundDevice
+                                            is set but Model is not: ich9'
                                           type: string
                                         name:
                                           description: User's defined name for this
@@ -25027,6 +26647,59 @@ var CRDsValidation map[string]string = map[string]string{
                                           description: If set, EFI will be used instead
                                             of BIOS.
                                           properties:
+                                            nvramTemplate:
+                                              description: NVRAMTemplate references
+                                                a custom UEFI variable store which
+                                                is used to initialize the NVRAM instead
+                                                of the default OVMF vars file, e.g.
+                                                to boot with custom enrolled Secure
+                                                Boot keys (PK, KEK, db). If the NVRAM
+                                                is persistent, the template is only
+                                                used when no NVRAM was persisted yet.
+                                              properties:
+                                                configMap:
+                                                  description: ConfigMap references
+                                                    a ConfigMap that contains the
+                                                    variable store as binary data.
+                                                  properties:
+                                                    name:
+                                                      description: 'Name of the referent.
+                                                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                        TODO: Add other useful fields.
+                                                        apiVersion, kind, uid?'
+                                                      type: string
+                                                  type: object
+                                                key:
+                                                  description: Key is the name of
+                                                    the variable store file in the
+                                                    referenced source. Defaults to
+                                                    OVMF_VARS.fd
+                                                  type: string
+                                                persistentVolumeClaim:
+                                                  description: PersistentVolumeClaim
+                                                    references a filesystem PVC that
+                                                    contains the variable store.
+                                                  properties:
+                                                    name:
+                                                      description: 'Name of the referent.
+                                                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                        TODO: Add other useful fields.
+                                                        apiVersion, kind, uid?'
+                                                      type: string
+                                                  type: object
+                                                secret:
+                                                  description: Secret references a
+                                                    k8s Secret that contains the variable
+                                                    store.
+                                                  properties:
+                                                    name:
+                                                      description: 'Name of the referent.
+                                                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                        TODO: Add other useful fields.
+                                                        apiVersion, kind, uid?'
+                                                      type: string
+                                                  type: object
+                                              type: object
                                             persistent:
                                               description: If set to true, Persistent
                                                 will persist the EFI NVRAM across
@@ -26263,6 +27936,41 @@ var CRDsValidation map[string]string = map[string]string{
                                             type: string
                                         type: object
                                     type: object
+                                  vhostUserBlk:
+                                    description: VhostUserBlk connects the disk to
+                                      a vhost-user-blk backend, e.g. an SPDK target,
+                                      which is served over a unix socket by a storage
+                                      plugin sidecar or by a node agent.
+                                    properties:
+                                      args:
+                                        description: Arguments passed to the storage
+                                          plugin sidecar.
+                                        items:
+                                          type: string
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                      image:
+                                        description: Image of the storage plugin sidecar
+                                          which serves the vhost-user-blk socket.
+                                          The sidecar receives the socket path in
+                                          the VHOST_USER_BLK_SOCKET environment variable.
+                                          If empty, the socket is expected to be provided
+                                          by a node agent in the /var/run/kubevirt-vhost-user-blk/<namespace>/<vmi
+                                          name> host directory.
+                                        type: string
+                                      queues:
+                                        description: Queues is the number of virtqueues
+                                          of the disk. Defaults to the number of queues
+                                          given by blockMultiQueue.
+                                        format: int32
+                                        type: integer
+                                      socketName:
+                                        description: SocketName is the file name of
+                                          the vhost-user-blk socket, it must not contain
+                                          a path. Defaults to "<volume name>.sock"
+                                          for sidecars and is required for node agents.
+                                        type: string
+                                    type: object
                                 required:
                                 - name
                                 type: object
@@ -26362,6 +28070,38 @@ var CRDsValidation map[string]string = map[string]string{
                         the vmi when started.
                       format: int64
                       type: integer
+                    pendingChanges:
+                      description: PendingChanges lists the changes of the VM template
+                        or of its instancetype which are not applied to the running
+                        VMI yet.
+                      items:
+                        description: VirtualMachinePendingChange is a difference between
+                          the desired spec of a VM and its running VMI
+                        properties:
+                          current:
+                            description: Current is the JSON encoded value of the
+                              field on the running VMI, empty if the field is not
+                              set
+                            type: string
+                          desired:
+                            description: Desired is the JSON encoded value of the
+                              field in the VM, empty if the field was removed
+                            type: string
+                          liveUpdatable:
+                            description: LiveUpdatable indicates that the change is
+                              applied to the running VMI without a restart
+                            type: boolean
+                          path:
+                            description: Path of the changed field in the VMI spec,
+                              e.g. spec.domain.cpu.sockets. Entries of named lists
+                              are addressed by their name, e.g. spec.volumes[name=data].
+                            type: string
+                        required:
+                        - liveUpdatable
+                        - path
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
                     printableStatus:
                       description: PrintableStatus is a human readable, high-level
                         representation of the status of the virtual machine
@@ -26528,6 +28268,84 @@ var CRDsValidation map[string]string = map[string]string{
                                       mode should be used. Supported values are: native,
                                       default, threads.'
                                     type: string
+                                  ioTune:
+                                    description: If specified, the I/O of the disk
+                                      is throttled to the given limits. The limits
+                                      can be changed on a running VMI through the
+                                      iotune subresource.
+                                    properties:
+                                      maxLengthSeconds:
+                                        description: MaxLengthSeconds is how long
+                                          in seconds the disk can burst to the maximum
+                                          limits. Defaults to 1 second.
+                                        format: int64
+                                        type: integer
+                                      readBytesSec:
+                                        description: ReadBytesSec limits the read
+                                          throughput of the disk in bytes per second.
+                                        format: int64
+                                        type: integer
+                                      readBytesSecMax:
+                                        description: ReadBytesSecMax is the read throughput
+                                          in bytes per second the disk can burst to.
+                                        format: int64
+                                        type: integer
+                                      readIOPSSec:
+                                        description: ReadIOPSSec limits the read I/O
+                                          operations per second of the disk.
+                                        format: int64
+                                        type: integer
+                                      readIOPSSecMax:
+                                        description: ReadIOPSSecMax is the read I/O
+                                          operations per second the disk can burst
+                                          to.
+                                        format: int64
+                                        type: integer
+                                      totalBytesSec:
+                                        description: TotalBytesSec limits the total
+                                          throughput of the disk in bytes per second.
+                                        format: int64
+                                        type: integer
+                                      totalBytesSecMax:
+                                        description: TotalBytesSecMax is the total
+                                          throughput in bytes per second the disk
+                                          can burst to.
+                                        format: int64
+                                        type: integer
+                                      totalIOPSSec:
+                                        description: TotalIOPSSec limits the total
+                                          I/O operations per second of the disk.
+                                        format: int64
+                                        type: integer
+                                      totalIOPSSecMax:
+                                        description: TotalIOPSSecMax is the total
+                                          I/O operations per second the disk can burst
+                                          to.
+                                        format: int64
+                                        type: integer
+                                      writeBytesSec:
+                                        description: WriteBytesSec limits the write
+                                          throughput of the disk in bytes per second.
+                                        format: int64
+                                        type: integer
+                                      writeBytesSecMax:
+                                        description: WriteBytesSecMax is the write
+                                          throughput in bytes per second the disk
+                                          can burst to.
+                                        format: int64
+                                        type: integer
+                                      writeIOPSSec:
+                                        description: WriteIOPSSec limits the write
+                                          I/O operations per second of the disk.
+                                        format: int64
+                                        type: integer
+                                      writeIOPSSecMax:
+                                        description: WriteIOPSSecMax is the write
+                                          I/O operations per second the disk can burst
+                                          to.
+                                        format: int64
+                                        type: integer
+                                    type: object
                                   lun:
                                     description: Attach a volume as a LUN to the vmi.
                                     properties:
@@ -89,6 +89,17 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "delete", "patch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					"nodes",
+				},
+				Verbs: []string{
+					"get", "list",
+				},
+			},
 			{
 				APIGroups: []string{
 					GroupName,
@@ -267,6 +278,7 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"create",
 					"list",
 					"get",
+					"delete",
 				},
 			},
 		},
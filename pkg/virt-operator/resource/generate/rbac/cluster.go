@@ -41,11 +41,17 @@ const (
 	VMInstancesGuestOSInfo = "virtualmachineinstances/guestosinfo"
 	VMInstancesFileSysList = "virtualmachineinstances/filesystemlist"
 	VMInstancesUserList    = "virtualmachineinstances/userlist"
+	VMInstancesGuestInfo   = "virtualmachineinstances/guestinfo"
+	VMInstancesStats       = "virtualmachineinstances/stats"
+	VMInstancesDomainXML   = "virtualmachineinstances/domainxml"
 
 	VMInstancesSEVFetchCertChain         = "virtualmachineinstances/sev/fetchcertchain"
 	VMInstancesSEVQueryLaunchMeasurement = "virtualmachineinstances/sev/querylaunchmeasurement"
 	VMInstancesSEVSetupSession           = "virtualmachineinstances/sev/setupsession"
 	VMInstancesSEVInjectLaunchSecret     = "virtualmachineinstances/sev/injectlaunchsecret"
+
+	NodesEvacuationPlan = "nodes/evacuationplan"
+	NodesEvacuate       = "nodes/evacuate"
 )
 
 func GetAllCluster() []runtime.Object {
@@ -152,6 +158,9 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					VMInstancesGuestOSInfo,
 					VMInstancesFileSysList,
 					VMInstancesUserList,
+					VMInstancesGuestInfo,
+					VMInstancesStats,
+					VMInstancesDomainXML,
 					VMInstancesSEVFetchCertChain,
 					VMInstancesSEVQueryLaunchMeasurement,
 				},
@@ -168,6 +177,7 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					"virtualmachineinstances/unpause",
 					"virtualmachineinstances/addvolume",
 					"virtualmachineinstances/removevolume",
+					"virtualmachineinstances/iotune",
 					"virtualmachineinstances/freeze",
 					"virtualmachineinstances/unfreeze",
 					"virtualmachineinstances/softreboot",
@@ -200,6 +210,9 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					"virtualmachines/restart",
 					"virtualmachines/addvolume",
 					"virtualmachines/removevolume",
+					"virtualmachines/iotune",
+					"virtualmachines/upgrade-instancetype",
+					"virtualmachines/resize",
 					"virtualmachines/migrate",
 					"virtualmachines/memorydump",
 				},
@@ -218,6 +231,28 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					"update",
 				},
 			},
+			{
+				APIGroups: []string{
+					GroupNameSubresources,
+				},
+				Resources: []string{
+					NodesEvacuationPlan,
+				},
+				Verbs: []string{
+					"get",
+				},
+			},
+			{
+				APIGroups: []string{
+					GroupNameSubresources,
+				},
+				Resources: []string{
+					NodesEvacuate,
+				},
+				Verbs: []string{
+					"update",
+				},
+			},
 			{
 				APIGroups: []string{
 					GroupName,
@@ -334,6 +369,9 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					VMInstancesGuestOSInfo,
 					VMInstancesFileSysList,
 					VMInstancesUserList,
+					VMInstancesGuestInfo,
+					VMInstancesStats,
+					VMInstancesDomainXML,
 					VMInstancesSEVFetchCertChain,
 					VMInstancesSEVQueryLaunchMeasurement,
 				},
@@ -350,6 +388,7 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					"virtualmachineinstances/unpause",
 					"virtualmachineinstances/addvolume",
 					"virtualmachineinstances/removevolume",
+					"virtualmachineinstances/iotune",
 					"virtualmachineinstances/freeze",
 					"virtualmachineinstances/unfreeze",
 					"virtualmachineinstances/softreboot",
@@ -382,6 +421,9 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					"virtualmachines/restart",
 					"virtualmachines/addvolume",
 					"virtualmachines/removevolume",
+					"virtualmachines/iotune",
+					"virtualmachines/upgrade-instancetype",
+					"virtualmachines/resize",
 					"virtualmachines/migrate",
 					"virtualmachines/memorydump",
 				},
@@ -524,8 +566,11 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					VMInstancesGuestOSInfo,
 					VMInstancesFileSysList,
 					VMInstancesUserList,
+					VMInstancesGuestInfo,
+					VMInstancesStats,
 					VMInstancesSEVFetchCertChain,
 					VMInstancesSEVQueryLaunchMeasurement,
+					NodesEvacuationPlan,
 				},
 				Verbs: []string{
 					"get",
@@ -0,0 +1,63 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ */
+
+package rbac
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+var _ = Describe("Cluster roles", func() {
+
+	DescribeTable("should grant the node evacuation subresources", func(clusterRole *rbacv1.ClusterRole, resource, verb string, expected bool) {
+		Expect(grants(clusterRole.Rules, GroupNameSubresources, resource, verb)).To(Equal(expected))
+	},
+		Entry("plan to admin", newAdminClusterRole(), NodesEvacuationPlan, "get", true),
+		Entry("evacuate to admin", newAdminClusterRole(), NodesEvacuate, "update", true),
+		Entry("plan to view", newViewClusterRole(), NodesEvacuationPlan, "get", true),
+		Entry("not evacuate to view", newViewClusterRole(), NodesEvacuate, "update", false),
+		Entry("not evacuate to edit", newEditClusterRole(), NodesEvacuate, "update", false),
+	)
+
+	DescribeTable("should allow virt-api to look up nodes", func(verb string) {
+		Expect(grants(newApiServerClusterRole().Rules, "", "nodes", verb)).To(BeTrue())
+	},
+		Entry("with get", "get"),
+		Entry("with list", "list"),
+	)
+})
+
+func grants(rules []rbacv1.PolicyRule, apiGroup, resource, verb string) bool {
+	for _, rule := range rules {
+		if contains(rule.APIGroups, apiGroup) && contains(rule.Resources, resource) && contains(rule.Verbs, verb) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
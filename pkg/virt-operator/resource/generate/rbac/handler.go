@@ -95,6 +95,17 @@ func newHandlerClusterRole() *rbacv1.ClusterRole {
 					"get",
 				},
 			},
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					"nodes/status",
+				},
+				Verbs: []string{
+					"patch",
+				},
+			},
 			{
 				APIGroups: []string{
 					"",
@@ -23,7 +23,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strconv"
+	"time"
 
 	kvtls "kubevirt.io/kubevirt/pkg/util/tls"
 
@@ -48,6 +50,11 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/apply"
 )
 
+const (
+	maintenanceWindowStartTimeLayout = "15:04"
+	maxMaintenanceWindowDuration     = 7 * 24 * time.Hour
+)
+
 // KubeVirtUpdateAdmitter validates KubeVirt updates
 type KubeVirtUpdateAdmitter struct {
 	Client        kubecli.KubevirtClient
@@ -110,6 +117,21 @@ func (admitter *KubeVirtUpdateAdmitter) Admit(ar *admissionv1.AdmissionReview) *
 		results = append(results, validateInfraReplicas(newKV.Spec.Infra.Replicas)...)
 	}
 
+	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.GuestAgentConfiguration, newKV.Spec.Configuration.GuestAgentConfiguration) {
+		results = append(results,
+			validateGuestAgentConfiguration(field.NewPath("spec").Child("configuration", "guestAgentConfiguration"), newKV.Spec.Configuration.GuestAgentConfiguration)...)
+	}
+
+	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.MigrationConfiguration, newKV.Spec.Configuration.MigrationConfiguration) {
+		results = append(results,
+			validateMigrationConfiguration(field.NewPath("spec").Child("configuration", "migrations"), newKV.Spec.Configuration.MigrationConfiguration)...)
+	}
+
+	if !equality.Semantic.DeepEqual(currKV.Spec.WorkloadUpdateStrategy, newKV.Spec.WorkloadUpdateStrategy) {
+		results = append(results,
+			validateWorkloadUpdateStrategy(field.NewPath("spec").Child("workloadUpdateStrategy"), &newKV.Spec.WorkloadUpdateStrategy)...)
+	}
+
 	response := validating_webhooks.NewAdmissionResponse(results)
 
 	if featureGatesChanged(&currKV.Spec, &newKV.Spec) {
@@ -308,6 +330,71 @@ func validateSeccompConfiguration(field *field.Path, seccompConf *v1.SeccompConf
 
 }
 
+func validateMigrationConfiguration(field *field.Path, migrationConf *v1.MigrationConfiguration) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+	if migrationConf == nil {
+		return statuses
+	}
+
+	streams := migrationConf.ParallelMigrationStreams
+	if streams != nil && *streams == 0 {
+		statuses = append(statuses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.Child("parallelMigrationStreams").String(),
+			Message: fmt.Sprintf("%s must be greater than zero", field.Child("parallelMigrationStreams").String()),
+		})
+	}
+
+	if compression := migrationConf.Compression; compression != nil {
+		switch *compression {
+		case v1.MigrationCompressionNone, v1.MigrationCompressionZstd:
+		case v1.MigrationCompressionXBZRLE:
+			// libvirt refuses to migrate with xbzrle over multiple connections
+			if streams != nil && *streams > 1 {
+				statuses = append(statuses, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Field:   field.Child("compression").String(),
+					Message: fmt.Sprintf("%s %s can not be used with more than one parallel migration stream", field.Child("compression").String(), *compression),
+				})
+			}
+		default:
+			statuses = append(statuses, metav1.StatusCause{
+				Type:  metav1.CauseTypeFieldValueNotSupported,
+				Field: field.Child("compression").String(),
+				Message: fmt.Sprintf("%s %s is not supported, supported values are %s, %s and %s", field.Child("compression").String(), *compression,
+					v1.MigrationCompressionNone, v1.MigrationCompressionZstd, v1.MigrationCompressionXBZRLE),
+			})
+		}
+	}
+
+	return statuses
+}
+
+// readOnlyGuestAgentCommand matches the guest agent commands which only query the guest.
+// Custom commands run in every VMI on every poll, so commands changing the guest, like
+// guest-exec or guest-set-user-password, must never be accepted.
+var readOnlyGuestAgentCommand = regexp.MustCompile(`^(guest-info|guest-get-[a-z0-9]+(-[a-z0-9]+)*)$`)
+
+func validateGuestAgentConfiguration(field *field.Path, agentConf *v1.GuestAgentConfiguration) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+	if agentConf == nil {
+		return statuses
+	}
+
+	for i, command := range agentConf.CustomCommands {
+		commandField := field.Child("customCommands").Index(i)
+		if !readOnlyGuestAgentCommand.MatchString(command) {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   commandField.String(),
+				Message: fmt.Sprintf("%s is not a read-only guest agent command, only guest-info and guest-get-* are allowed: %q", commandField.String(), command),
+			})
+		}
+	}
+
+	return statuses
+}
+
 func validateWorkloadPlacement(namespace string, placementConfig *v1.NodePlacement, client kubecli.KubevirtClient) []metav1.StatusCause {
 	statuses := []metav1.StatusCause{}
 
@@ -418,6 +505,56 @@ func validateInfraPlacement(namespace string, placementConfig *v1.NodePlacement,
 	return statuses
 }
 
+func validateWorkloadUpdateStrategy(field *field.Path, strategy *v1.KubeVirtWorkloadUpdateStrategy) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+
+	for i, window := range strategy.MaintenanceWindows {
+		windowField := field.Child("maintenanceWindows").Index(i)
+		if _, err := time.Parse(maintenanceWindowStartTimeLayout, window.StartTime); err != nil {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   windowField.Child("startTime").String(),
+				Message: fmt.Sprintf("%s must be a time of the day in the format HH:MM, got %q", windowField.Child("startTime").String(), window.StartTime),
+			})
+		}
+		if window.Duration.Duration <= 0 || window.Duration.Duration > maxMaintenanceWindowDuration {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   windowField.Child("duration").String(),
+				Message: fmt.Sprintf("%s must be longer than 0 and at most %s", windowField.Child("duration").String(), maxMaintenanceWindowDuration),
+			})
+		}
+	}
+
+	for _, percentage := range []struct {
+		name  string
+		value *int
+	}{
+		{"canaryPercentage", strategy.CanaryPercentage},
+		{"maxMigrationFailurePercentage", strategy.MaxMigrationFailurePercentage},
+	} {
+		if percentage.value != nil && (*percentage.value < 0 || *percentage.value > 100) {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   field.Child(percentage.name).String(),
+				Message: fmt.Sprintf("%s must be between 0 and 100", field.Child(percentage.name).String()),
+			})
+		}
+	}
+
+	if strategy.ExcludedSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(strategy.ExcludedSelector); err != nil {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   field.Child("excludedSelector").String(),
+				Message: err.Error(),
+			})
+		}
+	}
+
+	return statuses
+}
+
 func validateInfraReplicas(replicas *uint8) []metav1.StatusCause {
 	statuses := []metav1.StatusCause{}
 
@@ -23,6 +23,7 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -66,6 +67,106 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 		}, []string{vmProfileField.Child("customProfile", "runtimeDefaultProfile").String(), vmProfileField.Child("customProfile", "localhostProfile").String()}),
 	)
 
+	DescribeTable("validateGuestAgentConfiguration", func(agentConfiguration *v1.GuestAgentConfiguration, expectedFields []string) {
+		causes := validateGuestAgentConfiguration(test, agentConfiguration)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("without configuration", nil, []string{}),
+		Entry("with valid commands", &v1.GuestAgentConfiguration{
+			CustomCommands: []string{"guest-get-timezone", "guest-get-hostname"},
+		}, []string{}),
+		Entry("with a command not prefixed with guest-", &v1.GuestAgentConfiguration{
+			CustomCommands: []string{"guest-get-timezone", "get-hostname"},
+		}, []string{test.Child("customCommands").Index(1).String()}),
+		Entry("with a command containing a comma", &v1.GuestAgentConfiguration{
+			CustomCommands: []string{"guest-get-timezone,guest-get-hostname"},
+		}, []string{test.Child("customCommands").Index(0).String()}),
+		Entry("with guest-info", &v1.GuestAgentConfiguration{
+			CustomCommands: []string{"guest-info"},
+		}, []string{}),
+		Entry("with commands changing the guest", &v1.GuestAgentConfiguration{
+			CustomCommands: []string{"guest-exec", "guest-shutdown", "guest-set-user-password", "guest-file-write"},
+		}, []string{
+			test.Child("customCommands").Index(0).String(),
+			test.Child("customCommands").Index(1).String(),
+			test.Child("customCommands").Index(2).String(),
+			test.Child("customCommands").Index(3).String(),
+		}),
+		Entry("with a command containing a quote", &v1.GuestAgentConfiguration{
+			CustomCommands: []string{`guest-get-time","arguments":{}`},
+		}, []string{test.Child("customCommands").Index(0).String()}),
+	)
+
+	DescribeTable("validateMigrationConfiguration", func(migrationConfiguration *v1.MigrationConfiguration, expectedFields []string) {
+		causes := validateMigrationConfiguration(test, migrationConfiguration)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("without configuration", nil, []string{}),
+		Entry("with parallel streams and zstd", &v1.MigrationConfiguration{
+			ParallelMigrationStreams: pointer.Uint32(4),
+			Compression:              migrationCompressionPtr(v1.MigrationCompressionZstd),
+		}, []string{}),
+		Entry("with xbzrle and a single stream", &v1.MigrationConfiguration{
+			ParallelMigrationStreams: pointer.Uint32(1),
+			Compression:              migrationCompressionPtr(v1.MigrationCompressionXBZRLE),
+		}, []string{}),
+		Entry("with zero parallel streams", &v1.MigrationConfiguration{
+			ParallelMigrationStreams: pointer.Uint32(0),
+		}, []string{test.Child("parallelMigrationStreams").String()}),
+		Entry("with an unknown compression", &v1.MigrationConfiguration{
+			Compression: migrationCompressionPtr("lz4"),
+		}, []string{test.Child("compression").String()}),
+		Entry("with xbzrle and parallel streams", &v1.MigrationConfiguration{
+			ParallelMigrationStreams: pointer.Uint32(4),
+			Compression:              migrationCompressionPtr(v1.MigrationCompressionXBZRLE),
+		}, []string{test.Child("compression").String()}),
+	)
+
+	DescribeTable("validateWorkloadUpdateStrategy", func(strategy *v1.KubeVirtWorkloadUpdateStrategy, expectedFields []string) {
+		causes := validateWorkloadUpdateStrategy(test, strategy)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("without rollout controls", &v1.KubeVirtWorkloadUpdateStrategy{}, []string{}),
+		Entry("with valid rollout controls", &v1.KubeVirtWorkloadUpdateStrategy{
+			MaintenanceWindows: []v1.WorkloadUpdateMaintenanceWindow{{
+				Days:      []v1.WeekDay{"Saturday", "Sunday"},
+				StartTime: "22:30",
+				Duration:  metav1.Duration{Duration: 4 * time.Hour},
+			}},
+			ExcludedSelector:              &metav1.LabelSelector{MatchLabels: map[string]string{"update": "manual"}},
+			CanaryPercentage:              pointer.Int(10),
+			MaxMigrationFailurePercentage: pointer.Int(0),
+		}, []string{}),
+		Entry("with an invalid maintenance window", &v1.KubeVirtWorkloadUpdateStrategy{
+			MaintenanceWindows: []v1.WorkloadUpdateMaintenanceWindow{
+				{StartTime: "01:00", Duration: metav1.Duration{Duration: time.Hour}},
+				{StartTime: "25:00", Duration: metav1.Duration{Duration: 0}},
+			},
+		}, []string{
+			test.Child("maintenanceWindows").Index(1).Child("startTime").String(),
+			test.Child("maintenanceWindows").Index(1).Child("duration").String(),
+		}),
+		Entry("with a maintenance window longer than a week", &v1.KubeVirtWorkloadUpdateStrategy{
+			MaintenanceWindows: []v1.WorkloadUpdateMaintenanceWindow{{StartTime: "01:00", Duration: metav1.Duration{Duration: 8 * 24 * time.Hour}}},
+		}, []string{test.Child("maintenanceWindows").Index(0).Child("duration").String()}),
+		Entry("with percentages out of range", &v1.KubeVirtWorkloadUpdateStrategy{
+			CanaryPercentage:              pointer.Int(101),
+			MaxMigrationFailurePercentage: pointer.Int(-1),
+		}, []string{test.Child("canaryPercentage").String(), test.Child("maxMigrationFailurePercentage").String()}),
+		Entry("with an invalid excluded selector", &v1.KubeVirtWorkloadUpdateStrategy{
+			ExcludedSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "update", Operator: "Unknown"}}},
+		}, []string{test.Child("excludedSelector").String()}),
+	)
+
 	DescribeTable("test validateCustomizeComponents", func(cc v1.CustomizeComponents, expectedCauses int) {
 		causes := validateCustomizeComponents(cc)
 		Expect(causes).To(HaveLen(expectedCauses))
@@ -279,3 +380,7 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 		)
 	})
 })
+
+func migrationCompressionPtr(compression v1.MigrationCompression) *v1.MigrationCompression {
+	return &compression
+}
@@ -0,0 +1,516 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package vm
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/instancetype"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	"sigs.k8s.io/yaml"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/create/params"
+)
+
+const kubevirtLabelDomain = "kubevirt.io/"
+
+func (c *createVM) hasSource() bool {
+	return c.fromVM != "" || c.fromVMI != "" || c.fromExportManifest != ""
+}
+
+func (c *createVM) getVirtClient() (kubecli.KubevirtClient, string, error) {
+	namespace, _, err := c.clientConfig.Namespace()
+	if err != nil {
+		return nil, "", err
+	}
+
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	return virtClient, namespace, nil
+}
+
+// newVMFromSource returns a VirtualMachine based on the spec of an existing VirtualMachine, VirtualMachineInstance or
+// exported VirtualMachine manifest. The metadata, status and identity of the source are not copied.
+func (c *createVM) newVMFromSource(changed func(string) bool) (*v1.VirtualMachine, error) {
+	var (
+		source *v1.VirtualMachine
+		err    error
+	)
+	switch {
+	case c.fromVM != "":
+		source, err = c.getSourceVM()
+	case c.fromVMI != "":
+		source, err = c.getSourceVMI()
+	default:
+		source, err = readExportManifest(c.fromExportManifest)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	vm := &v1.VirtualMachine{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1.VirtualMachineGroupVersionKind.Kind,
+			APIVersion: v1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: c.name,
+		},
+		Spec: *source.Spec.DeepCopy(),
+	}
+	if vm.Spec.Template == nil {
+		vm.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{}
+	}
+	removeIdentity(vm)
+	renameDataVolumeTemplates(vm, source.Name)
+	cloneVolumes(vm, source.Name, source.Namespace)
+
+	if vm.Spec.Running != nil {
+		runStrategy := v1.RunStrategyHalted
+		if *vm.Spec.Running {
+			runStrategy = v1.RunStrategyAlways
+		}
+		vm.Spec.RunStrategy = &runStrategy
+		vm.Spec.Running = nil
+	}
+	if vm.Spec.RunStrategy == nil {
+		runStrategy := v1.VirtualMachineRunStrategy(c.runStrategy)
+		vm.Spec.RunStrategy = &runStrategy
+	}
+
+	if changed(TerminationGracePeriodFlag) {
+		vm.Spec.Template.Spec.TerminationGracePeriodSeconds = &c.terminationGracePeriod
+	}
+	if changed(MemoryFlag) {
+		memory, err := resource.ParseQuantity(c.memory)
+		if err != nil {
+			return nil, params.FlagErr(MemoryFlag, "%w", err)
+		}
+		vm.Spec.Template.Spec.Domain.Memory = &v1.Memory{Guest: &memory}
+	}
+
+	return vm, nil
+}
+
+func (c *createVM) getSourceVM() (*v1.VirtualMachine, error) {
+	virtClient, namespace, err := c.getVirtClient()
+	if err != nil {
+		return nil, err
+	}
+
+	vm, err := virtClient.VirtualMachine(namespace).Get(context.Background(), c.fromVM, &metav1.GetOptions{})
+	if err != nil {
+		return nil, params.FlagErr(FromVMFlag, "%w", err)
+	}
+
+	return vm, nil
+}
+
+func (c *createVM) getSourceVMI() (*v1.VirtualMachine, error) {
+	virtClient, namespace, err := c.getVirtClient()
+	if err != nil {
+		return nil, err
+	}
+
+	vmi, err := virtClient.VirtualMachineInstance(namespace).Get(context.Background(), c.fromVMI, &metav1.GetOptions{})
+	if err != nil {
+		return nil, params.FlagErr(FromVMIFlag, "%w", err)
+	}
+
+	// Labels added by KubeVirt describe the running VirtualMachineInstance and are not part of its template
+	labels := map[string]string{}
+	for key, value := range vmi.Labels {
+		if !strings.Contains(key, kubevirtLabelDomain) {
+			labels[key] = value
+		}
+	}
+
+	return &v1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      vmi.Name,
+			Namespace: vmi.Namespace,
+		},
+		Spec: v1.VirtualMachineSpec{
+			Template: &v1.VirtualMachineInstanceTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: vmi.Spec,
+			},
+		},
+	}, nil
+}
+
+// readExportManifest returns the VirtualMachine of a manifest retrieved from a VirtualMachineExport
+func readExportManifest(path string) (*v1.VirtualMachine, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, params.FlagErr(FromExportManifestFlag, "%w", err)
+	}
+	defer file.Close()
+
+	reader := yamlutil.NewYAMLReader(bufio.NewReader(file))
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, params.FlagErr(FromExportManifestFlag, "%w", err)
+		}
+
+		typeMeta := metav1.TypeMeta{}
+		if err := yaml.Unmarshal(doc, &typeMeta); err != nil {
+			return nil, params.FlagErr(FromExportManifestFlag, "%w", err)
+		}
+		if typeMeta.Kind != v1.VirtualMachineGroupVersionKind.Kind {
+			continue
+		}
+
+		vm := &v1.VirtualMachine{}
+		if err := yaml.Unmarshal(doc, vm); err != nil {
+			return nil, params.FlagErr(FromExportManifestFlag, "%w", err)
+		}
+		return vm, nil
+	}
+
+	return nil, params.FlagErr(FromExportManifestFlag, "no VirtualMachine found in %s", path)
+}
+
+// removeIdentity removes the fields identifying the source to the guest, they are generated again for the new VirtualMachine
+func removeIdentity(vm *v1.VirtualMachine) {
+	if vm.Spec.Instancetype != nil {
+		vm.Spec.Instancetype.RevisionName = ""
+	}
+	if vm.Spec.Preference != nil {
+		vm.Spec.Preference.RevisionName = ""
+	}
+
+	spec := &vm.Spec.Template.Spec
+	for i := range spec.Domain.Devices.Interfaces {
+		spec.Domain.Devices.Interfaces[i].MacAddress = ""
+	}
+	if spec.Domain.Firmware != nil {
+		spec.Domain.Firmware.UUID = ""
+		spec.Domain.Firmware.Serial = ""
+		if reflect.ValueOf(*spec.Domain.Firmware).IsZero() {
+			spec.Domain.Firmware = nil
+		}
+	}
+}
+
+// renameDataVolumeTemplates names the DataVolumeTemplates copied from the source after the new VirtualMachine and
+// updates the volumes using them, so that the new VirtualMachine gets its own DataVolumes instead of the ones of the source
+func renameDataVolumeTemplates(vm *v1.VirtualMachine, sourceName string) {
+	names := map[string]string{}
+	for i := range vm.Spec.DataVolumeTemplates {
+		dataVolumeTemplate := &vm.Spec.DataVolumeTemplates[i]
+		newName := newVolumeName(vm.Name, sourceName, dataVolumeTemplate.Name)
+		names[dataVolumeTemplate.Name] = newName
+		dataVolumeTemplate.Name = newName
+	}
+
+	for i := range vm.Spec.Template.Spec.Volumes {
+		volume := &vm.Spec.Template.Spec.Volumes[i]
+		if volume.DataVolume != nil {
+			if newName, ok := names[volume.DataVolume.Name]; ok {
+				volume.DataVolume.Name = newName
+			}
+		}
+		if volume.PersistentVolumeClaim != nil {
+			if newName, ok := names[volume.PersistentVolumeClaim.ClaimName]; ok {
+				volume.PersistentVolumeClaim.ClaimName = newName
+			}
+		}
+	}
+}
+
+// cloneVolumes adds a DataVolumeTemplate cloning each PVC or DataVolume used by the source without being created from
+// one of its DataVolumeTemplates, so that the new VirtualMachine does not use the disks of the source
+func cloneVolumes(vm *v1.VirtualMachine, sourceName, sourceNamespace string) {
+	templates := map[string]bool{}
+	for _, dataVolumeTemplate := range vm.Spec.DataVolumeTemplates {
+		templates[dataVolumeTemplate.Name] = true
+	}
+
+	names := map[string]string{}
+	cloneClaim := func(claimName string) string {
+		if templates[claimName] {
+			return claimName
+		}
+		if newName, ok := names[claimName]; ok {
+			return newName
+		}
+		newName := newVolumeName(vm.Name, sourceName, claimName)
+		names[claimName] = newName
+		vm.Spec.DataVolumeTemplates = append(vm.Spec.DataVolumeTemplates, v1.DataVolumeTemplateSpec{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: newName,
+			},
+			Spec: cdiv1.DataVolumeSpec{
+				Storage: &cdiv1.StorageSpec{},
+				Source: &cdiv1.DataVolumeSource{
+					PVC: &cdiv1.DataVolumeSourcePVC{
+						Namespace: sourceNamespace,
+						Name:      claimName,
+					},
+				},
+			},
+		})
+		return newName
+	}
+
+	for i := range vm.Spec.Template.Spec.Volumes {
+		volume := &vm.Spec.Template.Spec.Volumes[i]
+		if volume.DataVolume != nil {
+			volume.DataVolume.Name = cloneClaim(volume.DataVolume.Name)
+		}
+		if volume.PersistentVolumeClaim != nil {
+			volume.PersistentVolumeClaim.ClaimName = cloneClaim(volume.PersistentVolumeClaim.ClaimName)
+		}
+	}
+}
+
+// newVolumeName returns the name of a volume of the source named after the new VirtualMachine
+func newVolumeName(name, sourceName, volumeName string) string {
+	if sourceName != "" && strings.HasPrefix(volumeName, sourceName+"-") {
+		return name + strings.TrimPrefix(volumeName, sourceName)
+	}
+	return name + "-" + volumeName
+}
+
+// withMatchingInstancetypeAndPreference replaces the inline resources of a VirtualMachine created from a source with
+// the smallest VirtualMachineClusterInstancetype providing them and selects the VirtualMachineClusterPreference
+// matching most of its devices, unless an instancetype or preference is already used.
+func (c *createVM) withMatchingInstancetypeAndPreference(vm *v1.VirtualMachine) error {
+	if vm.Spec.Instancetype != nil && vm.Spec.Preference != nil {
+		removeInlineResources(&vm.Spec.Template.Spec)
+		return nil
+	}
+
+	virtClient, _, err := c.getVirtClient()
+	if err != nil {
+		return err
+	}
+
+	if vm.Spec.Instancetype == nil {
+		instancetypes, err := virtClient.VirtualMachineClusterInstancetype().List(context.Background(), metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to list instancetypes: %w", err)
+		}
+		if name := findNearestInstancetype(instancetypes.Items, &vm.Spec.Template.Spec); name != "" {
+			vm.Spec.Instancetype = &v1.InstancetypeMatcher{
+				Name: name,
+				Kind: instancetype.ClusterSingularResourceName,
+			}
+		}
+	}
+	if vm.Spec.Instancetype != nil {
+		removeInlineResources(&vm.Spec.Template.Spec)
+	}
+
+	if vm.Spec.Preference == nil {
+		preferences, err := virtClient.VirtualMachineClusterPreference().List(context.Background(), metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to list preferences: %w", err)
+		}
+		if name := findNearestPreference(preferences.Items, &vm.Spec.Template.Spec); name != "" {
+			vm.Spec.Preference = &v1.PreferenceMatcher{
+				Name: name,
+				Kind: instancetype.ClusterSingularPreferenceResourceName,
+			}
+		}
+	}
+
+	return nil
+}
+
+func guestVCPUs(spec *v1.VirtualMachineInstanceSpec) uint32 {
+	vcpus := uint32(1)
+	if cpu := spec.Domain.CPU; cpu != nil {
+		for _, count := range []uint32{cpu.Sockets, cpu.Cores, cpu.Threads} {
+			if count > 0 {
+				vcpus *= count
+			}
+		}
+	}
+	return vcpus
+}
+
+func guestMemory(spec *v1.VirtualMachineInstanceSpec) *resource.Quantity {
+	if spec.Domain.Memory != nil && spec.Domain.Memory.Guest != nil {
+		return spec.Domain.Memory.Guest
+	}
+	if memory, ok := spec.Domain.Resources.Requests[k8sv1.ResourceMemory]; ok {
+		return &memory
+	}
+	return nil
+}
+
+// findNearestInstancetype returns the name of the smallest instancetype providing the vCPUs and memory of the spec
+func findNearestInstancetype(instancetypes []instancetypev1beta1.VirtualMachineClusterInstancetype, spec *v1.VirtualMachineInstanceSpec) string {
+	memory := guestMemory(spec)
+	if memory == nil {
+		return ""
+	}
+	vcpus := guestVCPUs(spec)
+
+	var nearest *instancetypev1beta1.VirtualMachineClusterInstancetype
+	for i := range instancetypes {
+		candidate := &instancetypes[i]
+		// Devices of an instancetype are not part of a size and can not be matched against the spec
+		if len(candidate.Spec.GPUs) > 0 || len(candidate.Spec.HostDevices) > 0 {
+			continue
+		}
+		if candidate.Spec.CPU.Guest < vcpus || candidate.Spec.Memory.Guest.Cmp(*memory) < 0 {
+			continue
+		}
+		if nearest == nil || isSmallerInstancetype(candidate, nearest) {
+			nearest = candidate
+		}
+	}
+
+	if nearest == nil {
+		return ""
+	}
+	return nearest.Name
+}
+
+func isSmallerInstancetype(a, b *instancetypev1beta1.VirtualMachineClusterInstancetype) bool {
+	if a.Spec.CPU.Guest != b.Spec.CPU.Guest {
+		return a.Spec.CPU.Guest < b.Spec.CPU.Guest
+	}
+	if cmp := a.Spec.Memory.Guest.Cmp(b.Spec.Memory.Guest); cmp != 0 {
+		return cmp < 0
+	}
+	return a.Name < b.Name
+}
+
+func removeInlineResources(spec *v1.VirtualMachineInstanceSpec) {
+	spec.Domain.Memory = nil
+	if cpu := spec.Domain.CPU; cpu != nil {
+		cpu.Sockets, cpu.Cores, cpu.Threads = 0, 0, 0
+		if reflect.ValueOf(*cpu).IsZero() {
+			spec.Domain.CPU = nil
+		}
+	}
+
+	for _, resourceName := range []k8sv1.ResourceName{k8sv1.ResourceCPU, k8sv1.ResourceMemory} {
+		delete(spec.Domain.Resources.Requests, resourceName)
+		delete(spec.Domain.Resources.Limits, resourceName)
+	}
+	if len(spec.Domain.Resources.Requests) == 0 {
+		spec.Domain.Resources.Requests = nil
+	}
+	if len(spec.Domain.Resources.Limits) == 0 {
+		spec.Domain.Resources.Limits = nil
+	}
+}
+
+// findNearestPreference returns the name of the preference matching most of the devices of the spec without
+// preferring anything the spec does not use
+func findNearestPreference(preferences []instancetypev1beta1.VirtualMachineClusterPreference, spec *v1.VirtualMachineInstanceSpec) string {
+	nearest, nearestScore := "", 0
+	for i := range preferences {
+		score := preferenceScore(&preferences[i].Spec, spec)
+		if score > nearestScore || (score == nearestScore && score > 0 && preferences[i].Name < nearest) {
+			nearest, nearestScore = preferences[i].Name, score
+		}
+	}
+	return nearest
+}
+
+// preferenceScore returns the number of preferences used by the spec or -1 if any preference is not used by the spec
+func preferenceScore(preferenceSpec *instancetypev1beta1.VirtualMachinePreferenceSpec, spec *v1.VirtualMachineInstanceSpec) int {
+	score := 0
+	match := func(preferred, used string) bool {
+		if preferred == "" {
+			return true
+		}
+		if preferred == used {
+			score++
+			return true
+		}
+		return false
+	}
+
+	if preferenceSpec.Devices != nil {
+		if !match(string(preferenceSpec.Devices.PreferredDiskBus), firstDiskBus(spec)) ||
+			!match(preferenceSpec.Devices.PreferredInterfaceModel, firstInterfaceModel(spec)) {
+			return -1
+		}
+	}
+	if preferenceSpec.Machine != nil {
+		machineType := ""
+		if spec.Domain.Machine != nil {
+			machineType = spec.Domain.Machine.Type
+		}
+		if !match(preferenceSpec.Machine.PreferredMachineType, machineType) {
+			return -1
+		}
+	}
+	if preferenceSpec.Firmware != nil {
+		efi := spec.Domain.Firmware != nil && spec.Domain.Firmware.Bootloader != nil && spec.Domain.Firmware.Bootloader.EFI != nil
+		if preferenceSpec.Firmware.PreferredUseEfi != nil && !match(fmt.Sprint(*preferenceSpec.Firmware.PreferredUseEfi), fmt.Sprint(efi)) {
+			return -1
+		}
+		if preferenceSpec.Firmware.PreferredUseSecureBoot != nil {
+			secureBoot := efi && (spec.Domain.Firmware.Bootloader.EFI.SecureBoot == nil || *spec.Domain.Firmware.Bootloader.EFI.SecureBoot)
+			if !match(fmt.Sprint(*preferenceSpec.Firmware.PreferredUseSecureBoot), fmt.Sprint(secureBoot)) {
+				return -1
+			}
+		}
+	}
+
+	return score
+}
+
+func firstDiskBus(spec *v1.VirtualMachineInstanceSpec) string {
+	for _, disk := range spec.Domain.Devices.Disks {
+		if disk.Disk != nil {
+			return string(disk.Disk.Bus)
+		}
+	}
+	return ""
+}
+
+func firstInterfaceModel(spec *v1.VirtualMachineInstanceSpec) string {
+	if len(spec.Domain.Devices.Interfaces) > 0 {
+		return spec.Domain.Devices.Interfaces[0].Model
+	}
+	return ""
+}
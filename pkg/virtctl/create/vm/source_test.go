@@ -0,0 +1,321 @@
+package vm_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	v1 "kubevirt.io/api/core/v1"
+	instancetypeapi "kubevirt.io/api/instancetype"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	"kubevirt.io/client-go/api"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	"sigs.k8s.io/yaml"
+
+	. "kubevirt.io/kubevirt/pkg/virtctl/create/vm"
+)
+
+var _ = Describe("create vm from a source", func() {
+	var (
+		vmInterface  *kubecli.MockVirtualMachineInterface
+		vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+		virtClient   *kubevirtfake.Clientset
+	)
+
+	newSourceSpec := func() v1.VirtualMachineInstanceSpec {
+		return v1.VirtualMachineInstanceSpec{
+			Domain: v1.DomainSpec{
+				CPU: &v1.CPU{Sockets: 2},
+				Memory: &v1.Memory{
+					Guest: resource.NewQuantity(2*1024*1024*1024, resource.BinarySI),
+				},
+				Firmware: &v1.Firmware{
+					UUID:   "5d307ca9-b3ef-428c-8861-06e72d69f223",
+					Serial: "e4686d2c-6e8d-4335-b8fd-81bee22f4814",
+					Bootloader: &v1.Bootloader{
+						EFI: &v1.EFI{SecureBoot: pointer.Bool(false)},
+					},
+				},
+				Devices: v1.Devices{
+					Disks: []v1.Disk{{
+						Name:       "rootdisk",
+						DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{Bus: v1.DiskBusSATA}},
+					}},
+					Interfaces: []v1.Interface{{
+						Name:       "default",
+						Model:      "e1000e",
+						MacAddress: "02:00:00:00:00:01",
+					}},
+				},
+			},
+		}
+	}
+
+	newClusterInstancetype := func(name string, guestCPUs uint32, guestMemory string) {
+		_, err := virtClient.InstancetypeV1beta1().VirtualMachineClusterInstancetypes().Create(context.Background(), &instancetypev1beta1.VirtualMachineClusterInstancetype{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: instancetypev1beta1.VirtualMachineInstancetypeSpec{
+				CPU:    instancetypev1beta1.CPUInstancetype{Guest: guestCPUs},
+				Memory: instancetypev1beta1.MemoryInstancetype{Guest: resource.MustParse(guestMemory)},
+			},
+		}, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	newClusterPreference := func(name string, spec instancetypev1beta1.VirtualMachinePreferenceSpec) {
+		_, err := virtClient.InstancetypeV1beta1().VirtualMachineClusterPreferences().Create(context.Background(), &instancetypev1beta1.VirtualMachineClusterPreference{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       spec,
+		}, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		virtClient = kubevirtfake.NewSimpleClientset()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmInterface).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineClusterInstancetype().Return(virtClient.InstancetypeV1beta1().VirtualMachineClusterInstancetypes()).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineClusterPreference().Return(virtClient.InstancetypeV1beta1().VirtualMachineClusterPreferences()).AnyTimes()
+
+		newClusterInstancetype("u1.small", 1, "2Gi")
+		newClusterInstancetype("u1.medium", 2, "4Gi")
+		newClusterInstancetype("u1.large", 4, "8Gi")
+		newClusterPreference("windows", instancetypev1beta1.VirtualMachinePreferenceSpec{
+			Devices:  &instancetypev1beta1.DevicePreferences{PreferredDiskBus: v1.DiskBusSATA, PreferredInterfaceModel: "e1000e"},
+			Firmware: &instancetypev1beta1.FirmwarePreferences{PreferredUseEfi: pointer.Bool(true)},
+		})
+		newClusterPreference("windows.secureboot", instancetypev1beta1.VirtualMachinePreferenceSpec{
+			Devices:  &instancetypev1beta1.DevicePreferences{PreferredDiskBus: v1.DiskBusSATA, PreferredInterfaceModel: "e1000e"},
+			Firmware: &instancetypev1beta1.FirmwarePreferences{PreferredUseEfi: pointer.Bool(true), PreferredUseSecureBoot: pointer.Bool(true)},
+		})
+		newClusterPreference("linux", instancetypev1beta1.VirtualMachinePreferenceSpec{
+			Devices: &instancetypev1beta1.DevicePreferences{PreferredDiskBus: v1.DiskBusVirtio},
+		})
+	})
+
+	It("should copy a VM without its identity and convert the inline spec", func() {
+		source := kubecli.NewMinimalVM("source")
+		source.Labels = map[string]string{"app": "source"}
+		source.Spec.Running = pointer.Bool(false)
+		source.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{Spec: newSourceSpec()}
+		source.Status.Created = true
+		vmInterface.EXPECT().Get(context.Background(), "source", gomock.Any()).Return(source, nil)
+
+		out, err := runCmd(setFlag(NameFlag, "copy"), setFlag(FromVMFlag, "source"))
+		Expect(err).ToNot(HaveOccurred())
+		vm := unmarshalVM(out)
+
+		Expect(vm.Name).To(Equal("copy"))
+		Expect(vm.Labels).To(BeEmpty())
+		Expect(vm.Status).To(Equal(v1.VirtualMachineStatus{}))
+		Expect(vm.Spec.Running).To(BeNil())
+		Expect(*vm.Spec.RunStrategy).To(Equal(v1.RunStrategyHalted))
+
+		spec := vm.Spec.Template.Spec
+		Expect(spec.Domain.Devices.Interfaces[0].MacAddress).To(BeEmpty())
+		Expect(spec.Domain.Firmware.UUID).To(BeEmpty())
+		Expect(spec.Domain.Firmware.Serial).To(BeEmpty())
+		Expect(spec.Domain.Firmware.Bootloader.EFI).ToNot(BeNil())
+
+		Expect(vm.Spec.Instancetype).To(Equal(&v1.InstancetypeMatcher{Name: "u1.medium", Kind: instancetypeapi.ClusterSingularResourceName}))
+		Expect(spec.Domain.CPU).To(BeNil())
+		Expect(spec.Domain.Memory).To(BeNil())
+		Expect(vm.Spec.Preference).To(Equal(&v1.PreferenceMatcher{Name: "windows", Kind: instancetypeapi.ClusterSingularPreferenceResourceName}))
+	})
+
+	It("should give the DataVolumeTemplates of a VM new names", func() {
+		source := kubecli.NewMinimalVM("source")
+		source.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{Spec: newSourceSpec()}
+		source.Spec.DataVolumeTemplates = []v1.DataVolumeTemplateSpec{
+			{ObjectMeta: metav1.ObjectMeta{Name: "source-rootdisk"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "datadisk"}},
+		}
+		source.Spec.Template.Spec.Volumes = []v1.Volume{
+			{Name: "rootdisk", VolumeSource: v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "source-rootdisk"}}},
+			{Name: "datadisk", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+				PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "datadisk"},
+			}}},
+			{Name: "shareddisk", VolumeSource: v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "shared"}}},
+		}
+		vmInterface.EXPECT().Get(context.Background(), "source", gomock.Any()).Return(source, nil)
+
+		out, err := runCmd(setFlag(NameFlag, "copy"), setFlag(FromVMFlag, "source"))
+		Expect(err).ToNot(HaveOccurred())
+		vm := unmarshalVM(out)
+
+		Expect(vm.Spec.DataVolumeTemplates).To(HaveLen(3))
+		Expect(vm.Spec.DataVolumeTemplates[0].Name).To(Equal("copy-rootdisk"))
+		Expect(vm.Spec.DataVolumeTemplates[1].Name).To(Equal("copy-datadisk"))
+		volumes := vm.Spec.Template.Spec.Volumes
+		Expect(volumes[0].DataVolume.Name).To(Equal("copy-rootdisk"))
+		Expect(volumes[1].PersistentVolumeClaim.ClaimName).To(Equal("copy-datadisk"))
+		Expect(volumes[2].DataVolume.Name).To(Equal("copy-shared"))
+	})
+
+	It("should clone the volumes of a VM not created from its DataVolumeTemplates", func() {
+		source := kubecli.NewMinimalVM("source")
+		source.Namespace = metav1.NamespaceDefault
+		source.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{Spec: newSourceSpec()}
+		source.Spec.Template.Spec.Volumes = []v1.Volume{
+			{Name: "rootdisk", VolumeSource: v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "source-rootdisk"}}},
+			{Name: "datadisk", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+				PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "data"},
+			}}},
+		}
+		vmInterface.EXPECT().Get(context.Background(), "source", gomock.Any()).Return(source, nil)
+
+		out, err := runCmd(setFlag(NameFlag, "copy"), setFlag(FromVMFlag, "source"))
+		Expect(err).ToNot(HaveOccurred())
+		vm := unmarshalVM(out)
+
+		Expect(vm.Spec.DataVolumeTemplates).To(HaveLen(2))
+		Expect(vm.Spec.DataVolumeTemplates[0].Name).To(Equal("copy-rootdisk"))
+		Expect(vm.Spec.DataVolumeTemplates[0].Spec.Source.PVC).To(Equal(&cdiv1.DataVolumeSourcePVC{Namespace: metav1.NamespaceDefault, Name: "source-rootdisk"}))
+		Expect(vm.Spec.DataVolumeTemplates[1].Name).To(Equal("copy-data"))
+		Expect(vm.Spec.DataVolumeTemplates[1].Spec.Source.PVC).To(Equal(&cdiv1.DataVolumeSourcePVC{Namespace: metav1.NamespaceDefault, Name: "data"}))
+		volumes := vm.Spec.Template.Spec.Volumes
+		Expect(volumes[0].DataVolume.Name).To(Equal("copy-rootdisk"))
+		Expect(volumes[1].PersistentVolumeClaim.ClaimName).To(Equal("copy-data"))
+	})
+
+	It("should clone the volumes of a VMI", func() {
+		source := api.NewMinimalVMI("source")
+		source.Namespace = metav1.NamespaceDefault
+		source.Spec = newSourceSpec()
+		source.Spec.Volumes = []v1.Volume{
+			{Name: "rootdisk", VolumeSource: v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "source-rootdisk"}}},
+		}
+		vmiInterface.EXPECT().Get(context.Background(), "source", gomock.Any()).Return(source, nil)
+
+		out, err := runCmd(setFlag(NameFlag, "copy"), setFlag(FromVMIFlag, "source"))
+		Expect(err).ToNot(HaveOccurred())
+		vm := unmarshalVM(out)
+
+		Expect(vm.Spec.DataVolumeTemplates).To(HaveLen(1))
+		Expect(vm.Spec.DataVolumeTemplates[0].Name).To(Equal("copy-rootdisk"))
+		Expect(vm.Spec.DataVolumeTemplates[0].Spec.Source.PVC).To(Equal(&cdiv1.DataVolumeSourcePVC{Namespace: metav1.NamespaceDefault, Name: "source-rootdisk"}))
+		Expect(vm.Spec.Template.Spec.Volumes[0].DataVolume.Name).To(Equal("copy-rootdisk"))
+	})
+
+	It("should report an invalid memory when copying a VM", func() {
+		source := kubecli.NewMinimalVM("source")
+		source.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{Spec: newSourceSpec()}
+		vmInterface.EXPECT().Get(context.Background(), "source", gomock.Any()).Return(source, nil)
+
+		_, err := runCmd(setFlag(FromVMFlag, "source"), setFlag(MemoryFlag, "invalid"))
+		Expect(err).To(MatchError(ContainSubstring(MemoryFlag)))
+	})
+
+	It("should keep the instancetype and preference of a VM", func() {
+		source := kubecli.NewMinimalVM("source")
+		source.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{}
+		source.Spec.Instancetype = &v1.InstancetypeMatcher{Name: "u1.large", RevisionName: "source-u1.large"}
+		source.Spec.Preference = &v1.PreferenceMatcher{Name: "linux", RevisionName: "source-linux"}
+		vmInterface.EXPECT().Get(context.Background(), "source", gomock.Any()).Return(source, nil)
+
+		out, err := runCmd(setFlag(FromVMFlag, "source"))
+		Expect(err).ToNot(HaveOccurred())
+		vm := unmarshalVM(out)
+
+		Expect(vm.Spec.Instancetype).To(Equal(&v1.InstancetypeMatcher{Name: "u1.large"}))
+		Expect(vm.Spec.Preference).To(Equal(&v1.PreferenceMatcher{Name: "linux"}))
+		Expect(*vm.Spec.RunStrategy).To(Equal(v1.RunStrategyAlways))
+	})
+
+	It("should replace the inline resources with a specified instancetype", func() {
+		source := kubecli.NewMinimalVM("source")
+		source.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{Spec: newSourceSpec()}
+		vmInterface.EXPECT().Get(context.Background(), "source", gomock.Any()).Return(source, nil)
+
+		out, err := runCmd(setFlag(FromVMFlag, "source"), setFlag(InstancetypeFlag, "u1.large"))
+		Expect(err).ToNot(HaveOccurred())
+		vm := unmarshalVM(out)
+
+		Expect(vm.Spec.Instancetype.Name).To(Equal("u1.large"))
+		Expect(vm.Spec.Template.Spec.Domain.CPU).To(BeNil())
+		Expect(vm.Spec.Template.Spec.Domain.Memory).To(BeNil())
+	})
+
+	It("should keep the inline spec if no instancetype provides it", func() {
+		source := kubecli.NewMinimalVM("source")
+		source.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{Spec: newSourceSpec()}
+		source.Spec.Template.Spec.Domain.CPU.Sockets = 8
+		vmInterface.EXPECT().Get(context.Background(), "source", gomock.Any()).Return(source, nil)
+
+		out, err := runCmd(setFlag(FromVMFlag, "source"))
+		Expect(err).ToNot(HaveOccurred())
+		vm := unmarshalVM(out)
+
+		Expect(vm.Spec.Instancetype).To(BeNil())
+		Expect(vm.Spec.Template.Spec.Domain.CPU.Sockets).To(Equal(uint32(8)))
+		Expect(vm.Spec.Template.Spec.Domain.Memory).ToNot(BeNil())
+	})
+
+	It("should copy a VMI without the labels of KubeVirt", func() {
+		source := api.NewMinimalVMI("source")
+		source.Labels = map[string]string{"app": "source", v1.NodeNameLabel: "node01"}
+		source.Spec = newSourceSpec()
+		source.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot = pointer.Bool(true)
+		source.Spec.Domain.Resources.Requests = k8sv1.ResourceList{
+			k8sv1.ResourceMemory: resource.MustParse("2Gi"),
+		}
+		vmiInterface.EXPECT().Get(context.Background(), "source", gomock.Any()).Return(source, nil)
+
+		out, err := runCmd(setFlag(FromVMIFlag, "source"))
+		Expect(err).ToNot(HaveOccurred())
+		vm := unmarshalVM(out)
+
+		Expect(vm.Spec.Template.ObjectMeta.Labels).To(Equal(map[string]string{"app": "source"}))
+		Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress).To(BeEmpty())
+		Expect(vm.Spec.Template.Spec.Domain.Resources.Requests).To(BeEmpty())
+		Expect(vm.Spec.Instancetype.Name).To(Equal("u1.medium"))
+		Expect(vm.Spec.Preference.Name).To(Equal("windows.secureboot"))
+	})
+
+	It("should read the VM of an exported manifest", func() {
+		source := kubecli.NewMinimalVM("source")
+		source.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{Spec: newSourceSpec()}
+		source.Spec.Template.Spec.Domain.Devices.Disks[0].Disk.Bus = v1.DiskBusVirtio
+		source.Spec.Template.Spec.Domain.Devices.Interfaces[0].Model = ""
+		source.Spec.Template.Spec.Domain.Firmware = nil
+		sourceYaml, err := yaml.Marshal(source)
+		Expect(err).ToNot(HaveOccurred())
+		manifest := filepath.Join(GinkgoT().TempDir(), "manifest.yaml")
+		Expect(os.WriteFile(manifest, append([]byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: export-ca-cm\n---\n"), sourceYaml...), 0600)).To(Succeed())
+
+		out, err := runCmd(setFlag(FromExportManifestFlag, manifest))
+		Expect(err).ToNot(HaveOccurred())
+		vm := unmarshalVM(out)
+
+		Expect(vm.Spec.Template.Spec.Domain.Devices.Disks).To(Equal(source.Spec.Template.Spec.Domain.Devices.Disks))
+		Expect(vm.Spec.Instancetype.Name).To(Equal("u1.medium"))
+		Expect(vm.Spec.Preference.Name).To(Equal("linux"))
+	})
+
+	It("should fail if an exported manifest contains no VM", func() {
+		manifest := filepath.Join(GinkgoT().TempDir(), "manifest.yaml")
+		Expect(os.WriteFile(manifest, []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: export-ca-cm\n"), 0600)).To(Succeed())
+
+		_, err := runCmd(setFlag(FromExportManifestFlag, manifest))
+		Expect(err).To(MatchError(ContainSubstring("no VirtualMachine found")))
+	})
+
+	It("should not allow multiple sources", func() {
+		_, err := runCmd(setFlag(FromVMFlag, "source"), setFlag(FromVMIFlag, "source"))
+		Expect(err).To(MatchError(ContainSubstring("if any flags in the group [from-vm from-vmi from-export-manifest] are set none of the others can be")))
+	})
+})
@@ -29,6 +29,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/tools/clientcmd"
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/api/instancetype"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
@@ -57,6 +58,9 @@ const (
 	InferInstancetypeFlag      = "infer-instancetype"
 	InferPreferenceFlag        = "infer-preference"
 	VolumeImportFlag           = "volume-import"
+	FromVMFlag                 = "from-vm"
+	FromVMIFlag                = "from-vmi"
+	FromExportManifestFlag     = "from-export-manifest"
 
 	cloudInitDisk    = "cloudinitdisk"
 	inferNoOptDefVal = "inferNoOptDefVal"
@@ -87,8 +91,12 @@ type createVM struct {
 	inferInstancetype      string
 	inferPreference        string
 	volumeImport           []string
+	fromVM                 string
+	fromVMI                string
+	fromExportManifest     string
 
-	bootOrders map[uint]string
+	bootOrders   map[uint]string
+	clientConfig clientcmd.ClientConfig
 }
 
 type cloneVolume struct {
@@ -247,12 +255,12 @@ var runStrategies = []string{
 	string(v1.RunStrategyRerunOnFailure),
 }
 
-func NewCommand() *cobra.Command {
-	c := defaultCreateVM()
+func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	c := defaultCreateVM(clientConfig)
 	cmd := &cobra.Command{
 		Use:     VM,
 		Short:   "Create a VirtualMachine manifest.",
-		Long:    "Create a VirtualMachine manifest.\n\nIf no boot order was specified volumes have the following fixed boot order:\nContainerdisk > DataSource > Clone PVC > PVC",
+		Long:    "Create a VirtualMachine manifest.\n\nIf no boot order was specified volumes have the following fixed boot order:\nContainerdisk > DataSource > Clone PVC > PVC\n\nWhen created from an existing VirtualMachine, VirtualMachineInstance or exported manifest the spec of the source is copied without the MAC addresses, firmware UUID and serial. Its DataVolumeTemplates are renamed after the new VirtualMachine and its other PVCs and DataVolumes are cloned into new DataVolumeTemplates.\nAn inline spec is converted to the smallest VirtualMachineClusterInstancetype providing its vCPUs and memory and the VirtualMachineClusterPreference matching most of its devices.",
 		Args:    cobra.NoArgs,
 		Example: c.usage(),
 		RunE: func(cmd *cobra.Command, _ []string) error {
@@ -295,18 +303,24 @@ func NewCommand() *cobra.Command {
 	cmd.Flags().StringVar(&c.cloudInitUserData, CloudInitUserDataFlag, c.cloudInitUserData, "Specify the base64 encoded cloud-init user data of the VM.")
 	cmd.Flags().StringVar(&c.cloudInitNetworkData, CloudInitNetworkDataFlag, c.cloudInitNetworkData, "Specify the base64 encoded cloud-init network data of the VM.")
 
+	cmd.Flags().StringVar(&c.fromVM, FromVMFlag, c.fromVM, "Specify an existing VirtualMachine to copy the spec from.")
+	cmd.Flags().StringVar(&c.fromVMI, FromVMIFlag, c.fromVMI, "Specify an existing VirtualMachineInstance to copy the spec from.")
+	cmd.Flags().StringVar(&c.fromExportManifest, FromExportManifestFlag, c.fromExportManifest, "Specify a manifest retrieved from a VirtualMachineExport to copy the spec of the VirtualMachine from.")
+	cmd.MarkFlagsMutuallyExclusive(FromVMFlag, FromVMIFlag, FromExportManifestFlag)
+
 	cmd.Flags().SortFlags = false
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 
 	return cmd
 }
 
-func defaultCreateVM() createVM {
+func defaultCreateVM(clientConfig clientcmd.ClientConfig) createVM {
 	return createVM{
 		terminationGracePeriod: 180,
 		runStrategy:            string(v1.RunStrategyAlways),
 		memory:                 "512Mi",
 		bootOrders:             map[uint]string{},
+		clientConfig:           clientConfig,
 	}
 }
 
@@ -339,7 +353,14 @@ func volumeShouldNotExist(flag string, vm *v1.VirtualMachine, name string) error
 
 func (c *createVM) run(cmd *cobra.Command) error {
 	c.setDefaults()
-	vm, err := c.newVM()
+
+	var vm *v1.VirtualMachine
+	var err error
+	if c.hasSource() {
+		vm, err = c.newVMFromSource(cmd.Flags().Changed)
+	} else {
+		vm, err = c.newVM()
+	}
 	if err != nil {
 		return err
 	}
@@ -352,6 +373,12 @@ func (c *createVM) run(cmd *cobra.Command) error {
 		}
 	}
 
+	if c.hasSource() {
+		if err := c.withMatchingInstancetypeAndPreference(vm); err != nil {
+			return err
+		}
+	}
+
 	out, err := yaml.Marshal(vm)
 	if err != nil {
 		return err
@@ -420,7 +447,16 @@ func (c *createVM) usage() string {
   {{ProgramName}} create vm --instancetype=my-instancetype --preference=my-preference --volume-pvc=my-pvc
 
   # Create a manifest for a VirtualMachine with a specified DataVolumeTemplate
-  {{ProgramName}} create vm --volume-import type:pvc,name:my-pvc,namespace:default,size:256Mi`
+  {{ProgramName}} create vm --volume-import type:pvc,name:my-pvc,namespace:default,size:256Mi
+
+  # Create a manifest for a VirtualMachine copying the spec of an existing VirtualMachine
+  {{ProgramName}} create vm --name=my-copy --from-vm=my-vm
+
+  # Create a manifest for a VirtualMachine copying the spec of a running VirtualMachineInstance
+  {{ProgramName}} create vm --from-vmi=my-vmi
+
+  # Create a manifest for a VirtualMachine from a manifest retrieved from a VirtualMachineExport
+  {{ProgramName}} create vm --from-export-manifest=manifest.yaml`
 }
 
 func (c *createVM) newVM() (*v1.VirtualMachine, error) {
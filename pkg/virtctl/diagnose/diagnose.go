@@ -0,0 +1,441 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package diagnose
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_DIAGNOSE = "diagnose"
+	COMMAND_VM       = "vm"
+
+	outputArg = "output"
+
+	computeContainerName = "compute"
+	errorsFileName       = "errors.txt"
+
+	redacted = "REDACTED"
+)
+
+func usage() string {
+	return `  # Collect the diagnostic bundle of the VM 'myvm' into diagnose-default-myvm.tar.gz:
+  {{ProgramName}} diagnose vm myvm
+
+  # Collect the diagnostic bundle into a specific file:
+  {{ProgramName}} diagnose vm myvm --output=/tmp/myvm.tar.gz`
+}
+
+// NewCommand returns a cobra.Command to collect diagnostic bundles
+func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "diagnose vm",
+		Short:   "Collect everything needed to debug a virtual machine into a single archive.",
+		Example: usage(),
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Print(cmd.UsageString())
+		},
+	}
+	cmd.AddCommand(newVMCommand(clientConfig))
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newVMCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	var output string
+	cmd := &cobra.Command{
+		Use:   "vm (VM)",
+		Short: "Collect the diagnostic bundle of a virtual machine.",
+		Long: `Collect the diagnostic bundle of a virtual machine into a gzipped tarball.
+The bundle contains the VirtualMachine, its VirtualMachineInstance and migrations, the virt-launcher pods
+with cloud-init data, access credentials and environment variables redacted, and the logs of all their containers, the QEMU log, the related events, the labels of the node the VM runs on,
+the libvirt domain XML with credentials and launch secrets redacted, and the guest agent information.
+Whatever can not be collected is listed in ` + errorsFileName + ` inside the bundle.`,
+		Example: usage(),
+		Args:    templates.ExactArgs(COMMAND_VM, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(clientConfig, cmd, args[0], output)
+		},
+	}
+	cmd.Flags().StringVarP(&output, outputArg, "o", "", "The file to write the bundle to, diagnose-<namespace>-<name>.tar.gz if not set.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func run(clientConfig clientcmd.ClientConfig, cmd *cobra.Command, name, output string) error {
+	namespace, _, err := clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(clientConfig)
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	vm, err := virtClient.VirtualMachine(namespace).Get(context.Background(), name, &metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error fetching VirtualMachine %s: %v", name, err)
+	}
+
+	if output == "" {
+		output = fmt.Sprintf("diagnose-%s-%s.tar.gz", namespace, name)
+	}
+	file, err := os.Create(output)
+	if err != nil {
+		return fmt.Errorf("error creating %s: %v", output, err)
+	}
+	defer file.Close()
+
+	b := newBundle(file)
+	c := &collector{virtClient: virtClient, namespace: namespace, bundle: b}
+	c.collect(vm)
+	if err := b.close(); err != nil {
+		return fmt.Errorf("error writing %s: %v", output, err)
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Diagnostic bundle of VirtualMachine %s written to %s\n", name, output)
+	if len(b.errs) > 0 {
+		fmt.Fprintf(cmd.OutOrStdout(), "%d items could not be collected, see %s in the bundle\n", len(b.errs), errorsFileName)
+	}
+	return nil
+}
+
+type collector struct {
+	virtClient kubecli.KubevirtClient
+	namespace  string
+	bundle     *bundle
+}
+
+func (c *collector) collect(vm *v1.VirtualMachine) {
+	c.bundle.addYAML("virtualmachine.yaml", redactVM(vm))
+	involvedObjects := map[types.UID]bool{vm.UID: true}
+
+	vmi, err := c.virtClient.VirtualMachineInstance(c.namespace).Get(context.Background(), vm.Name, &metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			c.bundle.addError("VirtualMachineInstance: the VirtualMachine is not running")
+		} else {
+			c.bundle.addError("VirtualMachineInstance: %v", err)
+		}
+		vmi = nil
+	}
+
+	if vmi != nil {
+		c.bundle.addYAML("virtualmachineinstance.yaml", redactVMI(vmi))
+		involvedObjects[vmi.UID] = true
+
+		for _, pod := range c.collectPods(vmi) {
+			involvedObjects[pod.UID] = true
+		}
+		if vmi.Status.NodeName != "" {
+			c.collectNodeLabels(vmi.Status.NodeName)
+		}
+		if vmi.Status.Phase == v1.Running {
+			c.collectDomainXML(vmi)
+			c.collectGuestAgentInfo(vmi)
+		}
+	}
+
+	c.collectMigrations(vm.Name)
+	c.collectEvents(involvedObjects)
+}
+
+func (c *collector) collectPods(vmi *v1.VirtualMachineInstance) []k8sv1.Pod {
+	pods, err := c.virtClient.CoreV1().Pods(c.namespace).List(context.Background(), metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", v1.CreatedByLabel, vmi.UID),
+	})
+	if err != nil {
+		c.bundle.addError("virt-launcher pods: %v", err)
+		return nil
+	}
+
+	for _, pod := range pods.Items {
+		c.bundle.addYAML(path.Join("pods", pod.Name+".yaml"), redactPod(&pod))
+
+		var containers []k8sv1.Container
+		containers = append(containers, pod.Spec.InitContainers...)
+		containers = append(containers, pod.Spec.Containers...)
+		for _, container := range containers {
+			logs, err := c.virtClient.CoreV1().Pods(c.namespace).GetLogs(pod.Name, &k8sv1.PodLogOptions{Container: container.Name}).DoRaw(context.Background())
+			if err != nil {
+				c.bundle.addError("logs of container %s of pod %s: %v", container.Name, pod.Name, err)
+				continue
+			}
+			c.bundle.add(path.Join("pods", pod.Name, container.Name+".log"), logs)
+
+			if container.Name == computeContainerName {
+				if qemuLog := extractQEMULog(logs); len(qemuLog) > 0 {
+					c.bundle.add(path.Join("pods", pod.Name, "qemu.log"), qemuLog)
+				}
+			}
+		}
+	}
+	return pods.Items
+}
+
+func (c *collector) collectNodeLabels(nodeName string) {
+	node, err := c.virtClient.CoreV1().Nodes().Get(context.Background(), nodeName, metav1.GetOptions{})
+	if err != nil {
+		c.bundle.addError("node %s: %v", nodeName, err)
+		return
+	}
+	c.bundle.addYAML("node-labels.yaml", node.Labels)
+}
+
+func (c *collector) collectDomainXML(vmi *v1.VirtualMachineInstance) {
+	domainXML, err := c.virtClient.VirtualMachineInstance(c.namespace).DomainXML(context.Background(), vmi.Name)
+	if err != nil {
+		c.bundle.addError("domain XML: %v", err)
+		return
+	}
+	c.bundle.add("domain.xml", []byte(domainXML.XML))
+}
+
+func (c *collector) collectGuestAgentInfo(vmi *v1.VirtualMachineInstance) {
+	if !hasAgentConnected(vmi) {
+		c.bundle.addError("guest agent info: the guest agent is not connected")
+		return
+	}
+	guestOSInfo, err := c.virtClient.VirtualMachineInstance(c.namespace).GuestOsInfo(context.Background(), vmi.Name)
+	if err != nil {
+		c.bundle.addError("guest agent info: %v", err)
+		return
+	}
+	c.bundle.addYAML("guestosinfo.yaml", guestOSInfo)
+}
+
+func (c *collector) collectMigrations(vmiName string) {
+	migrations, err := c.virtClient.VirtualMachineInstanceMigration(c.namespace).List(&metav1.ListOptions{})
+	if err != nil {
+		c.bundle.addError("migrations: %v", err)
+		return
+	}
+
+	var vmiMigrations []v1.VirtualMachineInstanceMigration
+	for _, migration := range migrations.Items {
+		if migration.Spec.VMIName == vmiName {
+			vmiMigrations = append(vmiMigrations, migration)
+		}
+	}
+	c.bundle.addYAML("migrations.yaml", vmiMigrations)
+}
+
+func (c *collector) collectEvents(involvedObjects map[types.UID]bool) {
+	events, err := c.virtClient.CoreV1().Events(c.namespace).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		c.bundle.addError("events: %v", err)
+		return
+	}
+
+	var related []k8sv1.Event
+	for _, event := range events.Items {
+		if involvedObjects[event.InvolvedObject.UID] {
+			related = append(related, event)
+		}
+	}
+	c.bundle.addYAML("events.yaml", related)
+}
+
+func hasAgentConnected(vmi *v1.VirtualMachineInstance) bool {
+	for _, condition := range vmi.Status.Conditions {
+		if condition.Type == v1.VirtualMachineInstanceAgentConnected && condition.Status == k8sv1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// redactVM returns a copy of the VM without the cloud-init data and the access credentials
+func redactVM(vm *v1.VirtualMachine) *v1.VirtualMachine {
+	vm = vm.DeepCopy()
+	delete(vm.Annotations, k8sv1.LastAppliedConfigAnnotation)
+	if vm.Spec.Template != nil {
+		redactVMISpec(&vm.Spec.Template.Spec)
+	}
+	return vm
+}
+
+// redactVMI returns a copy of the VMI without the cloud-init data and the access credentials
+func redactVMI(vmi *v1.VirtualMachineInstance) *v1.VirtualMachineInstance {
+	vmi = vmi.DeepCopy()
+	delete(vmi.Annotations, k8sv1.LastAppliedConfigAnnotation)
+	redactVMISpec(&vmi.Spec)
+	return vmi
+}
+
+func redactVMISpec(spec *v1.VirtualMachineInstanceSpec) {
+	for i := range spec.Volumes {
+		if noCloud := spec.Volumes[i].CloudInitNoCloud; noCloud != nil {
+			redactString(&noCloud.UserData)
+			redactString(&noCloud.UserDataBase64)
+			redactString(&noCloud.NetworkData)
+			redactString(&noCloud.NetworkDataBase64)
+		}
+		if configDrive := spec.Volumes[i].CloudInitConfigDrive; configDrive != nil {
+			redactString(&configDrive.UserData)
+			redactString(&configDrive.UserDataBase64)
+			redactString(&configDrive.NetworkData)
+			redactString(&configDrive.NetworkDataBase64)
+		}
+	}
+
+	for _, accessCredential := range spec.AccessCredentials {
+		if sshPublicKey := accessCredential.SSHPublicKey; sshPublicKey != nil {
+			if sshPublicKey.Source.Secret != nil {
+				sshPublicKey.Source.Secret.SecretName = redacted
+			}
+			if qemuGuestAgent := sshPublicKey.PropagationMethod.QemuGuestAgent; qemuGuestAgent != nil {
+				for i := range qemuGuestAgent.Users {
+					qemuGuestAgent.Users[i] = redacted
+				}
+			}
+		}
+		if userPassword := accessCredential.UserPassword; userPassword != nil && userPassword.Source.Secret != nil {
+			userPassword.Source.Secret.SecretName = redacted
+		}
+	}
+}
+
+// redactPod returns a copy of the pod without the values of the environment variables
+func redactPod(pod *k8sv1.Pod) *k8sv1.Pod {
+	pod = pod.DeepCopy()
+	for i := range pod.Spec.InitContainers {
+		redactEnv(pod.Spec.InitContainers[i].Env)
+	}
+	for i := range pod.Spec.Containers {
+		redactEnv(pod.Spec.Containers[i].Env)
+	}
+	return pod
+}
+
+func redactEnv(env []k8sv1.EnvVar) {
+	for i := range env {
+		redactString(&env[i].Value)
+	}
+}
+
+func redactString(value *string) {
+	if *value != "" {
+		*value = redacted
+	}
+}
+
+// extractQEMULog returns the lines of the QEMU log, which virt-launcher forwards to its own log
+func extractQEMULog(logs []byte) []byte {
+	qemuLog := &bytes.Buffer{}
+	scanner := bufio.NewScanner(bytes.NewReader(logs))
+	scanner.Buffer(make([]byte, 1024), 512*1024)
+	for scanner.Scan() {
+		entry := struct {
+			Subcomponent string `json:"subcomponent"`
+			Timestamp    string `json:"timestamp"`
+			Msg          string `json:"msg"`
+		}{}
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil || entry.Subcomponent != "qemu" {
+			continue
+		}
+		fmt.Fprintf(qemuLog, "%s %s\n", entry.Timestamp, entry.Msg)
+	}
+	return qemuLog.Bytes()
+}
+
+// bundle writes files into a gzipped tarball and keeps track of what could not be collected
+type bundle struct {
+	gzipWriter *gzip.Writer
+	tarWriter  *tar.Writer
+	modTime    time.Time
+	err        error
+	errs       []string
+}
+
+func newBundle(out io.Writer) *bundle {
+	gzipWriter := gzip.NewWriter(out)
+	return &bundle{
+		gzipWriter: gzipWriter,
+		tarWriter:  tar.NewWriter(gzipWriter),
+		modTime:    time.Now(),
+	}
+}
+
+func (b *bundle) add(name string, data []byte) {
+	if b.err != nil {
+		return
+	}
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: b.modTime,
+	}
+	if b.err = b.tarWriter.WriteHeader(header); b.err != nil {
+		return
+	}
+	_, b.err = b.tarWriter.Write(data)
+}
+
+func (b *bundle) addYAML(name string, obj interface{}) {
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		b.addError("%s: %v", name, err)
+		return
+	}
+	b.add(name, data)
+}
+
+func (b *bundle) addError(format string, args ...interface{}) {
+	b.errs = append(b.errs, fmt.Sprintf(format, args...))
+}
+
+func (b *bundle) close() error {
+	if len(b.errs) > 0 {
+		b.add(errorsFileName, []byte(strings.Join(b.errs, "\n")+"\n"))
+	}
+	if b.err != nil {
+		return b.err
+	}
+	if err := b.tarWriter.Close(); err != nil {
+		return err
+	}
+	return b.gzipWriter.Close()
+}
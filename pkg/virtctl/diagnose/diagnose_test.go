@@ -0,0 +1,251 @@
+package diagnose_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/diagnose"
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Diagnose", func() {
+	const (
+		vmName   = "testvm"
+		nodeName = "node01"
+	)
+
+	var vmInterface *kubecli.MockVirtualMachineInterface
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+	var migrationInterface *kubecli.MockVirtualMachineInstanceMigrationInterface
+	var kubeClient *fake.Clientset
+	var output string
+
+	var vm *v1.VirtualMachine
+	var vmi *v1.VirtualMachineInstance
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		migrationInterface = kubecli.NewMockVirtualMachineInstanceMigrationInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmInterface).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstanceMigration(metav1.NamespaceDefault).Return(migrationInterface).AnyTimes()
+
+		vm = &v1.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Name: vmName, Namespace: metav1.NamespaceDefault, UID: "vm-uid"}}
+		vmi = &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: vmName, Namespace: metav1.NamespaceDefault, UID: "vmi-uid"},
+			Status: v1.VirtualMachineInstanceStatus{
+				Phase:    v1.Running,
+				NodeName: nodeName,
+				Conditions: []v1.VirtualMachineInstanceCondition{
+					{Type: v1.VirtualMachineInstanceAgentConnected, Status: k8sv1.ConditionTrue},
+				},
+			},
+		}
+
+		kubeClient = fake.NewSimpleClientset(
+			&k8sv1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "virt-launcher-testvm-abcde",
+					Namespace: metav1.NamespaceDefault,
+					UID:       "pod-uid",
+					Labels:    map[string]string{v1.CreatedByLabel: "vmi-uid"},
+				},
+				Spec: k8sv1.PodSpec{Containers: []k8sv1.Container{{Name: "compute"}, {Name: "guest-console-log"}}},
+			},
+			&k8sv1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: metav1.NamespaceDefault},
+			},
+			&k8sv1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: nodeName, Labels: map[string]string{"cpu-model.node.kubevirt.io/Skylake": "true"}},
+			},
+			&k8sv1.Event{
+				ObjectMeta:     metav1.ObjectMeta{Name: "vmi-event", Namespace: metav1.NamespaceDefault},
+				InvolvedObject: k8sv1.ObjectReference{UID: "vmi-uid"},
+				Reason:         "Started",
+			},
+			&k8sv1.Event{
+				ObjectMeta:     metav1.ObjectMeta{Name: "other-event", Namespace: metav1.NamespaceDefault},
+				InvolvedObject: k8sv1.ObjectReference{UID: "other-uid"},
+				Reason:         "Unrelated",
+			},
+		)
+		kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+
+		output = filepath.Join(GinkgoT().TempDir(), "bundle.tar.gz")
+	})
+
+	readBundle := func() map[string]string {
+		file, err := os.Open(output)
+		Expect(err).ToNot(HaveOccurred())
+		defer file.Close()
+		gzipReader, err := gzip.NewReader(file)
+		Expect(err).ToNot(HaveOccurred())
+		tarReader := tar.NewReader(gzipReader)
+
+		files := map[string]string{}
+		for {
+			header, err := tarReader.Next()
+			if err == io.EOF {
+				break
+			}
+			Expect(err).ToNot(HaveOccurred())
+			data, err := io.ReadAll(tarReader)
+			Expect(err).ToNot(HaveOccurred())
+			files[header.Name] = string(data)
+		}
+		return files
+	}
+
+	runDiagnose := func() (string, error) {
+		out := &bytes.Buffer{}
+		cmd := clientcmd.NewVirtctlCommand(diagnose.COMMAND_DIAGNOSE, diagnose.COMMAND_VM, vmName, "--output", output)
+		cmd.SetOut(out)
+		err := cmd.Execute()
+		return out.String(), err
+	}
+
+	It("should collect the bundle of a running VM", func() {
+		vmInterface.EXPECT().Get(context.Background(), vmName, &metav1.GetOptions{}).Return(vm, nil)
+		vmiInterface.EXPECT().Get(context.Background(), vmName, &metav1.GetOptions{}).Return(vmi, nil)
+		vmiInterface.EXPECT().DomainXML(context.Background(), vmName).Return(&v1.VirtualMachineInstanceDomainXML{XML: "<domain/>"}, nil)
+		vmiInterface.EXPECT().GuestOsInfo(context.Background(), vmName).Return(v1.VirtualMachineInstanceGuestAgentInfo{Hostname: "guest"}, nil)
+		migrationInterface.EXPECT().List(&metav1.ListOptions{}).Return(&v1.VirtualMachineInstanceMigrationList{
+			Items: []v1.VirtualMachineInstanceMigration{
+				{ObjectMeta: metav1.ObjectMeta{Name: "migration-of-testvm"}, Spec: v1.VirtualMachineInstanceMigrationSpec{VMIName: vmName}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "migration-of-other"}, Spec: v1.VirtualMachineInstanceMigrationSpec{VMIName: "other"}},
+			},
+		}, nil)
+
+		out, err := runDiagnose()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out).To(ContainSubstring("Diagnostic bundle of VirtualMachine testvm written to " + output))
+
+		files := readBundle()
+		Expect(files).To(HaveKey("virtualmachine.yaml"))
+		Expect(files).To(HaveKey("virtualmachineinstance.yaml"))
+		Expect(files).To(HaveKey("pods/virt-launcher-testvm-abcde.yaml"))
+		Expect(files).To(HaveKey("pods/virt-launcher-testvm-abcde/compute.log"))
+		Expect(files).To(HaveKey("pods/virt-launcher-testvm-abcde/guest-console-log.log"))
+		Expect(files).ToNot(HaveKey("pods/unrelated.yaml"))
+		Expect(files).To(HaveKeyWithValue("domain.xml", "<domain/>"))
+		Expect(files["guestosinfo.yaml"]).To(ContainSubstring("hostname: guest"))
+		Expect(files["node-labels.yaml"]).To(ContainSubstring("cpu-model.node.kubevirt.io/Skylake"))
+		Expect(files["migrations.yaml"]).To(ContainSubstring("migration-of-testvm"))
+		Expect(files["migrations.yaml"]).ToNot(ContainSubstring("migration-of-other"))
+		Expect(files["events.yaml"]).To(ContainSubstring("Started"))
+		Expect(files["events.yaml"]).ToNot(ContainSubstring("Unrelated"))
+		Expect(files).ToNot(HaveKey("errors.txt"))
+	})
+
+	It("should redact secrets from the collected objects", func() {
+		vm.Annotations = map[string]string{k8sv1.LastAppliedConfigAnnotation: "secret-applied-config"}
+		vm.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{
+			Spec: v1.VirtualMachineInstanceSpec{
+				Volumes: []v1.Volume{{
+					Name: "cloudinit",
+					VolumeSource: v1.VolumeSource{
+						CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserData: "password: secret-user-data"},
+					},
+				}},
+			},
+		}
+		vmi.Spec.Volumes = []v1.Volume{{
+			Name: "cloudinit",
+			VolumeSource: v1.VolumeSource{
+				CloudInitConfigDrive: &v1.CloudInitConfigDriveSource{UserDataBase64: "c2VjcmV0LXVzZXItZGF0YQ==", NetworkData: "secret-network-data"},
+			},
+		}}
+		vmi.Spec.AccessCredentials = []v1.AccessCredential{{
+			SSHPublicKey: &v1.SSHPublicKeyAccessCredential{
+				Source: v1.SSHPublicKeyAccessCredentialSource{Secret: &v1.AccessCredentialSecretSource{SecretName: "secret-ssh-keys"}},
+				PropagationMethod: v1.SSHPublicKeyAccessCredentialPropagationMethod{
+					QemuGuestAgent: &v1.QemuGuestAgentSSHPublicKeyAccessCredentialPropagation{Users: []string{"secret-user"}},
+				},
+			},
+		}}
+		pod, err := kubeClient.CoreV1().Pods(metav1.NamespaceDefault).Get(context.Background(), "virt-launcher-testvm-abcde", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		pod.Spec.Containers[0].Env = []k8sv1.EnvVar{{Name: "TOKEN", Value: "secret-env-value"}}
+		_, err = kubeClient.CoreV1().Pods(metav1.NamespaceDefault).Update(context.Background(), pod, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		vmInterface.EXPECT().Get(context.Background(), vmName, &metav1.GetOptions{}).Return(vm, nil)
+		vmiInterface.EXPECT().Get(context.Background(), vmName, &metav1.GetOptions{}).Return(vmi, nil)
+		vmiInterface.EXPECT().DomainXML(context.Background(), vmName).Return(&v1.VirtualMachineInstanceDomainXML{XML: "<domain/>"}, nil)
+		vmiInterface.EXPECT().GuestOsInfo(context.Background(), vmName).Return(v1.VirtualMachineInstanceGuestAgentInfo{}, nil)
+		migrationInterface.EXPECT().List(&metav1.ListOptions{}).Return(&v1.VirtualMachineInstanceMigrationList{}, nil)
+
+		_, err = runDiagnose()
+		Expect(err).ToNot(HaveOccurred())
+
+		files := readBundle()
+		for _, name := range []string{"virtualmachine.yaml", "virtualmachineinstance.yaml", "pods/virt-launcher-testvm-abcde.yaml"} {
+			Expect(files[name]).To(ContainSubstring("REDACTED"), name)
+			Expect(files[name]).ToNot(ContainSubstring("secret-"), name)
+			Expect(files[name]).ToNot(ContainSubstring("c2VjcmV0"), name)
+		}
+		Expect(files["pods/virt-launcher-testvm-abcde.yaml"]).To(ContainSubstring("TOKEN"))
+		Expect(vm.Spec.Template.Spec.Volumes[0].CloudInitNoCloud.UserData).To(Equal("password: secret-user-data"))
+	})
+
+	It("should record what could not be collected", func() {
+		vmInterface.EXPECT().Get(context.Background(), vmName, &metav1.GetOptions{}).Return(vm, nil)
+		vmi.Status.Conditions = nil
+		vmiInterface.EXPECT().Get(context.Background(), vmName, &metav1.GetOptions{}).Return(vmi, nil)
+		vmiInterface.EXPECT().DomainXML(context.Background(), vmName).Return(nil, fmt.Errorf("virt-handler is unreachable"))
+		migrationInterface.EXPECT().List(&metav1.ListOptions{}).Return(&v1.VirtualMachineInstanceMigrationList{}, nil)
+
+		out, err := runDiagnose()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out).To(ContainSubstring("2 items could not be collected"))
+
+		files := readBundle()
+		Expect(files).ToNot(HaveKey("domain.xml"))
+		Expect(files).ToNot(HaveKey("guestosinfo.yaml"))
+		Expect(files["errors.txt"]).To(ContainSubstring("domain XML: virt-handler is unreachable"))
+		Expect(files["errors.txt"]).To(ContainSubstring("guest agent info: the guest agent is not connected"))
+	})
+
+	It("should collect the bundle of a stopped VM", func() {
+		vmInterface.EXPECT().Get(context.Background(), vmName, &metav1.GetOptions{}).Return(vm, nil)
+		vmiInterface.EXPECT().Get(context.Background(), vmName, &metav1.GetOptions{}).Return(nil, k8serrors.NewNotFound(v1.Resource("virtualmachineinstance"), vmName))
+		migrationInterface.EXPECT().List(&metav1.ListOptions{}).Return(&v1.VirtualMachineInstanceMigrationList{}, nil)
+
+		_, err := runDiagnose()
+		Expect(err).ToNot(HaveOccurred())
+
+		files := readBundle()
+		Expect(files).To(HaveKey("virtualmachine.yaml"))
+		Expect(files).ToNot(HaveKey("virtualmachineinstance.yaml"))
+		Expect(files["errors.txt"]).To(ContainSubstring("the VirtualMachine is not running"))
+	})
+
+	It("should fail when the VM does not exist", func() {
+		vmInterface.EXPECT().Get(context.Background(), vmName, &metav1.GetOptions{}).Return(nil, k8serrors.NewNotFound(v1.Resource("virtualmachine"), vmName))
+
+		_, err := runDiagnose()
+		Expect(err).To(MatchError(ContainSubstring("error fetching VirtualMachine testvm")))
+		Expect(output).ToNot(BeAnExistingFile())
+	})
+})
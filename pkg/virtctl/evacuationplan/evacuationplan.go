@@ -0,0 +1,126 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package evacuationplan
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_EVACUATION_PLAN = "evacuation-plan"
+
+	executeArg = "execute"
+)
+
+var execute bool
+
+type command struct {
+	clientConfig clientcmd.ClientConfig
+	cmd          *cobra.Command
+}
+
+func usage() string {
+	return `  # Show which VMIs on node 'node01' would be live migrated, shut down or block a drain of the node:
+  {{ProgramName}} evacuation-plan node01
+
+  # Start live migrating all VMIs away from 'node01' ahead of a drain and show the progress:
+  {{ProgramName}} evacuation-plan node01 --execute`
+}
+
+// NewCommand returns a cobra.Command to show and execute the evacuation plan of a node
+func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "evacuation-plan (NODE)",
+		Short: "Show or execute the evacuation plan of a node.",
+		Long: `Show what happens to every VMI on a node when the node is drained, according to the eviction strategy of the VMI,
+together with the estimated data transferred by the live migrations and the progress of an ongoing evacuation.
+With --execute all VMIs which live migrate on eviction are marked for evacuation right away, the same way a drain does.
+VMIs which are shut down on eviction are left running until their pods are evicted.`,
+		Example: usage(),
+		Args:    templates.ExactArgs(COMMAND_EVACUATION_PLAN, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := command{clientConfig: clientConfig, cmd: cmd}
+			return c.run(args[0])
+		},
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	cmd.Flags().BoolVar(&execute, executeArg, false, "Start the evacuation by marking the VMIs which live migrate on eviction for evacuation.")
+	return cmd
+}
+
+func (c *command) run(nodeName string) error {
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	var plan *v1.NodeEvacuationPlan
+	if execute {
+		plan, err = virtClient.NodeEvacuation().Evacuate(context.Background(), nodeName)
+	} else {
+		plan, err = virtClient.NodeEvacuation().Plan(context.Background(), nodeName)
+	}
+	if err != nil {
+		return fmt.Errorf("error fetching the evacuation plan of node %s: %v", nodeName, err)
+	}
+
+	return PrintPlan(c.cmd.OutOrStdout(), plan)
+}
+
+// PrintPlan writes the entries and the progress of an evacuation plan in a human readable form
+func PrintPlan(out io.Writer, plan *v1.NodeEvacuationPlan) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAMESPACE\tNAME\tEVICTION STRATEGY\tACTION\tPHASE\tDATA\tREASON")
+	for _, entry := range plan.VMIs {
+		strategy := "<none>"
+		if entry.EvictionStrategy != nil {
+			strategy = string(*entry.EvictionStrategy)
+		}
+		data := ""
+		if entry.EstimatedDataTransfer != nil {
+			data = entry.EstimatedDataTransfer.String()
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", entry.Namespace, entry.Name, strategy, entry.Action, entry.Phase, data, entry.Reason)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(out)
+	if plan.EstimatedDataTransfer != nil {
+		fmt.Fprintf(out, "Estimated data transfer: %s\n", plan.EstimatedDataTransfer.String())
+	}
+	if status := plan.Status; status != nil {
+		fmt.Fprintf(out, "Pending: %d, Marked: %d, Migrating: %d, Migrated: %d, Failed: %d\n",
+			status.Pending, status.Marked, status.Migrating, status.Migrated, status.Failed)
+	}
+	return nil
+}
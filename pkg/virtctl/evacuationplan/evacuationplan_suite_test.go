@@ -0,0 +1,11 @@
+package evacuationplan_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestEvacuationPlan(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
@@ -0,0 +1,90 @@
+package evacuationplan_test
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/evacuationplan"
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Evacuation plan", func() {
+	const nodeName = "node01"
+
+	var nodeEvacuation *kubecli.MockNodeEvacuationInterface
+	var plan *v1.NodeEvacuationPlan
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		nodeEvacuation = kubecli.NewMockNodeEvacuationInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().NodeEvacuation().Return(nodeEvacuation).AnyTimes()
+
+		liveMigrate := v1.EvictionStrategyLiveMigrate
+		estimate := resource.MustParse("1Gi")
+		plan = &v1.NodeEvacuationPlan{
+			NodeName: nodeName,
+			VMIs: []v1.NodeEvacuationPlanEntry{
+				{
+					Namespace:             "default",
+					Name:                  "migratable",
+					Action:                v1.NodeEvacuationActionLiveMigrate,
+					EvictionStrategy:      &liveMigrate,
+					EstimatedDataTransfer: &estimate,
+					Phase:                 v1.NodeEvacuationPending,
+				},
+				{
+					Namespace: "default",
+					Name:      "no-strategy",
+					Action:    v1.NodeEvacuationActionShutdown,
+					Reason:    "VMI has no eviction strategy",
+					Phase:     v1.NodeEvacuationPending,
+				},
+			},
+			EstimatedDataTransfer: &estimate,
+			Status:                &v1.NodeEvacuationPlanStatus{Pending: 2},
+		}
+	})
+
+	It("should fail without a node", func() {
+		cmd := clientcmd.NewRepeatableVirtctlCommand(evacuationplan.COMMAND_EVACUATION_PLAN)
+		Expect(cmd()).NotTo(Succeed())
+	})
+
+	It("should print the evacuation plan of a node", func() {
+		nodeEvacuation.EXPECT().Plan(context.Background(), nodeName).Return(plan, nil)
+
+		out := &bytes.Buffer{}
+		cmd := clientcmd.NewVirtctlCommand(evacuationplan.COMMAND_EVACUATION_PLAN, nodeName)
+		cmd.SetOut(out)
+		Expect(cmd.Execute()).To(Succeed())
+
+		Expect(out.String()).To(MatchRegexp(`default\s+migratable\s+LiveMigrate\s+LiveMigrate\s+Pending\s+1Gi`))
+		Expect(out.String()).To(MatchRegexp(`default\s+no-strategy\s+<none>\s+Shutdown\s+Pending\s+VMI has no eviction strategy`))
+		Expect(out.String()).To(ContainSubstring("Estimated data transfer: 1Gi"))
+		Expect(out.String()).To(ContainSubstring("Pending: 2, Marked: 0, Migrating: 0, Migrated: 0, Failed: 0"))
+	})
+
+	It("should execute the evacuation plan of a node", func() {
+		plan.VMIs[0].Phase = v1.NodeEvacuationMarked
+		plan.Status = &v1.NodeEvacuationPlanStatus{Pending: 1, Marked: 1}
+		nodeEvacuation.EXPECT().Evacuate(context.Background(), nodeName).Return(plan, nil)
+
+		out := &bytes.Buffer{}
+		cmd := clientcmd.NewVirtctlCommand(evacuationplan.COMMAND_EVACUATION_PLAN, nodeName, "--execute")
+		cmd.SetOut(out)
+		Expect(cmd.Execute()).To(Succeed())
+
+		Expect(out.String()).To(MatchRegexp(`default\s+migratable\s+LiveMigrate\s+LiveMigrate\s+Marked`))
+		Expect(out.String()).To(ContainSubstring("Pending: 1, Marked: 1"))
+	})
+})
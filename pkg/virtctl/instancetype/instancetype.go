@@ -0,0 +1,173 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package instancetype
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_INSTANCETYPE = "instancetype"
+	COMMAND_UPGRADE      = "upgrade"
+
+	allArg    = "all"
+	dryRunArg = "dry-run"
+)
+
+type upgradeFlags struct {
+	all    bool
+	dryRun bool
+}
+
+func usage() string {
+	return `  # Show how the VM 'myvm' changes when it is upgraded to the latest generation of its instancetype and preference:
+  {{ProgramName}} instancetype upgrade myvm --dry-run
+
+  # Upgrade the VM 'myvm':
+  {{ProgramName}} instancetype upgrade myvm
+
+  # Upgrade all VMs of the namespace which reference an instancetype or preference:
+  {{ProgramName}} instancetype upgrade --all`
+}
+
+// NewCommand returns a cobra.Command to manage the instancetypes and preferences referenced by virtual machines
+func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "instancetype upgrade",
+		Short:   "Manage the instancetypes and preferences referenced by virtual machines.",
+		Example: usage(),
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Print(cmd.UsageString())
+		},
+	}
+	cmd.AddCommand(newUpgradeCommand(clientConfig))
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newUpgradeCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	flags := &upgradeFlags{}
+	cmd := &cobra.Command{
+		Use:     "upgrade (VM|--all)",
+		Short:   "Move virtual machines to the latest generation of their instancetype and preference.",
+		Long:    "Move virtual machines to the latest generation of their instancetype and preference.\nControllerRevisions stored with an older API version are rewritten as v1beta1.\nRunning virtual machines pick up the changes the next time they are started.",
+		Example: usage(),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if flags.all == (len(args) == 1) {
+				return fmt.Errorf("either a VM or --%s has to be provided", allArg)
+			}
+			return runUpgrade(clientConfig, cmd, flags, args)
+		},
+	}
+	cmd.Flags().BoolVar(&flags.all, allArg, false, "Upgrade all VMs of the namespace which reference an instancetype or preference.")
+	cmd.Flags().BoolVar(&flags.dryRun, dryRunArg, false, "Only show the changes, without upgrading the VMs.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func runUpgrade(clientConfig clientcmd.ClientConfig, cmd *cobra.Command, flags *upgradeFlags, args []string) error {
+	namespace, _, err := clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(clientConfig)
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	vmNames := args
+	if flags.all {
+		vms, err := virtClient.VirtualMachine(namespace).List(context.Background(), &metav1.ListOptions{})
+		if err != nil {
+			return fmt.Errorf("error listing VMs: %v", err)
+		}
+		vmNames = nil
+		for _, vm := range vms.Items {
+			if referencesRevision(&vm) {
+				vmNames = append(vmNames, vm.Name)
+			}
+		}
+	}
+
+	opts := &v1.InstancetypeUpgradeOptions{}
+	if flags.dryRun {
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+	out := cmd.OutOrStdout()
+	var failed []string
+	for _, name := range vmNames {
+		upgrade, err := virtClient.VirtualMachine(namespace).UpgradeInstancetype(context.Background(), name, opts)
+		if err != nil {
+			if !flags.all {
+				return fmt.Errorf("error upgrading VM %s: %v", name, err)
+			}
+			fmt.Fprintf(out, "VM %s: upgrade failed: %v\n", name, err)
+			failed = append(failed, name)
+			continue
+		}
+		printUpgrade(out, name, upgrade, flags.dryRun)
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to upgrade VMs: %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+func referencesRevision(vm *v1.VirtualMachine) bool {
+	return vm.Spec.Instancetype != nil && vm.Spec.Instancetype.RevisionName != "" ||
+		vm.Spec.Preference != nil && vm.Spec.Preference.RevisionName != ""
+}
+
+func printUpgrade(out io.Writer, vmName string, upgrade *v1.InstancetypeUpgrade, dryRun bool) {
+	action := "upgraded"
+	if dryRun {
+		action = "would be upgraded (dry run)"
+	}
+	fmt.Fprintf(out, "VM %s:\n", vmName)
+	printRevisionUpgrade(out, "instancetype", upgrade.Instancetype, action)
+	printRevisionUpgrade(out, "preference", upgrade.Preference, action)
+}
+
+func printRevisionUpgrade(out io.Writer, kind string, upgrade *v1.RevisionUpgrade, action string) {
+	if upgrade == nil {
+		return
+	}
+	if upgrade.RevisionName == upgrade.CurrentRevisionName {
+		fmt.Fprintf(out, "  %s: %s is up to date\n", kind, upgrade.CurrentRevisionName)
+		return
+	}
+	fmt.Fprintf(out, "  %s: %s (%s) %s to %s\n", kind, upgrade.CurrentRevisionName, upgrade.CurrentVersion, action, upgrade.RevisionName)
+	if upgrade.Diff != "" {
+		fmt.Fprintf(out, "    changes: %s\n", upgrade.Diff)
+	}
+}
@@ -0,0 +1,94 @@
+package instancetype_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Instancetype upgrade", func() {
+
+	var (
+		vmInterface *kubecli.MockVirtualMachineInterface
+		out         *bytes.Buffer
+	)
+
+	run := func(args ...string) error {
+		cmd := clientcmd.NewVirtctlCommand(append([]string{"instancetype", "upgrade"}, args...)...)
+		cmd.SetOut(out)
+		return cmd.Execute()
+	}
+
+	upgraded := &v1.InstancetypeUpgrade{
+		Instancetype: &v1.RevisionUpgrade{
+			CurrentRevisionName: "testvm-u1.small-1",
+			CurrentVersion:      "v1alpha2",
+			RevisionName:        "testvm-u1.small-2",
+			Diff:                `{"cpu":{"guest":2},"memory":{"guest":"4Gi"}}`,
+		},
+		Preference: &v1.RevisionUpgrade{
+			CurrentRevisionName: "testvm-fedora-1",
+			CurrentVersion:      "v1beta1",
+			RevisionName:        "testvm-fedora-1",
+		},
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmInterface).AnyTimes()
+		out = &bytes.Buffer{}
+	})
+
+	It("should require either a VM or --all", func() {
+		Expect(run()).To(MatchError(ContainSubstring("either a VM or --all has to be provided")))
+		Expect(run("testvm", "--all")).To(MatchError(ContainSubstring("either a VM or --all has to be provided")))
+	})
+
+	It("should upgrade a VM and print the changes", func() {
+		vmInterface.EXPECT().UpgradeInstancetype(context.Background(), "testvm", &v1.InstancetypeUpgradeOptions{}).Return(upgraded, nil)
+
+		Expect(run("testvm")).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("instancetype: testvm-u1.small-1 (v1alpha2) upgraded to testvm-u1.small-2"))
+		Expect(out.String()).To(ContainSubstring("    changes: {\"cpu\":{\"guest\":2},\"memory\":{\"guest\":\"4Gi\"}}\n"))
+		Expect(out.String()).To(ContainSubstring("preference: testvm-fedora-1 is up to date"))
+	})
+
+	It("should only show the changes with --dry-run", func() {
+		vmInterface.EXPECT().UpgradeInstancetype(context.Background(), "testvm", &v1.InstancetypeUpgradeOptions{DryRun: []string{metav1.DryRunAll}}).Return(upgraded, nil)
+
+		Expect(run("testvm", "--dry-run")).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("would be upgraded (dry run) to testvm-u1.small-2"))
+	})
+
+	It("should upgrade all VMs referencing an instancetype or preference", func() {
+		withInstancetype := kubecli.NewMinimalVM("withinstancetype")
+		withInstancetype.Spec.Instancetype = &v1.InstancetypeMatcher{Name: "u1.small", RevisionName: "withinstancetype-u1.small-1"}
+		withPreference := kubecli.NewMinimalVM("withpreference")
+		withPreference.Spec.Preference = &v1.PreferenceMatcher{Name: "fedora", RevisionName: "withpreference-fedora-1"}
+		withoutInstancetype := kubecli.NewMinimalVM("withoutinstancetype")
+
+		vmInterface.EXPECT().List(context.Background(), &metav1.ListOptions{}).Return(&v1.VirtualMachineList{
+			Items: []v1.VirtualMachine{*withInstancetype, *withPreference, *withoutInstancetype},
+		}, nil)
+		vmInterface.EXPECT().UpgradeInstancetype(context.Background(), "withinstancetype", gomock.Any()).Return(upgraded, nil)
+		vmInterface.EXPECT().UpgradeInstancetype(context.Background(), "withpreference", gomock.Any()).Return(nil, fmt.Errorf("preference requirements not met"))
+
+		err := run("--all")
+		Expect(err).To(MatchError(ContainSubstring("failed to upgrade VMs: withpreference")))
+		Expect(out.String()).To(ContainSubstring("VM withinstancetype:"))
+		Expect(out.String()).To(ContainSubstring("VM withpreference: upgrade failed: preference requirements not met"))
+	})
+})
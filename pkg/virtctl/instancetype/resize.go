@@ -0,0 +1,81 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package instancetype
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_RESIZE = "resize"
+
+	instancetypeArg = "instancetype"
+)
+
+func resizeUsage() string {
+	return `  # Resize the VM 'myvm' to the instancetype 'u1.large' of the same family:
+  {{ProgramName}} resize myvm --instancetype u1.large`
+}
+
+// NewResizeCommand returns a cobra.Command to switch a virtual machine to another instancetype of the same family
+func NewResizeCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	var instancetypeName string
+	cmd := &cobra.Command{
+		Use:     "resize (VM) --instancetype (INSTANCETYPE)",
+		Short:   "Switch a virtual machine to another instancetype of the same family.",
+		Long:    "Switch a virtual machine to another instancetype of the same family.\nThe new size is applied to a running virtual machine if its live update features allow it,\notherwise the virtual machine is marked with the RestartRequired condition.",
+		Example: resizeUsage(),
+		Args:    templates.ExactArgs(COMMAND_RESIZE, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runResize(clientConfig, cmd, args[0], instancetypeName)
+		},
+	}
+	cmd.Flags().StringVar(&instancetypeName, instancetypeArg, "", "Name of the instancetype to switch to.")
+	cmd.MarkFlagRequired(instancetypeArg)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func runResize(clientConfig clientcmd.ClientConfig, cmd *cobra.Command, vmName, instancetypeName string) error {
+	namespace, _, err := clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(clientConfig)
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	opts := &v1.VirtualMachineResizeOptions{Instancetype: instancetypeName}
+	if err := virtClient.VirtualMachine(namespace).Resize(context.Background(), vmName, opts); err != nil {
+		return fmt.Errorf("error resizing VM %s: %v", vmName, err)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "VM %s was scheduled to be resized to instancetype %s\n", vmName, instancetypeName)
+	return nil
+}
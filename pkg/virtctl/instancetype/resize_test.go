@@ -0,0 +1,57 @@
+package instancetype_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Resize", func() {
+
+	var (
+		vmInterface *kubecli.MockVirtualMachineInterface
+		out         *bytes.Buffer
+	)
+
+	run := func(args ...string) error {
+		cmd := clientcmd.NewVirtctlCommand(append([]string{"resize"}, args...)...)
+		cmd.SetOut(out)
+		return cmd.Execute()
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmInterface).AnyTimes()
+		out = &bytes.Buffer{}
+	})
+
+	It("should require an instancetype", func() {
+		Expect(run("testvm")).To(MatchError(ContainSubstring("required flag(s) \"instancetype\" not set")))
+	})
+
+	It("should resize the VM", func() {
+		vmInterface.EXPECT().Resize(context.Background(), "testvm", &v1.VirtualMachineResizeOptions{Instancetype: "u1.large"}).Return(nil)
+
+		Expect(run("testvm", "--instancetype", "u1.large")).To(Succeed())
+		Expect(out.String()).To(Equal("VM testvm was scheduled to be resized to instancetype u1.large\n"))
+	})
+
+	It("should report a failed resize", func() {
+		vmInterface.EXPECT().Resize(context.Background(), "testvm", &v1.VirtualMachineResizeOptions{Instancetype: "cx1.large"}).Return(fmt.Errorf("instancetype u1.small of family \"u1\" can not be resized to cx1.large of family \"cx1\""))
+
+		Expect(run("testvm", "--instancetype", "cx1.large")).To(MatchError(ContainSubstring("error resizing VM testvm")))
+	})
+})
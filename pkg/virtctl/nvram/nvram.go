@@ -0,0 +1,294 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package nvram
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"kubevirt.io/client-go/kubecli"
+
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+	"kubevirt.io/kubevirt/pkg/virtctl/vmexport"
+)
+
+const (
+	COMMAND_NVRAM = "nvram"
+	DUMP          = "dump"
+	INSPECT       = "inspect"
+
+	fileArg        = "file"
+	outputArg      = "output"
+	portForwardArg = "port-forward"
+	localPortArg   = "local-port"
+	insecureArg    = "insecure"
+
+	// nvramSubPath is the directory of the backend storage PVC holding the NVRAM files
+	nvramSubPath = "nvram"
+)
+
+var (
+	inputFile   string
+	outputFile  string
+	portForward bool
+	localPort   string
+	insecure    bool
+)
+
+type command struct {
+	clientConfig clientcmd.ClientConfig
+	cmd          *cobra.Command
+}
+
+type downloadFunc func(client kubecli.KubevirtClient, vmeInfo *vmexport.VMExportInfo) error
+
+// DownloadBackendStorage is used to store the function to download the backend storage PVC.
+// Useful for unit tests.
+var DownloadBackendStorage downloadFunc = vmexport.DownloadVirtualMachineExport
+
+func usage() string {
+	return `  # Download the persisted EFI NVRAM of the stopped virtual machine 'myvm':
+  {{ProgramName}} nvram dump myvm --output=myvm_VARS.fd
+
+  # List the variables and enrolled Secure Boot keys of the persisted EFI NVRAM of 'myvm':
+  {{ProgramName}} nvram inspect myvm
+
+  # List the variables and enrolled Secure Boot keys of a local variable store, e.g. a custom NVRAM template:
+  {{ProgramName}} nvram inspect --file=company_VARS.fd`
+}
+
+// NewCommand returns a cobra.Command to dump and inspect the persisted EFI NVRAM of a VM
+func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "nvram dump/inspect (VM)",
+		Short:   "Dump or inspect the persisted EFI NVRAM of a virtual machine.",
+		Long:    "Dump or inspect the persisted EFI NVRAM of a virtual machine. The NVRAM is downloaded from the backend storage of the VM, which requires the VM to be stopped.",
+		Example: usage(),
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := command{clientConfig: clientConfig, cmd: cmd}
+			return c.run(args)
+		},
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	cmd.Flags().StringVar(&inputFile, fileArg, "", "Inspect a local variable store file instead of the NVRAM of a VM.")
+	cmd.Flags().StringVar(&outputFile, outputArg, "", "Specifies the output path of the dumped NVRAM.")
+	cmd.Flags().BoolVar(&portForward, portForwardArg, false, "Configure and set port-forward in a random port to download the NVRAM.")
+	cmd.Flags().StringVar(&localPort, localPortArg, "0", "Specify port for port-forward.")
+	cmd.Flags().BoolVar(&insecure, insecureArg, false, "Specifies that the http request to download the NVRAM should be insecure.")
+	return cmd
+}
+
+func (c *command) run(args []string) error {
+	switch args[0] {
+	case DUMP:
+		if len(args) != 2 {
+			return fmt.Errorf("%s requires the name of a VM", DUMP)
+		}
+		if outputFile == "" {
+			return fmt.Errorf("missing --%s to write the NVRAM to", outputArg)
+		}
+		data, err := c.fetchNVRAM(args[1])
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(outputFile, data, 0600)
+	case INSPECT:
+		var data []byte
+		var err error
+		switch {
+		case inputFile != "" && len(args) == 2:
+			return fmt.Errorf("either specify a VM or --%s, not both", fileArg)
+		case inputFile != "":
+			data, err = os.ReadFile(inputFile)
+		case len(args) == 2:
+			data, err = c.fetchNVRAM(args[1])
+		default:
+			return fmt.Errorf("%s requires the name of a VM or --%s", INSPECT, fileArg)
+		}
+		if err != nil {
+			return err
+		}
+		store, err := ParseVariableStore(data)
+		if err != nil {
+			return err
+		}
+		return PrintVariableStore(c.cmd.OutOrStdout(), store)
+	default:
+		return fmt.Errorf("invalid action type %s", args[0])
+	}
+}
+
+// fetchNVRAM downloads the backend storage of a stopped VM through a
+// VirtualMachineExport and extracts its NVRAM file from the archive.
+func (c *command) fetchNVRAM(vmName string) ([]byte, error) {
+	namespace, _, err := c.clientConfig.Namespace()
+	if err != nil {
+		return nil, err
+	}
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return nil, fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	vm, err := virtClient.VirtualMachine(namespace).Get(context.Background(), vmName, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if vm.Spec.Template == nil || !backendstorage.HasPersistentEFI(&vm.Spec.Template.Spec) {
+		return nil, fmt.Errorf("VirtualMachine %s does not persist its EFI NVRAM", vmName)
+	}
+
+	vmi, err := virtClient.VirtualMachineInstance(namespace).Get(context.Background(), vmName, &metav1.GetOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return nil, err
+	}
+	if err == nil && !vmi.IsFinal() {
+		return nil, fmt.Errorf("VirtualMachine %s must be stopped to access its NVRAM", vmName)
+	}
+
+	buf := &bytes.Buffer{}
+	vmExportInfo := &vmexport.VMExportInfo{
+		ShouldCreate: true,
+		Insecure:     insecure,
+		KeepVme:      false,
+		OutputWriter: buf,
+		Namespace:    namespace,
+		Name:         fmt.Sprintf("export-nvram-%s", vmName),
+		ExportSource: k8sv1.TypedLocalObjectReference{
+			APIGroup: &k8sv1.SchemeGroupVersion.Group,
+			Kind:     "PersistentVolumeClaim",
+			Name:     backendstorage.PVCPrefix + vmName,
+		},
+		PortForward: portForward,
+		LocalPort:   localPort,
+	}
+	if portForward {
+		// the certificate of the export server does not cover the forwarded local address
+		vmExportInfo.Insecure = true
+		vmExportInfo.ServiceURL = fmt.Sprintf("127.0.0.1:%s", localPort)
+	}
+	if err := DownloadBackendStorage(virtClient, vmExportInfo); err != nil {
+		return nil, err
+	}
+
+	return ExtractNVRAM(buf, vmName)
+}
+
+// ExtractNVRAM returns the NVRAM file of the given VM from a gzipped tar archive of its backend storage
+func ExtractNVRAM(archive io.Reader, vmName string) ([]byte, error) {
+	gzipReader, err := gzip.NewReader(archive)
+	if err != nil {
+		return nil, err
+	}
+	defer gzipReader.Close()
+
+	expected := path.Join(nvramSubPath, vmName+"_VARS.fd")
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil, fmt.Errorf("%s not found in the backend storage of VirtualMachine %s", expected, vmName)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag == tar.TypeReg && path.Clean(header.Name) == expected {
+			return io.ReadAll(tarReader)
+		}
+	}
+}
+
+// PrintVariableStore writes the Secure Boot state and the variables of a store in a human readable form
+func PrintVariableStore(out io.Writer, store *VariableStore) error {
+	pk := store.Lookup(GlobalVariableGUID, "PK")
+	mode := "setup mode (no PK enrolled)"
+	if pk != nil {
+		mode = "user mode (PK enrolled)"
+	}
+	fmt.Fprintf(out, "Variables: %d\n", len(store.Variables))
+	fmt.Fprintf(out, "Secure Boot: %s\n", mode)
+
+	for _, db := range []struct {
+		vendor GUID
+		name   string
+	}{
+		{GlobalVariableGUID, "PK"},
+		{GlobalVariableGUID, "KEK"},
+		{ImageSecurityDatabaseGUID, "db"},
+		{ImageSecurityDatabaseGUID, "dbx"},
+	} {
+		variable := store.Lookup(db.vendor, db.name)
+		if variable == nil {
+			fmt.Fprintf(out, "%s: not enrolled\n", db.name)
+			continue
+		}
+		signatures, err := ParseSignatureLists(variable.Data)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %v", db.name, err)
+		}
+		fmt.Fprintf(out, "%s: %d entries\n", db.name, len(signatures))
+		for _, signature := range signatures {
+			fmt.Fprintf(out, "  %s\n", describeSignature(signature))
+		}
+	}
+
+	fmt.Fprintln(out)
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tVENDOR\tATTRIBUTES\tSIZE")
+	for _, variable := range store.Variables {
+		fmt.Fprintf(w, "%s\t%s\t0x%02x\t%d\n", variable.Name, variable.VendorGUID, variable.Attributes, len(variable.Data))
+	}
+	return w.Flush()
+}
+
+func describeSignature(signature Signature) string {
+	switch signature.Type {
+	case CertX509GUID:
+		cert, err := x509.ParseCertificate(signature.Data)
+		if err != nil {
+			return fmt.Sprintf("X509 (unparsable: %v)", err)
+		}
+		fingerprint := sha256.Sum256(signature.Data)
+		return fmt.Sprintf("X509 subject=%q issuer=%q sha256=%s", cert.Subject.String(), cert.Issuer.String(), hex.EncodeToString(fingerprint[:]))
+	case CertSHA256GUID:
+		return fmt.Sprintf("SHA256 %s", hex.EncodeToString(signature.Data))
+	default:
+		return fmt.Sprintf("%s (%d bytes)", signature.Type, len(signature.Data))
+	}
+}
@@ -0,0 +1,11 @@
+package nvram_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestNVRAM(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
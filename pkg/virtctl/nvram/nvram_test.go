@@ -0,0 +1,175 @@
+package nvram_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/nvram"
+	"kubevirt.io/kubevirt/pkg/virtctl/vmexport"
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+const vmName = "testvm"
+
+func newArchive(files map[string][]byte) []byte {
+	buf := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, content := range files {
+		Expect(tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(content)), Typeflag: tar.TypeReg})).To(Succeed())
+		_, err := tarWriter.Write(content)
+		Expect(err).ToNot(HaveOccurred())
+	}
+	Expect(tarWriter.Close()).To(Succeed())
+	Expect(gzipWriter.Close()).To(Succeed())
+	return buf.Bytes()
+}
+
+var _ = Describe("NVRAM", func() {
+	var vmInterface *kubecli.MockVirtualMachineInterface
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+	var store []byte
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+
+		store = newVariableStore(
+			testVariable{name: "PK", vendor: nvram.GlobalVariableGUID, state: varAdded, data: newSignatureList(nvram.CertX509GUID, nvram.GUID{1}, newCertificate("Company PK"))},
+			testVariable{name: "BootOrder", vendor: nvram.GlobalVariableGUID, state: varAdded, data: []byte{0, 0}},
+		)
+
+		nvram.DownloadBackendStorage = func(_ kubecli.KubevirtClient, vmeInfo *vmexport.VMExportInfo) error {
+			Expect(vmeInfo.ExportSource.Kind).To(Equal("PersistentVolumeClaim"))
+			Expect(vmeInfo.ExportSource.Name).To(Equal("persistent-state-for-" + vmName))
+			_, err := vmeInfo.OutputWriter.Write(newArchive(map[string][]byte{
+				"nvram/" + vmName + "_VARS.fd": store,
+			}))
+			return err
+		}
+	})
+
+	AfterEach(func() {
+		nvram.DownloadBackendStorage = vmexport.DownloadVirtualMachineExport
+	})
+
+	newVM := func(persistent bool) *v1.VirtualMachine {
+		return &v1.VirtualMachine{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: vmName, Namespace: k8smetav1.NamespaceDefault},
+			Spec: v1.VirtualMachineSpec{
+				Template: &v1.VirtualMachineInstanceTemplateSpec{
+					Spec: v1.VirtualMachineInstanceSpec{
+						Domain: v1.DomainSpec{
+							Firmware: &v1.Firmware{
+								Bootloader: &v1.Bootloader{
+									EFI: &v1.EFI{Persistent: &persistent},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	expectStoppedVM := func() {
+		vmInterface.EXPECT().Get(gomock.Any(), vmName, gomock.Any()).Return(newVM(true), nil)
+		vmiInterface.EXPECT().Get(gomock.Any(), vmName, gomock.Any()).Return(nil, errors.NewNotFound(schema.GroupResource{Resource: "virtualmachineinstances"}, vmName))
+	}
+
+	It("should fail with an unknown action", func() {
+		cmd := clientcmd.NewRepeatableVirtctlCommand("nvram", "list", vmName)
+		Expect(cmd()).To(MatchError(ContainSubstring("invalid action type list")))
+	})
+
+	It("should require an output file to dump", func() {
+		cmd := clientcmd.NewRepeatableVirtctlCommand("nvram", "dump", vmName)
+		Expect(cmd()).To(MatchError(ContainSubstring("missing --output")))
+	})
+
+	It("should refuse a VM without persistent EFI", func() {
+		vmInterface.EXPECT().Get(gomock.Any(), vmName, gomock.Any()).Return(newVM(false), nil)
+		cmd := clientcmd.NewRepeatableVirtctlCommand("nvram", "inspect", vmName)
+		Expect(cmd()).To(MatchError(ContainSubstring("does not persist its EFI NVRAM")))
+	})
+
+	It("should refuse a running VM", func() {
+		vmInterface.EXPECT().Get(gomock.Any(), vmName, gomock.Any()).Return(newVM(true), nil)
+		vmiInterface.EXPECT().Get(gomock.Any(), vmName, gomock.Any()).Return(&v1.VirtualMachineInstance{
+			Status: v1.VirtualMachineInstanceStatus{Phase: v1.Running},
+		}, nil)
+		cmd := clientcmd.NewRepeatableVirtctlCommand("nvram", "inspect", vmName)
+		Expect(cmd()).To(MatchError(ContainSubstring("must be stopped")))
+	})
+
+	It("should dump the NVRAM of a stopped VM", func() {
+		expectStoppedVM()
+		output := filepath.Join(GinkgoT().TempDir(), "VARS.fd")
+		cmd := clientcmd.NewRepeatableVirtctlCommand("nvram", "dump", vmName, "--output", output)
+		Expect(cmd()).To(Succeed())
+		Expect(os.ReadFile(output)).To(Equal(store))
+	})
+
+	DescribeTable("should verify the export server certificate unless requested otherwise", func(expectedInsecure bool, extraArgs ...string) {
+		expectStoppedVM()
+		download := nvram.DownloadBackendStorage
+		nvram.DownloadBackendStorage = func(client kubecli.KubevirtClient, vmeInfo *vmexport.VMExportInfo) error {
+			Expect(vmeInfo.Insecure).To(Equal(expectedInsecure))
+			return download(client, vmeInfo)
+		}
+		output := filepath.Join(GinkgoT().TempDir(), "VARS.fd")
+		cmd := clientcmd.NewRepeatableVirtctlCommand(append([]string{"nvram", "dump", vmName, "--output", output}, extraArgs...)...)
+		Expect(cmd()).To(Succeed())
+	},
+		Entry("by default", false),
+		Entry("with --insecure", true, "--insecure"),
+		Entry("with --port-forward", true, "--port-forward"),
+	)
+
+	It("should inspect the NVRAM of a stopped VM", func() {
+		expectStoppedVM()
+		out := &bytes.Buffer{}
+		cmd := clientcmd.NewVirtctlCommand("nvram", "inspect", vmName)
+		cmd.SetOut(out)
+		Expect(cmd.Execute()).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("Secure Boot: user mode (PK enrolled)"))
+		Expect(out.String()).To(ContainSubstring(`X509 subject="CN=Company PK"`))
+		Expect(out.String()).To(ContainSubstring("KEK: not enrolled"))
+		Expect(out.String()).To(ContainSubstring("BootOrder"))
+	})
+
+	It("should inspect a local variable store", func() {
+		file := filepath.Join(GinkgoT().TempDir(), "VARS.fd")
+		Expect(os.WriteFile(file, newVariableStore(), 0600)).To(Succeed())
+		out := &bytes.Buffer{}
+		cmd := clientcmd.NewVirtctlCommand("nvram", "inspect", "--file", file)
+		cmd.SetOut(out)
+		Expect(cmd.Execute()).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("Variables: 0"))
+		Expect(out.String()).To(ContainSubstring("Secure Boot: setup mode (no PK enrolled)"))
+	})
+
+	It("should fail if the backend storage holds no NVRAM", func() {
+		_, err := nvram.ExtractNVRAM(bytes.NewReader(newArchive(map[string][]byte{"tpm/state": {1}})), vmName)
+		Expect(err).To(MatchError(ContainSubstring("nvram/testvm_VARS.fd not found")))
+	})
+})
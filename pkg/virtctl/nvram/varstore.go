@@ -0,0 +1,232 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package nvram
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+)
+
+// The parser below understands the OVMF/AAVMF variable store layout:
+// a firmware volume header (edk2 PiFirmwareVolume.h) followed by a variable
+// store header and a list of variables (edk2 VariableFormat.h).
+
+const (
+	fvSignature            = "_FVH"
+	fvSignatureOffset      = 40
+	fvHeaderLengthOffset   = 48
+	variableStoreHeaderLen = 28
+	variableStartID        = 0x55AA
+
+	variableHeaderLen              = 32
+	authenticatedVariableHeaderLen = 60
+
+	// variable states, a variable is valid once it was added and not deleted
+	varAdded                    = 0x3F
+	varInDeletedTransition      = 0xFE
+	varAddedInDeletedTransition = varAdded & varInDeletedTransition
+)
+
+// GUID is an EFI GUID in its on-disk (mixed-endian) representation
+type GUID [16]byte
+
+func (g GUID) String() string {
+	return fmt.Sprintf("%08x-%04x-%04x-%s-%s",
+		binary.LittleEndian.Uint32(g[0:4]),
+		binary.LittleEndian.Uint16(g[4:6]),
+		binary.LittleEndian.Uint16(g[6:8]),
+		hex.EncodeToString(g[8:10]),
+		hex.EncodeToString(g[10:16]))
+}
+
+func mustParseGUID(s string) GUID {
+	var g GUID
+	b, err := hex.DecodeString(strings.ReplaceAll(s, "-", ""))
+	if err != nil || len(b) != len(g) {
+		panic(fmt.Sprintf("invalid GUID %q", s))
+	}
+	binary.LittleEndian.PutUint32(g[0:4], binary.BigEndian.Uint32(b[0:4]))
+	binary.LittleEndian.PutUint16(g[4:6], binary.BigEndian.Uint16(b[4:6]))
+	binary.LittleEndian.PutUint16(g[6:8], binary.BigEndian.Uint16(b[6:8]))
+	copy(g[8:], b[8:])
+	return g
+}
+
+var (
+	variableStoreGUID              = mustParseGUID("ddcf3616-3275-4164-98b6-fe85707ffe7d")
+	authenticatedVariableStoreGUID = mustParseGUID("aaf32c78-947b-439a-a180-2e144ec37792")
+
+	// vendor GUIDs of the Secure Boot related variables
+	GlobalVariableGUID           = mustParseGUID("8be4df61-93ca-11d2-aa0d-00e098032b8c")
+	ImageSecurityDatabaseGUID    = mustParseGUID("d719b2cb-3d3a-4596-a3bc-dad00e67656f")
+	SecureBootEnableVariableGUID = mustParseGUID("f0a30bc7-af08-4556-99c4-001009c93a44")
+
+	// signature types found in the Secure Boot signature databases
+	CertX509GUID   = mustParseGUID("a5c059a1-94e4-4aa7-87b5-ab155c2bf072")
+	CertSHA256GUID = mustParseGUID("c1c41626-504c-4092-aca9-41f936934328")
+)
+
+// Variable is a single UEFI variable found in the store
+type Variable struct {
+	Name       string
+	VendorGUID GUID
+	Attributes uint32
+	Data       []byte
+}
+
+// VariableStore holds the valid variables of an UEFI variable store
+type VariableStore struct {
+	Authenticated bool
+	Variables     []Variable
+}
+
+// Lookup returns the variable with the given vendor and name, or nil
+func (s *VariableStore) Lookup(vendor GUID, name string) *Variable {
+	for i := range s.Variables {
+		if s.Variables[i].VendorGUID == vendor && s.Variables[i].Name == name {
+			return &s.Variables[i]
+		}
+	}
+	return nil
+}
+
+// ParseVariableStore parses the content of an OVMF/AAVMF _VARS.fd file
+func ParseVariableStore(data []byte) (*VariableStore, error) {
+	if len(data) < fvHeaderLengthOffset+2 || string(data[fvSignatureOffset:fvSignatureOffset+4]) != fvSignature {
+		return nil, fmt.Errorf("not an UEFI variable store: firmware volume signature not found")
+	}
+	offset := int(binary.LittleEndian.Uint16(data[fvHeaderLengthOffset:]))
+	if len(data) < offset+variableStoreHeaderLen {
+		return nil, fmt.Errorf("variable store header is truncated")
+	}
+
+	store := &VariableStore{}
+	var signature GUID
+	copy(signature[:], data[offset:offset+16])
+	switch signature {
+	case authenticatedVariableStoreGUID:
+		store.Authenticated = true
+	case variableStoreGUID:
+	default:
+		return nil, fmt.Errorf("unknown variable store signature %s", signature)
+	}
+
+	size := int(binary.LittleEndian.Uint32(data[offset+16:]))
+	end := offset + size
+	if size < variableStoreHeaderLen || end > len(data) {
+		return nil, fmt.Errorf("invalid variable store size %d", size)
+	}
+
+	headerLen := variableHeaderLen
+	if store.Authenticated {
+		headerLen = authenticatedVariableHeaderLen
+	}
+
+	pos := offset + variableStoreHeaderLen
+	for pos+headerLen <= end {
+		header := data[pos : pos+headerLen]
+		if binary.LittleEndian.Uint16(header[0:2]) != variableStartID {
+			break
+		}
+		state := header[2]
+		attributes := binary.LittleEndian.Uint32(header[4:8])
+		// NameSize, DataSize and VendorGuid are always the last 24 bytes of the header
+		nameSize := int(binary.LittleEndian.Uint32(header[headerLen-24:]))
+		dataSize := int(binary.LittleEndian.Uint32(header[headerLen-20:]))
+		var vendor GUID
+		copy(vendor[:], header[headerLen-16:])
+
+		nameStart := pos + headerLen
+		dataStart := nameStart + nameSize
+		dataEnd := dataStart + dataSize
+		if nameSize < 0 || dataSize < 0 || dataEnd > end {
+			return nil, fmt.Errorf("variable at offset %d exceeds the variable store", pos)
+		}
+
+		if state == varAdded || state == varAddedInDeletedTransition {
+			store.Variables = append(store.Variables, Variable{
+				Name:       decodeUTF16(data[nameStart:dataStart]),
+				VendorGUID: vendor,
+				Attributes: attributes,
+				Data:       data[dataStart:dataEnd],
+			})
+		}
+
+		// variables are 4 byte aligned
+		pos = (dataEnd + 3) &^ 3
+	}
+
+	return store, nil
+}
+
+func decodeUTF16(b []byte) string {
+	u := make([]uint16, 0, len(b)/2)
+	for i := 0; i+1 < len(b); i += 2 {
+		c := binary.LittleEndian.Uint16(b[i:])
+		if c == 0 {
+			break
+		}
+		u = append(u, c)
+	}
+	return string(utf16.Decode(u))
+}
+
+// Signature is an entry of an EFI_SIGNATURE_LIST as stored in PK, KEK, db and dbx
+type Signature struct {
+	Type  GUID
+	Owner GUID
+	Data  []byte
+}
+
+// ParseSignatureLists parses the EFI_SIGNATURE_LIST entries of a signature database variable
+func ParseSignatureLists(data []byte) ([]Signature, error) {
+	const listHeaderLen = 28
+
+	var signatures []Signature
+	pos := 0
+	for pos < len(data) {
+		if pos+listHeaderLen > len(data) {
+			return nil, fmt.Errorf("signature list at offset %d is truncated", pos)
+		}
+		var sigType GUID
+		copy(sigType[:], data[pos:pos+16])
+		listSize := int(binary.LittleEndian.Uint32(data[pos+16:]))
+		headerSize := int(binary.LittleEndian.Uint32(data[pos+20:]))
+		sigSize := int(binary.LittleEndian.Uint32(data[pos+24:]))
+		if listSize < listHeaderLen || pos+listSize > len(data) || sigSize < 16 {
+			return nil, fmt.Errorf("invalid signature list at offset %d", pos)
+		}
+
+		for sigPos := pos + listHeaderLen + headerSize; sigPos+sigSize <= pos+listSize; sigPos += sigSize {
+			var owner GUID
+			copy(owner[:], data[sigPos:sigPos+16])
+			signatures = append(signatures, Signature{
+				Type:  sigType,
+				Owner: owner,
+				Data:  data[sigPos+16 : sigPos+sigSize],
+			})
+		}
+		pos += listSize
+	}
+	return signatures, nil
+}
@@ -0,0 +1,151 @@
+package nvram_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"math/big"
+	"time"
+	"unicode/utf16"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/nvram"
+)
+
+const (
+	varAdded   = 0x3F
+	varDeleted = 0x3C
+)
+
+var authenticatedVariableStoreGUID = nvram.GUID{0x78, 0x2c, 0xf3, 0xaa, 0x7b, 0x94, 0x9a, 0x43, 0xa1, 0x80, 0x2e, 0x14, 0x4e, 0xc3, 0x77, 0x92}
+
+type testVariable struct {
+	name   string
+	vendor nvram.GUID
+	state  byte
+	data   []byte
+}
+
+// newVariableStore builds an authenticated OVMF variable store
+func newVariableStore(variables ...testVariable) []byte {
+	const fvHeaderLen = 72
+	var vars []byte
+	for _, v := range variables {
+		name := []byte{}
+		for _, c := range append(utf16.Encode([]rune(v.name)), 0) {
+			name = binary.LittleEndian.AppendUint16(name, c)
+		}
+		header := make([]byte, 60)
+		binary.LittleEndian.PutUint16(header[0:], 0x55AA)
+		header[2] = v.state
+		binary.LittleEndian.PutUint32(header[4:], 0x27)
+		binary.LittleEndian.PutUint32(header[36:], uint32(len(name)))
+		binary.LittleEndian.PutUint32(header[40:], uint32(len(v.data)))
+		copy(header[44:], v.vendor[:])
+		vars = append(vars, header...)
+		vars = append(vars, name...)
+		vars = append(vars, v.data...)
+		for len(vars)%4 != 0 {
+			vars = append(vars, 0xff)
+		}
+	}
+
+	storeSize := 28 + len(vars) + 64
+	store := make([]byte, fvHeaderLen+storeSize)
+	copy(store[40:], "_FVH")
+	binary.LittleEndian.PutUint16(store[48:], fvHeaderLen)
+	copy(store[fvHeaderLen:], authenticatedVariableStoreGUID[:])
+	binary.LittleEndian.PutUint32(store[fvHeaderLen+16:], uint32(storeSize))
+	store[fvHeaderLen+20] = 0x5a
+	store[fvHeaderLen+21] = 0xfe
+	copy(store[fvHeaderLen+28:], vars)
+	for i := fvHeaderLen + 28 + len(vars); i < len(store); i++ {
+		store[i] = 0xff
+	}
+	return store
+}
+
+// newSignatureList builds an EFI_SIGNATURE_LIST containing the given signatures
+func newSignatureList(sigType nvram.GUID, owner nvram.GUID, signatures ...[]byte) []byte {
+	sigSize := 16 + len(signatures[0])
+	list := make([]byte, 28)
+	copy(list, sigType[:])
+	binary.LittleEndian.PutUint32(list[16:], uint32(28+len(signatures)*sigSize))
+	binary.LittleEndian.PutUint32(list[24:], uint32(sigSize))
+	for _, signature := range signatures {
+		list = append(list, owner[:]...)
+		list = append(list, signature...)
+	}
+	return list
+}
+
+func newCertificate(commonName string) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).ToNot(HaveOccurred())
+	return cert
+}
+
+var _ = Describe("Variable store", func() {
+
+	It("should format GUIDs in their canonical form", func() {
+		Expect(nvram.GlobalVariableGUID.String()).To(Equal("8be4df61-93ca-11d2-aa0d-00e098032b8c"))
+		Expect(authenticatedVariableStoreGUID.String()).To(Equal("aaf32c78-947b-439a-a180-2e144ec37792"))
+	})
+
+	It("should parse the valid variables of an authenticated store", func() {
+		store, err := nvram.ParseVariableStore(newVariableStore(
+			testVariable{name: "Boot0000", vendor: nvram.GlobalVariableGUID, state: varAdded, data: []byte{1, 2, 3}},
+			testVariable{name: "Boot0001", vendor: nvram.GlobalVariableGUID, state: varDeleted, data: []byte{4}},
+			testVariable{name: "BootOrder", vendor: nvram.GlobalVariableGUID, state: varAdded, data: []byte{0, 0}},
+		))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(store.Authenticated).To(BeTrue())
+		Expect(store.Variables).To(HaveLen(2))
+		Expect(store.Variables[0].Name).To(Equal("Boot0000"))
+		Expect(store.Variables[0].Data).To(Equal([]byte{1, 2, 3}))
+		Expect(store.Variables[0].Attributes).To(Equal(uint32(0x27)))
+		Expect(store.Lookup(nvram.GlobalVariableGUID, "BootOrder")).ToNot(BeNil())
+		Expect(store.Lookup(nvram.GlobalVariableGUID, "Boot0001")).To(BeNil())
+	})
+
+	It("should reject files which are no variable store", func() {
+		_, err := nvram.ParseVariableStore(make([]byte, 128))
+		Expect(err).To(MatchError(ContainSubstring("firmware volume signature not found")))
+	})
+
+	It("should reject a truncated variable", func() {
+		data := newVariableStore(testVariable{name: "PK", vendor: nvram.GlobalVariableGUID, state: varAdded, data: make([]byte, 8)})
+		// claim a data size beyond the end of the store
+		binary.LittleEndian.PutUint32(data[72+28+40:], 4096)
+		_, err := nvram.ParseVariableStore(data)
+		Expect(err).To(MatchError(ContainSubstring("exceeds the variable store")))
+	})
+
+	It("should parse signature lists", func() {
+		owner := nvram.GUID{1}
+		cert := newCertificate("Company PK")
+		hash := make([]byte, 32)
+		data := append(newSignatureList(nvram.CertX509GUID, owner, cert), newSignatureList(nvram.CertSHA256GUID, owner, hash, hash)...)
+
+		signatures, err := nvram.ParseSignatureLists(data)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(signatures).To(HaveLen(3))
+		Expect(signatures[0].Type).To(Equal(nvram.CertX509GUID))
+		Expect(signatures[0].Owner).To(Equal(owner))
+		Expect(signatures[0].Data).To(Equal(cert))
+		Expect(signatures[2].Type).To(Equal(nvram.CertSHA256GUID))
+	})
+})
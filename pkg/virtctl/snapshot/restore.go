@@ -0,0 +1,150 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/utils/pointer"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_RESTORE = "restore"
+
+	snapshotArg = "snapshot"
+)
+
+type restoreFlags struct {
+	name     string
+	snapshot string
+	wait     bool
+	timeout  time.Duration
+}
+
+func restoreUsage() string {
+	return `  # Restore the VM 'myvm' from the snapshot 'myvm-before-upgrade' and wait until the restore is complete:
+  {{ProgramName}} restore myvm --snapshot myvm-before-upgrade --wait`
+}
+
+// NewRestoreCommand returns a cobra.Command to restore a virtual machine from a VirtualMachineSnapshot
+func NewRestoreCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	flags := &restoreFlags{}
+	cmd := &cobra.Command{
+		Use:     "restore (VM) --snapshot (SNAPSHOT)",
+		Short:   "Restore a virtual machine from a snapshot.",
+		Example: restoreUsage(),
+		Args:    templates.ExactArgs(COMMAND_RESTORE, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runRestore(clientConfig, cmd, flags, args[0])
+		},
+	}
+	cmd.Flags().StringVar(&flags.snapshot, snapshotArg, "", "Name of the snapshot to restore the VM from.")
+	cmd.Flags().StringVar(&flags.name, nameArg, "", "Name of the restore. Defaults to the name of the VM followed by a timestamp.")
+	cmd.Flags().BoolVar(&flags.wait, waitArg, false, "Wait until the restore is complete.")
+	cmd.Flags().DurationVar(&flags.timeout, timeoutArg, defaultTimeout, "How long to wait for the restore to complete.")
+	cmd.MarkFlagRequired(snapshotArg)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func runRestore(clientConfig clientcmd.ClientConfig, cmd *cobra.Command, flags *restoreFlags, vmName string) error {
+	virtClient, namespace, err := getClient(clientConfig)
+	if err != nil {
+		return err
+	}
+
+	name := flags.name
+	if name == "" {
+		name = fmt.Sprintf("%s-restore-%s", vmName, time.Now().UTC().Format("20060102-150405"))
+	}
+	restore := &snapshotv1.VirtualMachineRestore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: snapshotv1.VirtualMachineRestoreSpec{
+			Target: k8sv1.TypedLocalObjectReference{
+				APIGroup: pointer.String(v1.VirtualMachineGroupVersionKind.Group),
+				Kind:     v1.VirtualMachineGroupVersionKind.Kind,
+				Name:     vmName,
+			},
+			VirtualMachineSnapshotName: flags.snapshot,
+		},
+	}
+
+	restore, err = virtClient.VirtualMachineRestore(namespace).Create(context.Background(), restore, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("error restoring VM %s from snapshot %s: %v", vmName, flags.snapshot, err)
+	}
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "Restore %s of VM %s from snapshot %s created\n", restore.Name, vmName, flags.snapshot)
+	if !flags.wait {
+		return nil
+	}
+
+	if err := waitForRestore(virtClient, namespace, restore.Name, out, flags.timeout); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Restore %s is complete\n", restore.Name)
+	return nil
+}
+
+// waitForRestore waits until the restore is complete and prints the progress whenever it changes
+func waitForRestore(virtClient kubecli.KubevirtClient, namespace, name string, out io.Writer, timeout time.Duration) error {
+	lastProgress := ""
+	err := wait.PollImmediate(PollInterval, timeout, func() (bool, error) {
+		restore, err := virtClient.VirtualMachineRestore(namespace).Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		if restore.Status == nil {
+			return false, nil
+		}
+		for _, condition := range restore.Status.Conditions {
+			if condition.Type == snapshotv1.ConditionFailure && condition.Status == k8sv1.ConditionTrue {
+				return false, fmt.Errorf("restore %s failed: %s", name, condition.Reason)
+			}
+		}
+		complete := restore.Status.Complete != nil && *restore.Status.Complete
+		if progress := progressMessage(fmt.Sprintf("Complete: %t", complete), restore.Status.Conditions); progress != lastProgress {
+			fmt.Fprintln(out, progress)
+			lastProgress = progress
+		}
+		return complete, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return fmt.Errorf("timed out waiting for restore %s to complete", name)
+	}
+	return err
+}
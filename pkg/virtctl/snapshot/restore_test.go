@@ -0,0 +1,101 @@
+package snapshot_test
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/snapshot"
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Restore", func() {
+
+	const (
+		vmName       = "testvm"
+		snapshotName = "testsnapshot"
+		restoreName  = "testrestore"
+	)
+
+	var (
+		virtClient *kubevirtfake.Clientset
+		out        *bytes.Buffer
+	)
+
+	run := func(args ...string) error {
+		cmd := clientcmd.NewVirtctlCommand(args...)
+		cmd.SetOut(out)
+		return cmd.Execute()
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		virtClient = kubevirtfake.NewSimpleClientset()
+		out = &bytes.Buffer{}
+
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineRestore(metav1.NamespaceDefault).Return(virtClient.SnapshotV1alpha1().VirtualMachineRestores(metav1.NamespaceDefault)).AnyTimes()
+		snapshot.PollInterval = 10 * time.Millisecond
+	})
+
+	It("should require the snapshot to restore from", func() {
+		Expect(run("restore", vmName)).To(MatchError(ContainSubstring(`required flag(s) "snapshot" not set`)))
+	})
+
+	It("should restore the VM from the snapshot", func() {
+		Expect(run("restore", vmName, "--snapshot", snapshotName, "--name", restoreName)).To(Succeed())
+
+		restore, err := virtClient.SnapshotV1alpha1().VirtualMachineRestores(metav1.NamespaceDefault).Get(context.Background(), restoreName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(restore.Spec.VirtualMachineSnapshotName).To(Equal(snapshotName))
+		Expect(restore.Spec.Target.Kind).To(Equal("VirtualMachine"))
+		Expect(restore.Spec.Target.Name).To(Equal(vmName))
+		Expect(out.String()).To(ContainSubstring("Restore testrestore of VM testvm from snapshot testsnapshot created"))
+	})
+
+	It("should wait until the restore is complete", func() {
+		virtClient.Fake.PrependReactor("create", "virtualmachinerestores", func(action testing.Action) (bool, runtime.Object, error) {
+			restore := action.(testing.CreateAction).GetObject().(*snapshotv1.VirtualMachineRestore)
+			restore.Status = &snapshotv1.VirtualMachineRestoreStatus{
+				Complete: pointer.Bool(true),
+				Conditions: []snapshotv1.Condition{
+					{Type: snapshotv1.ConditionReady, Status: k8sv1.ConditionTrue, Reason: "Operation complete"},
+				},
+			}
+			return false, nil, nil
+		})
+
+		Expect(run("restore", vmName, "--snapshot", snapshotName, "--name", restoreName, "--wait")).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("Complete: true, Ready: Operation complete"))
+		Expect(out.String()).To(ContainSubstring("Restore testrestore is complete"))
+	})
+
+	It("should fail if the restore fails", func() {
+		virtClient.Fake.PrependReactor("create", "virtualmachinerestores", func(action testing.Action) (bool, runtime.Object, error) {
+			restore := action.(testing.CreateAction).GetObject().(*snapshotv1.VirtualMachineRestore)
+			restore.Status = &snapshotv1.VirtualMachineRestoreStatus{
+				Conditions: []snapshotv1.Condition{
+					{Type: snapshotv1.ConditionFailure, Status: k8sv1.ConditionTrue, Reason: "VM is running"},
+				},
+			}
+			return false, nil, nil
+		})
+
+		err := run("restore", vmName, "--snapshot", snapshotName, "--name", restoreName, "--wait")
+		Expect(err).To(MatchError(ContainSubstring("restore testrestore failed: VM is running")))
+	})
+})
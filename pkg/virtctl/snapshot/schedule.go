@@ -0,0 +1,182 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"time"
+
+	"github.com/spf13/cobra"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/utils/pointer"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_SCHEDULE = "schedule"
+
+	intervalArg = "interval"
+	keepArg     = "keep"
+	countArg    = "count"
+
+	// ScheduleLabel marks the snapshots taken by a schedule with the name of their VM,
+	// only these snapshots are deleted when the schedule keeps a limited number of snapshots
+	ScheduleLabel = "snapshot.kubevirt.io/schedule"
+)
+
+type scheduleFlags struct {
+	interval        time.Duration
+	keep            int
+	count           int
+	timeout         time.Duration
+	failureDeadline time.Duration
+}
+
+func newScheduleCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	flags := &scheduleFlags{}
+	cmd := &cobra.Command{
+		Use:   "schedule (VM)",
+		Short: "Take snapshots of a virtual machine in a fixed interval and keep only the latest ones, until interrupted.",
+		Args:  templates.ExactArgs(COMMAND_SCHEDULE, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runSchedule(clientConfig, cmd, flags, args[0])
+		},
+	}
+	cmd.Flags().DurationVar(&flags.interval, intervalArg, 24*time.Hour, "Interval in which the snapshots are taken.")
+	cmd.Flags().IntVar(&flags.keep, keepArg, 0, "Number of scheduled snapshots of the VM to keep, older ones are deleted. Defaults to keeping all snapshots.")
+	cmd.Flags().IntVar(&flags.count, countArg, 0, "Number of snapshots to take before the schedule ends. Defaults to taking snapshots until interrupted.")
+	cmd.Flags().DurationVar(&flags.timeout, timeoutArg, defaultTimeout, "How long to wait for each snapshot to become ready to use.")
+	cmd.Flags().DurationVar(&flags.failureDeadline, failureDeadlineArg, 0, "How long each snapshot may take before it is marked as failed. Defaults to the deadline of the snapshot controller.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func runSchedule(clientConfig clientcmd.ClientConfig, cmd *cobra.Command, flags *scheduleFlags, vmName string) error {
+	if flags.interval <= 0 {
+		return fmt.Errorf("--%s must be positive", intervalArg)
+	}
+	if flags.keep < 0 || flags.count < 0 {
+		return fmt.Errorf("--%s and --%s must not be negative", keepArg, countArg)
+	}
+	virtClient, namespace, err := getClient(clientConfig)
+	if err != nil {
+		return err
+	}
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	out := cmd.OutOrStdout()
+	for taken := 1; ; taken++ {
+		// A failed snapshot does not end the schedule, the next one may succeed
+		if err := takeScheduledSnapshot(virtClient, namespace, vmName, out, flags); err != nil {
+			fmt.Fprintln(out, err)
+		}
+		if flags.count > 0 && taken >= flags.count {
+			return nil
+		}
+
+		select {
+		case <-interrupt:
+			return nil
+		case <-time.After(flags.interval):
+		}
+	}
+}
+
+// takeScheduledSnapshot takes a snapshot of the VM and, once it is ready to use, deletes the oldest scheduled
+// snapshots of the VM exceeding the number of snapshots to keep
+func takeScheduledSnapshot(virtClient kubecli.KubevirtClient, namespace, vmName string, out io.Writer, flags *scheduleFlags) error {
+	snapshot := &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: vmName + "-snapshot-",
+			Namespace:    namespace,
+			Labels:       map[string]string{ScheduleLabel: vmName},
+		},
+		Spec: snapshotv1.VirtualMachineSnapshotSpec{
+			Source: k8sv1.TypedLocalObjectReference{
+				APIGroup: pointer.String(v1.VirtualMachineGroupVersionKind.Group),
+				Kind:     v1.VirtualMachineGroupVersionKind.Kind,
+				Name:     vmName,
+			},
+		},
+	}
+	if flags.failureDeadline > 0 {
+		snapshot.Spec.FailureDeadline = &metav1.Duration{Duration: flags.failureDeadline}
+	}
+
+	snapshot, err := virtClient.VirtualMachineSnapshot(namespace).Create(context.Background(), snapshot, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("error creating snapshot of VM %s: %v", vmName, err)
+	}
+	fmt.Fprintf(out, "Snapshot %s of VM %s created\n", snapshot.Name, vmName)
+
+	snapshot, err = waitForSnapshot(virtClient, namespace, snapshot.Name, out, flags.timeout)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Snapshot %s is ready to use\n", snapshot.Name)
+
+	if flags.keep == 0 {
+		return nil
+	}
+	return deleteOldSnapshots(virtClient, namespace, vmName, out, flags.keep)
+}
+
+func deleteOldSnapshots(virtClient kubecli.KubevirtClient, namespace, vmName string, out io.Writer, keep int) error {
+	snapshots, err := virtClient.VirtualMachineSnapshot(namespace).List(context.Background(), metav1.ListOptions{
+		LabelSelector: labels.Set{ScheduleLabel: vmName}.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing the scheduled snapshots of VM %s: %v", vmName, err)
+	}
+	if len(snapshots.Items) <= keep {
+		return nil
+	}
+
+	items := snapshots.Items
+	sort.Slice(items, func(i, j int) bool {
+		if !items[i].CreationTimestamp.Equal(&items[j].CreationTimestamp) {
+			return items[i].CreationTimestamp.Before(&items[j].CreationTimestamp)
+		}
+		return items[i].Name < items[j].Name
+	})
+	for _, snapshot := range items[:len(items)-keep] {
+		if err := virtClient.VirtualMachineSnapshot(namespace).Delete(context.Background(), snapshot.Name, metav1.DeleteOptions{}); err != nil {
+			return fmt.Errorf("error deleting snapshot %s: %v", snapshot.Name, err)
+		}
+		fmt.Fprintf(out, "Snapshot %s deleted\n", snapshot.Name)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package snapshot_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/snapshot"
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Snapshot schedule", func() {
+
+	const vmName = "testvm"
+
+	var (
+		virtClient *kubevirtfake.Clientset
+		out        *bytes.Buffer
+		created    int
+		phase      snapshotv1.VirtualMachineSnapshotPhase
+	)
+
+	run := func(args ...string) error {
+		cmd := clientcmd.NewVirtctlCommand(append([]string{"snapshot", "schedule", vmName}, args...)...)
+		cmd.SetOut(out)
+		return cmd.Execute()
+	}
+
+	listSnapshots := func() []string {
+		snapshots, err := virtClient.SnapshotV1alpha1().VirtualMachineSnapshots(metav1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		var names []string
+		for _, vmSnapshot := range snapshots.Items {
+			names = append(names, vmSnapshot.Name)
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		manualSnapshot := &snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: "manual", Namespace: metav1.NamespaceDefault},
+		}
+		virtClient = kubevirtfake.NewSimpleClientset(manualSnapshot)
+		out = &bytes.Buffer{}
+		created = 0
+		phase = snapshotv1.Succeeded
+
+		// the fake client neither generates names nor takes snapshots
+		virtClient.Fake.PrependReactor("create", "virtualmachinesnapshots", func(action testing.Action) (bool, runtime.Object, error) {
+			vmSnapshot := action.(testing.CreateAction).GetObject().(*snapshotv1.VirtualMachineSnapshot)
+			created++
+			vmSnapshot.Name = fmt.Sprintf("%s%d", vmSnapshot.GenerateName, created)
+			vmSnapshot.CreationTimestamp = metav1.NewTime(time.Unix(int64(created), 0))
+			vmSnapshot.Status = &snapshotv1.VirtualMachineSnapshotStatus{
+				Phase:      phase,
+				ReadyToUse: pointer.Bool(phase == snapshotv1.Succeeded),
+				Error:      &snapshotv1.Error{Message: pointer.String("deadline exceeded")},
+			}
+			return false, nil, nil
+		})
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineSnapshot(metav1.NamespaceDefault).Return(virtClient.SnapshotV1alpha1().VirtualMachineSnapshots(metav1.NamespaceDefault)).AnyTimes()
+
+		snapshot.PollInterval = 10 * time.Millisecond
+	})
+
+	It("should take the given number of snapshots", func() {
+		Expect(run("--interval", "10ms", "--count", "3")).To(Succeed())
+		Expect(listSnapshots()).To(ConsistOf("manual", "testvm-snapshot-1", "testvm-snapshot-2", "testvm-snapshot-3"))
+		Expect(out.String()).To(ContainSubstring("Snapshot testvm-snapshot-3 is ready to use"))
+	})
+
+	It("should only keep the latest scheduled snapshots", func() {
+		Expect(run("--interval", "10ms", "--count", "4", "--keep", "2")).To(Succeed())
+		Expect(listSnapshots()).To(ConsistOf("manual", "testvm-snapshot-3", "testvm-snapshot-4"))
+		Expect(out.String()).To(ContainSubstring("Snapshot testvm-snapshot-1 deleted"))
+		Expect(out.String()).To(ContainSubstring("Snapshot testvm-snapshot-2 deleted"))
+	})
+
+	It("should not delete snapshots when a snapshot fails", func() {
+		phase = snapshotv1.Failed
+		Expect(run("--interval", "10ms", "--count", "2", "--keep", "1")).To(Succeed())
+		Expect(listSnapshots()).To(ConsistOf("manual", "testvm-snapshot-1", "testvm-snapshot-2"))
+		Expect(out.String()).To(ContainSubstring("snapshot testvm-snapshot-2 failed: deadline exceeded"))
+	})
+
+	DescribeTable("should reject", func(expected string, args ...string) {
+		Expect(run(args...)).To(MatchError(ContainSubstring(expected)))
+		Expect(created).To(BeZero())
+	},
+		Entry("a zero interval", "--interval must be positive", "--interval", "0s"),
+		Entry("a negative number of snapshots to keep", "must not be negative", "--keep", "-1"),
+	)
+})
@@ -0,0 +1,426 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/utils/pointer"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_SNAPSHOT = "snapshot"
+	COMMAND_CREATE   = "create"
+	COMMAND_LIST     = "list"
+	COMMAND_DESCRIBE = "describe"
+	COMMAND_DELETE   = "delete"
+
+	nameArg            = "name"
+	waitArg            = "wait"
+	timeoutArg         = "timeout"
+	failureDeadlineArg = "failure-deadline"
+
+	defaultTimeout = 10 * time.Minute
+)
+
+// PollInterval is the interval in which the snapshot and restore commands check for progress.
+// Useful for unit tests.
+var PollInterval = 2 * time.Second
+
+type createFlags struct {
+	name            string
+	wait            bool
+	timeout         time.Duration
+	failureDeadline time.Duration
+}
+
+func usage() string {
+	return `  # Take a snapshot of the VM 'myvm' and wait until it is ready to use:
+  {{ProgramName}} snapshot create myvm --wait
+
+  # Take a snapshot of the VM 'myvm' named 'myvm-before-upgrade':
+  {{ProgramName}} snapshot create myvm --name myvm-before-upgrade
+
+  # List the snapshots of all VMs, or of the VM 'myvm' only:
+  {{ProgramName}} snapshot list
+  {{ProgramName}} snapshot list myvm
+
+  # Show the conditions, indications and volume snapshots of the snapshot 'myvm-before-upgrade':
+  {{ProgramName}} snapshot describe myvm-before-upgrade
+
+  # Delete the snapshot 'myvm-before-upgrade':
+  {{ProgramName}} snapshot delete myvm-before-upgrade
+
+  # Take a snapshot of the VM 'myvm' every day and keep the latest 7 of them, until interrupted:
+  {{ProgramName}} snapshot schedule myvm --interval 24h --keep 7`
+}
+
+// NewSnapshotCommand returns a cobra.Command to create, list, describe, delete and schedule VirtualMachineSnapshots
+func NewSnapshotCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "snapshot create|list|describe|delete|schedule",
+		Short:   "Create, list, describe, delete and schedule snapshots of virtual machines.",
+		Example: usage(),
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Print(cmd.UsageString())
+		},
+	}
+	cmd.AddCommand(
+		newCreateCommand(clientConfig),
+		newListCommand(clientConfig),
+		newDescribeCommand(clientConfig),
+		newDeleteCommand(clientConfig),
+		newScheduleCommand(clientConfig),
+	)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newCreateCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	flags := &createFlags{}
+	cmd := &cobra.Command{
+		Use:   "create (VM)",
+		Short: "Take a snapshot of a virtual machine.",
+		Args:  templates.ExactArgs(COMMAND_CREATE, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runCreate(clientConfig, cmd, flags, args[0])
+		},
+	}
+	cmd.Flags().StringVar(&flags.name, nameArg, "", "Name of the snapshot. Defaults to the name of the VM followed by a timestamp.")
+	cmd.Flags().BoolVar(&flags.wait, waitArg, false, "Wait until the snapshot is ready to use.")
+	cmd.Flags().DurationVar(&flags.timeout, timeoutArg, defaultTimeout, "How long to wait for the snapshot to become ready to use.")
+	cmd.Flags().DurationVar(&flags.failureDeadline, failureDeadlineArg, 0, "How long the snapshot may take before it is marked as failed. Defaults to the deadline of the snapshot controller.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newListCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list [VM]",
+		Short: "List the snapshots of all virtual machines or of a single one.",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			vmName := ""
+			if len(args) > 0 {
+				vmName = args[0]
+			}
+			return runList(clientConfig, cmd, vmName)
+		},
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newDescribeCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe (SNAPSHOT)",
+		Short: "Show the details of a snapshot and of its volume snapshots.",
+		Args:  templates.ExactArgs(COMMAND_DESCRIBE, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDescribe(clientConfig, cmd, args[0])
+		},
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newDeleteCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete (SNAPSHOT)",
+		Short: "Delete a snapshot.",
+		Args:  templates.ExactArgs(COMMAND_DELETE, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDelete(clientConfig, cmd, args[0])
+		},
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func getClient(clientConfig clientcmd.ClientConfig) (kubecli.KubevirtClient, string, error) {
+	namespace, _, err := clientConfig.Namespace()
+	if err != nil {
+		return nil, "", err
+	}
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(clientConfig)
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+	return virtClient, namespace, nil
+}
+
+func runCreate(clientConfig clientcmd.ClientConfig, cmd *cobra.Command, flags *createFlags, vmName string) error {
+	virtClient, namespace, err := getClient(clientConfig)
+	if err != nil {
+		return err
+	}
+
+	name := flags.name
+	if name == "" {
+		name = fmt.Sprintf("%s-snapshot-%s", vmName, time.Now().UTC().Format("20060102-150405"))
+	}
+	snapshot := &snapshotv1.VirtualMachineSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: snapshotv1.VirtualMachineSnapshotSpec{
+			Source: k8sv1.TypedLocalObjectReference{
+				APIGroup: pointer.String(v1.VirtualMachineGroupVersionKind.Group),
+				Kind:     v1.VirtualMachineGroupVersionKind.Kind,
+				Name:     vmName,
+			},
+		},
+	}
+	if flags.failureDeadline > 0 {
+		snapshot.Spec.FailureDeadline = &metav1.Duration{Duration: flags.failureDeadline}
+	}
+
+	snapshot, err = virtClient.VirtualMachineSnapshot(namespace).Create(context.Background(), snapshot, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("error creating snapshot of VM %s: %v", vmName, err)
+	}
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "Snapshot %s of VM %s created\n", snapshot.Name, vmName)
+	if !flags.wait {
+		return nil
+	}
+
+	snapshot, err = waitForSnapshot(virtClient, namespace, snapshot.Name, out, flags.timeout)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Snapshot %s is ready to use\n", snapshot.Name)
+	printIndications(out, snapshot.Status.Indications)
+	return nil
+}
+
+// waitForSnapshot waits until the snapshot is ready to use and prints the progress whenever it changes
+func waitForSnapshot(virtClient kubecli.KubevirtClient, namespace, name string, out io.Writer, timeout time.Duration) (*snapshotv1.VirtualMachineSnapshot, error) {
+	var snapshot *snapshotv1.VirtualMachineSnapshot
+	lastProgress := ""
+	err := wait.PollImmediate(PollInterval, timeout, func() (bool, error) {
+		var err error
+		snapshot, err = virtClient.VirtualMachineSnapshot(namespace).Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		if snapshot.Status == nil {
+			return false, nil
+		}
+		if snapshot.Status.Phase == snapshotv1.Failed {
+			return false, fmt.Errorf("snapshot %s failed: %s", name, snapshotErrorMessage(snapshot.Status.Error, snapshot.Status.Conditions))
+		}
+		if progress := progressMessage(fmt.Sprintf("Phase: %s", snapshot.Status.Phase), snapshot.Status.Conditions); progress != lastProgress {
+			fmt.Fprintln(out, progress)
+			lastProgress = progress
+		}
+		return snapshot.Status.ReadyToUse != nil && *snapshot.Status.ReadyToUse, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return nil, fmt.Errorf("timed out waiting for snapshot %s to become ready to use", name)
+	}
+	return snapshot, err
+}
+
+func runList(clientConfig clientcmd.ClientConfig, cmd *cobra.Command, vmName string) error {
+	virtClient, namespace, err := getClient(clientConfig)
+	if err != nil {
+		return err
+	}
+
+	snapshots, err := virtClient.VirtualMachineSnapshot(namespace).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing snapshots: %v", err)
+	}
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tSOURCE\tPHASE\tREADY\tINDICATIONS\tCREATED")
+	for _, snapshot := range snapshots.Items {
+		if vmName != "" && snapshot.Spec.Source.Name != vmName {
+			continue
+		}
+		phase, ready, indications, created := "", "false", "", ""
+		if status := snapshot.Status; status != nil {
+			phase = string(status.Phase)
+			if status.ReadyToUse != nil {
+				ready = fmt.Sprintf("%t", *status.ReadyToUse)
+			}
+			indications = joinIndications(status.Indications)
+			if status.CreationTime != nil {
+				created = status.CreationTime.UTC().Format(time.RFC3339)
+			}
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", snapshot.Name, snapshot.Spec.Source.Name, phase, ready, indications, created)
+	}
+	return w.Flush()
+}
+
+func runDescribe(clientConfig clientcmd.ClientConfig, cmd *cobra.Command, name string) error {
+	virtClient, namespace, err := getClient(clientConfig)
+	if err != nil {
+		return err
+	}
+
+	snapshot, err := virtClient.VirtualMachineSnapshot(namespace).Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting snapshot %s: %v", name, err)
+	}
+
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "Name:       %s\n", snapshot.Name)
+	fmt.Fprintf(out, "Namespace:  %s\n", snapshot.Namespace)
+	fmt.Fprintf(out, "Source:     %s/%s\n", snapshot.Spec.Source.Kind, snapshot.Spec.Source.Name)
+	status := snapshot.Status
+	if status == nil {
+		fmt.Fprintln(out, "Phase:      Pending")
+		return nil
+	}
+	ready := status.ReadyToUse != nil && *status.ReadyToUse
+	fmt.Fprintf(out, "Phase:      %s\n", status.Phase)
+	fmt.Fprintf(out, "Ready:      %t\n", ready)
+	if status.CreationTime != nil {
+		fmt.Fprintf(out, "Created:    %s\n", status.CreationTime.UTC().Format(time.RFC3339))
+	}
+	if status.Error != nil && status.Error.Message != nil {
+		fmt.Fprintf(out, "Error:      %s\n", *status.Error.Message)
+	}
+	printIndications(out, status.Indications)
+	printConditions(out, status.Conditions)
+
+	if status.VirtualMachineSnapshotContentName == nil {
+		return nil
+	}
+	content, err := virtClient.VirtualMachineSnapshotContent(namespace).Get(context.Background(), *status.VirtualMachineSnapshotContentName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting snapshot content %s: %v", *status.VirtualMachineSnapshotContentName, err)
+	}
+	if content.Status == nil {
+		return nil
+	}
+	fmt.Fprintln(out, "Volume snapshots:")
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  NAME\tREADY\tCREATED\tERROR")
+	for _, volume := range content.Status.VolumeSnapshotStatus {
+		volumeReady := volume.ReadyToUse != nil && *volume.ReadyToUse
+		created, volumeError := "", ""
+		if volume.CreationTime != nil {
+			created = volume.CreationTime.UTC().Format(time.RFC3339)
+		}
+		if volume.Error != nil && volume.Error.Message != nil {
+			volumeError = *volume.Error.Message
+		}
+		fmt.Fprintf(w, "  %s\t%t\t%s\t%s\n", volume.VolumeSnapshotName, volumeReady, created, volumeError)
+	}
+	return w.Flush()
+}
+
+func runDelete(clientConfig clientcmd.ClientConfig, cmd *cobra.Command, name string) error {
+	virtClient, namespace, err := getClient(clientConfig)
+	if err != nil {
+		return err
+	}
+	if err := virtClient.VirtualMachineSnapshot(namespace).Delete(context.Background(), name, metav1.DeleteOptions{}); err != nil {
+		return fmt.Errorf("error deleting snapshot %s: %v", name, err)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Snapshot %s deleted\n", name)
+	return nil
+}
+
+// progressMessage appends the reasons of the true conditions to the given status
+func progressMessage(message string, conditions []snapshotv1.Condition) string {
+	for _, condition := range conditions {
+		if condition.Status == k8sv1.ConditionTrue && condition.Reason != "" {
+			message = fmt.Sprintf("%s, %s: %s", message, condition.Type, condition.Reason)
+		}
+	}
+	return message
+}
+
+func snapshotErrorMessage(snapshotError *snapshotv1.Error, conditions []snapshotv1.Condition) string {
+	if snapshotError != nil && snapshotError.Message != nil {
+		return *snapshotError.Message
+	}
+	for _, condition := range conditions {
+		if condition.Type == snapshotv1.ConditionFailure && condition.Status == k8sv1.ConditionTrue {
+			return condition.Reason
+		}
+	}
+	return "unknown error"
+}
+
+func joinIndications(indications []snapshotv1.Indication) string {
+	names := make([]string, 0, len(indications))
+	for _, indication := range indications {
+		names = append(names, string(indication))
+	}
+	return strings.Join(names, ",")
+}
+
+func printIndications(out io.Writer, indications []snapshotv1.Indication) {
+	if len(indications) == 0 {
+		return
+	}
+	fmt.Fprintln(out, "Indications:")
+	for _, indication := range indications {
+		fmt.Fprintf(out, "  %s: %s\n", indication, describeIndication(indication))
+	}
+}
+
+func describeIndication(indication snapshotv1.Indication) string {
+	switch indication {
+	case snapshotv1.VMSnapshotOnlineSnapshotIndication:
+		return "the snapshot was taken while the VM was running"
+	case snapshotv1.VMSnapshotGuestAgentIndication:
+		return "the guest agent froze the filesystems of the guest, the snapshot is consistent"
+	case snapshotv1.VMSnapshotNoGuestAgentIndication:
+		return "no guest agent was connected, the snapshot is only crash consistent"
+	}
+	return ""
+}
+
+func printConditions(out io.Writer, conditions []snapshotv1.Condition) {
+	if len(conditions) == 0 {
+		return
+	}
+	fmt.Fprintln(out, "Conditions:")
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "  TYPE\tSTATUS\tREASON")
+	for _, condition := range conditions {
+		fmt.Fprintf(w, "  %s\t%s\t%s\n", condition.Type, condition.Status, condition.Reason)
+	}
+	w.Flush()
+}
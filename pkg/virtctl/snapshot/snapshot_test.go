@@ -0,0 +1,191 @@
+package snapshot_test
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/snapshot"
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Snapshot", func() {
+
+	const (
+		vmName       = "testvm"
+		snapshotName = "testsnapshot"
+		contentName  = "testcontent"
+	)
+
+	var (
+		ctrl       *gomock.Controller
+		virtClient *kubevirtfake.Clientset
+		out        *bytes.Buffer
+	)
+
+	newSnapshot := func() *snapshotv1.VirtualMachineSnapshot {
+		return &snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: snapshotName, Namespace: metav1.NamespaceDefault},
+			Spec: snapshotv1.VirtualMachineSnapshotSpec{
+				Source: k8sv1.TypedLocalObjectReference{Kind: "VirtualMachine", Name: vmName},
+			},
+			Status: &snapshotv1.VirtualMachineSnapshotStatus{
+				Phase:                             snapshotv1.Succeeded,
+				ReadyToUse:                        pointer.Bool(true),
+				VirtualMachineSnapshotContentName: pointer.String(contentName),
+				Indications: []snapshotv1.Indication{
+					snapshotv1.VMSnapshotOnlineSnapshotIndication,
+					snapshotv1.VMSnapshotGuestAgentIndication,
+				},
+				Conditions: []snapshotv1.Condition{
+					{Type: snapshotv1.ConditionReady, Status: k8sv1.ConditionTrue, Reason: "Operation complete"},
+				},
+			},
+		}
+	}
+
+	run := func(args ...string) error {
+		cmd := clientcmd.NewVirtctlCommand(args...)
+		cmd.SetOut(out)
+		return cmd.Execute()
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		virtClient = kubevirtfake.NewSimpleClientset()
+		out = &bytes.Buffer{}
+
+		snapshotClient := virtClient.SnapshotV1alpha1()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineSnapshot(metav1.NamespaceDefault).Return(snapshotClient.VirtualMachineSnapshots(metav1.NamespaceDefault)).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineSnapshotContent(metav1.NamespaceDefault).Return(snapshotClient.VirtualMachineSnapshotContents(metav1.NamespaceDefault)).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineRestore(metav1.NamespaceDefault).Return(snapshotClient.VirtualMachineRestores(metav1.NamespaceDefault)).AnyTimes()
+
+		snapshot.PollInterval = 10 * time.Millisecond
+	})
+
+	Context("create", func() {
+
+		It("should create a snapshot of the VM", func() {
+			Expect(run("snapshot", "create", vmName, "--name", snapshotName, "--failure-deadline", "1m")).To(Succeed())
+
+			vmSnapshot, err := virtClient.SnapshotV1alpha1().VirtualMachineSnapshots(metav1.NamespaceDefault).Get(context.Background(), snapshotName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vmSnapshot.Spec.Source.Kind).To(Equal("VirtualMachine"))
+			Expect(vmSnapshot.Spec.Source.Name).To(Equal(vmName))
+			Expect(*vmSnapshot.Spec.Source.APIGroup).To(Equal("kubevirt.io"))
+			Expect(vmSnapshot.Spec.FailureDeadline.Duration).To(Equal(time.Minute))
+			Expect(out.String()).To(ContainSubstring("Snapshot testsnapshot of VM testvm created"))
+		})
+
+		It("should generate a name for the snapshot", func() {
+			Expect(run("snapshot", "create", vmName)).To(Succeed())
+
+			snapshots, err := virtClient.SnapshotV1alpha1().VirtualMachineSnapshots(metav1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(snapshots.Items).To(HaveLen(1))
+			Expect(snapshots.Items[0].Name).To(HavePrefix("testvm-snapshot-"))
+		})
+
+		It("should wait until the snapshot is ready to use and print its indications", func() {
+			virtClient.Fake.PrependReactor("create", "virtualmachinesnapshots", func(action testing.Action) (bool, runtime.Object, error) {
+				vmSnapshot := action.(testing.CreateAction).GetObject().(*snapshotv1.VirtualMachineSnapshot)
+				vmSnapshot.Status = newSnapshot().Status
+				return false, nil, nil
+			})
+
+			Expect(run("snapshot", "create", vmName, "--name", snapshotName, "--wait")).To(Succeed())
+			Expect(out.String()).To(ContainSubstring("Phase: Succeeded, Ready: Operation complete"))
+			Expect(out.String()).To(ContainSubstring("Snapshot testsnapshot is ready to use"))
+			Expect(out.String()).To(ContainSubstring("Online"))
+			Expect(out.String()).To(ContainSubstring("GuestAgent"))
+		})
+
+		It("should fail if the snapshot fails", func() {
+			virtClient.Fake.PrependReactor("create", "virtualmachinesnapshots", func(action testing.Action) (bool, runtime.Object, error) {
+				vmSnapshot := action.(testing.CreateAction).GetObject().(*snapshotv1.VirtualMachineSnapshot)
+				vmSnapshot.Status = &snapshotv1.VirtualMachineSnapshotStatus{
+					Phase: snapshotv1.Failed,
+					Error: &snapshotv1.Error{Message: pointer.String("deadline exceeded")},
+				}
+				return false, nil, nil
+			})
+
+			err := run("snapshot", "create", vmName, "--name", snapshotName, "--wait")
+			Expect(err).To(MatchError(ContainSubstring("snapshot testsnapshot failed: deadline exceeded")))
+		})
+
+		It("should time out if the snapshot does not become ready to use", func() {
+			err := run("snapshot", "create", vmName, "--name", snapshotName, "--wait", "--timeout", "50ms")
+			Expect(err).To(MatchError(ContainSubstring("timed out waiting for snapshot testsnapshot")))
+		})
+	})
+
+	Context("with an existing snapshot", func() {
+
+		BeforeEach(func() {
+			otherSnapshot := newSnapshot()
+			otherSnapshot.Name = "othersnapshot"
+			otherSnapshot.Spec.Source.Name = "othervm"
+			content := &snapshotv1.VirtualMachineSnapshotContent{
+				ObjectMeta: metav1.ObjectMeta{Name: contentName, Namespace: metav1.NamespaceDefault},
+				Status: &snapshotv1.VirtualMachineSnapshotContentStatus{
+					VolumeSnapshotStatus: []snapshotv1.VolumeSnapshotStatus{
+						{VolumeSnapshotName: "vmsnapshot-rootdisk", ReadyToUse: pointer.Bool(true)},
+						{VolumeSnapshotName: "vmsnapshot-datadisk", ReadyToUse: pointer.Bool(false), Error: &snapshotv1.Error{Message: pointer.String("quota exceeded")}},
+					},
+				},
+			}
+			virtClient = kubevirtfake.NewSimpleClientset(newSnapshot(), otherSnapshot, content)
+			snapshotClient := virtClient.SnapshotV1alpha1()
+			kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineSnapshot(metav1.NamespaceDefault).Return(snapshotClient.VirtualMachineSnapshots(metav1.NamespaceDefault)).AnyTimes()
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineSnapshotContent(metav1.NamespaceDefault).Return(snapshotClient.VirtualMachineSnapshotContents(metav1.NamespaceDefault)).AnyTimes()
+		})
+
+		It("should list the snapshots of all VMs", func() {
+			Expect(run("snapshot", "list")).To(Succeed())
+			Expect(out.String()).To(ContainSubstring("NAME"))
+			Expect(out.String()).To(ContainSubstring(snapshotName))
+			Expect(out.String()).To(ContainSubstring("othersnapshot"))
+			Expect(out.String()).To(ContainSubstring("Online,GuestAgent"))
+		})
+
+		It("should list the snapshots of a single VM", func() {
+			Expect(run("snapshot", "list", vmName)).To(Succeed())
+			Expect(out.String()).To(ContainSubstring(snapshotName))
+			Expect(out.String()).ToNot(ContainSubstring("othersnapshot"))
+		})
+
+		It("should describe the snapshot and its volume snapshots", func() {
+			Expect(run("snapshot", "describe", snapshotName)).To(Succeed())
+			Expect(out.String()).To(ContainSubstring("Phase:      Succeeded"))
+			Expect(out.String()).To(ContainSubstring("Ready:      true"))
+			Expect(out.String()).To(ContainSubstring("Online: the snapshot was taken while the VM was running"))
+			Expect(out.String()).To(MatchRegexp(`Ready\s+True\s+Operation complete`))
+			Expect(out.String()).To(MatchRegexp(`vmsnapshot-rootdisk\s+true`))
+			Expect(out.String()).To(MatchRegexp(`vmsnapshot-datadisk\s+false\s+quota exceeded`))
+		})
+
+		It("should delete the snapshot", func() {
+			Expect(run("snapshot", "delete", snapshotName)).To(Succeed())
+			_, err := virtClient.SnapshotV1alpha1().VirtualMachineSnapshots(metav1.NamespaceDefault).Get(context.Background(), snapshotName, metav1.GetOptions{})
+			Expect(err).To(HaveOccurred())
+			Expect(out.String()).To(ContainSubstring("Snapshot testsnapshot deleted"))
+		})
+	})
+})
@@ -0,0 +1,337 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package top
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_TOP = "top"
+	COMMAND_VM  = "vm"
+	COMMAND_VMI = "vmi"
+
+	sortByArg   = "sort-by"
+	intervalArg = "interval"
+
+	sortByName   = "name"
+	sortByCPU    = "cpu"
+	sortByMemory = "memory"
+)
+
+type topFlags struct {
+	sortBy   string
+	interval time.Duration
+}
+
+type command struct {
+	clientConfig clientcmd.ClientConfig
+	cmd          *cobra.Command
+	flags        *topFlags
+	vmsOnly      bool
+}
+
+// vmiUsage is the resource usage of a VMI between two samples of its stats
+type vmiUsage struct {
+	name         string
+	cpuCores     float64
+	cpuPercent   float64
+	memoryBytes  uint64
+	diskReadBps  float64
+	diskWriteBps float64
+	netRxBps     float64
+	netTxBps     float64
+}
+
+func usage() string {
+	return `  # Show the CPU, memory, disk and network usage of the VM 'myvm':
+  {{ProgramName}} top vm myvm
+
+  # Show the usage of all running VMIs of the namespace, the busiest first:
+  {{ProgramName}} top vmi --sort-by=cpu
+
+  # Measure the usage over 5 seconds instead of 1:
+  {{ProgramName}} top vm myvm --interval=5s`
+}
+
+// NewCommand returns a cobra.Command to show the live resource usage of VMs and VMIs
+func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "top vm|vmi",
+		Short:   "Show the CPU, memory, disk and network usage of virtual machines.",
+		Example: usage(),
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Print(cmd.UsageString())
+		},
+	}
+	cmd.AddCommand(
+		newTopCommand(clientConfig, COMMAND_VM, "Show the resource usage of running virtual machines.", true),
+		newTopCommand(clientConfig, COMMAND_VMI, "Show the resource usage of running virtual machine instances.", false),
+	)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newTopCommand(clientConfig clientcmd.ClientConfig, name, short string, vmsOnly bool) *cobra.Command {
+	flags := &topFlags{}
+	cmd := &cobra.Command{
+		Use:   name + " [NAME]",
+		Short: short,
+		Long: short + `
+Without a name all running instances of the namespace are shown.
+The stats are sampled twice, --interval apart, to compute the CPU, disk and network rates.
+Memory is the memory in use by the guest, or the resident memory of the hypervisor process
+if the guest does not report its memory usage.`,
+		Example: usage(),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := command{clientConfig: clientConfig, cmd: cmd, flags: flags, vmsOnly: vmsOnly}
+			return c.run(args)
+		},
+	}
+	cmd.Flags().StringVar(&flags.sortBy, sortByArg, sortByName, fmt.Sprintf("Sort the output by %s, %s or %s.", sortByName, sortByCPU, sortByMemory))
+	cmd.Flags().DurationVar(&flags.interval, intervalArg, time.Second, "The time between the two samples the rates are computed from.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func (c *command) run(args []string) error {
+	switch c.flags.sortBy {
+	case sortByName, sortByCPU, sortByMemory:
+	default:
+		return fmt.Errorf("invalid --%s %q, must be one of %s, %s or %s", sortByArg, c.flags.sortBy, sortByName, sortByCPU, sortByMemory)
+	}
+	if c.flags.interval <= 0 {
+		return fmt.Errorf("--%s must be greater than zero", intervalArg)
+	}
+
+	namespace, _, err := c.clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+	vmiClient := virtClient.VirtualMachineInstance(namespace)
+
+	var names []string
+	if len(args) == 1 {
+		names, err = c.getName(vmiClient, args[0])
+	} else {
+		names, err = c.listNames(vmiClient)
+	}
+	if err != nil {
+		return err
+	}
+
+	first, err := c.sample(vmiClient, names, len(args) == 1)
+	if err != nil {
+		return err
+	}
+	time.Sleep(c.flags.interval)
+	second, err := c.sample(vmiClient, names, len(args) == 1)
+	if err != nil {
+		return err
+	}
+
+	var usages []vmiUsage
+	for _, name := range names {
+		if first[name] == nil || second[name] == nil {
+			continue
+		}
+		usages = append(usages, computeUsage(name, first[name], second[name]))
+	}
+
+	sortUsages(usages, c.flags.sortBy)
+	return printUsages(c.cmd.OutOrStdout(), usages)
+}
+
+func (c *command) getName(vmiClient kubecli.VirtualMachineInstanceInterface, name string) ([]string, error) {
+	vmi, err := vmiClient.Get(context.Background(), name, &metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) && c.vmsOnly {
+			return nil, fmt.Errorf("VirtualMachine %s is not running", name)
+		}
+		return nil, fmt.Errorf("error fetching VirtualMachineInstance %s: %v", name, err)
+	}
+	if vmi.Status.Phase != v1.Running {
+		return nil, fmt.Errorf("VirtualMachineInstance %s is not running", name)
+	}
+	return []string{name}, nil
+}
+
+func (c *command) listNames(vmiClient kubecli.VirtualMachineInstanceInterface) ([]string, error) {
+	vmis, err := vmiClient.List(context.Background(), &metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing VirtualMachineInstances: %v", err)
+	}
+
+	var names []string
+	for i := range vmis.Items {
+		vmi := &vmis.Items[i]
+		if vmi.Status.Phase != v1.Running {
+			continue
+		}
+		if c.vmsOnly {
+			owner := metav1.GetControllerOf(vmi)
+			if owner == nil || owner.Kind != v1.VirtualMachineGroupVersionKind.Kind {
+				continue
+			}
+		}
+		names = append(names, vmi.Name)
+	}
+	return names, nil
+}
+
+// sample fetches the stats of all given VMIs. When listing, VMIs whose stats can not
+// be fetched are left out with a warning, since they may just have been stopped.
+func (c *command) sample(vmiClient kubecli.VirtualMachineInstanceInterface, names []string, single bool) (map[string]*v1.VirtualMachineInstanceStats, error) {
+	samples := map[string]*v1.VirtualMachineInstanceStats{}
+	for _, name := range names {
+		stats, err := vmiClient.Stats(context.Background(), name)
+		if err != nil {
+			if single {
+				return nil, fmt.Errorf("error fetching the stats of %s: %v", name, err)
+			}
+			fmt.Fprintf(c.cmd.ErrOrStderr(), "Skipping %s: %v\n", name, err)
+			continue
+		}
+		samples[name] = stats
+	}
+	return samples, nil
+}
+
+func computeUsage(name string, first, second *v1.VirtualMachineInstanceStats) vmiUsage {
+	u := vmiUsage{name: name}
+
+	if memory := second.Memory; memory != nil {
+		if memory.AvailableBytes > 0 && memory.UnusedBytes > 0 && memory.AvailableBytes >= memory.UnusedBytes {
+			u.memoryBytes = memory.AvailableBytes - memory.UnusedBytes
+		} else {
+			u.memoryBytes = memory.ResidentBytes
+		}
+	}
+
+	seconds := second.Timestamp.Sub(first.Timestamp.Time).Seconds()
+	if seconds <= 0 {
+		return u
+	}
+	rate := func(before, after uint64) float64 {
+		if after < before {
+			// the counters were reset, e.g. by a migration
+			return 0
+		}
+		return float64(after-before) / seconds
+	}
+
+	if first.CPU != nil && second.CPU != nil {
+		u.cpuCores = rate(first.CPU.TimeNanoseconds, second.CPU.TimeNanoseconds) / float64(time.Second)
+		if second.CPU.VCPUs > 0 {
+			u.cpuPercent = u.cpuCores / float64(second.CPU.VCPUs) * 100
+		}
+	}
+
+	disksBefore := map[string]v1.VirtualMachineInstanceDiskStats{}
+	for _, disk := range first.Disks {
+		disksBefore[disk.Name] = disk
+	}
+	for _, disk := range second.Disks {
+		if before, exists := disksBefore[disk.Name]; exists {
+			u.diskReadBps += rate(before.ReadBytes, disk.ReadBytes)
+			u.diskWriteBps += rate(before.WriteBytes, disk.WriteBytes)
+		}
+	}
+
+	interfacesBefore := map[string]v1.VirtualMachineInstanceInterfaceStats{}
+	for _, iface := range first.Interfaces {
+		interfacesBefore[iface.Name] = iface
+	}
+	for _, iface := range second.Interfaces {
+		if before, exists := interfacesBefore[iface.Name]; exists {
+			u.netRxBps += rate(before.RxBytes, iface.RxBytes)
+			u.netTxBps += rate(before.TxBytes, iface.TxBytes)
+		}
+	}
+
+	return u
+}
+
+func sortUsages(usages []vmiUsage, sortBy string) {
+	sort.SliceStable(usages, func(i, j int) bool {
+		switch sortBy {
+		case sortByCPU:
+			if usages[i].cpuCores != usages[j].cpuCores {
+				return usages[i].cpuCores > usages[j].cpuCores
+			}
+		case sortByMemory:
+			if usages[i].memoryBytes != usages[j].memoryBytes {
+				return usages[i].memoryBytes > usages[j].memoryBytes
+			}
+		}
+		return usages[i].name < usages[j].name
+	})
+}
+
+func printUsages(out io.Writer, usages []vmiUsage) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tCPU(cores)\tCPU%\tMEMORY\tDISK READ\tDISK WRITE\tNET RX\tNET TX")
+	for _, u := range usages {
+		fmt.Fprintf(w, "%s\t%dm\t%.1f%%\t%s\t%s/s\t%s/s\t%s/s\t%s/s\n",
+			u.name,
+			int64(u.cpuCores*1000),
+			u.cpuPercent,
+			formatBytes(float64(u.memoryBytes)),
+			formatBytes(u.diskReadBps),
+			formatBytes(u.diskWriteBps),
+			formatBytes(u.netRxBps),
+			formatBytes(u.netTxBps),
+		)
+	}
+	return w.Flush()
+}
+
+func formatBytes(bytes float64) string {
+	units := []string{"B", "KiB", "MiB", "GiB", "TiB"}
+	unit := 0
+	for bytes >= 1024 && unit < len(units)-1 {
+		bytes /= 1024
+		unit++
+	}
+	if unit == 0 {
+		return fmt.Sprintf("%.0f%s", bytes, units[unit])
+	}
+	return fmt.Sprintf("%.1f%s", bytes, units[unit])
+}
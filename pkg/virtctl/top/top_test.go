@@ -0,0 +1,157 @@
+package top_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/top"
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Top", func() {
+	const (
+		gib = 1024 * 1024 * 1024
+		mib = 1024 * 1024
+	)
+
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+	var start time.Time
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+		start = time.Now()
+	})
+
+	runningVMI := func(name string, ownedByVM bool) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault},
+			Status:     v1.VirtualMachineInstanceStatus{Phase: v1.Running},
+		}
+		if ownedByVM {
+			vm := &v1.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Name: name}}
+			vmi.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(vm, v1.VirtualMachineGroupVersionKind)}
+		}
+		return vmi
+	}
+
+	// newStats returns the stats of a VMI with 2 vCPUs and 4Gi of memory, offset seconds after start,
+	// after the VMI consumed cpuSeconds of CPU time and read and received 1Mi per second.
+	newStats := func(offset int, cpuSeconds float64, usedMemory uint64) *v1.VirtualMachineInstanceStats {
+		counter := uint64(offset) * mib
+		return &v1.VirtualMachineInstanceStats{
+			Timestamp: metav1.NewMicroTime(start.Add(time.Duration(offset) * time.Second)),
+			CPU: &v1.VirtualMachineInstanceCPUStats{
+				VCPUs:           2,
+				TimeNanoseconds: uint64(cpuSeconds * float64(time.Second)),
+			},
+			Memory: &v1.VirtualMachineInstanceMemoryStats{
+				AvailableBytes: 4 * gib,
+				UnusedBytes:    4*gib - usedMemory,
+			},
+			Disks:      []v1.VirtualMachineInstanceDiskStats{{Name: "rootdisk", ReadBytes: counter}},
+			Interfaces: []v1.VirtualMachineInstanceInterfaceStats{{Name: "default", RxBytes: counter}},
+		}
+	}
+
+	expectStats := func(name string, first, second *v1.VirtualMachineInstanceStats) {
+		gomock.InOrder(
+			vmiInterface.EXPECT().Stats(context.Background(), name).Return(first, nil),
+			vmiInterface.EXPECT().Stats(context.Background(), name).Return(second, nil),
+		)
+	}
+
+	runTop := func(args ...string) (string, error) {
+		out := &bytes.Buffer{}
+		cmd := clientcmd.NewVirtctlCommand(append([]string{top.COMMAND_TOP}, append(args, "--interval=1ms")...)...)
+		cmd.SetOut(out)
+		err := cmd.Execute()
+		return out.String(), err
+	}
+
+	It("should show the usage of a VM", func() {
+		vmiInterface.EXPECT().Get(context.Background(), "testvm", &metav1.GetOptions{}).Return(runningVMI("testvm", true), nil)
+		expectStats("testvm", newStats(0, 10, gib), newStats(2, 11, gib))
+
+		out, err := runTop(top.COMMAND_VM, "testvm")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(out).To(MatchRegexp(`NAME\s+CPU\(cores\)\s+CPU%\s+MEMORY\s+DISK READ\s+DISK WRITE\s+NET RX\s+NET TX`))
+		Expect(out).To(MatchRegexp(`testvm\s+500m\s+25\.0%\s+1\.0GiB\s+1\.0MiB/s\s+0B/s\s+1\.0MiB/s\s+0B/s`))
+	})
+
+	It("should fail when the VM is not running", func() {
+		vmiInterface.EXPECT().Get(context.Background(), "testvm", &metav1.GetOptions{}).Return(nil, k8serrors.NewNotFound(v1.Resource("virtualmachineinstance"), "testvm"))
+
+		_, err := runTop(top.COMMAND_VM, "testvm")
+		Expect(err).To(MatchError("VirtualMachine testvm is not running"))
+	})
+
+	It("should fail when the stats of a single VMI can not be fetched", func() {
+		vmiInterface.EXPECT().Get(context.Background(), "testvmi", &metav1.GetOptions{}).Return(runningVMI("testvmi", false), nil)
+		vmiInterface.EXPECT().Stats(context.Background(), "testvmi").Return(nil, fmt.Errorf("unavailable"))
+
+		_, err := runTop(top.COMMAND_VMI, "testvmi")
+		Expect(err).To(MatchError("error fetching the stats of testvmi: unavailable"))
+	})
+
+	Context("when listing the namespace", func() {
+		BeforeEach(func() {
+			stopped := runningVMI("stopped", true)
+			stopped.Status.Phase = v1.Succeeded
+			vmiInterface.EXPECT().List(context.Background(), &metav1.ListOptions{}).Return(&v1.VirtualMachineInstanceList{
+				Items: []v1.VirtualMachineInstance{
+					*runningVMI("busy", true),
+					*runningVMI("idle", true),
+					*runningVMI("standalone", false),
+					*stopped,
+				},
+			}, nil)
+		})
+
+		DescribeTable("should sort the VMs", func(sortBy string, expected string) {
+			expectStats("busy", newStats(0, 0, gib), newStats(1, 2, gib))
+			expectStats("idle", newStats(0, 0, 2*gib), newStats(1, 0, 2*gib))
+
+			out, err := runTop(top.COMMAND_VM, "--sort-by="+sortBy)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).ToNot(ContainSubstring("standalone"))
+			Expect(out).ToNot(ContainSubstring("stopped"))
+			Expect(out).To(MatchRegexp(expected))
+		},
+			Entry("by name", "name", `(?s)busy\s+2000m\s+100\.0%.*idle\s+0m\s+0\.0%`),
+			Entry("by cpu", "cpu", `(?s)busy.*idle`),
+			Entry("by memory", "memory", `(?s)idle\s+0m\s+0\.0%\s+2\.0GiB.*busy`),
+		)
+
+		It("should show all running VMIs and skip the ones without stats", func() {
+			expectStats("busy", newStats(0, 0, gib), newStats(1, 1, gib))
+			vmiInterface.EXPECT().Stats(context.Background(), "idle").Return(nil, fmt.Errorf("unavailable")).Times(2)
+			expectStats("standalone", newStats(0, 0, gib), newStats(1, 0, gib))
+
+			out, err := runTop(top.COMMAND_VMI)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(out).To(MatchRegexp(`busy\s+1000m`))
+			Expect(out).To(MatchRegexp(`standalone\s+0m`))
+			Expect(out).ToNot(ContainSubstring("idle"))
+		})
+	})
+
+	It("should reject an unknown sort order", func() {
+		_, err := runTop(top.COMMAND_VMI, "--sort-by=disk")
+		Expect(err).To(MatchError(ContainSubstring(`invalid --sort-by "disk"`)))
+	})
+})
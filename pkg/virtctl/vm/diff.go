@@ -0,0 +1,139 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package vm
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_VM   = "vm"
+	COMMAND_DIFF = "diff"
+)
+
+// NewVMCommand returns the vm command, which groups the commands inspecting a VirtualMachine
+func NewVMCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     COMMAND_VM,
+		Short:   "Inspect virtual machines.",
+		Example: usageDiff(),
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Print(cmd.UsageString())
+		},
+	}
+	cmd.AddCommand(NewDiffCommand(clientConfig))
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func NewDiffCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff (VM)",
+		Short: "Show the changes of a virtual machine which are not applied to its running instance yet.",
+		Long: `Show the changes of a virtual machine which are not applied to its running instance yet.
+Changes are either applied to the running instance without a restart, like hotplugged CPU sockets,
+or only take effect once the virtual machine is restarted.`,
+		Example: usageDiff(),
+		Args:    templates.ExactArgs(COMMAND_DIFF, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := Command{clientConfig: clientConfig, command: COMMAND_DIFF}
+			return c.diffRun(cmd, args)
+		},
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usageDiff() string {
+	return `  # Show the pending changes of a virtual machine called 'myvm':
+  {{ProgramName}} vm diff myvm`
+}
+
+func (o *Command) diffRun(cmd *cobra.Command, args []string) error {
+	vmName := args[0]
+
+	virtClient, namespace, err := GetNamespaceAndClient(o.clientConfig)
+	if err != nil {
+		return err
+	}
+
+	vm, err := virtClient.VirtualMachine(namespace).Get(context.Background(), vmName, &metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Error getting VirtualMachine %s: %v", vmName, err)
+	}
+
+	out := cmd.OutOrStdout()
+	if !vm.Status.Created {
+		fmt.Fprintf(out, "VirtualMachine %s is not running, all changes are applied when it is started\n", vmName)
+		return nil
+	}
+	if len(vm.Status.PendingChanges) == 0 {
+		fmt.Fprintf(out, "VirtualMachine %s has no pending changes\n", vmName)
+		return nil
+	}
+
+	if err := printPendingChanges(out, vm.Status.PendingChanges); err != nil {
+		return err
+	}
+
+	restartRequired := 0
+	for _, change := range vm.Status.PendingChanges {
+		if !change.LiveUpdatable {
+			restartRequired++
+		}
+	}
+	if restartRequired == 0 {
+		fmt.Fprintln(out, "\nNo restart required, all changes are applied to the running VirtualMachine")
+	} else {
+		fmt.Fprintf(out, "\nRestart required, %d of %d changes are applied on the next restart of the VirtualMachine\n", restartRequired, len(vm.Status.PendingChanges))
+	}
+	return nil
+}
+
+func printPendingChanges(out io.Writer, changes []v1.VirtualMachinePendingChange) error {
+	valueOrNone := func(value string) string {
+		if value == "" {
+			return "<none>"
+		}
+		return value
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PATH\tCURRENT\tDESIRED\tLIVE UPDATE")
+	for _, change := range changes {
+		liveUpdate := "no"
+		if change.LiveUpdatable {
+			liveUpdate = "yes"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", change.Path, valueOrNone(change.Current), valueOrNone(change.Desired), liveUpdate)
+	}
+	return w.Flush()
+}
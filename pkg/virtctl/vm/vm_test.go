@@ -1,6 +1,7 @@
 package vm_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os"
@@ -91,6 +92,10 @@ var _ = Describe("VirtualMachine", func() {
 			cmd := clientcmd.NewRepeatableVirtctlCommand("expand")
 			Expect(cmd()).NotTo(Succeed())
 		})
+		It("should fail a vm diff", func() {
+			cmd := clientcmd.NewRepeatableVirtctlCommand("vm", "diff")
+			Expect(cmd()).NotTo(Succeed())
+		})
 	})
 
 	Context("should patch VM", func() {
@@ -693,4 +698,50 @@ status:
 			Expect(err).Should(MatchError("error decoding VirtualMachine error converting YAML to JSON: yaml: mapping values are not allowed in this context"))
 		})
 	})
+
+	Context("diff", func() {
+		runDiff := func() string {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+			vmInterface.EXPECT().Get(context.Background(), vm.Name, &k8smetav1.GetOptions{}).Return(vm, nil).Times(1)
+
+			out := &bytes.Buffer{}
+			cmd := clientcmd.NewVirtctlCommand("vm", "diff", vm.Name)
+			cmd.SetOut(out)
+			Expect(cmd.Execute()).To(Succeed())
+			return out.String()
+		}
+
+		BeforeEach(func() {
+			vm = kubecli.NewMinimalVM(vmName)
+			vm.Status.Created = true
+		})
+
+		It("should report a stopped VM", func() {
+			vm.Status.Created = false
+			Expect(runDiff()).To(Equal("VirtualMachine testvm is not running, all changes are applied when it is started\n"))
+		})
+
+		It("should report a VM without pending changes", func() {
+			Expect(runDiff()).To(Equal("VirtualMachine testvm has no pending changes\n"))
+		})
+
+		It("should show the pending changes of a VM which can be applied live", func() {
+			vm.Status.PendingChanges = []v1.VirtualMachinePendingChange{
+				{Path: "spec.domain.cpu.sockets", Current: "2", Desired: "4", LiveUpdatable: true},
+			}
+			output := runDiff()
+			Expect(output).To(ContainSubstring("PATH                     CURRENT  DESIRED  LIVE UPDATE\nspec.domain.cpu.sockets  2        4        yes\n"))
+			Expect(output).To(HaveSuffix("No restart required, all changes are applied to the running VirtualMachine\n"))
+		})
+
+		It("should show the pending changes of a VM which require a restart", func() {
+			vm.Status.PendingChanges = []v1.VirtualMachinePendingChange{
+				{Path: "spec.domain.cpu.sockets", Current: "2", Desired: "4", LiveUpdatable: true},
+				{Path: "spec.volumes[name=data]", Desired: `{"containerDisk":{"image":"data"},"name":"data"}`},
+			}
+			output := runDiff()
+			Expect(output).To(ContainSubstring(`spec.volumes[name=data]  <none>   {"containerDisk":{"image":"data"},"name":"data"}  no`))
+			Expect(output).To(HaveSuffix("Restart required, 1 of 2 changes are applied on the next restart of the VirtualMachine\n"))
+		})
+	})
 })
@@ -0,0 +1,297 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package vnc
+
+import (
+	"embed"
+	"html/template"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/gorilla/websocket"
+
+	"kubevirt.io/client-go/kubecli"
+)
+
+const (
+	// websockifyPath is where noVNC connects to by default
+	websockifyPath = "/websockify"
+	viewerPath     = "/rfb.js"
+
+	rfbVersion33       = "RFB 003.003\n"
+	rfbSecurityNone    = 1
+	rfbSecurityVNCAuth = 2
+	rfbSharedFlag      = 1
+)
+
+// webUI holds the page and the RFB viewer of the web UI, so that browsers don't have to load a viewer from elsewhere
+//
+//go:embed web
+var webUI embed.FS
+
+var webUITemplate = template.Must(template.ParseFS(webUI, "web/index.html"))
+
+// Proxy bridges any number of simultaneous VNC viewers to a VMI. Every viewer gets its own
+// shared connection to the vnc subresource, so that the viewers do not disconnect each other.
+type Proxy struct {
+	vmiClient     kubecli.VirtualMachineInstanceInterface
+	namespace     string
+	name          string
+	listenAddress string
+
+	upgrader websocket.Upgrader
+}
+
+func NewProxy(vmiClient kubecli.VirtualMachineInstanceInterface, namespace, name, listenAddress string) *Proxy {
+	return &Proxy{
+		vmiClient:     vmiClient,
+		namespace:     namespace,
+		name:          name,
+		listenAddress: listenAddress,
+		upgrader: websocket.Upgrader{
+			// noVNC asks for the binary subprotocol
+			Subprotocols: []string{"binary"},
+		},
+	}
+}
+
+// ServeVNC accepts raw VNC viewers, like remote-viewer, on the listener until it is closed
+func (p *Proxy) ServeVNC(ln net.Listener) error {
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			return err
+		}
+		glog.V(2).Infof("VNC viewer %s connected", conn.RemoteAddr())
+		go p.bridge(conn)
+	}
+}
+
+// Handler serves the web UI with its embedded viewer and the noVNC compatible websocket bridge.
+// Websocket connections are only accepted from pages served by the proxy itself and
+// for the listen address or localhost, so that neither other web sites open in the
+// browser nor DNS rebinding can connect to the VMI.
+func (p *Proxy) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", p.serveWebUI)
+	mux.HandleFunc(viewerPath, p.serveViewer)
+	mux.HandleFunc(websockifyPath, p.serveWebsocket)
+	return mux
+}
+
+func (p *Proxy) serveWebUI(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	err := webUITemplate.Execute(w, struct {
+		Namespace string
+		Name      string
+		Path      string
+	}{p.namespace, p.name, websockifyPath})
+	if err != nil {
+		glog.Errorf("Failed to render the web UI: %v", err)
+	}
+}
+
+func (p *Proxy) serveViewer(w http.ResponseWriter, _ *http.Request) {
+	viewer, err := webUI.ReadFile("web" + viewerPath)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/javascript; charset=utf-8")
+	if _, err := w.Write(viewer); err != nil {
+		glog.Errorf("Failed to send the viewer: %v", err)
+	}
+}
+
+func (p *Proxy) serveWebsocket(w http.ResponseWriter, r *http.Request) {
+	if !p.isAllowedHost(r.Host) {
+		glog.Errorf("Rejected the connection of %s to host %s", r.RemoteAddr, r.Host)
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return
+	}
+	conn, err := p.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		glog.Errorf("Failed to upgrade the connection of %s: %v", r.RemoteAddr, err)
+		return
+	}
+	glog.V(2).Infof("Web viewer %s connected", r.RemoteAddr)
+	p.bridge(&websocketConn{conn: conn})
+}
+
+// isAllowedHost returns whether the Host header names the listen address or localhost.
+// IP addresses of the machine are accepted too when listening on all interfaces.
+func (p *Proxy) isAllowedHost(hostport string) bool {
+	host, _, err := net.SplitHostPort(hostport)
+	if err != nil {
+		host = hostport
+	}
+	if host == "localhost" || host == p.listenAddress {
+		return true
+	}
+	ip := net.ParseIP(strings.Trim(host, "[]"))
+	if ip == nil {
+		return false
+	}
+	if ip.IsLoopback() {
+		return true
+	}
+	listenIP := net.ParseIP(p.listenAddress)
+	return listenIP != nil && (listenIP.Equal(ip) || listenIP.IsUnspecified())
+}
+
+func (p *Proxy) bridge(viewer io.ReadWriteCloser) {
+	defer viewer.Close()
+
+	vnc, err := p.vmiClient.SharedVNC(p.name)
+	if err != nil {
+		glog.Errorf("Can't access VMI %s: %v", p.name, err)
+		return
+	}
+
+	pipeInReader, pipeInWriter := io.Pipe()
+	pipeOutReader, pipeOutWriter := io.Pipe()
+
+	go func() {
+		err := vnc.Stream(kubecli.StreamOptions{
+			In:  pipeInReader,
+			Out: pipeOutWriter,
+		})
+		if err != nil {
+			glog.V(2).Infof("VNC stream of VMI %s closed: %v", p.name, err)
+		}
+		pipeInReader.Close()
+		pipeOutWriter.Close()
+	}()
+
+	go func() {
+		pipeInWriter.CloseWithError(copySharedClientInit(pipeInWriter, viewer))
+	}()
+
+	if _, err := io.Copy(viewer, pipeOutReader); err != nil {
+		glog.V(2).Infof("VNC viewer disconnected: %v", err)
+	}
+}
+
+// copySharedClientInit copies the RFB messages of a viewer to the VNC stream and sets the shared-flag of its
+// ClientInit message. QEMU disconnects all other viewers when a viewer asks for exclusive access, which
+// viewers like remote-viewer do by default.
+func copySharedClientInit(dst io.Writer, viewer io.Reader) error {
+	version := make([]byte, len(rfbVersion33))
+	if err := copyN(dst, viewer, version); err != nil {
+		return err
+	}
+	if !strings.HasPrefix(string(version), "RFB ") {
+		return copyRest(dst, viewer)
+	}
+
+	// Up to version 3.3 the server selects the security type, which is None for VMIs
+	securityResponseLength := 0
+	if string(version) != rfbVersion33 {
+		securityType := make([]byte, 1)
+		if err := copyN(dst, viewer, securityType); err != nil {
+			return err
+		}
+		switch securityType[0] {
+		case rfbSecurityNone:
+		case rfbSecurityVNCAuth:
+			securityResponseLength = 16
+		default:
+			// the ClientInit message can not be found in unknown security handshakes
+			return copyRest(dst, viewer)
+		}
+	}
+	if err := copyN(dst, viewer, make([]byte, securityResponseLength)); err != nil {
+		return err
+	}
+
+	clientInit := make([]byte, 1)
+	if _, err := io.ReadFull(viewer, clientInit); err != nil {
+		return err
+	}
+	if clientInit[0] != rfbSharedFlag {
+		glog.V(2).Info("Setting the shared-flag of the VNC viewer")
+	}
+	if _, err := dst.Write([]byte{rfbSharedFlag}); err != nil {
+		return err
+	}
+	return copyRest(dst, viewer)
+}
+
+// copyN reads len(buf) bytes from src into buf and writes them to dst
+func copyN(dst io.Writer, src io.Reader, buf []byte) error {
+	if _, err := io.ReadFull(src, buf); err != nil {
+		return err
+	}
+	_, err := dst.Write(buf)
+	return err
+}
+
+func copyRest(dst io.Writer, src io.Reader) error {
+	_, err := io.Copy(dst, src)
+	return err
+}
+
+// websocketConn reads and writes the binary messages of a websocket as a byte stream
+type websocketConn struct {
+	conn   *websocket.Conn
+	reader io.Reader
+}
+
+func (c *websocketConn) Read(p []byte) (int, error) {
+	for {
+		if c.reader == nil {
+			messageType, reader, err := c.conn.NextReader()
+			if err != nil {
+				return 0, err
+			}
+			if messageType != websocket.BinaryMessage {
+				continue
+			}
+			c.reader = reader
+		}
+		n, err := c.reader.Read(p)
+		if err == io.EOF {
+			c.reader = nil
+			if n > 0 {
+				return n, nil
+			}
+			continue
+		}
+		return n, err
+	}
+}
+
+func (c *websocketConn) Write(p []byte) (int, error) {
+	if err := c.conn.WriteMessage(websocket.BinaryMessage, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (c *websocketConn) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,223 @@
+package vnc_test
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/websocket"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/vnc"
+)
+
+// echoStream greets every viewer with its number and echoes everything the viewer sends
+type echoStream struct {
+	greeting string
+}
+
+func (s *echoStream) Stream(options kubecli.StreamOptions) error {
+	if _, err := options.Out.Write([]byte(s.greeting)); err != nil {
+		return err
+	}
+	_, err := io.Copy(options.Out, options.In)
+	return err
+}
+
+func (s *echoStream) AsConn() net.Conn {
+	return nil
+}
+
+var _ = Describe("VNC proxy", func() {
+	const vmiName = "testvmi"
+
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+	var proxy *vnc.Proxy
+	var streams int32
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		streams = 0
+		vmiInterface.EXPECT().SharedVNC(vmiName).DoAndReturn(func(string) (kubecli.StreamInterface, error) {
+			return &echoStream{greeting: fmt.Sprintf("viewer %d\n", atomic.AddInt32(&streams, 1))}, nil
+		}).AnyTimes()
+		proxy = vnc.NewProxy(vmiInterface, metav1.NamespaceDefault, vmiName, "127.0.0.1")
+	})
+
+	// clientInit is the handshake of a viewer asking for exclusive access, sharedClientInit is what the VMI receives
+	const (
+		clientInit       = "RFB 003.008\n\x01\x00"
+		sharedClientInit = "RFB 003.008\n\x01\x01"
+	)
+
+	readLine := func(r io.Reader) string {
+		buf := make([]byte, 64)
+		n, err := r.Read(buf)
+		Expect(err).ToNot(HaveOccurred())
+		return string(buf[:n])
+	}
+
+	readN := func(r io.Reader, n int) string {
+		buf := make([]byte, n)
+		_, err := io.ReadFull(r, buf)
+		Expect(err).ToNot(HaveOccurred())
+		return string(buf)
+	}
+
+	readMessages := func(conn *websocket.Conn, n int) string {
+		var received []byte
+		for len(received) < n {
+			_, msg, err := conn.ReadMessage()
+			Expect(err).ToNot(HaveOccurred())
+			received = append(received, msg...)
+		}
+		return string(received)
+	}
+
+	Context("web UI", func() {
+		var server *httptest.Server
+
+		BeforeEach(func() {
+			server = httptest.NewServer(proxy.Handler())
+			DeferCleanup(server.Close)
+		})
+
+		dial := func(header http.Header) (*websocket.Conn, *http.Response, error) {
+			url := "ws" + strings.TrimPrefix(server.URL, "http") + "/websockify"
+			return websocket.DefaultDialer.Dial(url, header)
+		}
+
+		get := func(path string) (*http.Response, string) {
+			resp, err := http.Get(server.URL + path)
+			Expect(err).ToNot(HaveOccurred())
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			Expect(err).ToNot(HaveOccurred())
+			return resp, string(body)
+		}
+
+		It("should serve the web UI page", func() {
+			resp, body := get("/")
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(body).To(ContainSubstring(`<title>default/testvmi</title>`))
+			Expect(body).To(ContainSubstring(`import RFB from "./rfb.js"`))
+			Expect(body).To(ContainSubstring("websockify"))
+		})
+
+		It("should serve the embedded viewer", func() {
+			resp, body := get("/rfb.js")
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(resp.Header.Get("Content-Type")).To(HavePrefix("text/javascript"))
+			Expect(body).To(ContainSubstring("export default class RFB"))
+		})
+
+		It("should not serve other pages", func() {
+			resp, err := http.Get(server.URL + "/vnc.html")
+			Expect(err).ToNot(HaveOccurred())
+			resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+		})
+
+		It("should bridge simultaneous websocket viewers to their own VNC streams", func() {
+			first, _, err := dial(nil)
+			Expect(err).ToNot(HaveOccurred())
+			defer first.Close()
+			_, msg, err := first.ReadMessage()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(msg)).To(Equal("viewer 1\n"))
+
+			second, _, err := dial(nil)
+			Expect(err).ToNot(HaveOccurred())
+			defer second.Close()
+			_, msg, err = second.ReadMessage()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(msg)).To(Equal("viewer 2\n"))
+
+			Expect(first.WriteMessage(websocket.BinaryMessage, []byte(clientInit+"key event"))).To(Succeed())
+			Expect(readMessages(first, len(sharedClientInit+"key event"))).To(Equal(sharedClientInit + "key event"))
+		})
+
+		It("should reject websocket connections from other origins", func() {
+			_, resp, err := dial(http.Header{"Origin": []string{"http://example.com"}})
+			Expect(err).To(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+			Expect(atomic.LoadInt32(&streams)).To(BeZero())
+		})
+
+		DescribeTable("should reject websocket connections to other hosts", func(host string) {
+			_, resp, err := dial(http.Header{"Host": []string{host}, "Origin": []string{"http://" + host}})
+			Expect(err).To(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+			Expect(atomic.LoadInt32(&streams)).To(BeZero())
+		},
+			Entry("with a host name", "attacker.example.com"),
+			Entry("with another IP address", "192.0.2.1"),
+		)
+
+		DescribeTable("should accept websocket connections to the listen address and localhost", func(host string) {
+			conn, _, err := dial(http.Header{"Host": []string{host}, "Origin": []string{"http://" + host}})
+			Expect(err).ToNot(HaveOccurred())
+			defer conn.Close()
+			_, msg, err := conn.ReadMessage()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(msg)).To(Equal("viewer 1\n"))
+		},
+			Entry("with the listen address", "127.0.0.1:5900"),
+			Entry("with localhost", "localhost:5900"),
+			Entry("with the IPv6 loopback address", "[::1]:5900"),
+		)
+	})
+
+	It("should bridge simultaneous raw VNC viewers to their own VNC streams", func() {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		defer ln.Close()
+		go proxy.ServeVNC(ln)
+
+		first, err := net.Dial("tcp", ln.Addr().String())
+		Expect(err).ToNot(HaveOccurred())
+		defer first.Close()
+		Expect(readLine(first)).To(Equal("viewer 1\n"))
+
+		second, err := net.Dial("tcp", ln.Addr().String())
+		Expect(err).ToNot(HaveOccurred())
+		defer second.Close()
+		Expect(readLine(second)).To(Equal("viewer 2\n"))
+
+		_, err = second.Write([]byte(clientInit + "pointer event"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(readN(second, len(sharedClientInit+"pointer event"))).To(Equal(sharedClientInit + "pointer event"))
+	})
+
+	DescribeTable("should ask the VMI for shared access for every viewer", func(handshake, expected string) {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		defer ln.Close()
+		go proxy.ServeVNC(ln)
+
+		conn, err := net.Dial("tcp", ln.Addr().String())
+		Expect(err).ToNot(HaveOccurred())
+		defer conn.Close()
+		Expect(readLine(conn)).To(Equal("viewer 1\n"))
+
+		_, err = conn.Write([]byte(handshake + "pointer event"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(readN(conn, len(expected+"pointer event"))).To(Equal(expected + "pointer event"))
+	},
+		Entry("with version 3.8 and no authentication", clientInit, sharedClientInit),
+		Entry("with version 3.8 and VNC authentication",
+			"RFB 003.008\n\x02"+strings.Repeat("\x00", 16)+"\x00", "RFB 003.008\n\x02"+strings.Repeat("\x00", 16)+"\x01"),
+		Entry("with version 3.3", "RFB 003.003\n\x00", "RFB 003.003\n\x01"),
+		Entry("with a viewer already asking for shared access", sharedClientInit, sharedClientInit),
+	)
+})
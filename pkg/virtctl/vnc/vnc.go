@@ -20,20 +20,24 @@
 package vnc
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net"
+	"net/http"
 	"os"
 	"os/exec"
 	"os/signal"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"time"
 
 	"github.com/golang/glog"
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/clientcmd"
 
 	"kubevirt.io/client-go/kubecli"
@@ -65,6 +69,7 @@ const (
 var listenAddressFmt string
 var listenAddress = "127.0.0.1"
 var proxyOnly bool
+var web bool
 var customPort = 0
 
 func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
@@ -82,6 +87,7 @@ func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 	cmd.Flags().BoolVar(&proxyOnly, "proxy-only", proxyOnly, "--proxy-only=false: Setting this true will run only the virtctl vnc proxy and show the port where VNC viewers can connect")
 	cmd.Flags().IntVar(&customPort, "port", customPort,
 		"--port=0: Assigning a port value to this will try to run the proxy on the given port if the port is accessible; If unassigned, the proxy will run on a random port")
+	cmd.Flags().BoolVar(&web, "web", web, "--web=false: Setting this true together with --proxy-only will serve a web UI with an embedded viewer and a noVNC compatible websocket bridge instead of raw VNC")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	cmd.AddCommand(screenshot.NewScreenshotCommand(clientConfig))
 	return cmd
@@ -104,6 +110,13 @@ func (o *VNC) Run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if proxyOnly {
+		return o.runProxy(cmd, virtCli, namespace, vmi)
+	}
+	if web {
+		return fmt.Errorf("--web can only be used together with --proxy-only")
+	}
+
 	// setup connection with VM
 	vnc, err := virtCli.VirtualMachineInstance(namespace).VNC(vmi)
 	if err != nil {
@@ -330,7 +343,70 @@ func remoteViewerArgs(port int) (args []string) {
 	return
 }
 
+// runProxy serves VNC or the web UI to any number of simultaneous viewers until interrupted
+func (o *VNC) runProxy(cmd *cobra.Command, virtCli kubecli.KubevirtClient, namespace, vmi string) error {
+	vmiClient := virtCli.VirtualMachineInstance(namespace)
+	if _, err := vmiClient.Get(context.Background(), vmi, &metav1.GetOptions{}); err != nil {
+		return fmt.Errorf("Can't access VMI %s: %s", vmi, err.Error())
+	}
+
+	listenAddressFmt = listenAddress + ":%d"
+	lnAddr, err := net.ResolveTCPAddr("tcp", fmt.Sprintf(listenAddressFmt, customPort))
+	if err != nil {
+		return fmt.Errorf("Can't resolve the address: %s", err.Error())
+	}
+	ln, err := net.ListenTCP("tcp", lnAddr)
+	if err != nil {
+		return fmt.Errorf("Can't listen on %s: %s", lnAddr, err.Error())
+	}
+	defer ln.Close()
+
+	proxy := NewProxy(vmiClient, namespace, vmi, listenAddress)
+	port := ln.Addr().(*net.TCPAddr).Port
+	options := struct {
+		Port int    `json:"port"`
+		URL  string `json:"url,omitempty"`
+	}{Port: port}
+
+	serveResChan := make(chan error, 1)
+	if web {
+		options.URL = fmt.Sprintf("http://%s/", net.JoinHostPort(listenAddress, strconv.Itoa(port)))
+		server := &http.Server{Handler: proxy.Handler(), ReadHeaderTimeout: 10 * time.Second}
+		defer server.Close()
+		go func() {
+			serveResChan <- server.Serve(ln)
+		}()
+	} else {
+		go func() {
+			serveResChan <- proxy.ServeVNC(ln)
+		}()
+	}
+
+	optionString, err := json.Marshal(options)
+	if err != nil {
+		return fmt.Errorf("Error encountered: %s", err.Error())
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), string(optionString))
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	select {
+	case <-interrupt:
+		return nil
+	case err = <-serveResChan:
+		return fmt.Errorf("Error encountered: %s", err.Error())
+	}
+}
+
 func usage() string {
 	return `  # Connect to 'testvmi' via remote-viewer:
-   {{ProgramName}} vnc testvmi`
+   {{ProgramName}} vnc testvmi
+
+  # Run only the proxy, so that any number of VNC viewers can connect to 'testvmi' at the same time:
+   {{ProgramName}} vnc testvmi --proxy-only
+
+  # Open the console of 'testvmi' in a browser at the URL printed by the proxy:
+   {{ProgramName}} vnc testvmi --proxy-only --web`
 }
@@ -0,0 +1,11 @@
+package vnc_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestVNC(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
@@ -0,0 +1,375 @@
+// This file is part of the KubeVirt project
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Copyright 2023 Red Hat, Inc.
+
+// RFB is a minimal VNC viewer speaking RFB 3.8 over a websocket. It supports the Raw, CopyRect and
+// DesktopSize encodings, which QEMU always provides, and forwards the keyboard and the pointer.
+// Like noVNC it emits a "connect" event and a "disconnect" event with a "clean" detail.
+
+const securityNone = 1;
+
+const encodingRaw = 0;
+const encodingCopyRect = 1;
+const encodingDesktopSize = -223;
+
+const keysyms = {
+  Backspace: 0xff08, Tab: 0xff09, Enter: 0xff0d, Escape: 0xff1b, Delete: 0xffff,
+  Home: 0xff50, ArrowLeft: 0xff51, ArrowUp: 0xff52, ArrowRight: 0xff53, ArrowDown: 0xff54,
+  PageUp: 0xff55, PageDown: 0xff56, End: 0xff57, Insert: 0xff63, CapsLock: 0xffe5,
+  F1: 0xffbe, F2: 0xffbf, F3: 0xffc0, F4: 0xffc1, F5: 0xffc2, F6: 0xffc3,
+  F7: 0xffc4, F8: 0xffc5, F9: 0xffc6, F10: 0xffc7, F11: 0xffc8, F12: 0xffc9,
+};
+
+// modifier keysyms for the left and the right key
+const modifierKeysyms = {
+  Shift: [0xffe1, 0xffe2], Control: [0xffe3, 0xffe4], Meta: [0xffe7, 0xffe8], Alt: [0xffe9, 0xffea],
+};
+
+export default class RFB extends EventTarget {
+  constructor(target, url, options = {}) {
+    super();
+    this.scaleViewport = false;
+    this._shared = options.shared !== false;
+    this._connected = false;
+    this._closed = false;
+    this._chunks = [];
+    this._length = 0;
+    this._pending = null;
+    this._pressedKeys = new Map();
+    this._buttons = 0;
+
+    this._canvas = document.createElement("canvas");
+    this._canvas.tabIndex = 0;
+    this._canvas.style.display = "block";
+    this._canvas.style.margin = "auto";
+    this._canvas.style.outline = "none";
+    target.appendChild(this._canvas);
+    this._context = this._canvas.getContext("2d");
+    this._addInputListeners();
+
+    this._ws = new WebSocket(url, ["binary"]);
+    this._ws.binaryType = "arraybuffer";
+    this._ws.onmessage = (e) => this._receive(new Uint8Array(e.data));
+    this._ws.onopen = () => this._run().catch((err) => {
+      if (!this._closed) {
+        console.error(err);
+        this._ws.close();
+      }
+    });
+    this._ws.onclose = (e) => this._disconnect(e.wasClean && this._connected);
+  }
+
+  set scaleViewport(scale) {
+    this._scaleViewport = scale;
+    if (this._canvas) {
+      this._canvas.style.maxWidth = scale ? "100%" : "";
+      this._canvas.style.maxHeight = scale ? "100%" : "";
+    }
+  }
+
+  get scaleViewport() {
+    return this._scaleViewport;
+  }
+
+  disconnect() {
+    this._ws.close();
+  }
+
+  async _run() {
+    const version = this._decode(await this._read(12));
+    if (!version.startsWith("RFB 003.")) {
+      throw new Error(`unsupported server version ${version}`);
+    }
+    this._send(new TextEncoder().encode("RFB 003.008\n"));
+
+    const count = (await this._read(1))[0];
+    if (count === 0) {
+      throw new Error(await this._readReason());
+    }
+    const types = await this._read(count);
+    if (!types.includes(securityNone)) {
+      throw new Error("the server requires an unsupported authentication");
+    }
+    this._send([securityNone]);
+    if (this._view(await this._read(4)).getUint32(0) !== 0) {
+      throw new Error(await this._readReason());
+    }
+
+    this._send([this._shared ? 1 : 0]);
+    const serverInit = this._view(await this._read(24));
+    this._resize(serverInit.getUint16(0), serverInit.getUint16(2));
+    await this._read(serverInit.getUint32(20));
+
+    this._setPixelFormat();
+    this._setEncodings([encodingRaw, encodingCopyRect, encodingDesktopSize]);
+    this._requestUpdate(false);
+    this._connected = true;
+    this.dispatchEvent(new CustomEvent("connect"));
+
+    for (;;) {
+      const messageType = (await this._read(1))[0];
+      switch (messageType) {
+        case 0:
+          await this._framebufferUpdate();
+          break;
+        case 1:
+          // SetColourMapEntries, not used with true colour
+          await this._read(this._view(await this._read(5)).getUint16(3) * 6);
+          break;
+        case 2:
+          // Bell
+          break;
+        case 3:
+          // ServerCutText
+          await this._read(this._view(await this._read(7)).getUint32(3));
+          break;
+        default:
+          throw new Error(`unsupported server message ${messageType}`);
+      }
+    }
+  }
+
+  async _framebufferUpdate() {
+    const rects = this._view(await this._read(3)).getUint16(1);
+    for (let i = 0; i < rects; i++) {
+      const header = this._view(await this._read(12));
+      const x = header.getUint16(0);
+      const y = header.getUint16(2);
+      const width = header.getUint16(4);
+      const height = header.getUint16(6);
+      const encoding = header.getInt32(8);
+      switch (encoding) {
+        case encodingRaw: {
+          const pixels = await this._read(width * height * 4);
+          for (let p = 3; p < pixels.length; p += 4) {
+            pixels[p] = 255;
+          }
+          if (width > 0 && height > 0) {
+            this._context.putImageData(new ImageData(new Uint8ClampedArray(pixels.buffer), width, height), x, y);
+          }
+          break;
+        }
+        case encodingCopyRect: {
+          const source = this._view(await this._read(4));
+          this._context.drawImage(this._canvas, source.getUint16(0), source.getUint16(2), width, height, x, y, width, height);
+          break;
+        }
+        case encodingDesktopSize:
+          this._resize(width, height);
+          break;
+        default:
+          throw new Error(`unsupported encoding ${encoding}`);
+      }
+    }
+    this._requestUpdate(true);
+  }
+
+  _resize(width, height) {
+    this._canvas.width = width;
+    this._canvas.height = height;
+  }
+
+  // _setPixelFormat asks for 32 bit little endian pixels laid out like the RGBA of ImageData
+  _setPixelFormat() {
+    const message = new DataView(new ArrayBuffer(20));
+    message.setUint8(0, 0);
+    message.setUint8(4, 32);
+    message.setUint8(5, 24);
+    message.setUint8(6, 0);
+    message.setUint8(7, 1);
+    message.setUint16(8, 255);
+    message.setUint16(10, 255);
+    message.setUint16(12, 255);
+    message.setUint8(14, 0);
+    message.setUint8(15, 8);
+    message.setUint8(16, 16);
+    this._send(new Uint8Array(message.buffer));
+  }
+
+  _setEncodings(encodings) {
+    const message = new DataView(new ArrayBuffer(4 + encodings.length * 4));
+    message.setUint8(0, 2);
+    message.setUint16(2, encodings.length);
+    encodings.forEach((encoding, i) => message.setInt32(4 + i * 4, encoding));
+    this._send(new Uint8Array(message.buffer));
+  }
+
+  _requestUpdate(incremental) {
+    const message = new DataView(new ArrayBuffer(10));
+    message.setUint8(0, 3);
+    message.setUint8(1, incremental ? 1 : 0);
+    message.setUint16(6, this._canvas.width);
+    message.setUint16(8, this._canvas.height);
+    this._send(new Uint8Array(message.buffer));
+  }
+
+  _sendKey(keysym, down) {
+    const message = new DataView(new ArrayBuffer(8));
+    message.setUint8(0, 4);
+    message.setUint8(1, down ? 1 : 0);
+    message.setUint32(4, keysym);
+    this._send(new Uint8Array(message.buffer));
+  }
+
+  _sendPointer(buttons, x, y) {
+    const message = new DataView(new ArrayBuffer(6));
+    message.setUint8(0, 5);
+    message.setUint8(1, buttons);
+    message.setUint16(2, x);
+    message.setUint16(4, y);
+    this._send(new Uint8Array(message.buffer));
+  }
+
+  _addInputListeners() {
+    const canvas = this._canvas;
+    canvas.addEventListener("keydown", (e) => {
+      const keysym = this._keysym(e);
+      if (keysym === null) {
+        return;
+      }
+      e.preventDefault();
+      this._pressedKeys.set(e.code, keysym);
+      this._sendKey(keysym, true);
+    });
+    canvas.addEventListener("keyup", (e) => {
+      // release the key which was pressed, the key of the event may have changed with the modifiers
+      const keysym = this._pressedKeys.has(e.code) ? this._pressedKeys.get(e.code) : this._keysym(e);
+      if (keysym === null) {
+        return;
+      }
+      e.preventDefault();
+      this._pressedKeys.delete(e.code);
+      this._sendKey(keysym, false);
+    });
+    canvas.addEventListener("blur", () => {
+      this._pressedKeys.forEach((keysym) => this._sendKey(keysym, false));
+      this._pressedKeys.clear();
+    });
+
+    const pointer = (e) => {
+      e.preventDefault();
+      const rect = canvas.getBoundingClientRect();
+      const x = Math.floor((e.clientX - rect.left) * canvas.width / rect.width);
+      const y = Math.floor((e.clientY - rect.top) * canvas.height / rect.height);
+      return [Math.min(Math.max(x, 0), canvas.width - 1), Math.min(Math.max(y, 0), canvas.height - 1)];
+    };
+    // the RFB button mask is left, middle, right while the DOM uses left, right, middle
+    const buttonMask = (buttons) => (buttons & 1) | ((buttons & 4) >> 1) | ((buttons & 2) << 1);
+    for (const type of ["mousedown", "mouseup", "mousemove"]) {
+      canvas.addEventListener(type, (e) => {
+        if (type === "mousedown") {
+          canvas.focus();
+        }
+        const [x, y] = pointer(e);
+        this._buttons = buttonMask(e.buttons);
+        this._sendPointer(this._buttons, x, y);
+      });
+    }
+    canvas.addEventListener("wheel", (e) => {
+      const [x, y] = pointer(e);
+      const wheel = e.deltaY < 0 ? 8 : 16;
+      this._sendPointer(this._buttons | wheel, x, y);
+      this._sendPointer(this._buttons, x, y);
+    });
+    canvas.addEventListener("contextmenu", (e) => e.preventDefault());
+  }
+
+  _keysym(e) {
+    if (e.key in modifierKeysyms) {
+      return modifierKeysyms[e.key][e.location === KeyboardEvent.DOM_KEY_LOCATION_RIGHT ? 1 : 0];
+    }
+    if (e.key in keysyms) {
+      return keysyms[e.key];
+    }
+    const codePoint = e.key.codePointAt(0);
+    if (codePoint === undefined || e.key.length !== String.fromCodePoint(codePoint).length) {
+      return null;
+    }
+    // Latin-1 characters are their own keysyms, other Unicode characters are offset by 0x01000000
+    return codePoint < 0x100 ? codePoint : 0x01000000 | codePoint;
+  }
+
+  _send(data) {
+    if (this._ws.readyState === WebSocket.OPEN) {
+      this._ws.send(Uint8Array.from(data));
+    }
+  }
+
+  _readReason() {
+    return this._read(4).then((length) => this._read(this._view(length).getUint32(0))).then((reason) => this._decode(reason));
+  }
+
+  _view(data) {
+    return new DataView(data.buffer, data.byteOffset, data.byteLength);
+  }
+
+  _decode(data) {
+    return new TextDecoder().decode(data);
+  }
+
+  _receive(data) {
+    this._chunks.push(data);
+    this._length += data.length;
+    this._fulfill();
+  }
+
+  // _read resolves with the next n bytes received from the server
+  _read(n) {
+    return new Promise((resolve, reject) => {
+      if (this._closed) {
+        reject(new Error("disconnected"));
+        return;
+      }
+      this._pending = { n, resolve, reject };
+      this._fulfill();
+    });
+  }
+
+  _fulfill() {
+    const pending = this._pending;
+    if (!pending || this._length < pending.n) {
+      return;
+    }
+    this._pending = null;
+
+    const data = new Uint8Array(pending.n);
+    let offset = 0;
+    while (offset < pending.n) {
+      const chunk = this._chunks[0];
+      const count = Math.min(chunk.length, pending.n - offset);
+      data.set(chunk.subarray(0, count), offset);
+      offset += count;
+      if (count === chunk.length) {
+        this._chunks.shift();
+      } else {
+        this._chunks[0] = chunk.subarray(count);
+      }
+    }
+    this._length -= pending.n;
+    pending.resolve(data);
+  }
+
+  _disconnect(clean) {
+    if (this._closed) {
+      return;
+    }
+    this._closed = true;
+    if (this._pending) {
+      this._pending.reject(new Error("disconnected"));
+      this._pending = null;
+    }
+    this.dispatchEvent(new CustomEvent("disconnect", { detail: { clean } }));
+  }
+}
@@ -282,6 +282,11 @@ func (in *CPU) DeepCopyInto(out *CPU) {
 		*out = new(Realtime)
 		**out = **in
 	}
+	if in.ThreadPlacement != nil {
+		in, out := &in.ThreadPlacement, &out.ThreadPlacement
+		*out = new(ThreadPlacement)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -295,6 +300,50 @@ func (in *CPU) DeepCopy() *CPU {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPUBaseline) DeepCopyInto(out *CPUBaseline) {
+	*out = *in
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUBaseline.
+func (in *CPUBaseline) DeepCopy() *CPUBaseline {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUBaseline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPUBaselineNodePool) DeepCopyInto(out *CPUBaselineNodePool) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUBaselineNodePool.
+func (in *CPUBaselineNodePool) DeepCopy() *CPUBaselineNodePool {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUBaselineNodePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CPUFeature) DeepCopyInto(out *CPUFeature) {
 	*out = *in
@@ -394,6 +443,11 @@ func (in *Clock) DeepCopyInto(out *Clock) {
 		*out = new(Timer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GuestTimeSync != nil {
+		in, out := &in.GuestTimeSync, &out.GuestTimeSync
+		*out = new(GuestTimeSync)
+		**out = **in
+	}
 	return
 }
 
@@ -1014,6 +1068,11 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(DiskErrorPolicy)
 		**out = **in
 	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(DiskIOTune)
+		**out = **in
+	}
 	return
 }
 
@@ -1058,6 +1117,48 @@ func (in *DiskDevice) DeepCopy() *DiskDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTune) DeepCopyInto(out *DiskIOTune) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTune.
+func (in *DiskIOTune) DeepCopy() *DiskIOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTuneOptions) DeepCopyInto(out *DiskIOTuneOptions) {
+	*out = *in
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(DiskIOTune)
+		**out = **in
+	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTuneOptions.
+func (in *DiskIOTuneOptions) DeepCopy() *DiskIOTuneOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTuneOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskTarget) DeepCopyInto(out *DiskTarget) {
 	*out = *in
@@ -1234,6 +1335,11 @@ func (in *EFI) DeepCopyInto(out *EFI) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.NVRAMTemplate != nil {
+		in, out := &in.NVRAMTemplate, &out.NVRAMTemplate
+		*out = new(NVRAMTemplateSource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1692,6 +1798,27 @@ func (in *GuestAgentCommandInfo) DeepCopy() *GuestAgentCommandInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestAgentConfiguration) DeepCopyInto(out *GuestAgentConfiguration) {
+	*out = *in
+	if in.CustomCommands != nil {
+		in, out := &in.CustomCommands, &out.CustomCommands
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestAgentConfiguration.
+func (in *GuestAgentConfiguration) DeepCopy() *GuestAgentConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestAgentConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GuestAgentPing) DeepCopyInto(out *GuestAgentPing) {
 	*out = *in
@@ -1708,6 +1835,47 @@ func (in *GuestAgentPing) DeepCopy() *GuestAgentPing {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestTimeSync) DeepCopyInto(out *GuestTimeSync) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestTimeSync.
+func (in *GuestTimeSync) DeepCopy() *GuestTimeSync {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestTimeSync)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestTimeSyncStatus) DeepCopyInto(out *GuestTimeSyncStatus) {
+	*out = *in
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.DriftMilliseconds != nil {
+		in, out := &in.DriftMilliseconds, &out.DriftMilliseconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestTimeSyncStatus.
+func (in *GuestTimeSyncStatus) DeepCopy() *GuestTimeSyncStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestTimeSyncStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HPETTimer) DeepCopyInto(out *HPETTimer) {
 	*out = *in
@@ -1914,6 +2082,22 @@ func (in *Input) DeepCopy() *Input {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InputHostDevice) DeepCopyInto(out *InputHostDevice) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InputHostDevice.
+func (in *InputHostDevice) DeepCopy() *InputHostDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(InputHostDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstancetypeMatcher) DeepCopyInto(out *InstancetypeMatcher) {
 	*out = *in
@@ -1930,6 +2114,55 @@ func (in *InstancetypeMatcher) DeepCopy() *InstancetypeMatcher {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstancetypeUpgrade) DeepCopyInto(out *InstancetypeUpgrade) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Instancetype != nil {
+		in, out := &in.Instancetype, &out.Instancetype
+		*out = new(RevisionUpgrade)
+		**out = **in
+	}
+	if in.Preference != nil {
+		in, out := &in.Preference, &out.Preference
+		*out = new(RevisionUpgrade)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstancetypeUpgrade.
+func (in *InstancetypeUpgrade) DeepCopy() *InstancetypeUpgrade {
+	if in == nil {
+		return nil
+	}
+	out := new(InstancetypeUpgrade)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstancetypeUpgradeOptions) DeepCopyInto(out *InstancetypeUpgradeOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstancetypeUpgradeOptions.
+func (in *InstancetypeUpgradeOptions) DeepCopy() *InstancetypeUpgradeOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(InstancetypeUpgradeOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Interface) DeepCopyInto(out *Interface) {
 	*out = *in
@@ -2408,6 +2641,23 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 		*out = new(LiveUpdateConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GuestAgentConfiguration != nil {
+		in, out := &in.GuestAgentConfiguration, &out.GuestAgentConfiguration
+		*out = new(GuestAgentConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeFailover != nil {
+		in, out := &in.NodeFailover, &out.NodeFailover
+		*out = new(NodeFailoverConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CPUBaselineNodePools != nil {
+		in, out := &in.CPUBaselineNodePools, &out.CPUBaselineNodePools
+		*out = make([]CPUBaselineNodePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2555,6 +2805,18 @@ func (in *KubeVirtStatus) DeepCopyInto(out *KubeVirtStatus) {
 		*out = make([]GenerationStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.WorkloadUpdateStatus != nil {
+		in, out := &in.WorkloadUpdateStatus, &out.WorkloadUpdateStatus
+		*out = new(KubeVirtWorkloadUpdateStatus)
+		**out = **in
+	}
+	if in.CPUBaselines != nil {
+		in, out := &in.CPUBaselines, &out.CPUBaselines
+		*out = make([]CPUBaseline, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2568,6 +2830,22 @@ func (in *KubeVirtStatus) DeepCopy() *KubeVirtStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVirtWorkloadUpdateStatus) DeepCopyInto(out *KubeVirtWorkloadUpdateStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVirtWorkloadUpdateStatus.
+func (in *KubeVirtWorkloadUpdateStatus) DeepCopy() *KubeVirtWorkloadUpdateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVirtWorkloadUpdateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeVirtWorkloadUpdateStrategy) DeepCopyInto(out *KubeVirtWorkloadUpdateStrategy) {
 	*out = *in
@@ -2586,6 +2864,33 @@ func (in *KubeVirtWorkloadUpdateStrategy) DeepCopyInto(out *KubeVirtWorkloadUpda
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]WorkloadUpdateMaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExcludedNamespaces != nil {
+		in, out := &in.ExcludedNamespaces, &out.ExcludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedSelector != nil {
+		in, out := &in.ExcludedSelector, &out.ExcludedSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CanaryPercentage != nil {
+		in, out := &in.CanaryPercentage, &out.CanaryPercentage
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxMigrationFailurePercentage != nil {
+		in, out := &in.MaxMigrationFailurePercentage, &out.MaxMigrationFailurePercentage
+		*out = new(int)
+		**out = **in
+	}
 	return
 }
 
@@ -2915,6 +3220,16 @@ func (in *MigrationConfiguration) DeepCopyInto(out *MigrationConfiguration) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ParallelMigrationStreams != nil {
+		in, out := &in.ParallelMigrationStreams, &out.ParallelMigrationStreams
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(MigrationCompression)
+		**out = **in
+	}
 	return
 }
 
@@ -2981,6 +3296,37 @@ func (in *NUMAGuestMappingPassthrough) DeepCopy() *NUMAGuestMappingPassthrough {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NVRAMTemplateSource) DeepCopyInto(out *NVRAMTemplateSource) {
+	*out = *in
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.PersistentVolumeClaim != nil {
+		in, out := &in.PersistentVolumeClaim, &out.PersistentVolumeClaim
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NVRAMTemplateSource.
+func (in *NVRAMTemplateSource) DeepCopy() *NVRAMTemplateSource {
+	if in == nil {
+		return nil
+	}
+	out := new(NVRAMTemplateSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Network) DeepCopyInto(out *Network) {
 	*out = *in
@@ -3058,18 +3404,120 @@ func (in *NetworkSource) DeepCopy() *NetworkSource {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeMediatedDeviceTypesConfig) DeepCopyInto(out *NodeMediatedDeviceTypesConfig) {
+func (in *NodeEvacuationPlan) DeepCopyInto(out *NodeEvacuationPlan) {
 	*out = *in
-	if in.NodeSelector != nil {
-		in, out := &in.NodeSelector, &out.NodeSelector
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	out.TypeMeta = in.TypeMeta
+	if in.VMIs != nil {
+		in, out := &in.VMIs, &out.VMIs
+		*out = make([]NodeEvacuationPlanEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.MediatedDevicesTypes != nil {
-		in, out := &in.MediatedDevicesTypes, &out.MediatedDevicesTypes
-		*out = make([]string, len(*in))
+	if in.EstimatedDataTransfer != nil {
+		in, out := &in.EstimatedDataTransfer, &out.EstimatedDataTransfer
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(NodeEvacuationPlanStatus)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeEvacuationPlan.
+func (in *NodeEvacuationPlan) DeepCopy() *NodeEvacuationPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeEvacuationPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeEvacuationPlanEntry) DeepCopyInto(out *NodeEvacuationPlanEntry) {
+	*out = *in
+	if in.EvictionStrategy != nil {
+		in, out := &in.EvictionStrategy, &out.EvictionStrategy
+		*out = new(EvictionStrategy)
+		**out = **in
+	}
+	if in.EstimatedDataTransfer != nil {
+		in, out := &in.EstimatedDataTransfer, &out.EstimatedDataTransfer
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeEvacuationPlanEntry.
+func (in *NodeEvacuationPlanEntry) DeepCopy() *NodeEvacuationPlanEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeEvacuationPlanEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeEvacuationPlanStatus) DeepCopyInto(out *NodeEvacuationPlanStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeEvacuationPlanStatus.
+func (in *NodeEvacuationPlanStatus) DeepCopy() *NodeEvacuationPlanStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeEvacuationPlanStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeFailoverConfiguration) DeepCopyInto(out *NodeFailoverConfiguration) {
+	*out = *in
+	if in.NotReadyTimeout != nil {
+		in, out := &in.NotReadyTimeout, &out.NotReadyTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RequireFencing != nil {
+		in, out := &in.RequireFencing, &out.RequireFencing
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeFailoverConfiguration.
+func (in *NodeFailoverConfiguration) DeepCopy() *NodeFailoverConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeFailoverConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeMediatedDeviceTypesConfig) DeepCopyInto(out *NodeMediatedDeviceTypesConfig) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MediatedDevicesTypes != nil {
+		in, out := &in.MediatedDevicesTypes, &out.MediatedDevicesTypes
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 	if in.MediatedDeviceTypes != nil {
@@ -3197,6 +3645,18 @@ func (in *PermittedHostDevices) DeepCopyInto(out *PermittedHostDevices) {
 		*out = make([]MediatedHostDevice, len(*in))
 		copy(*out, *in)
 	}
+	if in.InputDevices != nil {
+		in, out := &in.InputDevices, &out.InputDevices
+		*out = make([]InputHostDevice, len(*in))
+		copy(*out, *in)
+	}
+	if in.USB != nil {
+		in, out := &in.USB, &out.USB
+		*out = make([]USBHostDevice, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -3599,6 +4059,22 @@ func (in *RestartOptions) DeepCopy() *RestartOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RevisionUpgrade) DeepCopyInto(out *RevisionUpgrade) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RevisionUpgrade.
+func (in *RevisionUpgrade) DeepCopy() *RevisionUpgrade {
+	if in == nil {
+		return nil
+	}
+	out := new(RevisionUpgrade)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Rng) DeepCopyInto(out *Rng) {
 	*out = *in
@@ -4091,6 +4567,27 @@ func (in *TPMDevice) DeepCopy() *TPMDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ThreadPlacement) DeepCopyInto(out *ThreadPlacement) {
+	*out = *in
+	if in.ReservedCPUs != nil {
+		in, out := &in.ReservedCPUs, &out.ReservedCPUs
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThreadPlacement.
+func (in *ThreadPlacement) DeepCopy() *ThreadPlacement {
+	if in == nil {
+		return nil
+	}
+	out := new(ThreadPlacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Timer) DeepCopyInto(out *Timer) {
 	*out = *in
@@ -4169,6 +4666,43 @@ func (in *TopologyHints) DeepCopy() *TopologyHints {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *USBHostDevice) DeepCopyInto(out *USBHostDevice) {
+	*out = *in
+	if in.Selectors != nil {
+		in, out := &in.Selectors, &out.Selectors
+		*out = make([]USBSelector, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new USBHostDevice.
+func (in *USBHostDevice) DeepCopy() *USBHostDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(USBHostDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *USBSelector) DeepCopyInto(out *USBSelector) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new USBSelector.
+func (in *USBSelector) DeepCopy() *USBSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(USBSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UnpauseOptions) DeepCopyInto(out *UnpauseOptions) {
 	*out = *in
@@ -4335,6 +4869,32 @@ func (in *VSOCKOptions) DeepCopy() *VSOCKOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VhostUserBlkSource) DeepCopyInto(out *VhostUserBlkSource) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Queues != nil {
+		in, out := &in.Queues, &out.Queues
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VhostUserBlkSource.
+func (in *VhostUserBlkSource) DeepCopy() *VhostUserBlkSource {
+	if in == nil {
+		return nil
+	}
+	out := new(VhostUserBlkSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachine) DeepCopyInto(out *VirtualMachine) {
 	*out = *in
@@ -4381,6 +4941,22 @@ func (in *VirtualMachineCondition) DeepCopy() *VirtualMachineCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineDependency) DeepCopyInto(out *VirtualMachineDependency) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineDependency.
+func (in *VirtualMachineDependency) DeepCopy() *VirtualMachineDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstance) DeepCopyInto(out *VirtualMachineInstance) {
 	*out = *in
@@ -4409,6 +4985,22 @@ func (in *VirtualMachineInstance) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceCPUStats) DeepCopyInto(out *VirtualMachineInstanceCPUStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceCPUStats.
+func (in *VirtualMachineInstanceCPUStats) DeepCopy() *VirtualMachineInstanceCPUStats {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceCPUStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceCondition) DeepCopyInto(out *VirtualMachineInstanceCondition) {
 	*out = *in
@@ -4427,6 +5019,47 @@ func (in *VirtualMachineInstanceCondition) DeepCopy() *VirtualMachineInstanceCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceDiskStats) DeepCopyInto(out *VirtualMachineInstanceDiskStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceDiskStats.
+func (in *VirtualMachineInstanceDiskStats) DeepCopy() *VirtualMachineInstanceDiskStats {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceDiskStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceDomainXML) DeepCopyInto(out *VirtualMachineInstanceDomainXML) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceDomainXML.
+func (in *VirtualMachineInstanceDomainXML) DeepCopy() *VirtualMachineInstanceDomainXML {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceDomainXML)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineInstanceDomainXML) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceFileSystem) DeepCopyInto(out *VirtualMachineInstanceFileSystem) {
 	*out = *in
@@ -4495,6 +5128,61 @@ func (in *VirtualMachineInstanceFileSystemList) DeepCopyObject() runtime.Object
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestAgentCommandResult) DeepCopyInto(out *VirtualMachineInstanceGuestAgentCommandResult) {
+	*out = *in
+	if in.Result != nil {
+		in, out := &in.Result, &out.Result
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestAgentCommandResult.
+func (in *VirtualMachineInstanceGuestAgentCommandResult) DeepCopy() *VirtualMachineInstanceGuestAgentCommandResult {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestAgentCommandResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestAgentCommandResultList) DeepCopyInto(out *VirtualMachineInstanceGuestAgentCommandResultList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineInstanceGuestAgentCommandResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestAgentCommandResultList.
+func (in *VirtualMachineInstanceGuestAgentCommandResultList) DeepCopy() *VirtualMachineInstanceGuestAgentCommandResultList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestAgentCommandResultList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineInstanceGuestAgentCommandResultList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceGuestAgentInfo) DeepCopyInto(out *VirtualMachineInstanceGuestAgentInfo) {
 	*out = *in
@@ -4511,6 +5199,28 @@ func (in *VirtualMachineInstanceGuestAgentInfo) DeepCopyInto(out *VirtualMachine
 		copy(*out, *in)
 	}
 	in.FSInfo.DeepCopyInto(&out.FSInfo)
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]VirtualMachineInstanceGuestOSDisk, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CPUStats != nil {
+		in, out := &in.CPUStats, &out.CPUStats
+		*out = make([]VirtualMachineInstanceGuestOSCPUStats, len(*in))
+		copy(*out, *in)
+	}
+	if in.MemoryBlockInfo != nil {
+		in, out := &in.MemoryBlockInfo, &out.MemoryBlockInfo
+		*out = new(VirtualMachineInstanceGuestOSMemoryBlockInfo)
+		**out = **in
+	}
+	if in.Load != nil {
+		in, out := &in.Load, &out.Load
+		*out = new(VirtualMachineInstanceGuestOSLoad)
+		**out = **in
+	}
 	return
 }
 
@@ -4532,6 +5242,43 @@ func (in *VirtualMachineInstanceGuestAgentInfo) DeepCopyObject() runtime.Object
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestOSCPUStats) DeepCopyInto(out *VirtualMachineInstanceGuestOSCPUStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestOSCPUStats.
+func (in *VirtualMachineInstanceGuestOSCPUStats) DeepCopy() *VirtualMachineInstanceGuestOSCPUStats {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestOSCPUStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestOSDisk) DeepCopyInto(out *VirtualMachineInstanceGuestOSDisk) {
+	*out = *in
+	if in.Dependencies != nil {
+		in, out := &in.Dependencies, &out.Dependencies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestOSDisk.
+func (in *VirtualMachineInstanceGuestOSDisk) DeepCopy() *VirtualMachineInstanceGuestOSDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestOSDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceGuestOSInfo) DeepCopyInto(out *VirtualMachineInstanceGuestOSInfo) {
 	*out = *in
@@ -4548,6 +5295,38 @@ func (in *VirtualMachineInstanceGuestOSInfo) DeepCopy() *VirtualMachineInstanceG
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestOSLoad) DeepCopyInto(out *VirtualMachineInstanceGuestOSLoad) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestOSLoad.
+func (in *VirtualMachineInstanceGuestOSLoad) DeepCopy() *VirtualMachineInstanceGuestOSLoad {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestOSLoad)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestOSMemoryBlockInfo) DeepCopyInto(out *VirtualMachineInstanceGuestOSMemoryBlockInfo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestOSMemoryBlockInfo.
+func (in *VirtualMachineInstanceGuestOSMemoryBlockInfo) DeepCopy() *VirtualMachineInstanceGuestOSMemoryBlockInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestOSMemoryBlockInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceGuestOSUser) DeepCopyInto(out *VirtualMachineInstanceGuestOSUser) {
 	*out = *in
@@ -4595,6 +5374,22 @@ func (in *VirtualMachineInstanceGuestOSUserList) DeepCopyObject() runtime.Object
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceInterfaceStats) DeepCopyInto(out *VirtualMachineInstanceInterfaceStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceInterfaceStats.
+func (in *VirtualMachineInstanceInterfaceStats) DeepCopy() *VirtualMachineInstanceInterfaceStats {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceInterfaceStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceList) DeepCopyInto(out *VirtualMachineInstanceList) {
 	*out = *in
@@ -4628,12 +5423,28 @@ func (in *VirtualMachineInstanceList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceMemoryStats) DeepCopyInto(out *VirtualMachineInstanceMemoryStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceMemoryStats.
+func (in *VirtualMachineInstanceMemoryStats) DeepCopy() *VirtualMachineInstanceMemoryStats {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceMemoryStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceMigration) DeepCopyInto(out *VirtualMachineInstanceMigration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 	return
 }
@@ -4727,6 +5538,11 @@ func (in *VirtualMachineInstanceMigrationPhaseTransitionTimestamp) DeepCopy() *V
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceMigrationSpec) DeepCopyInto(out *VirtualMachineInstanceMigrationSpec) {
 	*out = *in
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(MigrationPriority)
+		**out = **in
+	}
 	return
 }
 
@@ -5193,6 +6009,52 @@ func (in *VirtualMachineInstanceSpec) DeepCopy() *VirtualMachineInstanceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceStats) DeepCopyInto(out *VirtualMachineInstanceStats) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		*out = new(VirtualMachineInstanceCPUStats)
+		**out = **in
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(VirtualMachineInstanceMemoryStats)
+		**out = **in
+	}
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]VirtualMachineInstanceDiskStats, len(*in))
+		copy(*out, *in)
+	}
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]VirtualMachineInstanceInterfaceStats, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceStats.
+func (in *VirtualMachineInstanceStats) DeepCopy() *VirtualMachineInstanceStats {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineInstanceStats) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstanceStatus) {
 	*out = *in
@@ -5262,6 +6124,11 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 		*out = new(CPUTopology)
 		**out = **in
 	}
+	if in.GuestTimeSync != nil {
+		in, out := &in.GuestTimeSync, &out.GuestTimeSync
+		*out = new(GuestTimeSyncStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5376,6 +6243,39 @@ func (in *VirtualMachineOptions) DeepCopy() *VirtualMachineOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePendingChange) DeepCopyInto(out *VirtualMachinePendingChange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePendingChange.
+func (in *VirtualMachinePendingChange) DeepCopy() *VirtualMachinePendingChange {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePendingChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineResizeOptions) DeepCopyInto(out *VirtualMachineResizeOptions) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineResizeOptions.
+func (in *VirtualMachineResizeOptions) DeepCopy() *VirtualMachineResizeOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineResizeOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 	*out = *in
@@ -5416,6 +6316,11 @@ func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 		*out = new(LiveUpdateFeatures)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]VirtualMachineDependency, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -5526,6 +6431,11 @@ func (in *VirtualMachineStatus) DeepCopyInto(out *VirtualMachineStatus) {
 		*out = new(VirtualMachineMemoryDumpRequest)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PendingChanges != nil {
+		in, out := &in.PendingChanges, &out.PendingChanges
+		*out = make([]VirtualMachinePendingChange, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -5676,6 +6586,11 @@ func (in *VolumeSource) DeepCopyInto(out *VolumeSource) {
 		*out = new(MemoryDumpVolumeSource)
 		**out = **in
 	}
+	if in.VhostUserBlk != nil {
+		in, out := &in.VhostUserBlk, &out.VhostUserBlk
+		*out = new(VhostUserBlkSource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5757,3 +6672,25 @@ func (in *WatchdogDevice) DeepCopy() *WatchdogDevice {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkloadUpdateMaintenanceWindow) DeepCopyInto(out *WorkloadUpdateMaintenanceWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]WeekDay, len(*in))
+		copy(*out, *in)
+	}
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkloadUpdateMaintenanceWindow.
+func (in *WorkloadUpdateMaintenanceWindow) DeepCopy() *WorkloadUpdateMaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkloadUpdateMaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
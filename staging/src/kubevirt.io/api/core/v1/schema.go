@@ -34,6 +34,7 @@ const (
 	IOThreadsPolicyAuto    IOThreadsPolicy = "auto"
 	CPUModeHostPassthrough                 = "host-passthrough"
 	CPUModeHostModel                       = "host-model"
+	CPUModeClusterBaseline                 = "cluster-baseline"
 	DefaultCPUModel                        = CPUModeHostModel
 )
 
@@ -119,6 +120,28 @@ type ServiceAccountVolumeSource struct {
 type DownwardMetricsVolumeSource struct {
 }
 
+// VhostUserBlkSource represents a disk backed by a vhost-user-blk socket.
+type VhostUserBlkSource struct {
+	// Image of the storage plugin sidecar which serves the vhost-user-blk socket.
+	// The sidecar receives the socket path in the VHOST_USER_BLK_SOCKET environment variable.
+	// If empty, the socket is expected to be provided by a node agent in the
+	// /var/run/kubevirt-vhost-user-blk/<namespace>/<vmi name> host directory.
+	// +optional
+	Image string `json:"image,omitempty"`
+	// Arguments passed to the storage plugin sidecar.
+	// +optional
+	// +listType=atomic
+	Args []string `json:"args,omitempty"`
+	// SocketName is the file name of the vhost-user-blk socket, it must not contain a path.
+	// Defaults to "<volume name>.sock" for sidecars and is required for node agents.
+	// +optional
+	SocketName string `json:"socketName,omitempty"`
+	// Queues is the number of virtqueues of the disk.
+	// Defaults to the number of queues given by blockMultiQueue.
+	// +optional
+	Queues *uint32 `json:"queues,omitempty"`
+}
+
 // Represents a Sysprep volume source.
 type SysprepSource struct {
 	// Secret references a k8s Secret that contains Sysprep answer file named autounattend.xml that should be attached as disk of CDROM type.
@@ -250,6 +273,30 @@ type EFI struct {
 	// Defaults to false
 	// +optional
 	Persistent *bool `json:"persistent,omitempty"`
+	// NVRAMTemplate references a custom UEFI variable store which is used to
+	// initialize the NVRAM instead of the default OVMF vars file, e.g. to boot
+	// with custom enrolled Secure Boot keys (PK, KEK, db).
+	// If the NVRAM is persistent, the template is only used when no NVRAM was persisted yet.
+	// +optional
+	NVRAMTemplate *NVRAMTemplateSource `json:"nvramTemplate,omitempty"`
+}
+
+// NVRAMTemplateSource represents the source of a custom UEFI variable store.
+// Exactly one of Secret, ConfigMap or PersistentVolumeClaim must be set.
+type NVRAMTemplateSource struct {
+	// Secret references a k8s Secret that contains the variable store.
+	// +optional
+	Secret *v1.LocalObjectReference `json:"secret,omitempty"`
+	// ConfigMap references a ConfigMap that contains the variable store as binary data.
+	// +optional
+	ConfigMap *v1.LocalObjectReference `json:"configMap,omitempty"`
+	// PersistentVolumeClaim references a filesystem PVC that contains the variable store.
+	// +optional
+	PersistentVolumeClaim *v1.LocalObjectReference `json:"persistentVolumeClaim,omitempty"`
+	// Key is the name of the variable store file in the referenced source.
+	// Defaults to OVMF_VARS.fd
+	// +optional
+	Key string `json:"key,omitempty"`
 }
 
 // If set, the VM will be booted from the defined kernel / initrd.
@@ -316,6 +363,8 @@ type CPU struct {
 	// List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
 	// It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
 	// and "host-model" to get CPU closest to the node one.
+	// "cluster-baseline" is replaced with the most recent CPU model and the CPU features
+	// supported by all nodes when the VMI is created, keeping it migratable across the cluster.
 	// Defaults to host-model.
 	// +optional
 	Model string `json:"model,omitempty"`
@@ -338,6 +387,31 @@ type CPU struct {
 	// Realtime instructs the virt-launcher to tune the VMI for lower latency, optional for real time workloads
 	// +optional
 	Realtime *Realtime `json:"realtime,omitempty"`
+	// ThreadPlacement requests additional dedicated pCPUs to be allocated for the VMI and places
+	// the emulator, the I/O threads and the vhost-net threads on them, away from the vCPUs.
+	// Requires DedicatedCPUPlacement and cannot be combined with IsolateEmulatorThread.
+	// +optional
+	ThreadPlacement *ThreadPlacement `json:"threadPlacement,omitempty"`
+}
+
+// ThreadPlacement configures the reserved pCPUs of the VMI's non-vCPU threads.
+type ThreadPlacement struct {
+	// ReservedCPUs is the number of dedicated pCPUs allocated in addition to the vCPUs.
+	// The emulator, the I/O threads and the vhost-net threads are pinned to them by virt-handler
+	// once the domain is started. Defaults to 1.
+	// +optional
+	ReservedCPUs *uint32 `json:"reservedCPUs,omitempty"`
+}
+
+// GetReservedCPUs returns the number of reserved pCPUs, applying the default if unset
+func (t *ThreadPlacement) GetReservedCPUs() uint32 {
+	if t == nil {
+		return 0
+	}
+	if t.ReservedCPUs == nil {
+		return 1
+	}
+	return *t.ReservedCPUs
 }
 
 // Realtime holds the tuning knobs specific for realtime workloads.
@@ -501,7 +575,7 @@ const (
 type SoundDevice struct {
 	// User's defined name for this sound device
 	Name string `json:"name"`
-	// We only support ich9 or ac97.
+	// We only support ich9, ac97 or virtio.
 	// If SoundDevice is not set: No sound card is emulated.
 	// If SoundDevice is set but Model is not: ich9
 	// +optional
@@ -524,8 +598,9 @@ const (
 type InputType string
 
 const (
-	InputTypeTablet   InputType = "tablet"
-	InputTypeKeyboard InputType = "keyboard"
+	InputTypeTablet      InputType = "tablet"
+	InputTypeKeyboard    InputType = "keyboard"
+	InputTypePassthrough InputType = "passthrough"
 )
 
 type Input struct {
@@ -533,10 +608,15 @@ type Input struct {
 	// Supported values: virtio, usb.
 	Bus InputBus `json:"bus,omitempty"`
 	// Type indicated the type of input device.
-	// Supported values: tablet.
+	// Supported values: tablet, passthrough.
 	Type InputType `json:"type"`
 	// Name is the device name
 	Name string `json:"name"`
+	// DeviceName is the resource name of the permitted host input device
+	// which is passed through to the guest. Required for the passthrough type,
+	// which only supports the virtio bus.
+	// +optional
+	DeviceName string `json:"deviceName,omitempty"`
 }
 
 type Filesystem struct {
@@ -622,6 +702,56 @@ type Disk struct {
 	// If specified, it can change the default error policy (stop) for the disk
 	// +optional
 	ErrorPolicy *DiskErrorPolicy `json:"errorPolicy,omitempty"`
+	// If specified, the I/O of the disk is throttled to the given limits.
+	// The limits can be changed on a running VMI through the iotune subresource.
+	// +optional
+	IOTune *DiskIOTune `json:"ioTune,omitempty"`
+}
+
+// DiskIOTune limits the bandwidth and the I/O operations per second of a disk.
+// Total limits can't be combined with read or write limits of the same kind.
+// Unset or zero limits are unlimited.
+type DiskIOTune struct {
+	// TotalBytesSec limits the total throughput of the disk in bytes per second.
+	// +optional
+	TotalBytesSec uint64 `json:"totalBytesSec,omitempty"`
+	// ReadBytesSec limits the read throughput of the disk in bytes per second.
+	// +optional
+	ReadBytesSec uint64 `json:"readBytesSec,omitempty"`
+	// WriteBytesSec limits the write throughput of the disk in bytes per second.
+	// +optional
+	WriteBytesSec uint64 `json:"writeBytesSec,omitempty"`
+	// TotalIOPSSec limits the total I/O operations per second of the disk.
+	// +optional
+	TotalIOPSSec uint64 `json:"totalIOPSSec,omitempty"`
+	// ReadIOPSSec limits the read I/O operations per second of the disk.
+	// +optional
+	ReadIOPSSec uint64 `json:"readIOPSSec,omitempty"`
+	// WriteIOPSSec limits the write I/O operations per second of the disk.
+	// +optional
+	WriteIOPSSec uint64 `json:"writeIOPSSec,omitempty"`
+	// TotalBytesSecMax is the total throughput in bytes per second the disk can burst to.
+	// +optional
+	TotalBytesSecMax uint64 `json:"totalBytesSecMax,omitempty"`
+	// ReadBytesSecMax is the read throughput in bytes per second the disk can burst to.
+	// +optional
+	ReadBytesSecMax uint64 `json:"readBytesSecMax,omitempty"`
+	// WriteBytesSecMax is the write throughput in bytes per second the disk can burst to.
+	// +optional
+	WriteBytesSecMax uint64 `json:"writeBytesSecMax,omitempty"`
+	// TotalIOPSSecMax is the total I/O operations per second the disk can burst to.
+	// +optional
+	TotalIOPSSecMax uint64 `json:"totalIOPSSecMax,omitempty"`
+	// ReadIOPSSecMax is the read I/O operations per second the disk can burst to.
+	// +optional
+	ReadIOPSSecMax uint64 `json:"readIOPSSecMax,omitempty"`
+	// WriteIOPSSecMax is the write I/O operations per second the disk can burst to.
+	// +optional
+	WriteIOPSSecMax uint64 `json:"writeIOPSSecMax,omitempty"`
+	// MaxLengthSeconds is how long in seconds the disk can burst to the maximum limits.
+	// Defaults to 1 second.
+	// +optional
+	MaxLengthSeconds uint64 `json:"maxLengthSeconds,omitempty"`
 }
 
 // CustomBlockSize represents the desired logical and physical block size for a VM disk.
@@ -803,6 +933,10 @@ type VolumeSource struct {
 	DownwardMetrics *DownwardMetricsVolumeSource `json:"downwardMetrics,omitempty"`
 	// MemoryDump is attached to the virt launcher and is populated with a memory dump of the vmi
 	MemoryDump *MemoryDumpVolumeSource `json:"memoryDump,omitempty"`
+	// VhostUserBlk connects the disk to a vhost-user-blk backend, e.g. an SPDK target, which is
+	// served over a unix socket by a storage plugin sidecar or by a node agent.
+	// +optional
+	VhostUserBlk *VhostUserBlkSource `json:"vhostUserBlk,omitempty"`
 }
 
 // HotplugVolumeSource Represents the source of a volume to mount which are capable
@@ -906,6 +1040,27 @@ type Clock struct {
 	// Timer specifies whih timers are attached to the vmi.
 	// +optional
 	Timer *Timer `json:"timer,omitempty"`
+	// GuestTimeSync configures the resynchronization of the guest clock through the guest agent
+	// after the guest was not running for a while: after unpause, live migration and filesystem thaw.
+	// +optional
+	GuestTimeSync *GuestTimeSync `json:"guestTimeSync,omitempty"`
+}
+
+type GuestTimeSyncPolicy string
+
+const (
+	// GuestTimeSyncAuto resynchronizes the guest clock after unpause, live migration and filesystem thaw
+	GuestTimeSyncAuto GuestTimeSyncPolicy = "Auto"
+	// GuestTimeSyncDisabled never resynchronizes the guest clock
+	GuestTimeSyncDisabled GuestTimeSyncPolicy = "Disabled"
+)
+
+// GuestTimeSync configures the resynchronization of the guest clock.
+type GuestTimeSync struct {
+	// Policy defines when the guest clock is resynchronized.
+	// One of: Auto, Disabled. Defaults to Auto.
+	// +optional
+	Policy GuestTimeSyncPolicy `json:"policy,omitempty"`
 }
 
 // Represents all available timers in a vmi.
@@ -50,6 +50,16 @@ func (DownwardMetricsVolumeSource) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VhostUserBlkSource) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "VhostUserBlkSource represents a disk backed by a vhost-user-blk socket.",
+		"image":      "Image of the storage plugin sidecar which serves the vhost-user-blk socket.\nThe sidecar receives the socket path in the VHOST_USER_BLK_SOCKET environment variable.\nIf empty, the socket is expected to be provided by a node agent in the\n/var/run/kubevirt-vhost-user-blk/<namespace>/<vmi name> host directory.\n+optional",
+		"args":       "Arguments passed to the storage plugin sidecar.\n+optional\n+listType=atomic",
+		"socketName": "SocketName is the file name of the vhost-user-blk socket, it must not contain a path.\nDefaults to \"<volume name>.sock\" for sidecars and is required for node agents.\n+optional",
+		"queues":     "Queues is the number of virtqueues of the disk.\nDefaults to the number of queues given by blockMultiQueue.\n+optional",
+	}
+}
+
 func (SysprepSource) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":          "Represents a Sysprep volume source.",
@@ -121,9 +131,20 @@ func (BIOS) SwaggerDoc() map[string]string {
 
 func (EFI) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":           "If set, EFI will be used instead of BIOS.",
-		"secureBoot": "If set, SecureBoot will be enabled and the OVMF roms will be swapped for\nSecureBoot-enabled ones.\nRequires SMM to be enabled.\nDefaults to true\n+optional",
-		"persistent": "If set to true, Persistent will persist the EFI NVRAM across reboots.\nDefaults to false\n+optional",
+		"":              "If set, EFI will be used instead of BIOS.",
+		"secureBoot":    "If set, SecureBoot will be enabled and the OVMF roms will be swapped for\nSecureBoot-enabled ones.\nRequires SMM to be enabled.\nDefaults to true\n+optional",
+		"persistent":    "If set to true, Persistent will persist the EFI NVRAM across reboots.\nDefaults to false\n+optional",
+		"nvramTemplate": "NVRAMTemplate references a custom UEFI variable store which is used to\ninitialize the NVRAM instead of the default OVMF vars file, e.g. to boot\nwith custom enrolled Secure Boot keys (PK, KEK, db).\nIf the NVRAM is persistent, the template is only used when no NVRAM was persisted yet.\n+optional",
+	}
+}
+
+func (NVRAMTemplateSource) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                      "NVRAMTemplateSource represents the source of a custom UEFI variable store.\nExactly one of Secret, ConfigMap or PersistentVolumeClaim must be set.",
+		"secret":                "Secret references a k8s Secret that contains the variable store.\n+optional",
+		"configMap":             "ConfigMap references a ConfigMap that contains the variable store as binary data.\n+optional",
+		"persistentVolumeClaim": "PersistentVolumeClaim references a filesystem PVC that contains the variable store.\n+optional",
+		"key":                   "Key is the name of the variable store file in the referenced source.\nDefaults to OVMF_VARS.fd\n+optional",
 	}
 }
 
@@ -161,12 +182,20 @@ func (CPU) SwaggerDoc() map[string]string {
 		"sockets":               "Sockets specifies the number of sockets inside the vmi.\nMust be a value greater or equal 1.",
 		"maxSockets":            "MaxSockets specifies the maximum amount of sockets that can\nbe hotplugged",
 		"threads":               "Threads specifies the number of threads inside the vmi.\nMust be a value greater or equal 1.",
-		"model":                 "Model specifies the CPU model inside the VMI.\nList of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.\nIt is possible to specify special cases like \"host-passthrough\" to get the same CPU as the node\nand \"host-model\" to get CPU closest to the node one.\nDefaults to host-model.\n+optional",
+		"model":                 "Model specifies the CPU model inside the VMI.\nList of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.\nIt is possible to specify special cases like \"host-passthrough\" to get the same CPU as the node\nand \"host-model\" to get CPU closest to the node one.\n\"cluster-baseline\" is replaced with the most recent CPU model and the CPU features\nsupported by all nodes when the VMI is created, keeping it migratable across the cluster.\nDefaults to host-model.\n+optional",
 		"features":              "Features specifies the CPU features list inside the VMI.\n+optional",
 		"dedicatedCpuPlacement": "DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node\nwith enough dedicated pCPUs and pin the vCPUs to it.\n+optional",
 		"numa":                  "NUMA allows specifying settings for the guest NUMA topology\n+optional",
 		"isolateEmulatorThread": "IsolateEmulatorThread requests one more dedicated pCPU to be allocated for the VMI to place\nthe emulator thread on it.\n+optional",
 		"realtime":              "Realtime instructs the virt-launcher to tune the VMI for lower latency, optional for real time workloads\n+optional",
+		"threadPlacement":       "ThreadPlacement requests additional dedicated pCPUs to be allocated for the VMI and places\nthe emulator, the I/O threads and the vhost-net threads on them, away from the vCPUs.\nRequires DedicatedCPUPlacement and cannot be combined with IsolateEmulatorThread.\n+optional",
+	}
+}
+
+func (ThreadPlacement) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "ThreadPlacement configures the reserved pCPUs of the VMI's non-vCPU threads.",
+		"reservedCPUs": "ReservedCPUs is the number of dedicated pCPUs allocated in addition to the vCPUs.\nThe emulator, the I/O threads and the vhost-net threads are pinned to them by virt-handler\nonce the domain is started. Defaults to 1.\n+optional",
 	}
 }
 
@@ -263,7 +292,7 @@ func (SoundDevice) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":      "Represents the user's configuration to emulate sound cards in the VMI.",
 		"name":  "User's defined name for this sound device",
-		"model": "We only support ich9 or ac97.\nIf SoundDevice is not set: No sound card is emulated.\nIf SoundDevice is set but Model is not: ich9\n+optional",
+		"model": "We only support ich9, ac97 or virtio.\nIf SoundDevice is not set: No sound card is emulated.\nIf SoundDevice is set but Model is not: ich9\n+optional",
 	}
 }
 
@@ -275,9 +304,10 @@ func (TPMDevice) SwaggerDoc() map[string]string {
 
 func (Input) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"bus":  "Bus indicates the bus of input device to emulate.\nSupported values: virtio, usb.",
-		"type": "Type indicated the type of input device.\nSupported values: tablet.",
-		"name": "Name is the device name",
+		"bus":        "Bus indicates the bus of input device to emulate.\nSupported values: virtio, usb.",
+		"type":       "Type indicated the type of input device.\nSupported values: tablet, passthrough.",
+		"name":       "Name is the device name",
+		"deviceName": "DeviceName is the resource name of the permitted host input device\nwhich is passed through to the guest. Required for the passthrough type,\nwhich only supports the virtio bus.\n+optional",
 	}
 }
 
@@ -329,6 +359,26 @@ func (Disk) SwaggerDoc() map[string]string {
 		"blockSize":         "If specified, the virtual disk will be presented with the given block sizes.\n+optional",
 		"shareable":         "If specified the disk is made sharable and multiple write from different VMs are permitted\n+optional",
 		"errorPolicy":       "If specified, it can change the default error policy (stop) for the disk\n+optional",
+		"ioTune":            "If specified, the I/O of the disk is throttled to the given limits.\nThe limits can be changed on a running VMI through the iotune subresource.\n+optional",
+	}
+}
+
+func (DiskIOTune) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "DiskIOTune limits the bandwidth and the I/O operations per second of a disk.\nTotal limits can't be combined with read or write limits of the same kind.\nUnset or zero limits are unlimited.",
+		"totalBytesSec":    "TotalBytesSec limits the total throughput of the disk in bytes per second.\n+optional",
+		"readBytesSec":     "ReadBytesSec limits the read throughput of the disk in bytes per second.\n+optional",
+		"writeBytesSec":    "WriteBytesSec limits the write throughput of the disk in bytes per second.\n+optional",
+		"totalIOPSSec":     "TotalIOPSSec limits the total I/O operations per second of the disk.\n+optional",
+		"readIOPSSec":      "ReadIOPSSec limits the read I/O operations per second of the disk.\n+optional",
+		"writeIOPSSec":     "WriteIOPSSec limits the write I/O operations per second of the disk.\n+optional",
+		"totalBytesSecMax": "TotalBytesSecMax is the total throughput in bytes per second the disk can burst to.\n+optional",
+		"readBytesSecMax":  "ReadBytesSecMax is the read throughput in bytes per second the disk can burst to.\n+optional",
+		"writeBytesSecMax": "WriteBytesSecMax is the write throughput in bytes per second the disk can burst to.\n+optional",
+		"totalIOPSSecMax":  "TotalIOPSSecMax is the total I/O operations per second the disk can burst to.\n+optional",
+		"readIOPSSecMax":   "ReadIOPSSecMax is the read I/O operations per second the disk can burst to.\n+optional",
+		"writeIOPSSecMax":  "WriteIOPSSecMax is the write I/O operations per second the disk can burst to.\n+optional",
+		"maxLengthSeconds": "MaxLengthSeconds is how long in seconds the disk can burst to the maximum limits.\nDefaults to 1 second.\n+optional",
 	}
 }
 
@@ -427,6 +477,7 @@ func (VolumeSource) SwaggerDoc() map[string]string {
 		"serviceAccount":        "ServiceAccountVolumeSource represents a reference to a service account.\nThere can only be one volume of this type!\nMore info: https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/\n+optional",
 		"downwardMetrics":       "DownwardMetrics adds a very small disk to VMIs which contains a limited view of host and guest\nmetrics. The disk content is compatible with vhostmd (https://github.com/vhostmd/vhostmd) and vm-dump-metrics.",
 		"memoryDump":            "MemoryDump is attached to the virt launcher and is populated with a memory dump of the vmi",
+		"vhostUserBlk":          "VhostUserBlk connects the disk to a vhost-user-blk backend, e.g. an SPDK target, which is\nserved over a unix socket by a storage plugin sidecar or by a node agent.\n+optional",
 	}
 }
 
@@ -496,8 +547,16 @@ func (ClockOffsetUTC) SwaggerDoc() map[string]string {
 
 func (Clock) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":      "Represents the clock and timers of a vmi.\n+kubebuilder:pruning:PreserveUnknownFields",
-		"timer": "Timer specifies whih timers are attached to the vmi.\n+optional",
+		"":              "Represents the clock and timers of a vmi.\n+kubebuilder:pruning:PreserveUnknownFields",
+		"timer":         "Timer specifies whih timers are attached to the vmi.\n+optional",
+		"guestTimeSync": "GuestTimeSync configures the resynchronization of the guest clock through the guest agent\nafter the guest was not running for a while: after unpause, live migration and filesystem thaw.\n+optional",
+	}
+}
+
+func (GuestTimeSync) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "GuestTimeSync configures the resynchronization of the guest clock.",
+		"policy": "Policy defines when the guest clock is resynchronized.\nOne of: Auto, Disabled. Defaults to Auto.\n+optional",
 	}
 }
 
@@ -30,6 +30,7 @@ import (
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -280,6 +281,36 @@ type VirtualMachineInstanceStatus struct {
 	// Current topology may differ from the desired topology in the spec while CPU hotplug
 	// takes place.
 	CurrentCPUTopology *CPUTopology `json:"currentCPUTopology,omitempty"`
+
+	// GuestTimeSync reports the last resynchronization of the guest clock through the guest agent
+	// +optional
+	GuestTimeSync *GuestTimeSyncStatus `json:"guestTimeSync,omitempty"`
+}
+
+// GuestTimeSyncReason is the event which triggered a guest clock resynchronization
+type GuestTimeSyncReason string
+
+const (
+	// GuestTimeSyncReasonUnpause is used when the guest clock was resynchronized after the VMI was unpaused
+	GuestTimeSyncReasonUnpause GuestTimeSyncReason = "Unpause"
+	// GuestTimeSyncReasonMigration is used when the guest clock was resynchronized after a live migration
+	GuestTimeSyncReasonMigration GuestTimeSyncReason = "Migration"
+	// GuestTimeSyncReasonThaw is used when the guest clock was resynchronized after the guest filesystems were thawed
+	GuestTimeSyncReasonThaw GuestTimeSyncReason = "Thaw"
+)
+
+// GuestTimeSyncStatus reports the last guest clock resynchronization
+type GuestTimeSyncStatus struct {
+	// LastSyncTime is the time of the last successful resynchronization
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+	// Reason is the event which triggered the last resynchronization
+	// +optional
+	Reason GuestTimeSyncReason `json:"reason,omitempty"`
+	// DriftMilliseconds is the difference between the host and the guest clock measured
+	// right before the last resynchronization. A positive value means the guest clock was behind.
+	// +optional
+	DriftMilliseconds *int64 `json:"driftMilliseconds,omitempty"`
 }
 
 // PersistentVolumeClaimInfo contains the relavant information virt-handler needs cached about a PVC
@@ -507,8 +538,12 @@ const (
 	VirtualMachineInstanceReasonCPUModeNotMigratable = "CPUModeLiveMigratable"
 	// Reason means that VMI is not live migratable because it uses virtiofs
 	VirtualMachineInstanceReasonVirtIOFSNotMigratable = "VirtIOFSNotLiveMigratable"
+	// Reason means that VMI is not live migratable because it uses vhost-user-blk disks
+	VirtualMachineInstanceReasonVhostUserBlkNotMigratable = "VhostUserBlkNotLiveMigratable"
 	// Reason means that VMI is not live migratable because it uses PCI host devices
 	VirtualMachineInstanceReasonHostDeviceNotMigratable = "HostDeviceNotLiveMigratable"
+	// Reason means that VMI is not live migratable because it passes through host input devices
+	VirtualMachineInstanceReasonInputPassthroughNotMigratable = "InputPassthroughNotLiveMigratable"
 	// Reason means that VMI is not live migratable because it uses Secure Encrypted Virtualization (SEV)
 	VirtualMachineInstanceReasonSEVNotMigratable = "SEVNotLiveMigratable"
 	// Reason means that VMI is not live migratable because it uses HyperV Reenlightenment while TSC Frequency is not available
@@ -540,6 +575,19 @@ const (
 	// VirtualMachineInstanceMigrationAbortRequested indicates that live migration abort has been requested
 	VirtualMachineInstanceMigrationAbortRequested          VirtualMachineInstanceMigrationConditionType = "migrationAbortRequested"
 	VirtualMachineInstanceMigrationRejectedByResourceQuota VirtualMachineInstanceMigrationConditionType = "migrationRejectedByResourceQuota"
+	// VirtualMachineInstanceMigrationQueued indicates that the migration waits for other migrations before it can be started
+	VirtualMachineInstanceMigrationQueued VirtualMachineInstanceMigrationConditionType = "migrationQueued"
+)
+
+// These are the reasons of the migrationQueued condition
+const (
+	// MigrationQueuedClusterLimitReason is set when the cluster wide parallel migrations limit is reached
+	MigrationQueuedClusterLimitReason = "ParallelMigrationsPerClusterReached"
+	// MigrationQueuedNodeLimitReason is set when the outbound migrations limit of the source node is reached
+	MigrationQueuedNodeLimitReason = "ParallelOutboundMigrationsPerNodeReached"
+	// MigrationQueuedBehindOtherMigrationsReason is set when migrations with a higher priority or from
+	// namespaces with less running migrations are started first
+	MigrationQueuedBehindOtherMigrationsReason = "QueuedBehindOtherMigrations"
 )
 
 type VirtualMachineInstanceCondition struct {
@@ -1226,8 +1274,24 @@ type VirtualMachineInstanceMigrationList struct {
 type VirtualMachineInstanceMigrationSpec struct {
 	// The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace
 	VMIName string `json:"vmiName,omitempty" valid:"required"`
+	// Priority defines the order in which pending migrations are started once the cluster or
+	// node migration limits are reached. Defaults to High for evacuations, Low for workload
+	// updates and Normal for everything else. High can only be requested by users allowed to
+	// migrate in all namespaces
+	// +kubebuilder:validation:Enum=High;Normal;Low
+	// +optional
+	Priority *MigrationPriority `json:"priority,omitempty"`
 }
 
+// MigrationPriority defines the order in which pending migrations are started
+type MigrationPriority string
+
+const (
+	MigrationPriorityHigh   MigrationPriority = "High"
+	MigrationPriorityNormal MigrationPriority = "Normal"
+	MigrationPriorityLow    MigrationPriority = "Low"
+)
+
 // VirtualMachineInstanceMigrationPhaseTransitionTimestamp gives a timestamp in relation to when a phase is set on a vmi
 type VirtualMachineInstanceMigrationPhaseTransitionTimestamp struct {
 	// Phase is the status of the VirtualMachineInstanceMigrationPhase in kubernetes world. It is not the VirtualMachineInstanceMigrationPhase status, but partially correlates to it.
@@ -1414,6 +1478,31 @@ type VirtualMachineSpec struct {
 
 	// LiveUpdateFeatures references a configuration of hotpluggable resources
 	LiveUpdateFeatures *LiveUpdateFeatures `json:"liveUpdateFeatures,omitempty" optional:"true"`
+
+	// DependsOn lists the VirtualMachines of the same namespace which have to be running before this
+	// VirtualMachine is started. When stopped together, dependents are stopped before their dependencies.
+	// +listType=atomic
+	// +optional
+	DependsOn []VirtualMachineDependency `json:"dependsOn,omitempty" optional:"true"`
+}
+
+// VirtualMachineDependencyCondition is the state a dependency has to reach before its dependents are started
+type VirtualMachineDependencyCondition string
+
+const (
+	// DependencyReady waits for the VirtualMachineInstance of the dependency to be ready
+	DependencyReady VirtualMachineDependencyCondition = "Ready"
+	// DependencyAgentConnected waits for the guest agent of the dependency to be connected
+	DependencyAgentConnected VirtualMachineDependencyCondition = "AgentConnected"
+)
+
+// VirtualMachineDependency references a VirtualMachine which has to be running before its dependent is started
+type VirtualMachineDependency struct {
+	// Name of the VirtualMachine in the same namespace
+	Name string `json:"name"`
+	// Condition the VirtualMachineInstance of the dependency has to reach, Ready if not set
+	// +optional
+	Condition VirtualMachineDependencyCondition `json:"condition,omitempty"`
 }
 
 // StateChangeRequestType represents the existing state change requests that are possible
@@ -1471,6 +1560,9 @@ const (
 	// VirtualMachineStatusWaitingForVolumeBinding indicates that some PersistentVolumeClaims backing
 	// the virtual machine volume are still not bound.
 	VirtualMachineStatusWaitingForVolumeBinding VirtualMachinePrintableStatus = "WaitingForVolumeBinding"
+	// VirtualMachineStatusWaitingForDependencies indicates that the virtual machine waits for the virtual machines
+	// it depends on before it is started.
+	VirtualMachineStatusWaitingForDependencies VirtualMachinePrintableStatus = "WaitingForDependencies"
 )
 
 // VirtualMachineStartFailure tracks VMIs which failed to transition successfully
@@ -1531,6 +1623,27 @@ type VirtualMachineStatus struct {
 	// updated through an Update() before ObservedGeneration in Status.
 	// +optional
 	DesiredGeneration int64 `json:"desiredGeneration,omitempty" optional:"true"`
+
+	// PendingChanges lists the changes of the VM template or of its instancetype
+	// which are not applied to the running VMI yet.
+	// +listType=atomic
+	// +optional
+	PendingChanges []VirtualMachinePendingChange `json:"pendingChanges,omitempty" optional:"true"`
+}
+
+// VirtualMachinePendingChange is a difference between the desired spec of a VM and its running VMI
+type VirtualMachinePendingChange struct {
+	// Path of the changed field in the VMI spec, e.g. spec.domain.cpu.sockets.
+	// Entries of named lists are addressed by their name, e.g. spec.volumes[name=data].
+	Path string `json:"path"`
+	// Current is the JSON encoded value of the field on the running VMI, empty if the field is not set
+	// +optional
+	Current string `json:"current,omitempty"`
+	// Desired is the JSON encoded value of the field in the VM, empty if the field was removed
+	// +optional
+	Desired string `json:"desired,omitempty"`
+	// LiveUpdatable indicates that the change is applied to the running VMI without a restart
+	LiveUpdatable bool `json:"liveUpdatable"`
 }
 
 type VolumeSnapshotStatus struct {
@@ -1586,6 +1699,14 @@ const (
 	// VirtualMachinePaused is added in a virtual machine when its vmi
 	// signals with its own condition that it is paused.
 	VirtualMachinePaused VirtualMachineConditionType = "Paused"
+
+	// VirtualMachineRestartRequired is added in a virtual machine when its template or instancetype
+	// changed in a way that can not be applied to the running vmi.
+	VirtualMachineRestartRequired VirtualMachineConditionType = "RestartRequired"
+
+	// VirtualMachineDependenciesPending is added in a virtual machine while its start waits for the virtual machines
+	// it depends on, or its stop waits for the virtual machines depending on it.
+	VirtualMachineDependenciesPending VirtualMachineConditionType = "DependenciesPending"
 )
 
 type HostDiskType string
@@ -1779,6 +1900,95 @@ type KubeVirtWorkloadUpdateStrategy struct {
 	//
 	// +optional
 	BatchEvictionInterval *metav1.Duration `json:"batchEvictionInterval,omitempty"`
+
+	// MaintenanceWindows restricts automated workload updates to the given windows.
+	// Outside of all windows no new migrations or evictions are issued.
+	//
+	// An empty list allows workload updates at any time
+	//
+	// +listType=atomic
+	// +optional
+	MaintenanceWindows []WorkloadUpdateMaintenanceWindow `json:"maintenanceWindows,omitempty"`
+
+	// ExcludedNamespaces lists namespaces whose VMIs are never updated automatically
+	//
+	// +listType=set
+	// +optional
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+
+	// ExcludedSelector selects VMIs by their labels which are never updated automatically
+	//
+	// +optional
+	ExcludedSelector *metav1.LabelSelector `json:"excludedSelector,omitempty"`
+
+	// CanaryPercentage is the percentage of the outdated VMIs which are updated first.
+	// The rollout only continues to the remaining VMIs once all canaries were updated successfully.
+	//
+	// Defaults to 0, which disables the canary phase
+	//
+	// +optional
+	CanaryPercentage *int `json:"canaryPercentage,omitempty"`
+
+	// MaxMigrationFailurePercentage pauses the rollout once the percentage of failed
+	// workload update migrations exceeds it. The rollout stays paused until the threshold
+	// is raised or a new rollout starts.
+	//
+	// +optional
+	MaxMigrationFailurePercentage *int `json:"maxMigrationFailurePercentage,omitempty"`
+}
+
+// WorkloadUpdateMaintenanceWindow is a recurring time window in which automated workload updates are allowed
+type WorkloadUpdateMaintenanceWindow struct {
+	// Days of the week the window opens on. An empty list opens the window every day
+	//
+	// +listType=set
+	// +optional
+	Days []WeekDay `json:"days,omitempty"`
+
+	// StartTime is the time of the day in UTC the window opens at, in the format HH:MM
+	StartTime string `json:"startTime"`
+
+	// Duration is how long the window stays open
+	Duration metav1.Duration `json:"duration"`
+}
+
+// +kubebuilder:validation:Enum=Monday;Tuesday;Wednesday;Thursday;Friday;Saturday;Sunday
+type WeekDay string
+
+// WorkloadUpdatePhase is the phase of the rollout of a new virt-launcher to the running workloads
+type WorkloadUpdatePhase string
+
+const (
+	// WorkloadUpdatePhaseCanary means only the canary VMIs are being updated
+	WorkloadUpdatePhaseCanary WorkloadUpdatePhase = "Canary"
+	// WorkloadUpdatePhaseProgressing means all outdated VMIs are being updated
+	WorkloadUpdatePhaseProgressing WorkloadUpdatePhase = "Progressing"
+	// WorkloadUpdatePhaseWaitingForMaintenanceWindow means no maintenance window is open right now
+	WorkloadUpdatePhaseWaitingForMaintenanceWindow WorkloadUpdatePhase = "WaitingForMaintenanceWindow"
+	// WorkloadUpdatePhasePaused means the rollout was paused because too many migrations failed
+	WorkloadUpdatePhasePaused WorkloadUpdatePhase = "Paused"
+	// WorkloadUpdatePhaseCompleted means no VMI which can be updated automatically is outdated anymore
+	WorkloadUpdatePhaseCompleted WorkloadUpdatePhase = "Completed"
+)
+
+// KubeVirtWorkloadUpdateStatus reports the progress of the rollout of a new virt-launcher to the running workloads
+type KubeVirtWorkloadUpdateStatus struct {
+	// LauncherImage is the virt-launcher image the workloads are updated to
+	LauncherImage string `json:"launcherImage,omitempty"`
+	// Phase is the phase of the rollout
+	Phase WorkloadUpdatePhase `json:"phase,omitempty"`
+	// Reason is a human readable explanation of the phase
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// CanaryTarget is the number of VMIs which have to be updated before the rollout continues
+	// +optional
+	CanaryTarget int `json:"canaryTarget,omitempty"`
+	// SucceededMigrations is the number of workload update migrations which succeeded during the rollout
+	SucceededMigrations int `json:"succeededMigrations"`
+	// FailedMigrations is the number of workload update migrations which failed during the rollout
+	FailedMigrations int `json:"failedMigrations"`
+	// Evictions is the number of VMIs evicted during the rollout
+	Evictions int `json:"evictions"`
 }
 
 type KubeVirtSpec struct {
@@ -1928,6 +2138,30 @@ type KubeVirtStatus struct {
 	DefaultArchitecture                     string              `json:"defaultArchitecture,omitempty"`
 	// +listType=atomic
 	Generations []GenerationStatus `json:"generations,omitempty" optional:"true"`
+	// WorkloadUpdateStatus reports the progress of the automated workload updates.
+	// It is only reported when a canary, maintenance windows or a migration failure threshold are configured.
+	WorkloadUpdateStatus *KubeVirtWorkloadUpdateStatus `json:"workloadUpdateStatus,omitempty" optional:"true"`
+	// CPUBaselines are the CPU models and features supported by all schedulable nodes of the
+	// cluster and of each configured CPU baseline node pool.
+	// VMIs requesting the cluster-baseline CPU model are started with them.
+	// +listType=atomic
+	CPUBaselines []CPUBaseline `json:"cpuBaselines,omitempty" optional:"true"`
+}
+
+// CPUBaseline is the CPU model and the CPU features supported by a set of nodes
+type CPUBaseline struct {
+	// NodePool is the name of the CPU baseline node pool, empty for the whole cluster
+	// +optional
+	NodePool string `json:"nodePool,omitempty"`
+	// Model is the most recent CPU model supported by all nodes, empty if there is none
+	// +optional
+	Model string `json:"model,omitempty"`
+	// Features are the CPU features supported by all nodes
+	// +listType=set
+	// +optional
+	Features []string `json:"features,omitempty"`
+	// Nodes is the number of nodes the baseline was computed from
+	Nodes int `json:"nodes"`
 }
 
 // KubeVirtPhase is a label for the phase of a KubeVirt deployment at the current time.
@@ -2109,6 +2343,199 @@ type VirtualMachineInstanceGuestAgentInfo struct {
 	// FSFreezeStatus is the state of the fs of the guest
 	// it can be either frozen or thawed
 	FSFreezeStatus string `json:"fsFreezeStatus,omitempty"`
+	// Disks is a list of the disks and partitions known to the guest
+	// +listType=atomic
+	Disks []VirtualMachineInstanceGuestOSDisk `json:"disks,omitempty"`
+	// CPUStats contains the usage counters of every guest CPU
+	// +listType=atomic
+	CPUStats []VirtualMachineInstanceGuestOSCPUStats `json:"cpuStats,omitempty"`
+	// MemoryBlockInfo contains the memory block information of the guest
+	MemoryBlockInfo *VirtualMachineInstanceGuestOSMemoryBlockInfo `json:"memoryBlockInfo,omitempty"`
+	// Load contains the guest system load averages
+	Load *VirtualMachineInstanceGuestOSLoad `json:"load,omitempty"`
+}
+
+// VirtualMachineInstanceGuestOSDisk represents a disk or partition as seen by the guest
+type VirtualMachineInstanceGuestOSDisk struct {
+	// Name is the path of the block device in the guest, e.g. /dev/vda
+	Name string `json:"name"`
+	// Partition is true if the block device is a partition
+	Partition bool `json:"partition,omitempty"`
+	// Dependencies are the block devices this device depends on, e.g. the disk of a partition
+	// +listType=atomic
+	Dependencies []string `json:"dependencies,omitempty"`
+	// Alias is the name of the device in the guest, e.g. the device mapper name
+	Alias string `json:"alias,omitempty"`
+	// Serial is the serial number of the disk
+	Serial string `json:"serial,omitempty"`
+	// BusType is the bus the disk is attached to, e.g. virtio or scsi
+	BusType string `json:"busType,omitempty"`
+}
+
+// VirtualMachineInstanceGuestOSCPUStats represents the usage counters of a single guest CPU.
+// All times are reported in milliseconds since the guest booted.
+type VirtualMachineInstanceGuestOSCPUStats struct {
+	// CPU is the index of the guest CPU
+	CPU int `json:"cpu"`
+	// User is the time spent in user mode
+	User int64 `json:"user"`
+	// Nice is the time spent in user mode with low priority
+	Nice int64 `json:"nice,omitempty"`
+	// System is the time spent in system mode
+	System int64 `json:"system"`
+	// Idle is the time spent in the idle task
+	Idle int64 `json:"idle"`
+	// IOWait is the time spent waiting for I/O to complete
+	IOWait int64 `json:"iowait,omitempty"`
+	// IRQ is the time spent servicing interrupts
+	IRQ int64 `json:"irq,omitempty"`
+	// SoftIRQ is the time spent servicing softirqs
+	SoftIRQ int64 `json:"softirq,omitempty"`
+	// Steal is the time spent in other operating systems when running in a virtualized environment
+	Steal int64 `json:"steal,omitempty"`
+	// Guest is the time spent running a virtual CPU for guest operating systems
+	Guest int64 `json:"guest,omitempty"`
+	// GuestNice is the time spent running a niced guest
+	GuestNice int64 `json:"guestnice,omitempty"`
+}
+
+// VirtualMachineInstanceGuestOSMemoryBlockInfo represents the memory block information of the guest
+type VirtualMachineInstanceGuestOSMemoryBlockInfo struct {
+	// Size is the size of a memory block in bytes
+	Size int64 `json:"size"`
+}
+
+// VirtualMachineInstanceGuestOSLoad represents the guest system load averages
+type VirtualMachineInstanceGuestOSLoad struct {
+	// Load1m is the load average over the last minute
+	Load1m float64 `json:"load1m"`
+	// Load5m is the load average over the last 5 minutes
+	Load5m float64 `json:"load5m"`
+	// Load15m is the load average over the last 15 minutes
+	Load15m float64 `json:"load15m"`
+}
+
+// VirtualMachineInstanceGuestAgentCommandResultList comprises the results of the custom guest agent commands
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstanceGuestAgentCommandResultList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineInstanceGuestAgentCommandResult `json:"items"`
+}
+
+// VirtualMachineInstanceGuestAgentCommandResult is the latest result of a custom guest agent command
+type VirtualMachineInstanceGuestAgentCommandResult struct {
+	// Command is the name of the guest agent command
+	Command string `json:"command"`
+	// Result is the JSON value the guest agent returned
+	// +optional
+	Result *runtime.RawExtension `json:"result,omitempty"`
+	// Error is the error the guest agent returned, if the command failed
+	// +optional
+	Error string `json:"error,omitempty"`
+	// LastUpdateTime is the time the command was last executed
+	// +optional
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// VirtualMachineInstanceStats is the latest resource usage of a VirtualMachineInstance as reported by the hypervisor
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstanceStats struct {
+	metav1.TypeMeta `json:",inline"`
+	// Timestamp is the time the stats were collected
+	Timestamp metav1.MicroTime `json:"timestamp"`
+	// CPU is the CPU usage of the VirtualMachineInstance
+	// +optional
+	CPU *VirtualMachineInstanceCPUStats `json:"cpu,omitempty"`
+	// Memory is the memory usage of the VirtualMachineInstance
+	// +optional
+	Memory *VirtualMachineInstanceMemoryStats `json:"memory,omitempty"`
+	// Disks is the block device usage of the VirtualMachineInstance
+	// +optional
+	// +listType=atomic
+	Disks []VirtualMachineInstanceDiskStats `json:"disks,omitempty"`
+	// Interfaces is the network interface usage of the VirtualMachineInstance
+	// +optional
+	// +listType=atomic
+	Interfaces []VirtualMachineInstanceInterfaceStats `json:"interfaces,omitempty"`
+}
+
+// VirtualMachineInstanceCPUStats holds the cumulative CPU time of a VirtualMachineInstance
+type VirtualMachineInstanceCPUStats struct {
+	// VCPUs is the number of vCPUs of the domain
+	VCPUs uint `json:"vcpus"`
+	// TimeNanoseconds is the total CPU time consumed by the domain
+	TimeNanoseconds uint64 `json:"timeNanoseconds"`
+	// UserNanoseconds is the CPU time spent in user mode
+	// +optional
+	UserNanoseconds uint64 `json:"userNanoseconds,omitempty"`
+	// SystemNanoseconds is the CPU time spent in kernel mode
+	// +optional
+	SystemNanoseconds uint64 `json:"systemNanoseconds,omitempty"`
+}
+
+// VirtualMachineInstanceMemoryStats holds the memory usage of a VirtualMachineInstance
+type VirtualMachineInstanceMemoryStats struct {
+	// AvailableBytes is the memory visible to the guest
+	// +optional
+	AvailableBytes uint64 `json:"availableBytes,omitempty"`
+	// UnusedBytes is the memory left completely unused by the guest
+	// +optional
+	UnusedBytes uint64 `json:"unusedBytes,omitempty"`
+	// UsableBytes is the memory the guest could reclaim without swapping
+	// +optional
+	UsableBytes uint64 `json:"usableBytes,omitempty"`
+	// ActualBalloonBytes is the current balloon size
+	// +optional
+	ActualBalloonBytes uint64 `json:"actualBalloonBytes,omitempty"`
+	// ResidentBytes is the resident set size of the QEMU process
+	// +optional
+	ResidentBytes uint64 `json:"residentBytes,omitempty"`
+}
+
+// VirtualMachineInstanceDiskStats holds the cumulative I/O counters of a disk
+type VirtualMachineInstanceDiskStats struct {
+	// Name is the name of the disk
+	Name string `json:"name"`
+	// ReadBytes is the number of bytes read
+	ReadBytes uint64 `json:"readBytes"`
+	// ReadRequests is the number of read requests
+	ReadRequests uint64 `json:"readRequests"`
+	// WriteBytes is the number of bytes written
+	WriteBytes uint64 `json:"writeBytes"`
+	// WriteRequests is the number of write requests
+	WriteRequests uint64 `json:"writeRequests"`
+}
+
+// VirtualMachineInstanceInterfaceStats holds the cumulative traffic counters of a network interface
+type VirtualMachineInstanceInterfaceStats struct {
+	// Name is the name of the interface
+	Name string `json:"name"`
+	// RxBytes is the number of bytes received
+	RxBytes uint64 `json:"rxBytes"`
+	// RxPackets is the number of packets received
+	RxPackets uint64 `json:"rxPackets"`
+	// RxErrors is the number of receive errors
+	// +optional
+	RxErrors uint64 `json:"rxErrors,omitempty"`
+	// TxBytes is the number of bytes transmitted
+	TxBytes uint64 `json:"txBytes"`
+	// TxPackets is the number of packets transmitted
+	TxPackets uint64 `json:"txPackets"`
+	// TxErrors is the number of transmit errors
+	// +optional
+	TxErrors uint64 `json:"txErrors,omitempty"`
+}
+
+// VirtualMachineInstanceDomainXML is the libvirt domain XML of a running VirtualMachineInstance
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineInstanceDomainXML struct {
+	metav1.TypeMeta `json:",inline"`
+	// XML is the domain XML, with credentials and launch secrets redacted
+	XML string `json:"xml"`
 }
 
 // List of commands that QEMU guest agent supports
@@ -2245,6 +2672,73 @@ type RemoveVolumeOptions struct {
 	DryRun []string `json:"dryRun,omitempty"`
 }
 
+// DiskIOTuneOptions is provided when changing the I/O limits of a disk of a running VMI
+type DiskIOTuneOptions struct {
+	// Name of the disk whose I/O limits are changed
+	Name string `json:"name"`
+	// IOTune represents the new I/O limits of the disk.
+	// If not set, the disk is no longer throttled.
+	// +optional
+	IOTune *DiskIOTune `json:"ioTune,omitempty"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty"`
+}
+
+// InstancetypeUpgradeOptions is provided when upgrading a VirtualMachine to the latest generation of its
+// instancetype and preference
+type InstancetypeUpgradeOptions struct {
+	metav1.TypeMeta `json:",inline"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty"`
+}
+
+// InstancetypeUpgrade describes how the ControllerRevisions referenced by a VirtualMachine change when the
+// VirtualMachine is upgraded to the latest generation of its instancetype and preference
+type InstancetypeUpgrade struct {
+	metav1.TypeMeta `json:",inline"`
+	// Instancetype describes the upgrade of the instancetype ControllerRevision
+	// +optional
+	Instancetype *RevisionUpgrade `json:"instancetype,omitempty"`
+	// Preference describes the upgrade of the preference ControllerRevision
+	// +optional
+	Preference *RevisionUpgrade `json:"preference,omitempty"`
+}
+
+// RevisionUpgrade describes the upgrade of a single ControllerRevision referenced by a VirtualMachine
+type RevisionUpgrade struct {
+	// CurrentRevisionName is the ControllerRevision referenced by the VirtualMachine before the upgrade
+	CurrentRevisionName string `json:"currentRevisionName"`
+	// CurrentVersion is the API version of the object stored in the current ControllerRevision
+	// +optional
+	CurrentVersion string `json:"currentVersion,omitempty"`
+	// RevisionName is the ControllerRevision referenced by the VirtualMachine after the upgrade.
+	// It equals CurrentRevisionName if the VirtualMachine is already up to date.
+	RevisionName string `json:"revisionName"`
+	// Diff is the JSON merge patch which turns the current spec into the upgraded one
+	// +optional
+	Diff string `json:"diff,omitempty"`
+}
+
+// VirtualMachineResizeOptions is provided when switching a VirtualMachine to another instancetype of the same family
+type VirtualMachineResizeOptions struct {
+	metav1.TypeMeta `json:",inline"`
+	// Instancetype is the name of the instancetype to switch to. It has to be of the same kind and family
+	// as the instancetype currently referenced by the VirtualMachine.
+	Instancetype string `json:"instancetype"`
+}
+
 type TokenBucketRateLimiter struct {
 	// QPS indicates the maximum QPS to the apiserver from this client.
 	// If it's zero, the component default will be used
@@ -2336,6 +2830,53 @@ type KubeVirtConfiguration struct {
 	AutoCPULimitNamespaceLabelSelector *metav1.LabelSelector `json:"autoCPULimitNamespaceLabelSelector,omitempty"`
 	// LiveUpdateConfiguration holds defaults for live update features
 	LiveUpdateConfiguration *LiveUpdateConfiguration `json:"liveUpdateConfiguration,omitempty"`
+	// GuestAgentConfiguration holds the cluster wide configuration of the guest agent polling
+	GuestAgentConfiguration *GuestAgentConfiguration `json:"guestAgentConfiguration,omitempty"`
+	// NodeFailover configures the failover of VirtualMachineInstances running on NotReady nodes.
+	// When unset, VirtualMachineInstances are only failed once virt-handler stops sending heartbeats.
+	NodeFailover *NodeFailoverConfiguration `json:"nodeFailover,omitempty"`
+	// CPUBaselineNodePools split the cluster into pools of nodes which get a CPU baseline of their own.
+	// VMIs requesting the cluster-baseline CPU model use the baseline of the pool whose node selector
+	// is part of their own node selector, and the baseline of the whole cluster otherwise.
+	// +listType=map
+	// +listMapKey=name
+	CPUBaselineNodePools []CPUBaselineNodePool `json:"cpuBaselineNodePools,omitempty"`
+}
+
+// CPUBaselineNodePool is a pool of nodes which gets a CPU baseline of its own
+type CPUBaselineNodePool struct {
+	// Name of the node pool
+	Name string `json:"name"`
+	// NodeSelector selects the nodes of the pool
+	NodeSelector map[string]string `json:"nodeSelector"`
+}
+
+// NodeFailoverConfiguration configures the failover of VirtualMachineInstances running on NotReady nodes
+type NodeFailoverConfiguration struct {
+	// NotReadyTimeout is how long a node has to be NotReady before the
+	// VirtualMachineInstances running on it are failed over.
+	// Defaults to 1m
+	// +optional
+	NotReadyTimeout *metav1.Duration `json:"notReadyTimeout,omitempty"`
+	// RequireFencing only fails over VirtualMachineInstances once the node was fenced, which is
+	// signaled by the node.kubernetes.io/out-of-service taint. Kubernetes then force deletes the
+	// virt-launcher pods on the node and detaches their volumes.
+	// Without fencing the VirtualMachineInstances are failed right away, but their volumes are
+	// still only released once the node is fenced, since the node may still be writing to them.
+	// Defaults to true
+	// +optional
+	RequireFencing *bool `json:"requireFencing,omitempty"`
+}
+
+// GuestAgentConfiguration holds the cluster wide configuration of the guest agent polling
+type GuestAgentConfiguration struct {
+	// CustomCommands is a list of additional read-only guest agent commands (e.g. guest-get-vcpus)
+	// which are executed periodically in every VirtualMachineInstance with a connected guest agent.
+	// Their results are exposed through the guestinfo subresource of the VirtualMachineInstance.
+	// Only guest-info and guest-get-* commands are accepted.
+	// +listType=set
+	// +optional
+	CustomCommands []string `json:"customCommands,omitempty"`
 }
 
 type ArchConfiguration struct {
@@ -2369,6 +2910,8 @@ const (
 	ContainerDisk SupportContainerType = "container-disk"
 	// VirtioFS is the container resources used to attach a virtio-fs volume to the Virtual Machine
 	VirtioFS SupportContainerType = "virtiofs"
+	// VhostUserBlk is the container resources used by the storage plugin sidecar of a vhost-user-blk volume
+	VhostUserBlk SupportContainerType = "vhost-user-blk"
 	// SideCar is the container resources for a side car
 	SideCar SupportContainerType = "sidecar"
 )
@@ -2480,8 +3023,25 @@ type MigrationConfiguration struct {
 	// That will ensure the target virt-launcher doesn't share categories with another pod on the node.
 	// However, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.
 	MatchSELinuxLevelOnMigration *bool `json:"matchSELinuxLevelOnMigration,omitempty"`
+	// ParallelMigrationStreams is the number of parallel connections used to transfer the guest memory.
+	// Using more than one stream switches the migration to multifd. Defaults to a single stream
+	ParallelMigrationStreams *uint32 `json:"parallelMigrationStreams,omitempty"`
+	// Compression is the method used to compress the guest memory pages sent during live migrations.
+	// Zstd compression is only available together with multiple parallel migration streams, while
+	// xbzrle only sends the delta of pages which were already transferred. Defaults to none
+	// +kubebuilder:validation:Enum=none;zstd;xbzrle
+	Compression *MigrationCompression `json:"compression,omitempty"`
 }
 
+// MigrationCompression is the method used to compress the guest memory during live migrations
+type MigrationCompression string
+
+const (
+	MigrationCompressionNone   MigrationCompression = "none"
+	MigrationCompressionZstd   MigrationCompression = "zstd"
+	MigrationCompressionXBZRLE MigrationCompression = "xbzrle"
+)
+
 // DiskVerification holds container disks verification limits
 type DiskVerification struct {
 	MemoryLimit *resource.Quantity `json:"memoryLimit"`
@@ -2537,8 +3097,10 @@ type LogVerbosity struct {
 }
 
 const (
-	PCIResourcePrefix  = "PCI_RESOURCE"
-	MDevResourcePrefix = "MDEV_PCI_RESOURCE"
+	PCIResourcePrefix   = "PCI_RESOURCE"
+	MDevResourcePrefix  = "MDEV_PCI_RESOURCE"
+	InputResourcePrefix = "INPUT_RESOURCE"
+	USBResourcePrefix   = "USB_RESOURCE"
 )
 
 // PermittedHostDevices holds information about devices allowed for passthrough
@@ -2547,6 +3109,10 @@ type PermittedHostDevices struct {
 	PciHostDevices []PciHostDevice `json:"pciHostDevices,omitempty"`
 	// +listType=atomic
 	MediatedDevices []MediatedHostDevice `json:"mediatedDevices,omitempty"`
+	// +listType=atomic
+	InputDevices []InputHostDevice `json:"inputDevices,omitempty"`
+	// +listType=atomic
+	USB []USBHostDevice `json:"usb,omitempty"`
 }
 
 // PciHostDevice represents a host PCI device allowed for passthrough
@@ -2571,6 +3137,46 @@ type MediatedHostDevice struct {
 	ExternalResourceProvider bool   `json:"externalResourceProvider,omitempty"`
 }
 
+// InputHostDevice represents a host evdev input device allowed for passthrough
+type InputHostDevice struct {
+	// EvdevPath is the path of the evdev input device on the host,
+	// preferably a stable one like /dev/input/by-id/usb-Vendor_Keyboard-event-kbd
+	EvdevPath string `json:"evdevPath"`
+	// The name of the resource that is representing the device. Exposed by
+	// a device plugin and requested by VMs.
+	ResourceName string `json:"resourceName"`
+	// If true, KubeVirt will leave the allocation and monitoring to an
+	// external device plugin
+	ExternalResourceProvider bool `json:"externalResourceProvider,omitempty"`
+}
+
+// USBHostDevice represents host USB devices allowed for passthrough
+type USBHostDevice struct {
+	// The name of the resource that is representing the devices. Exposed by
+	// a device plugin and requested by VMs. Typically of the form
+	// vendor.com/product_name
+	ResourceName string `json:"resourceName"`
+	// Selectors of the USB devices exposed as this resource.
+	// Every host USB device matching one of the selectors can be allocated.
+	// +listType=atomic
+	Selectors []USBSelector `json:"selectors,omitempty"`
+	// If true, KubeVirt will leave the allocation and monitoring to an
+	// external device plugin
+	ExternalResourceProvider bool `json:"externalResourceProvider,omitempty"`
+}
+
+// USBSelector selects host USB devices
+type USBSelector struct {
+	// The vendor ID of the USB device, e.g. 046b
+	Vendor string `json:"vendor"`
+	// The product ID of the USB device, e.g. ff10
+	Product string `json:"product"`
+	// BusPath restricts the selector to the device plugged into the given port,
+	// using the sysfs notation <bus>-<port>[.<port>...], e.g. 3-1.2
+	// +optional
+	BusPath string `json:"busPath,omitempty"`
+}
+
 // MediatedDevicesConfiguration holds information about MDEV types to be defined, if available
 type MediatedDevicesConfiguration struct {
 	// Deprecated. Use mediatedDeviceTypes instead.
@@ -2644,6 +3250,84 @@ type ClusterProfilerRequest struct {
 	PageSize      int64  `json:"pageSize"`
 }
 
+// NodeEvacuationAction describes what happens to a VirtualMachineInstance when its node is evacuated
+type NodeEvacuationAction string
+
+const (
+	// NodeEvacuationActionLiveMigrate means the VirtualMachineInstance is live migrated away from the node
+	NodeEvacuationActionLiveMigrate NodeEvacuationAction = "LiveMigrate"
+	// NodeEvacuationActionShutdown means the VirtualMachineInstance is shut down when its pod is evicted
+	NodeEvacuationActionShutdown NodeEvacuationAction = "Shutdown"
+	// NodeEvacuationActionBlocked means the VirtualMachineInstance requires a live migration but is not migratable,
+	// which blocks the eviction of its pod and therefore the drain of the node
+	NodeEvacuationActionBlocked NodeEvacuationAction = "Blocked"
+	// NodeEvacuationActionExternal means the VirtualMachineInstance is marked for eviction and left to an external controller
+	NodeEvacuationActionExternal NodeEvacuationAction = "External"
+)
+
+// NodeEvacuationPhase describes how far the evacuation of a VirtualMachineInstance has progressed
+type NodeEvacuationPhase string
+
+const (
+	// NodeEvacuationPending means the VirtualMachineInstance was not marked for evacuation yet
+	NodeEvacuationPending NodeEvacuationPhase = "Pending"
+	// NodeEvacuationMarked means the VirtualMachineInstance is marked for evacuation and waits for a migration
+	NodeEvacuationMarked NodeEvacuationPhase = "Marked"
+	// NodeEvacuationMigrating means the VirtualMachineInstance is currently being migrated away from the node
+	NodeEvacuationMigrating NodeEvacuationPhase = "Migrating"
+	// NodeEvacuationMigrated means the VirtualMachineInstance was successfully migrated away from the node
+	NodeEvacuationMigrated NodeEvacuationPhase = "Migrated"
+	// NodeEvacuationFailed means the last evacuation migration of the VirtualMachineInstance failed
+	NodeEvacuationFailed NodeEvacuationPhase = "Failed"
+)
+
+// NodeEvacuationPlan lists what happens to every VirtualMachineInstance on a node when the node is evacuated
+// and, once the evacuation was started, how far it has progressed
+type NodeEvacuationPlan struct {
+	metav1.TypeMeta `json:",inline"`
+	// NodeName is the name of the node the plan was computed for
+	NodeName string `json:"nodeName"`
+	// VMIs holds one entry per VirtualMachineInstance affected by the evacuation
+	// +optional
+	// +listType=atomic
+	VMIs []NodeEvacuationPlanEntry `json:"vmis,omitempty"`
+	// EstimatedDataTransfer is the sum of the data estimated to be transferred by all live migrations in the plan
+	// +optional
+	EstimatedDataTransfer *resource.Quantity `json:"estimatedDataTransfer,omitempty"`
+	// Status summarizes the progress of the evacuation
+	// +optional
+	Status *NodeEvacuationPlanStatus `json:"status,omitempty"`
+}
+
+// NodeEvacuationPlanEntry describes the evacuation of a single VirtualMachineInstance
+type NodeEvacuationPlanEntry struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// Action is what happens to the VirtualMachineInstance when the node is evacuated
+	Action NodeEvacuationAction `json:"action"`
+	// EvictionStrategy is the effective eviction strategy of the VirtualMachineInstance
+	// +optional
+	EvictionStrategy *EvictionStrategy `json:"evictionStrategy,omitempty"`
+	// Reason explains why the VirtualMachineInstance can't be live migrated
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// EstimatedDataTransfer is the guest memory plus, for block migrations, the size of the non-shared volumes
+	// +optional
+	EstimatedDataTransfer *resource.Quantity `json:"estimatedDataTransfer,omitempty"`
+	// Phase is the progress of the evacuation of the VirtualMachineInstance
+	// +optional
+	Phase NodeEvacuationPhase `json:"phase,omitempty"`
+}
+
+// NodeEvacuationPlanStatus counts the VirtualMachineInstances of a NodeEvacuationPlan per phase
+type NodeEvacuationPlanStatus struct {
+	Pending   int `json:"pending"`
+	Marked    int `json:"marked"`
+	Migrating int `json:"migrating"`
+	Migrated  int `json:"migrated"`
+	Failed    int `json:"failed"`
+}
+
 type Matcher interface {
 	GetName() string
 	GetRevisionName() string
@@ -80,6 +80,16 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"selinuxContext":                "SELinuxContext is the actual SELinux context of the virt-launcher pod\n+optional",
 		"machine":                       "Machine shows the final resulting qemu machine type. This can be different\nthan the machine type selected in the spec, due to qemus machine type alias mechanism.\n+optional",
 		"currentCPUTopology":            "CurrentCPUTopology specifies the current CPU topology used by the VM workload.\nCurrent topology may differ from the desired topology in the spec while CPU hotplug\ntakes place.",
+		"guestTimeSync":                 "GuestTimeSync reports the last resynchronization of the guest clock through the guest agent\n+optional",
+	}
+}
+
+func (GuestTimeSyncStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "GuestTimeSyncStatus reports the last guest clock resynchronization",
+		"lastSyncTime":      "LastSyncTime is the time of the last successful resynchronization\n+optional",
+		"reason":            "Reason is the event which triggered the last resynchronization\n+optional",
+		"driftMilliseconds": "DriftMilliseconds is the difference between the host and the guest clock measured\nright before the last resynchronization. A positive value means the guest clock was behind.\n+optional",
 	}
 }
 
@@ -269,7 +279,8 @@ func (VirtualMachineInstanceMigrationList) SwaggerDoc() map[string]string {
 
 func (VirtualMachineInstanceMigrationSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"vmiName": "The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace",
+		"vmiName":  "The name of the VMI to perform the migration on. VMI must exist in the migration objects namespace",
+		"priority": "Priority defines the order in which pending migrations are started once the cluster or\nnode migration limits are reached. Defaults to High for evacuations, Low for workload\nupdates and Normal for everything else. High can only be requested by users allowed to\nmigrate in all namespaces\n+kubebuilder:validation:Enum=High;Normal;Low\n+optional",
 	}
 }
 
@@ -333,6 +344,15 @@ func (VirtualMachineSpec) SwaggerDoc() map[string]string {
 		"template":            "Template is the direct specification of VirtualMachineInstance",
 		"dataVolumeTemplates": "dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference.\nDataVolumes in this list are dynamically created for the VirtualMachine and are tied to the VirtualMachine's life-cycle.",
 		"liveUpdateFeatures":  "LiveUpdateFeatures references a configuration of hotpluggable resources",
+		"dependsOn":           "DependsOn lists the VirtualMachines of the same namespace which have to be running before this\nVirtualMachine is started. When stopped together, dependents are stopped before their dependencies.\n+listType=atomic\n+optional",
+	}
+}
+
+func (VirtualMachineDependency) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "VirtualMachineDependency references a VirtualMachine which has to be running before its dependent is started",
+		"name":      "Name of the VirtualMachine in the same namespace",
+		"condition": "Condition the VirtualMachineInstance of the dependency has to reach, Ready if not set\n+optional",
 	}
 }
 
@@ -358,6 +378,17 @@ func (VirtualMachineStatus) SwaggerDoc() map[string]string {
 		"memoryDumpRequest":      "MemoryDumpRequest tracks memory dump request phase and info of getting a memory\ndump to the given pvc\n+nullable\n+optional",
 		"observedGeneration":     "ObservedGeneration is the generation observed by the vmi when started.\n+optional",
 		"desiredGeneration":      "DesiredGeneration is the generation which is desired for the VMI.\nThis will be used in comparisons with ObservedGeneration to understand when\nthe VMI is out of sync. This will be changed at the same time as\nObservedGeneration to remove errors which could occur if Generation is\nupdated through an Update() before ObservedGeneration in Status.\n+optional",
+		"pendingChanges":         "PendingChanges lists the changes of the VM template or of its instancetype\nwhich are not applied to the running VMI yet.\n+listType=atomic\n+optional",
+	}
+}
+
+func (VirtualMachinePendingChange) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "VirtualMachinePendingChange is a difference between the desired spec of a VM and its running VMI",
+		"path":          "Path of the changed field in the VMI spec, e.g. spec.domain.cpu.sockets.\nEntries of named lists are addressed by their name, e.g. spec.volumes[name=data].",
+		"current":       "Current is the JSON encoded value of the field on the running VMI, empty if the field is not set\n+optional",
+		"desired":       "Desired is the JSON encoded value of the field in the VM, empty if the field was removed\n+optional",
+		"liveUpdatable": "LiveUpdatable indicates that the change is applied to the running VMI without a restart",
 	}
 }
 
@@ -449,10 +480,37 @@ func (KubeVirtCertificateRotateStrategy) SwaggerDoc() map[string]string {
 
 func (KubeVirtWorkloadUpdateStrategy) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                      "KubeVirtWorkloadUpdateStrategy defines options related to updating a KubeVirt install",
-		"workloadUpdateMethods": "WorkloadUpdateMethods defines the methods that can be used to disrupt workloads\nduring automated workload updates.\nWhen multiple methods are present, the least disruptive method takes\nprecedence over more disruptive methods. For example if both LiveMigrate and Shutdown\nmethods are listed, only VMs which are not live migratable will be restarted/shutdown\n\nAn empty list defaults to no automated workload updating\n\n+listType=atomic\n+optional",
-		"batchEvictionSize":     "BatchEvictionSize Represents the number of VMIs that can be forced updated per\nthe BatchShutdownInteral interval\n\nDefaults to 10\n\n+optional",
-		"batchEvictionInterval": "BatchEvictionInterval Represents the interval to wait before issuing the next\nbatch of shutdowns\n\nDefaults to 1 minute\n\n+optional",
+		"":                              "KubeVirtWorkloadUpdateStrategy defines options related to updating a KubeVirt install",
+		"workloadUpdateMethods":         "WorkloadUpdateMethods defines the methods that can be used to disrupt workloads\nduring automated workload updates.\nWhen multiple methods are present, the least disruptive method takes\nprecedence over more disruptive methods. For example if both LiveMigrate and Shutdown\nmethods are listed, only VMs which are not live migratable will be restarted/shutdown\n\nAn empty list defaults to no automated workload updating\n\n+listType=atomic\n+optional",
+		"batchEvictionSize":             "BatchEvictionSize Represents the number of VMIs that can be forced updated per\nthe BatchShutdownInteral interval\n\nDefaults to 10\n\n+optional",
+		"batchEvictionInterval":         "BatchEvictionInterval Represents the interval to wait before issuing the next\nbatch of shutdowns\n\nDefaults to 1 minute\n\n+optional",
+		"maintenanceWindows":            "MaintenanceWindows restricts automated workload updates to the given windows.\nOutside of all windows no new migrations or evictions are issued.\n\nAn empty list allows workload updates at any time\n\n+listType=atomic\n+optional",
+		"excludedNamespaces":            "ExcludedNamespaces lists namespaces whose VMIs are never updated automatically\n\n+listType=set\n+optional",
+		"excludedSelector":              "ExcludedSelector selects VMIs by their labels which are never updated automatically\n\n+optional",
+		"canaryPercentage":              "CanaryPercentage is the percentage of the outdated VMIs which are updated first.\nThe rollout only continues to the remaining VMIs once all canaries were updated successfully.\n\nDefaults to 0, which disables the canary phase\n\n+optional",
+		"maxMigrationFailurePercentage": "MaxMigrationFailurePercentage pauses the rollout once the percentage of failed\nworkload update migrations exceeds it. The rollout stays paused until the threshold\nis raised or a new rollout starts.\n\n+optional",
+	}
+}
+
+func (WorkloadUpdateMaintenanceWindow) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "WorkloadUpdateMaintenanceWindow is a recurring time window in which automated workload updates are allowed",
+		"days":      "Days of the week the window opens on. An empty list opens the window every day\n\n+listType=set\n+optional",
+		"startTime": "StartTime is the time of the day in UTC the window opens at, in the format HH:MM",
+		"duration":  "Duration is how long the window stays open",
+	}
+}
+
+func (KubeVirtWorkloadUpdateStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "KubeVirtWorkloadUpdateStatus reports the progress of the rollout of a new virt-launcher to the running workloads",
+		"launcherImage":       "LauncherImage is the virt-launcher image the workloads are updated to",
+		"phase":               "Phase is the phase of the rollout",
+		"reason":              "Reason is a human readable explanation of the phase\n+optional",
+		"canaryTarget":        "CanaryTarget is the number of VMIs which have to be updated before the rollout continues\n+optional",
+		"succeededMigrations": "SucceededMigrations is the number of workload update migrations which succeeded during the rollout",
+		"failedMigrations":    "FailedMigrations is the number of workload update migrations which failed during the rollout",
+		"evictions":           "Evictions is the number of VMIs evicted during the rollout",
 	}
 }
 
@@ -510,8 +568,20 @@ func (GenerationStatus) SwaggerDoc() map[string]string {
 
 func (KubeVirtStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":            "KubeVirtStatus represents information pertaining to a KubeVirt deployment.",
-		"generations": "+listType=atomic",
+		"":                     "KubeVirtStatus represents information pertaining to a KubeVirt deployment.",
+		"generations":          "+listType=atomic",
+		"workloadUpdateStatus": "WorkloadUpdateStatus reports the progress of the automated workload updates.\nIt is only reported when a canary, maintenance windows or a migration failure threshold are configured.",
+		"cpuBaselines":         "CPUBaselines are the CPU models and features supported by all schedulable nodes of the\ncluster and of each configured CPU baseline node pool.\nVMIs requesting the cluster-baseline CPU model are started with them.\n+listType=atomic",
+	}
+}
+
+func (CPUBaseline) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "CPUBaseline is the CPU model and the CPU features supported by a set of nodes",
+		"nodePool": "NodePool is the name of the CPU baseline node pool, empty for the whole cluster\n+optional",
+		"model":    "Model is the most recent CPU model supported by all nodes, empty if there is none\n+optional",
+		"features": "Features are the CPU features supported by all nodes\n+listType=set\n+optional",
+		"nodes":    "Nodes is the number of nodes the baseline was computed from",
 	}
 }
 
@@ -579,6 +649,134 @@ func (VirtualMachineInstanceGuestAgentInfo) SwaggerDoc() map[string]string {
 		"userList":          "UserList is a list of active guest OS users",
 		"fsInfo":            "FSInfo is a guest os filesystem information containing the disk mapping and disk mounts with usage",
 		"fsFreezeStatus":    "FSFreezeStatus is the state of the fs of the guest\nit can be either frozen or thawed",
+		"disks":             "Disks is a list of the disks and partitions known to the guest\n+listType=atomic",
+		"cpuStats":          "CPUStats contains the usage counters of every guest CPU\n+listType=atomic",
+		"memoryBlockInfo":   "MemoryBlockInfo contains the memory block information of the guest",
+		"load":              "Load contains the guest system load averages",
+	}
+}
+
+func (VirtualMachineInstanceGuestOSDisk) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "VirtualMachineInstanceGuestOSDisk represents a disk or partition as seen by the guest",
+		"name":         "Name is the path of the block device in the guest, e.g. /dev/vda",
+		"partition":    "Partition is true if the block device is a partition",
+		"dependencies": "Dependencies are the block devices this device depends on, e.g. the disk of a partition\n+listType=atomic",
+		"alias":        "Alias is the name of the device in the guest, e.g. the device mapper name",
+		"serial":       "Serial is the serial number of the disk",
+		"busType":      "BusType is the bus the disk is attached to, e.g. virtio or scsi",
+	}
+}
+
+func (VirtualMachineInstanceGuestOSCPUStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "VirtualMachineInstanceGuestOSCPUStats represents the usage counters of a single guest CPU.\nAll times are reported in milliseconds since the guest booted.",
+		"cpu":       "CPU is the index of the guest CPU",
+		"user":      "User is the time spent in user mode",
+		"nice":      "Nice is the time spent in user mode with low priority",
+		"system":    "System is the time spent in system mode",
+		"idle":      "Idle is the time spent in the idle task",
+		"iowait":    "IOWait is the time spent waiting for I/O to complete",
+		"irq":       "IRQ is the time spent servicing interrupts",
+		"softirq":   "SoftIRQ is the time spent servicing softirqs",
+		"steal":     "Steal is the time spent in other operating systems when running in a virtualized environment",
+		"guest":     "Guest is the time spent running a virtual CPU for guest operating systems",
+		"guestnice": "GuestNice is the time spent running a niced guest",
+	}
+}
+
+func (VirtualMachineInstanceGuestOSMemoryBlockInfo) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "VirtualMachineInstanceGuestOSMemoryBlockInfo represents the memory block information of the guest",
+		"size": "Size is the size of a memory block in bytes",
+	}
+}
+
+func (VirtualMachineInstanceGuestOSLoad) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "VirtualMachineInstanceGuestOSLoad represents the guest system load averages",
+		"load1m":  "Load1m is the load average over the last minute",
+		"load5m":  "Load5m is the load average over the last 5 minutes",
+		"load15m": "Load15m is the load average over the last 15 minutes",
+	}
+}
+
+func (VirtualMachineInstanceGuestAgentCommandResultList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineInstanceGuestAgentCommandResultList comprises the results of the custom guest agent commands\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineInstanceGuestAgentCommandResult) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VirtualMachineInstanceGuestAgentCommandResult is the latest result of a custom guest agent command",
+		"command":        "Command is the name of the guest agent command",
+		"result":         "Result is the JSON value the guest agent returned\n+optional",
+		"error":          "Error is the error the guest agent returned, if the command failed\n+optional",
+		"lastUpdateTime": "LastUpdateTime is the time the command was last executed\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "VirtualMachineInstanceStats is the latest resource usage of a VirtualMachineInstance as reported by the hypervisor\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"timestamp":  "Timestamp is the time the stats were collected",
+		"cpu":        "CPU is the CPU usage of the VirtualMachineInstance\n+optional",
+		"memory":     "Memory is the memory usage of the VirtualMachineInstance\n+optional",
+		"disks":      "Disks is the block device usage of the VirtualMachineInstance\n+optional\n+listType=atomic",
+		"interfaces": "Interfaces is the network interface usage of the VirtualMachineInstance\n+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineInstanceCPUStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "VirtualMachineInstanceCPUStats holds the cumulative CPU time of a VirtualMachineInstance",
+		"vcpus":             "VCPUs is the number of vCPUs of the domain",
+		"timeNanoseconds":   "TimeNanoseconds is the total CPU time consumed by the domain",
+		"userNanoseconds":   "UserNanoseconds is the CPU time spent in user mode\n+optional",
+		"systemNanoseconds": "SystemNanoseconds is the CPU time spent in kernel mode\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceMemoryStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "VirtualMachineInstanceMemoryStats holds the memory usage of a VirtualMachineInstance",
+		"availableBytes":     "AvailableBytes is the memory visible to the guest\n+optional",
+		"unusedBytes":        "UnusedBytes is the memory left completely unused by the guest\n+optional",
+		"usableBytes":        "UsableBytes is the memory the guest could reclaim without swapping\n+optional",
+		"actualBalloonBytes": "ActualBalloonBytes is the current balloon size\n+optional",
+		"residentBytes":      "ResidentBytes is the resident set size of the QEMU process\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceDiskStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "VirtualMachineInstanceDiskStats holds the cumulative I/O counters of a disk",
+		"name":          "Name is the name of the disk",
+		"readBytes":     "ReadBytes is the number of bytes read",
+		"readRequests":  "ReadRequests is the number of read requests",
+		"writeBytes":    "WriteBytes is the number of bytes written",
+		"writeRequests": "WriteRequests is the number of write requests",
+	}
+}
+
+func (VirtualMachineInstanceInterfaceStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "VirtualMachineInstanceInterfaceStats holds the cumulative traffic counters of a network interface",
+		"name":      "Name is the name of the interface",
+		"rxBytes":   "RxBytes is the number of bytes received",
+		"rxPackets": "RxPackets is the number of packets received",
+		"rxErrors":  "RxErrors is the number of receive errors\n+optional",
+		"txBytes":   "TxBytes is the number of bytes transmitted",
+		"txPackets": "TxPackets is the number of packets transmitted",
+		"txErrors":  "TxErrors is the number of transmit errors\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceDomainXML) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":    "VirtualMachineInstanceDomainXML is the libvirt domain XML of a running VirtualMachineInstance\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"xml": "XML is the domain XML, with credentials and launch secrets redacted",
 	}
 }
 
@@ -663,6 +861,47 @@ func (RemoveVolumeOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (DiskIOTuneOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "DiskIOTuneOptions is provided when changing the I/O limits of a disk of a running VMI",
+		"name":   "Name of the disk whose I/O limits are changed",
+		"ioTune": "IOTune represents the new I/O limits of the disk.\nIf not set, the disk is no longer throttled.\n+optional",
+		"dryRun": "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
+func (InstancetypeUpgradeOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "InstancetypeUpgradeOptions is provided when upgrading a VirtualMachine to the latest generation of its\ninstancetype and preference",
+		"dryRun": "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
+func (InstancetypeUpgrade) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "InstancetypeUpgrade describes how the ControllerRevisions referenced by a VirtualMachine change when the\nVirtualMachine is upgraded to the latest generation of its instancetype and preference",
+		"instancetype": "Instancetype describes the upgrade of the instancetype ControllerRevision\n+optional",
+		"preference":   "Preference describes the upgrade of the preference ControllerRevision\n+optional",
+	}
+}
+
+func (RevisionUpgrade) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "RevisionUpgrade describes the upgrade of a single ControllerRevision referenced by a VirtualMachine",
+		"currentRevisionName": "CurrentRevisionName is the ControllerRevision referenced by the VirtualMachine before the upgrade",
+		"currentVersion":      "CurrentVersion is the API version of the object stored in the current ControllerRevision\n+optional",
+		"revisionName":        "RevisionName is the ControllerRevision referenced by the VirtualMachine after the upgrade.\nIt equals CurrentRevisionName if the VirtualMachine is already up to date.",
+		"diff":                "Diff is the JSON merge patch which turns the current spec into the upgraded one\n+optional",
+	}
+}
+
+func (VirtualMachineResizeOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "VirtualMachineResizeOptions is provided when switching a VirtualMachine to another instancetype of the same family",
+		"instancetype": "Instancetype is the name of the instancetype to switch to. It has to be of the same kind and family\nas the instancetype currently referenced by the VirtualMachine.",
+	}
+}
+
 func (TokenBucketRateLimiter) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"qps":   "QPS indicates the maximum QPS to the apiserver from this client.\nIf it's zero, the component default will be used",
@@ -700,6 +939,32 @@ func (KubeVirtConfiguration) SwaggerDoc() map[string]string {
 		"ksmConfiguration":                   "KSMConfiguration holds the information regarding the enabling the KSM in the nodes (if available).",
 		"autoCPULimitNamespaceLabelSelector": "When set, AutoCPULimitNamespaceLabelSelector will set a CPU limit on virt-launcher for VMIs running inside\nnamespaces that match the label selector.\nThe CPU limit will equal the number of requested vCPUs.\nThis setting does not apply to VMIs with dedicated CPUs.",
 		"liveUpdateConfiguration":            "LiveUpdateConfiguration holds defaults for live update features",
+		"guestAgentConfiguration":            "GuestAgentConfiguration holds the cluster wide configuration of the guest agent polling",
+		"nodeFailover":                       "NodeFailover configures the failover of VirtualMachineInstances running on NotReady nodes.\nWhen unset, VirtualMachineInstances are only failed once virt-handler stops sending heartbeats.",
+		"cpuBaselineNodePools":               "CPUBaselineNodePools split the cluster into pools of nodes which get a CPU baseline of their own.\nVMIs requesting the cluster-baseline CPU model use the baseline of the pool whose node selector\nis part of their own node selector, and the baseline of the whole cluster otherwise.\n+listType=map\n+listMapKey=name",
+	}
+}
+
+func (CPUBaselineNodePool) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "CPUBaselineNodePool is a pool of nodes which gets a CPU baseline of its own",
+		"name":         "Name of the node pool",
+		"nodeSelector": "NodeSelector selects the nodes of the pool",
+	}
+}
+
+func (NodeFailoverConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "NodeFailoverConfiguration configures the failover of VirtualMachineInstances running on NotReady nodes",
+		"notReadyTimeout": "NotReadyTimeout is how long a node has to be NotReady before the\nVirtualMachineInstances running on it are failed over.\nDefaults to 1m\n+optional",
+		"requireFencing":  "RequireFencing only fails over VirtualMachineInstances once the node was fenced, which is\nsignaled by the node.kubernetes.io/out-of-service taint. Kubernetes then force deletes the\nvirt-launcher pods on the node and detaches their volumes.\nWithout fencing the VirtualMachineInstances are failed right away, but their volumes are\nstill only released once the node is fenced, since the node may still be writing to them.\nDefaults to true\n+optional",
+	}
+}
+
+func (GuestAgentConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "GuestAgentConfiguration holds the cluster wide configuration of the guest agent polling",
+		"customCommands": "CustomCommands is a list of additional read-only guest agent commands (e.g. guest-get-vcpus)\nwhich are executed periodically in every VirtualMachineInstance with a connected guest agent.\nTheir results are exposed through the guestinfo subresource of the VirtualMachineInstance.\nOnly guest-info and guest-get-* commands are accepted.\n+listType=set\n+optional",
 	}
 }
 
@@ -774,6 +1039,8 @@ func (MigrationConfiguration) SwaggerDoc() map[string]string {
 		"disableTLS":                        "When set to true, DisableTLS will disable the additional layer of live migration encryption\nprovided by KubeVirt. This is usually a bad idea. Defaults to false",
 		"network":                           "Network is the name of the CNI network to use for live migrations. By default, migrations go\nthrough the pod network.",
 		"matchSELinuxLevelOnMigration":      "By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.\nWhen set to true, MatchSELinuxLevelOnMigration lets the CRI auto-assign a random level to the target.\nThat will ensure the target virt-launcher doesn't share categories with another pod on the node.\nHowever, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.",
+		"parallelMigrationStreams":          "ParallelMigrationStreams is the number of parallel connections used to transfer the guest memory.\nUsing more than one stream switches the migration to multifd. Defaults to a single stream",
+		"compression":                       "Compression is the method used to compress the guest memory pages sent during live migrations.\nZstd compression is only available together with multiple parallel migration streams, while\nxbzrle only sends the delta of pages which were already transferred. Defaults to none\n+kubebuilder:validation:Enum=none;zstd;xbzrle",
 	}
 }
 
@@ -809,6 +1076,8 @@ func (PermittedHostDevices) SwaggerDoc() map[string]string {
 		"":                "PermittedHostDevices holds information about devices allowed for passthrough",
 		"pciHostDevices":  "+listType=atomic",
 		"mediatedDevices": "+listType=atomic",
+		"inputDevices":    "+listType=atomic",
+		"usb":             "+listType=atomic",
 	}
 }
 
@@ -827,6 +1096,33 @@ func (MediatedHostDevice) SwaggerDoc() map[string]string {
 	}
 }
 
+func (InputHostDevice) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                         "InputHostDevice represents a host evdev input device allowed for passthrough",
+		"evdevPath":                "EvdevPath is the path of the evdev input device on the host,\npreferably a stable one like /dev/input/by-id/usb-Vendor_Keyboard-event-kbd",
+		"resourceName":             "The name of the resource that is representing the device. Exposed by\na device plugin and requested by VMs.",
+		"externalResourceProvider": "If true, KubeVirt will leave the allocation and monitoring to an\nexternal device plugin",
+	}
+}
+
+func (USBHostDevice) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                         "USBHostDevice represents host USB devices allowed for passthrough",
+		"resourceName":             "The name of the resource that is representing the devices. Exposed by\na device plugin and requested by VMs. Typically of the form\nvendor.com/product_name",
+		"selectors":                "Selectors of the USB devices exposed as this resource.\nEvery host USB device matching one of the selectors can be allocated.\n+listType=atomic",
+		"externalResourceProvider": "If true, KubeVirt will leave the allocation and monitoring to an\nexternal device plugin",
+	}
+}
+
+func (USBSelector) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "USBSelector selects host USB devices",
+		"vendor":  "The vendor ID of the USB device, e.g. 046b",
+		"product": "The product ID of the USB device, e.g. ff10",
+		"busPath": "BusPath restricts the selector to the device plugged into the given port,\nusing the sysfs notation <bus>-<port>[.<port>...], e.g. 3-1.2\n+optional",
+	}
+}
+
 func (MediatedDevicesConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                        "MediatedDevicesConfiguration holds information about MDEV types to be defined, if available",
@@ -882,6 +1178,33 @@ func (ClusterProfilerRequest) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
 
+func (NodeEvacuationPlan) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                      "NodeEvacuationPlan lists what happens to every VirtualMachineInstance on a node when the node is evacuated\nand, once the evacuation was started, how far it has progressed",
+		"nodeName":              "NodeName is the name of the node the plan was computed for",
+		"vmis":                  "VMIs holds one entry per VirtualMachineInstance affected by the evacuation\n+optional\n+listType=atomic",
+		"estimatedDataTransfer": "EstimatedDataTransfer is the sum of the data estimated to be transferred by all live migrations in the plan\n+optional",
+		"status":                "Status summarizes the progress of the evacuation\n+optional",
+	}
+}
+
+func (NodeEvacuationPlanEntry) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                      "NodeEvacuationPlanEntry describes the evacuation of a single VirtualMachineInstance",
+		"action":                "Action is what happens to the VirtualMachineInstance when the node is evacuated",
+		"evictionStrategy":      "EvictionStrategy is the effective eviction strategy of the VirtualMachineInstance\n+optional",
+		"reason":                "Reason explains why the VirtualMachineInstance can't be live migrated\n+optional",
+		"estimatedDataTransfer": "EstimatedDataTransfer is the guest memory plus, for block migrations, the size of the non-shared volumes\n+optional",
+		"phase":                 "Phase is the progress of the evacuation of the VirtualMachineInstance\n+optional",
+	}
+}
+
+func (NodeEvacuationPlanStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "NodeEvacuationPlanStatus counts the VirtualMachineInstances of a NodeEvacuationPlan per phase",
+	}
+}
+
 func (InstancetypeMatcher) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                "InstancetypeMatcher references a instancetype that is used to fill fields in the VMI template.",
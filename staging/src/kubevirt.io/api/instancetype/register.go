@@ -43,6 +43,10 @@ const (
 	DefaultPreferenceKindLabel   = "instancetype.kubevirt.io/default-preference-kind"
 )
 
+// InstancetypeFamilyLabel groups instancetypes which only differ in size, a VirtualMachine can be resized
+// between the members of a family
+const InstancetypeFamilyLabel = "instancetype.kubevirt.io/family"
+
 const (
 	ControllerRevisionObjectGenerationLabel = "instancetype.kubevirt.io/object-generation"
 	ControllerRevisionObjectKindLabel       = "instancetype.kubevirt.io/object-kind"
@@ -67,3 +67,7 @@ func Convert_v1beta1_MemoryInstancetype_To_v1alpha2_MemoryInstancetype(in *v1bet
 func Convert_v1beta1_VirtualMachineInstancetypeSpec_To_v1alpha2_VirtualMachineInstancetypeSpec(in *v1beta1.VirtualMachineInstancetypeSpec, out *VirtualMachineInstancetypeSpec, s conversion.Scope) error {
 	return autoConvert_v1beta1_VirtualMachineInstancetypeSpec_To_v1alpha2_VirtualMachineInstancetypeSpec(in, out, s)
 }
+
+func Convert_v1beta1_VolumePreferences_To_v1alpha2_VolumePreferences(in *v1beta1.VolumePreferences, out *VolumePreferences, s conversion.Scope) error {
+	return autoConvert_v1beta1_VolumePreferences_To_v1alpha2_VolumePreferences(in, out, s)
+}
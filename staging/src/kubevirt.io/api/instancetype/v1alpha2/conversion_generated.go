@@ -188,11 +188,6 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1beta1.VolumePreferences)(nil), (*VolumePreferences)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1beta1_VolumePreferences_To_v1alpha2_VolumePreferences(a.(*v1beta1.VolumePreferences), b.(*VolumePreferences), scope)
-	}); err != nil {
-		return err
-	}
 	if err := s.AddGeneratedConversionFunc((*VolumePreferences)(nil), (*v1beta1.VolumePreferences)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_VolumePreferences_To_v1beta1_VolumePreferences(a.(*VolumePreferences), b.(*v1beta1.VolumePreferences), scope)
 	}); err != nil {
@@ -228,6 +223,11 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*v1beta1.VolumePreferences)(nil), (*VolumePreferences)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VolumePreferences_To_v1alpha2_VolumePreferences(a.(*v1beta1.VolumePreferences), b.(*VolumePreferences), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -331,6 +331,7 @@ func autoConvert_v1beta1_DevicePreferences_To_v1alpha2_DevicePreferences(in *v1b
 	out.PreferredNetworkInterfaceMultiQueue = (*bool)(unsafe.Pointer(in.PreferredNetworkInterfaceMultiQueue))
 	out.PreferredTPM = (*corev1.TPMDevice)(unsafe.Pointer(in.PreferredTPM))
 	// WARNING: in.PreferredInterfaceMasquerade requires manual conversion: does not exist in peer-type
+	// WARNING: in.PreferredInterfaceBinding requires manual conversion: does not exist in peer-type
 	return nil
 }
 
@@ -790,7 +791,15 @@ func autoConvert_v1beta1_VirtualMachinePreferenceSpec_To_v1alpha2_VirtualMachine
 	out.Features = (*FeaturePreferences)(unsafe.Pointer(in.Features))
 	out.Firmware = (*FirmwarePreferences)(unsafe.Pointer(in.Firmware))
 	out.Machine = (*MachinePreferences)(unsafe.Pointer(in.Machine))
-	out.Volumes = (*VolumePreferences)(unsafe.Pointer(in.Volumes))
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = new(VolumePreferences)
+		if err := Convert_v1beta1_VolumePreferences_To_v1alpha2_VolumePreferences(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Volumes = nil
+	}
 	// WARNING: in.PreferredSubdomain requires manual conversion: does not exist in peer-type
 	// WARNING: in.PreferredTerminationGracePeriodSeconds requires manual conversion: does not exist in peer-type
 	// WARNING: in.Requirements requires manual conversion: does not exist in peer-type
@@ -820,7 +829,15 @@ func autoConvert_v1alpha2_VirtualMachinePreferenceSpec_To_v1beta1_VirtualMachine
 	out.Features = (*v1beta1.FeaturePreferences)(unsafe.Pointer(in.Features))
 	out.Firmware = (*v1beta1.FirmwarePreferences)(unsafe.Pointer(in.Firmware))
 	out.Machine = (*v1beta1.MachinePreferences)(unsafe.Pointer(in.Machine))
-	out.Volumes = (*v1beta1.VolumePreferences)(unsafe.Pointer(in.Volumes))
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = new(v1beta1.VolumePreferences)
+		if err := Convert_v1alpha2_VolumePreferences_To_v1beta1_VolumePreferences(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Volumes = nil
+	}
 	return nil
 }
 
@@ -831,14 +848,10 @@ func Convert_v1alpha2_VirtualMachinePreferenceSpec_To_v1beta1_VirtualMachinePref
 
 func autoConvert_v1beta1_VolumePreferences_To_v1alpha2_VolumePreferences(in *v1beta1.VolumePreferences, out *VolumePreferences, s conversion.Scope) error {
 	out.PreferredStorageClassName = in.PreferredStorageClassName
+	// WARNING: in.PreferredAccessMode requires manual conversion: does not exist in peer-type
 	return nil
 }
 
-// Convert_v1beta1_VolumePreferences_To_v1alpha2_VolumePreferences is an autogenerated conversion function.
-func Convert_v1beta1_VolumePreferences_To_v1alpha2_VolumePreferences(in *v1beta1.VolumePreferences, out *VolumePreferences, s conversion.Scope) error {
-	return autoConvert_v1beta1_VolumePreferences_To_v1alpha2_VolumePreferences(in, out, s)
-}
-
 func autoConvert_v1alpha2_VolumePreferences_To_v1beta1_VolumePreferences(in *VolumePreferences, out *v1beta1.VolumePreferences, s conversion.Scope) error {
 	out.PreferredStorageClassName = in.PreferredStorageClassName
 	return nil
@@ -213,6 +213,11 @@ func (in *DevicePreferences) DeepCopyInto(out *DevicePreferences) {
 		*out = new(v1.InterfaceMasquerade)
 		**out = **in
 	}
+	if in.PreferredInterfaceBinding != nil {
+		in, out := &in.PreferredInterfaceBinding, &out.PreferredInterfaceBinding
+		*out = new(v1.PluginBinding)
+		**out = **in
+	}
 	return
 }
 
@@ -20,6 +20,7 @@
 package v1beta1
 
 import (
+	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -282,6 +283,11 @@ type VolumePreferences struct {
 	//
 	//+optional
 	PreferredStorageClassName string `json:"preferredStorageClassName,omitempty"`
+
+	// PreferredAccessMode optionally defines the preferred access mode of DataVolumeTemplates not requesting one
+	//
+	//+optional
+	PreferredAccessMode k8sv1.PersistentVolumeAccessMode `json:"preferredAccessMode,omitempty"`
 }
 
 // PreferredCPUTopology defines a preferred CPU topology to be exposed to the guest
@@ -435,6 +441,11 @@ type DevicePreferences struct {
 	//
 	// +optional
 	PreferredInterfaceMasquerade *v1.InterfaceMasquerade `json:"preferredInterfaceMasquerade,omitempty"`
+
+	// PreferredInterfaceBinding optionally defines the preferred binding plugin to use with each network interface.
+	//
+	// +optional
+	PreferredInterfaceBinding *v1.PluginBinding `json:"preferredInterfaceBinding,omitempty"`
 }
 
 // FeaturePreferences contains various optional defaults for Features.
@@ -110,6 +110,7 @@ func (VirtualMachinePreferenceSpec) SwaggerDoc() map[string]string {
 func (VolumePreferences) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"preferredStorageClassName": "PreffereedStorageClassName optionally defines the preferred storageClass\n\n+optional",
+		"preferredAccessMode":       "PreferredAccessMode optionally defines the preferred access mode of DataVolumeTemplates not requesting one\n\n+optional",
 	}
 }
 
@@ -148,6 +149,7 @@ func (DevicePreferences) SwaggerDoc() map[string]string {
 		"preferredNetworkInterfaceMultiQueue": "PreferredNetworkInterfaceMultiQueue optionally enables the vhost multiqueue feature for virtio interfaces.\n\n+optional",
 		"preferredTPM":                        "PreferredTPM optionally defines the preferred TPM device to be used.\n\n+optional",
 		"preferredInterfaceMasquerade":        "PreferredInterfaceMasquerade optionally defines the preferred masquerade configuration to use with each network interface.\n\n+optional",
+		"preferredInterfaceBinding":           "PreferredInterfaceBinding optionally defines the preferred binding plugin to use with each network interface.\n\n+optional",
 	}
 }
 
@@ -51,6 +51,10 @@ type MigrationPolicySpec struct {
 	CompletionTimeoutPerGiB *int64 `json:"completionTimeoutPerGiB,omitempty"`
 	//+optional
 	AllowPostCopy *bool `json:"allowPostCopy,omitempty"`
+	//+optional
+	ParallelMigrationStreams *uint32 `json:"parallelMigrationStreams,omitempty"`
+	//+optional
+	Compression *k6tv1.MigrationCompression `json:"compression,omitempty"`
 }
 
 type LabelSelector map[string]string
@@ -99,6 +103,16 @@ func (m *MigrationPolicy) GetMigrationConfByPolicy(clusterMigrationConfiguration
 		changed = true
 		*clusterMigrationConfigurations.AllowPostCopy = *policySpec.AllowPostCopy
 	}
+	if policySpec.ParallelMigrationStreams != nil {
+		changed = true
+		parallelMigrationStreams := *policySpec.ParallelMigrationStreams
+		clusterMigrationConfigurations.ParallelMigrationStreams = &parallelMigrationStreams
+	}
+	if policySpec.Compression != nil {
+		changed = true
+		compression := *policySpec.Compression
+		clusterMigrationConfigurations.Compression = &compression
+	}
 
 	return changed, nil
 }
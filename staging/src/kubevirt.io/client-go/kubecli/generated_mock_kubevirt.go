@@ -275,6 +275,16 @@ func (_mr *_MockKubevirtClientRecorder) ExpandSpec(arg0 interface{}) *gomock.Cal
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ExpandSpec", arg0)
 }
 
+func (_m *MockKubevirtClient) NodeEvacuation() NodeEvacuationInterface {
+	ret := _m.ctrl.Call(_m, "NodeEvacuation")
+	ret0, _ := ret[0].(NodeEvacuationInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) NodeEvacuation() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "NodeEvacuation")
+}
+
 func (_m *MockKubevirtClient) ServerVersion() ServerVersionInterface {
 	ret := _m.ctrl.Call(_m, "ServerVersion")
 	ret0, _ := ret[0].(ServerVersionInterface)
@@ -1131,6 +1141,17 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) VNC(arg0 interface{}) *
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VNC", arg0)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) SharedVNC(name string) (StreamInterface, error) {
+	ret := _m.ctrl.Call(_m, "SharedVNC", name)
+	ret0, _ := ret[0].(StreamInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) SharedVNC(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SharedVNC", arg0)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) Screenshot(ctx context.Context, name string, options *v120.ScreenshotOptions) ([]byte, error) {
 	ret := _m.ctrl.Call(_m, "Screenshot", ctx, name, options)
 	ret0, _ := ret[0].([]byte)
@@ -1236,6 +1257,39 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) FilesystemList(arg0, ar
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "FilesystemList", arg0, arg1)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) GuestAgentCommandResults(ctx context.Context, name string) (v120.VirtualMachineInstanceGuestAgentCommandResultList, error) {
+	ret := _m.ctrl.Call(_m, "GuestAgentCommandResults", ctx, name)
+	ret0, _ := ret[0].(v120.VirtualMachineInstanceGuestAgentCommandResultList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) GuestAgentCommandResults(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestAgentCommandResults", arg0, arg1)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) Stats(ctx context.Context, name string) (*v120.VirtualMachineInstanceStats, error) {
+	ret := _m.ctrl.Call(_m, "Stats", ctx, name)
+	ret0, _ := ret[0].(*v120.VirtualMachineInstanceStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) Stats(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "Stats", arg0, arg1)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) DomainXML(ctx context.Context, name string) (*v120.VirtualMachineInstanceDomainXML, error) {
+	ret := _m.ctrl.Call(_m, "DomainXML", ctx, name)
+	ret0, _ := ret[0].(*v120.VirtualMachineInstanceDomainXML)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) DomainXML(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DomainXML", arg0, arg1)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) AddVolume(ctx context.Context, name string, addVolumeOptions *v120.AddVolumeOptions) error {
 	ret := _m.ctrl.Call(_m, "AddVolume", ctx, name, addVolumeOptions)
 	ret0, _ := ret[0].(error)
@@ -1256,6 +1310,16 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) RemoveVolume(arg0, arg1
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveVolume", arg0, arg1, arg2)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) SetIOTune(ctx context.Context, name string, ioTuneOptions *v120.DiskIOTuneOptions) error {
+	ret := _m.ctrl.Call(_m, "SetIOTune", ctx, name, ioTuneOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) SetIOTune(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetIOTune", arg0, arg1, arg2)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) VSOCK(name string, options *v120.VSOCKOptions) (StreamInterface, error) {
 	ret := _m.ctrl.Call(_m, "VSOCK", name, options)
 	ret0, _ := ret[0].(StreamInterface)
@@ -1739,6 +1803,37 @@ func (_mr *_MockVirtualMachineInterfaceRecorder) RemoveVolume(arg0, arg1, arg2 i
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveVolume", arg0, arg1, arg2)
 }
 
+func (_m *MockVirtualMachineInterface) SetIOTune(ctx context.Context, name string, ioTuneOptions *v120.DiskIOTuneOptions) error {
+	ret := _m.ctrl.Call(_m, "SetIOTune", ctx, name, ioTuneOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) SetIOTune(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetIOTune", arg0, arg1, arg2)
+}
+
+func (_m *MockVirtualMachineInterface) UpgradeInstancetype(ctx context.Context, name string, upgradeOptions *v120.InstancetypeUpgradeOptions) (*v120.InstancetypeUpgrade, error) {
+	ret := _m.ctrl.Call(_m, "UpgradeInstancetype", ctx, name, upgradeOptions)
+	ret0, _ := ret[0].(*v120.InstancetypeUpgrade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) UpgradeInstancetype(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "UpgradeInstancetype", arg0, arg1, arg2)
+}
+
+func (_m *MockVirtualMachineInterface) Resize(ctx context.Context, name string, resizeOptions *v120.VirtualMachineResizeOptions) error {
+	ret := _m.ctrl.Call(_m, "Resize", ctx, name, resizeOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) Resize(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "Resize", arg0, arg1, arg2)
+}
+
 func (_m *MockVirtualMachineInterface) PortForward(name string, port int, protocol string) (StreamInterface, error) {
 	ret := _m.ctrl.Call(_m, "PortForward", name, port, protocol)
 	ret0, _ := ret[0].(StreamInterface)
@@ -2059,3 +2154,46 @@ func (_m *MockExpandSpecInterface) ForVirtualMachine(vm *v120.VirtualMachine) (*
 func (_mr *_MockExpandSpecInterfaceRecorder) ForVirtualMachine(arg0 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ForVirtualMachine", arg0)
 }
+
+// Mock of NodeEvacuationInterface interface
+type MockNodeEvacuationInterface struct {
+	ctrl     *gomock.Controller
+	recorder *_MockNodeEvacuationInterfaceRecorder
+}
+
+// Recorder for MockNodeEvacuationInterface (not exported)
+type _MockNodeEvacuationInterfaceRecorder struct {
+	mock *MockNodeEvacuationInterface
+}
+
+func NewMockNodeEvacuationInterface(ctrl *gomock.Controller) *MockNodeEvacuationInterface {
+	mock := &MockNodeEvacuationInterface{ctrl: ctrl}
+	mock.recorder = &_MockNodeEvacuationInterfaceRecorder{mock}
+	return mock
+}
+
+func (_m *MockNodeEvacuationInterface) EXPECT() *_MockNodeEvacuationInterfaceRecorder {
+	return _m.recorder
+}
+
+func (_m *MockNodeEvacuationInterface) Plan(ctx context.Context, nodeName string) (*v120.NodeEvacuationPlan, error) {
+	ret := _m.ctrl.Call(_m, "Plan", ctx, nodeName)
+	ret0, _ := ret[0].(*v120.NodeEvacuationPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockNodeEvacuationInterfaceRecorder) Plan(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "Plan", arg0, arg1)
+}
+
+func (_m *MockNodeEvacuationInterface) Evacuate(ctx context.Context, nodeName string) (*v120.NodeEvacuationPlan, error) {
+	ret := _m.ctrl.Call(_m, "Evacuate", ctx, nodeName)
+	ret0, _ := ret[0].(*v120.NodeEvacuationPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockNodeEvacuationInterfaceRecorder) Evacuate(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "Evacuate", arg0, arg1)
+}
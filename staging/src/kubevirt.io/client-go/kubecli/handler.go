@@ -21,6 +21,7 @@ const (
 	consoleTemplateURI        = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/console"
 	usbredirTemplateURI       = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/usbredir"
 	vncTemplateURI            = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vnc"
+	sharedVNCTemplateURI      = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vnc?shared=true"
 	vsockTemplateURI          = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vsock"
 	pauseTemplateURI          = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/pause"
 	unpauseTemplateURI        = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/unpause"
@@ -31,6 +32,10 @@ const (
 	userListTemplateURI       = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/userlist"
 	filesystemListTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/filesystemlist"
 
+	guestAgentCommandResultsTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestinfo"
+	statsTemplateURI                    = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/stats"
+	domainXMLTemplateURI                = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/domainxml"
+
 	sevFetchCertChainTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/fetchcertchain"
 	sevQueryLaunchMeasurementTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/querylaunchmeasurement"
 	sevInjectLaunchSecretTemplateURI     = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/injectlaunchsecret"
@@ -56,6 +61,7 @@ type VirtHandlerConn interface {
 	ConsoleURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	USBRedirURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	VNCURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	SharedVNCURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	VSOCKURI(vmi *virtv1.VirtualMachineInstance, port string, tls string) (string, error)
 	PauseURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	UnpauseURI(vmi *virtv1.VirtualMachineInstance) (string, error)
@@ -71,6 +77,9 @@ type VirtHandlerConn interface {
 	GuestInfoURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	UserListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	FilesystemListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	GuestAgentCommandResultsURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	StatsURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	DomainXMLURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 }
 
 type virtHandler struct {
@@ -185,6 +194,10 @@ func (v *virtHandlerConn) VNCURI(vmi *virtv1.VirtualMachineInstance) (string, er
 	return v.formatURI(vncTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) SharedVNCURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(sharedVNCTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) VSOCKURI(vmi *virtv1.VirtualMachineInstance, port string, tls string) (string, error) {
 	baseURI, err := v.formatURI(vsockTemplateURI, vmi)
 	if err != nil {
@@ -288,6 +301,18 @@ func (v *virtHandlerConn) FilesystemListURI(vmi *virtv1.VirtualMachineInstance)
 	return v.formatURI(filesystemListTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) GuestAgentCommandResultsURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(guestAgentCommandResultsTemplateURI, vmi)
+}
+
+func (v *virtHandlerConn) StatsURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(statsTemplateURI, vmi)
+}
+
+func (v *virtHandlerConn) DomainXMLURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(domainXMLTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) SEVFetchCertChainURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(sevFetchCertChainTemplateURI, vmi)
 }
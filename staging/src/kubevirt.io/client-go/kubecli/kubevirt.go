@@ -78,6 +78,7 @@ type KubevirtClient interface {
 	VirtualMachineClusterPreference() instancetypev1beta1.VirtualMachineClusterPreferenceInterface
 	MigrationPolicy() migrationsv1.MigrationPolicyInterface
 	ExpandSpec(namespace string) ExpandSpecInterface
+	NodeEvacuation() NodeEvacuationInterface
 	ServerVersion() ServerVersionInterface
 	VirtualMachineClone(namespace string) clonev1alpha1.VirtualMachineCloneInterface
 	ClusterProfiler() *ClusterProfiler
@@ -239,6 +240,7 @@ type VirtualMachineInstanceInterface interface {
 	SerialConsole(name string, options *SerialConsoleOptions) (StreamInterface, error)
 	USBRedir(vmiName string) (StreamInterface, error)
 	VNC(name string) (StreamInterface, error)
+	SharedVNC(name string) (StreamInterface, error)
 	Screenshot(ctx context.Context, name string, options *v1.ScreenshotOptions) ([]byte, error)
 	PortForward(name string, port int, protocol string) (StreamInterface, error)
 	Pause(ctx context.Context, name string, pauseOptions *v1.PauseOptions) error
@@ -249,8 +251,12 @@ type VirtualMachineInstanceInterface interface {
 	GuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error)
 	UserList(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestOSUserList, error)
 	FilesystemList(ctx context.Context, name string) (v1.VirtualMachineInstanceFileSystemList, error)
+	GuestAgentCommandResults(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentCommandResultList, error)
+	Stats(ctx context.Context, name string) (*v1.VirtualMachineInstanceStats, error)
+	DomainXML(ctx context.Context, name string) (*v1.VirtualMachineInstanceDomainXML, error)
 	AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error
 	RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v1.RemoveVolumeOptions) error
+	SetIOTune(ctx context.Context, name string, ioTuneOptions *v1.DiskIOTuneOptions) error
 	VSOCK(name string, options *v1.VSOCKOptions) (StreamInterface, error)
 	SEVFetchCertChain(name string) (v1.SEVPlatformInfo, error)
 	SEVQueryLaunchMeasurement(name string) (v1.SEVMeasurementInfo, error)
@@ -300,6 +306,9 @@ type VirtualMachineInterface interface {
 	Migrate(ctx context.Context, name string, migrateOptions *v1.MigrateOptions) error
 	AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error
 	RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v1.RemoveVolumeOptions) error
+	SetIOTune(ctx context.Context, name string, ioTuneOptions *v1.DiskIOTuneOptions) error
+	UpgradeInstancetype(ctx context.Context, name string, upgradeOptions *v1.InstancetypeUpgradeOptions) (*v1.InstancetypeUpgrade, error)
+	Resize(ctx context.Context, name string, resizeOptions *v1.VirtualMachineResizeOptions) error
 	PortForward(name string, port int, protocol string) (StreamInterface, error)
 	MemoryDump(ctx context.Context, name string, memoryDumpRequest *v1.VirtualMachineMemoryDumpRequest) error
 	RemoveMemoryDump(ctx context.Context, name string) error
@@ -334,3 +343,8 @@ type ServerVersionInterface interface {
 type ExpandSpecInterface interface {
 	ForVirtualMachine(vm *v1.VirtualMachine) (*v1.VirtualMachine, error)
 }
+
+type NodeEvacuationInterface interface {
+	Plan(ctx context.Context, nodeName string) (*v1.NodeEvacuationPlan, error)
+	Evacuate(ctx context.Context, nodeName string) (*v1.NodeEvacuationPlan, error)
+}
@@ -0,0 +1,71 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package kubecli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/client-go/rest"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+func (k *kubevirt) NodeEvacuation() NodeEvacuationInterface {
+	return &nodeEvacuation{
+		restClient: k.restClient,
+		resource:   "nodes",
+	}
+}
+
+type nodeEvacuation struct {
+	restClient *rest.RESTClient
+	resource   string
+}
+
+// Plan returns what would happen to the VirtualMachineInstances on the node if it was evacuated,
+// together with the progress of an already started evacuation.
+func (n *nodeEvacuation) Plan(ctx context.Context, nodeName string) (*v1.NodeEvacuationPlan, error) {
+	return n.decodePlan(n.restClient.Get().AbsPath(n.uri(nodeName, "evacuationplan")).Do(ctx))
+}
+
+// Evacuate marks all VirtualMachineInstances on the node which live migrate on eviction for evacuation
+// and returns the resulting plan.
+func (n *nodeEvacuation) Evacuate(ctx context.Context, nodeName string) (*v1.NodeEvacuationPlan, error) {
+	return n.decodePlan(n.restClient.Put().AbsPath(n.uri(nodeName, "evacuate")).Do(ctx))
+}
+
+func (n *nodeEvacuation) decodePlan(result rest.Result) (*v1.NodeEvacuationPlan, error) {
+	data, err := result.Raw()
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &v1.NodeEvacuationPlan{}
+	if err := json.Unmarshal(data, plan); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+func (n *nodeEvacuation) uri(nodeName, subresource string) string {
+	return fmt.Sprintf("/apis/"+v1.SubresourceGroupName+"/%s/%s/%s/%s", v1.ApiStorageVersion, n.resource, nodeName, subresource)
+}
@@ -0,0 +1,91 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package kubecli
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("Kubevirt NodeEvacuation Client", func() {
+
+	var server *ghttp.Server
+	nodePath := fmt.Sprintf("/apis/subresources.kubevirt.io/%s/nodes/node01", v1.SubresourceStorageGroupVersion.Version)
+	proxyPath := "/proxy/path"
+
+	plan := &v1.NodeEvacuationPlan{
+		NodeName: "node01",
+		VMIs: []v1.NodeEvacuationPlanEntry{
+			{Namespace: "default", Name: "testvmi", Action: v1.NodeEvacuationActionLiveMigrate, Phase: v1.NodeEvacuationPending},
+		},
+	}
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+	})
+
+	DescribeTable("should fetch the evacuation plan of a node", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", path.Join(proxyPath, nodePath, "evacuationplan")),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, plan),
+		))
+		fetchedPlan, err := client.NodeEvacuation().Plan(context.Background(), "node01")
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fetchedPlan).To(Equal(plan))
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
+	DescribeTable("should evacuate a node", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("PUT", path.Join(proxyPath, nodePath, "evacuate")),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, plan),
+		))
+		evacuationPlan, err := client.NodeEvacuation().Evacuate(context.Background(), "node01")
+
+		Expect(server.ReceivedRequests()).To(HaveLen(1))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(evacuationPlan).To(Equal(plan))
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
+	AfterEach(func() {
+		server.Close()
+	})
+})
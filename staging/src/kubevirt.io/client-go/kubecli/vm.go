@@ -282,6 +282,49 @@ func (v *vm) RemoveVolume(ctx context.Context, name string, removeVolumeOptions
 	return v.restClient.Put().AbsPath(uri).Body([]byte(JSON)).Do(ctx).Error()
 }
 
+func (v *vm) SetIOTune(ctx context.Context, name string, ioTuneOptions *v1.DiskIOTuneOptions) error {
+	uri := fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion, v.namespace, name, "iotune")
+
+	JSON, err := json.Marshal(ioTuneOptions)
+
+	if err != nil {
+		return err
+	}
+
+	return v.restClient.Put().AbsPath(uri).Body([]byte(JSON)).Do(ctx).Error()
+}
+
+func (v *vm) UpgradeInstancetype(ctx context.Context, name string, upgradeOptions *v1.InstancetypeUpgradeOptions) (*v1.InstancetypeUpgrade, error) {
+	uri := fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion, v.namespace, name, "upgrade-instancetype")
+
+	JSON, err := json.Marshal(upgradeOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := v.restClient.Put().AbsPath(uri).Body(JSON).Do(ctx).Raw()
+	if err != nil {
+		return nil, err
+	}
+
+	upgrade := &v1.InstancetypeUpgrade{}
+	if err := json.Unmarshal(data, upgrade); err != nil {
+		return nil, err
+	}
+	return upgrade, nil
+}
+
+func (v *vm) Resize(ctx context.Context, name string, resizeOptions *v1.VirtualMachineResizeOptions) error {
+	uri := fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion, v.namespace, name, "resize")
+
+	JSON, err := json.Marshal(resizeOptions)
+	if err != nil {
+		return err
+	}
+
+	return v.restClient.Put().AbsPath(uri).Body(JSON).Do(ctx).Error()
+}
+
 func (v *vm) PortForward(name string, port int, protocol string) (StreamInterface, error) {
 	return asyncSubresourceHelper(v.config, v.resource, v.namespace, name, buildPortForwardResourcePath(port, protocol), url.Values{})
 }
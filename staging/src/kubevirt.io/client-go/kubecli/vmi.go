@@ -171,6 +171,13 @@ func (v *vmis) VNC(name string) (StreamInterface, error) {
 	return asyncSubresourceHelper(v.config, v.resource, v.namespace, name, "vnc", url.Values{})
 }
 
+// SharedVNC opens a VNC connection which does not close the other shared connections of the VMI
+func (v *vmis) SharedVNC(name string) (StreamInterface, error) {
+	queryParams := url.Values{}
+	queryParams.Add("shared", "true")
+	return asyncSubresourceHelper(v.config, v.resource, v.namespace, name, "vnc", queryParams)
+}
+
 func (v *vmis) PortForward(name string, port int, protocol string) (StreamInterface, error) {
 	return asyncSubresourceHelper(v.config, v.resource, v.namespace, name, buildPortForwardResourcePath(port, protocol), url.Values{})
 }
@@ -454,6 +461,27 @@ func (v *vmis) FilesystemList(ctx context.Context, name string) (v1.VirtualMachi
 	return fsList, err
 }
 
+func (v *vmis) GuestAgentCommandResults(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentCommandResultList, error) {
+	results := v1.VirtualMachineInstanceGuestAgentCommandResultList{}
+	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "guestinfo")
+	err := v.restClient.Get().AbsPath(uri).Do(ctx).Into(&results)
+	return results, err
+}
+
+func (v *vmis) Stats(ctx context.Context, name string) (*v1.VirtualMachineInstanceStats, error) {
+	stats := &v1.VirtualMachineInstanceStats{}
+	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "stats")
+	err := v.restClient.Get().AbsPath(uri).Do(ctx).Into(stats)
+	return stats, err
+}
+
+func (v *vmis) DomainXML(ctx context.Context, name string) (*v1.VirtualMachineInstanceDomainXML, error) {
+	domainXML := &v1.VirtualMachineInstanceDomainXML{}
+	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "domainxml")
+	err := v.restClient.Get().AbsPath(uri).Do(ctx).Into(domainXML)
+	return domainXML, err
+}
+
 func (v *vmis) Screenshot(ctx context.Context, name string, screenshotOptions *v1.ScreenshotOptions) ([]byte, error) {
 	moveCursor := "false"
 	if screenshotOptions.MoveCursor == true {
@@ -493,6 +521,18 @@ func (v *vmis) RemoveVolume(ctx context.Context, name string, removeVolumeOption
 	return v.restClient.Put().AbsPath(uri).Body([]byte(JSON)).Do(ctx).Error()
 }
 
+func (v *vmis) SetIOTune(ctx context.Context, name string, ioTuneOptions *v1.DiskIOTuneOptions) error {
+	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "iotune")
+
+	JSON, err := json.Marshal(ioTuneOptions)
+
+	if err != nil {
+		return err
+	}
+
+	return v.restClient.Put().AbsPath(uri).Body([]byte(JSON)).Do(ctx).Error()
+}
+
 func (v *vmis) VSOCK(name string, options *v1.VSOCKOptions) (StreamInterface, error) {
 	if options == nil || options.TargetPort == 0 {
 		return nil, fmt.Errorf("target port is required but not provided")
@@ -13,7 +13,9 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 
 	_ "kubevirt.io/kubevirt/pkg/monitoring/configuration"
+	"kubevirt.io/kubevirt/pkg/monitoring/deviceplugin"
 	domainstats "kubevirt.io/kubevirt/pkg/monitoring/domainstats/prometheus" // import for prometheus metrics
+	migrationproxy "kubevirt.io/kubevirt/pkg/monitoring/migrationproxy"
 	_ "kubevirt.io/kubevirt/pkg/virt-controller/watch"
 )
 
@@ -144,6 +146,36 @@ func getMetricsNotIncludeInEndpointByDefault() metricList {
 			description: "The rate at which the disk is being transferred.",
 			mType:       "Gauge",
 		},
+		{
+			name:        migrationproxy.TransferredBytesMetricName,
+			description: "Amount of bytes piped through the migration proxy, broken down by vmi uid, role, channel, connection and direction.",
+			mType:       "Counter",
+		},
+		{
+			name:        migrationproxy.ActiveConnectionsMetricName,
+			description: "Amount of open migration proxy connections, broken down by vmi uid, role and channel.",
+			mType:       "Gauge",
+		},
+		{
+			name:        deviceplugin.HealthyMetricName,
+			description: "Indication for a device plugin of virt-handler which is registered with the kubelet and advertises healthy devices, broken down by node and resource name.",
+			mType:       "Gauge",
+		},
+		{
+			name:        deviceplugin.RestartsMetricName,
+			description: "Amount of restarts of a device plugin of virt-handler, broken down by node and resource name.",
+			mType:       "Counter",
+		},
+		{
+			name:        deviceplugin.AllocatableMetricName,
+			description: "Amount of devices of a device plugin of virt-handler which the kubelet reports as allocatable, broken down by node and resource name.",
+			mType:       "Gauge",
+		},
+		{
+			name:        deviceplugin.AllocatedMetricName,
+			description: "Amount of devices of a device plugin of virt-handler which are allocated to pods, broken down by node and resource name.",
+			mType:       "Gauge",
+		},
 		{
 			name:        "kubevirt_vmi_phase_count",
 			description: "Sum of VMIs per phase and node. `phase` can be one of the following: [`Pending`, `Scheduling`, `Scheduled`, `Running`, `Succeeded`, `Failed`, `Unknown`].",
@@ -174,6 +206,11 @@ func getMetricsNotIncludeInEndpointByDefault() metricList {
 			description: "Histogram of VM phase transitions duration from deletion time in seconds.",
 			mType:       "Histogram",
 		},
+		{
+			name:        "kubevirt_vmi_node_failovers_total",
+			description: "Total number of VirtualMachineInstances which were failed over because their node was NotReady.",
+			mType:       "Counter",
+		},
 		{
 			name:        "kubevirt_virt_operator_leading_status",
 			description: "Indication for an operating virt-operator.",
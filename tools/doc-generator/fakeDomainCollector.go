@@ -66,13 +66,27 @@ func (fc fakeDomainCollector) Collect(ch chan<- prometheus.Metric) {
 		},
 	}
 
+	guestInfo := &k6tv1.VirtualMachineInstanceGuestAgentInfo{
+		Load: &k6tv1.VirtualMachineInstanceGuestOSLoad{
+			Load1m:  0.5,
+			Load5m:  0.3,
+			Load15m: 0.1,
+		},
+		CPUStats: []k6tv1.VirtualMachineInstanceGuestOSCPUStats{
+			{CPU: 0, User: 1000, System: 500, Idle: 10000},
+		},
+		MemoryBlockInfo: &k6tv1.VirtualMachineInstanceGuestOSMemoryBlockInfo{
+			Size: 134217728,
+		},
+	}
+
 	vmi := k6tv1.VirtualMachineInstance{
 		Status: k6tv1.VirtualMachineInstanceStatus{
 			Phase:    k6tv1.Running,
 			NodeName: "test",
 		},
 	}
-	ps.Report("test", &vmi, &domainstats.VirtualMachineInstanceStats{DomainStats: &out, FsStats: fs})
+	ps.Report("test", &vmi, &domainstats.VirtualMachineInstanceStats{DomainStats: &out, FsStats: fs, GuestInfo: guestInfo})
 }
 
 type fakeDomainIdentifier struct {